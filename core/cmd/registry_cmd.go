@@ -13,6 +13,7 @@ import (
 	. "github.com/river-build/river/core/node/base"
 	"github.com/river-build/river/core/node/crypto"
 	"github.com/river-build/river/core/node/infra"
+	"github.com/river-build/river/core/node/nodes"
 	. "github.com/river-build/river/core/node/protocol"
 	"github.com/river-build/river/core/node/registries"
 	. "github.com/river-build/river/core/node/shared"
@@ -193,6 +194,111 @@ func blockNumber(cfg *config.Config) error {
 	return nil
 }
 
+type rebalanceOpts struct {
+	apply             bool
+	batchSize         int
+	maxStreamsPerNode int
+	replFactor        int
+	keyfile           string
+}
+
+func srRebalance(cfg *config.Config, opts *rebalanceOpts) error {
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+
+	var wallet *crypto.Wallet
+	if opts.apply {
+		var err error
+		wallet, err = crypto.LoadWallet(ctx, opts.keyfile)
+		if err != nil {
+			return err
+		}
+	}
+
+	blockchain, err := crypto.NewBlockchain(ctx, &cfg.RiverChain, wallet, infra.NewMetricsFactory(nil, "river", "cmdline"), nil)
+	if err != nil {
+		return err
+	}
+
+	registryContract, err := registries.NewRiverRegistryContract(ctx, blockchain, &cfg.RegistryContract)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Using block number: %d\n", blockchain.InitialBlockNum)
+
+	replFactor := opts.replFactor
+	if replFactor <= 0 {
+		chainConfig, err := crypto.NewOnChainConfig(
+			ctx, blockchain.Client, registryContract.Address, blockchain.InitialBlockNum, blockchain.ChainMonitor)
+		if err != nil {
+			return err
+		}
+		replFactor = int(chainConfig.Get().ReplicationFactor)
+	}
+
+	streams, err := registryContract.GetAllStreams(ctx, blockchain.InitialBlockNum)
+	if err != nil {
+		return err
+	}
+
+	nodeRecords, err := registryContract.GetAllNodes(ctx, blockchain.InitialBlockNum)
+	if err != nil {
+		return err
+	}
+
+	changes, err := nodes.PlanStreamRebalance(streams, nodeRecords, nodes.RebalanceParams{
+		ReplicationFactor: replFactor,
+		MaxStreamsPerNode: opts.maxStreamsPerNode,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Streams: %d, nodes: %d, replication factor: %d, changes: %d\n",
+		len(streams), len(nodeRecords), replFactor, len(changes))
+
+	if !opts.apply {
+		for _, c := range changes {
+			op := "remove"
+			if c.IsAdded {
+				op = "place "
+			}
+			fmt.Printf("%s %s %s\n", op, c.StreamId.String(), c.Node.Hex())
+		}
+		return nil
+	}
+
+	blockchain.StartChainMonitor(ctx)
+
+	batchSize := max(1, opts.batchSize)
+	for start := 0; start < len(changes); start += batchSize {
+		batch := changes[start:min(start+batchSize, len(changes))]
+
+		pending := make([]crypto.TransactionPoolPendingTransaction, len(batch))
+		for i, c := range batch {
+			pending[i], err = registryContract.UpdateStreamPlacement(ctx, c.StreamId, c.Node, c.IsAdded)
+			if err != nil {
+				return err
+			}
+		}
+
+		failed := 0
+		for i, tx := range pending {
+			receipt := <-tx.Wait()
+			if receipt == nil || receipt.Status != crypto.TransactionResultSuccess {
+				failed++
+				fmt.Printf("FAILED %s %s %s\n", batch[i].StreamId.String(), batch[i].Node.Hex(), tx.TransactionHash().Hex())
+			}
+		}
+
+		fmt.Printf("Applied %d/%d changes\n", start+len(batch), len(changes))
+		if failed > 0 {
+			return RiverError(Err_ERR_UNSPECIFIED, "Stream placement transactions failed", "failed", failed)
+		}
+	}
+
+	return nil
+}
+
 func init() {
 	srCmd := &cobra.Command{
 		Use:     "registry",
@@ -240,6 +346,47 @@ func init() {
 		},
 	})
 
+	rebalanceCmd := &cobra.Command{
+		Use:   "rebalance",
+		Short: "Plan and apply stream placement changes to balance streams over operational nodes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				opts rebalanceOpts
+				err  error
+			)
+			if opts.apply, err = cmd.Flags().GetBool("apply"); err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+			if opts.apply && dryRun {
+				return fmt.Errorf("--apply and --dry-run are mutually exclusive")
+			}
+			if opts.batchSize, err = cmd.Flags().GetInt("batch-size"); err != nil {
+				return err
+			}
+			if opts.maxStreamsPerNode, err = cmd.Flags().GetInt("max-streams-per-node"); err != nil {
+				return err
+			}
+			if opts.replFactor, err = cmd.Flags().GetInt("replication-factor"); err != nil {
+				return err
+			}
+			if opts.keyfile, err = cmd.Flags().GetString("keyfile"); err != nil {
+				return err
+			}
+			return srRebalance(cmdConfig, &opts)
+		},
+	}
+	rebalanceCmd.Flags().Bool("dry-run", false, "Only print planned changes (default)")
+	rebalanceCmd.Flags().Bool("apply", false, "Submit planned changes to the stream registry")
+	rebalanceCmd.Flags().Int("batch-size", 50, "Number of transactions submitted before waiting for receipts")
+	rebalanceCmd.Flags().Int("max-streams-per-node", 0, "Max streams per node, if 0 streams are spread evenly")
+	rebalanceCmd.Flags().Int("replication-factor", 0, "Replication factor, if 0 on-chain setting is used")
+	rebalanceCmd.Flags().String("keyfile", crypto.WALLET_PATH_PRIVATE_KEY, "Wallet key file used to submit changes")
+	srCmd.AddCommand(rebalanceCmd)
+
 	srCmd.AddCommand(&cobra.Command{
 		Use:     "blocknumber",
 		Aliases: []string{"bn"},
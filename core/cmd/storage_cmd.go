@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/rpc"
+
+	"github.com/spf13/cobra"
+)
+
+func runStorageFsck(cfg *config.Config, repair bool) error {
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+
+	problems, err := rpc.RunStorageFsck(ctx, cfg, repair)
+	if err != nil {
+		return err
+	}
+
+	unrepaired := 0
+	for _, p := range problems {
+		if p.Repaired {
+			fmt.Printf("REPAIRED %s\n", p)
+		} else {
+			fmt.Printf("PROBLEM  %s\n", p)
+			unrepaired++
+		}
+	}
+	fmt.Printf("Problems found: %d, repaired: %d\n", len(problems), len(problems)-unrepaired)
+
+	if unrepaired > 0 {
+		return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Storage has unrepaired problems", "count", unrepaired)
+	}
+	return nil
+}
+
+func init() {
+	storageCmd := &cobra.Command{
+		Use:   "storage",
+		Short: "Local storage management commands",
+	}
+	rootCmd.AddCommand(storageCmd)
+
+	fsckCmd := &cobra.Command{
+		Use:   "fsck",
+		Short: "Check consistency of all streams in local storage. Node must be stopped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			repair, err := cmd.Flags().GetBool("repair")
+			if err != nil {
+				return err
+			}
+			return runStorageFsck(cmdConfig, repair)
+		},
+	}
+	fsckCmd.Flags().Bool("repair", false, "Rebuild broken streams from peers and drop orphaned tables")
+	storageCmd.AddCommand(fsckCmd)
+}
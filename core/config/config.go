@@ -72,6 +72,22 @@ type Config struct {
 	// Storage
 	Database    DatabaseConfig
 	StorageType string
+	// Used if StorageType is "embedded".
+	EmbeddedStorage EmbeddedStorageConfig
+	// If set, local storage of a stream is deleted when this node is removed from the stream.
+	PurgeRemovedStreams bool
+	// Used if StorageType is "postgres" to move historical miniblocks out of the database.
+	ColdStorage ColdStorageConfig
+	// Used if StorageType is "postgres" to compress stored miniblocks and events.
+	StorageCompression StorageCompressionConfig
+	// MiniblockPruneInterval is how often miniblocks outside of the on-chain retention window are pruned.
+	// If 0, default to 1 hour. Miniblocks are never pruned in archive mode.
+	MiniblockPruneInterval time.Duration
+	// SyncSessionTTL is how long the state of a streams sync session is kept after the client disconnected,
+	// so the client can continue the session with ResumeSync. If 0, default to 10 minutes.
+	SyncSessionTTL time.Duration
+	// SyncBuffer limits buffering of updates for clients of streams sync sessions that fall behind.
+	SyncBuffer SyncBufferConfig
 
 	// Blockchain configuration
 	BaseChain  ChainConfig
@@ -166,6 +182,10 @@ type NetworkConfig struct {
 
 	// If unset or <= 0, 5 seconds is used.
 	HttpRequestTimeout time.Duration
+
+	// MaxGetStreamsIds is the maximum number of stream ids in a single GetStreams request.
+	// If unset or <= 0, 100 is used.
+	MaxGetStreamsIds int
 }
 
 func (nc *NetworkConfig) GetHttpRequestTimeout() time.Duration {
@@ -175,6 +195,43 @@ func (nc *NetworkConfig) GetHttpRequestTimeout() time.Duration {
 	return nc.HttpRequestTimeout
 }
 
+func (nc *NetworkConfig) GetMaxGetStreamsIds() int {
+	if nc.MaxGetStreamsIds <= 0 {
+		return 100
+	}
+	return nc.MaxGetStreamsIds
+}
+
+const (
+	// SyncBufferPolicyReset replaces buffered updates of the stream with a sync reset when the buffer is full.
+	SyncBufferPolicyReset = "reset"
+	// SyncBufferPolicyCancel cancels the sync session when the buffer is full.
+	SyncBufferPolicyCancel = "cancel"
+)
+
+type SyncBufferConfig struct {
+	// MaxEvents is the maximum number of events buffered for the client of a sync session.
+	// Buffered updates of the same stream are coalesced. If 0, default to 4096.
+	MaxEvents int
+	// OverflowPolicy is applied when the buffer is full, either "reset" or "cancel".
+	// If empty, default to "reset".
+	OverflowPolicy string
+}
+
+func (c *SyncBufferConfig) GetMaxEvents() int {
+	if c.MaxEvents <= 0 {
+		return 4096
+	}
+	return c.MaxEvents
+}
+
+func (c *SyncBufferConfig) GetOverflowPolicy() string {
+	if c.OverflowPolicy == "" {
+		return SyncBufferPolicyReset
+	}
+	return c.OverflowPolicy
+}
+
 type DatabaseConfig struct {
 	Url                       string `dlog:"omit" json:"-" yaml:"-"` // Sensitive data, omitted from logging.
 	Host                      string
@@ -192,6 +249,97 @@ type DatabaseConfig struct {
 	StartupDelay time.Duration
 }
 
+type EmbeddedStorageConfig struct {
+	// Path is the directory where embedded storage keeps its data.
+	// Only a single node process can use the directory at a time.
+	Path string
+}
+
+// ColdStorageConfig configures the cold tier that keeps miniblocks older than the latest snapshot
+// in content-addressed files instead of the database.
+type ColdStorageConfig struct {
+	// Type is "filesystem" or "s3". Cold tier is disabled if empty.
+	Type string
+	// Path is the directory used by the "filesystem" cold tier.
+	Path string
+	// S3 is used by the "s3" cold tier.
+	S3 S3Config
+
+	// MoveInterval is the time between runs moving miniblocks to the cold tier. If 0, default to 10 minutes.
+	MoveInterval time.Duration
+	// MoveBatchSize is the max number of miniblocks moved per stream in a single run. If 0, default to 100.
+	MoveBatchSize int
+}
+
+func (c *ColdStorageConfig) GetMoveInterval() time.Duration {
+	if c.MoveInterval <= 0 {
+		return 10 * time.Minute
+	}
+	return c.MoveInterval
+}
+
+func (c *ColdStorageConfig) GetMoveBatchSize() int {
+	if c.MoveBatchSize <= 0 {
+		return 100
+	}
+	return c.MoveBatchSize
+}
+
+// StorageCompressionConfig configures zstd compression of miniblocks and events stored in the database.
+// Compressed and uncompressed data can be mixed, so compression can be turned on and off at any time.
+type StorageCompressionConfig struct {
+	// Enabled turns on compression of newly written data.
+	Enabled bool
+	// Level is zstd compression level from 1 (fastest) to 4 (best). If 0, default to 3.
+	Level int
+	// RewriteExisting turns on background job compressing miniblocks written before compression was enabled.
+	RewriteExisting bool
+	// RewriteInterval is the time between runs of the rewrite job. If 0, default to 10 minutes.
+	RewriteInterval time.Duration
+	// RewriteBatchSize is the max number of miniblocks compressed per stream in a single run. If 0, default to 100.
+	RewriteBatchSize int
+}
+
+func (c *StorageCompressionConfig) GetLevel() int {
+	if c.Level <= 0 {
+		return 3
+	}
+	return c.Level
+}
+
+func (c *StorageCompressionConfig) GetRewriteInterval() time.Duration {
+	if c.RewriteInterval <= 0 {
+		return 10 * time.Minute
+	}
+	return c.RewriteInterval
+}
+
+func (c *StorageCompressionConfig) GetRewriteBatchSize() int {
+	if c.RewriteBatchSize <= 0 {
+		return 100
+	}
+	return c.RewriteBatchSize
+}
+
+// S3Config configures access to S3-compatible object storage, such as AWS S3 or MinIO.
+type S3Config struct {
+	// Endpoint is the URL of the service, i.e. https://s3.us-east-1.amazonaws.com or http://localhost:9000.
+	// Path-style addressing is used: objects are stored at Endpoint/Bucket/Prefix/key.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyId     string
+	SecretAccessKey string `dlog:"omit" json:"-" yaml:"-"` // Sensitive data, omitted from logging.
+}
+
+func (c *S3Config) GetRegion() string {
+	if c.Region == "" {
+		return "us-east-1"
+	}
+	return c.Region
+}
+
 func (c DatabaseConfig) GetUrl() string {
 	if c.Host != "" {
 		return fmt.Sprintf(
@@ -390,6 +538,20 @@ func (c *Config) GetGraffiti() string {
 	return c.Graffiti
 }
 
+func (c *Config) GetMiniblockPruneInterval() time.Duration {
+	if c.MiniblockPruneInterval <= 0 {
+		return time.Hour
+	}
+	return c.MiniblockPruneInterval
+}
+
+func (c *Config) GetSyncSessionTTL() time.Duration {
+	if c.SyncSessionTTL <= 0 {
+		return 10 * time.Minute
+	}
+	return c.SyncSessionTTL
+}
+
 func (c *Config) GetEntitlementContractAddress() common.Address {
 	return c.EntitlementContract.Address
 }
@@ -111,6 +111,44 @@ func (args *ChainAuthArgs) String() string {
 	)
 }
 
+func (k chainAuthKind) String() string {
+	switch k {
+	case chainAuthKindSpace:
+		return "space"
+	case chainAuthKindChannel:
+		return "channel"
+	case chainAuthKindSpaceEnabled:
+		return "space_enabled"
+	case chainAuthKindChannelEnabled:
+		return "channel_enabled"
+	case chainAuthKindIsSpaceMember:
+		return "is_space_member"
+	default:
+		return "unknown"
+	}
+}
+
+// Kind returns the name of the check kind, e.g. "space" or "channel".
+func (args *ChainAuthArgs) Kind() string {
+	return args.kind.String()
+}
+
+func (args *ChainAuthArgs) SpaceId() shared.StreamId {
+	return args.spaceId
+}
+
+func (args *ChainAuthArgs) ChannelId() shared.StreamId {
+	return args.channelId
+}
+
+func (args *ChainAuthArgs) Principal() common.Address {
+	return args.principal
+}
+
+func (args *ChainAuthArgs) Permission() Permission {
+	return args.permission
+}
+
 func (args *ChainAuthArgs) withLinkedWallets(linkedWallets []common.Address) *ChainAuthArgs {
 	ret := *args
 	var builder strings.Builder
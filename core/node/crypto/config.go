@@ -44,6 +44,31 @@ const (
 	StreamCacheExpirationPollIntervalMsConfigKey    = "stream.cacheExpirationPollIntervalMs"
 	MediaStreamMembershipLimitsGDMConfigKey         = "media.streamMembershipLimits.77"
 	MediaStreamMembershipLimitsDMConfigKey          = "media.streamMembershipLimits.88"
+	// StreamRetentionDefaultSnapshotsConfigKey is the number of snapshots non-archive nodes keep for a stream.
+	// Miniblocks before the oldest kept snapshot are pruned. 0 disables pruning by snapshot count.
+	StreamRetentionDefaultSnapshotsConfigKey = "stream.retention.default.snapshots"
+	// StreamRetentionDefaultAgeSecConfigKey is the minimum age of miniblocks before they can be pruned
+	// by non-archive nodes. 0 disables pruning by age.
+	StreamRetentionDefaultAgeSecConfigKey    = "stream.retention.default.ageSeconds"
+	StreamRetentionChannelSnapshotsConfigKey = "stream.retention.20.snapshots"
+	StreamRetentionChannelAgeSecConfigKey    = "stream.retention.20.ageSeconds"
+	StreamRetentionDMSnapshotsConfigKey      = "stream.retention.88.snapshots"
+	StreamRetentionDMAgeSecConfigKey         = "stream.retention.88.ageSeconds"
+	StreamRetentionGDMSnapshotsConfigKey     = "stream.retention.77.snapshots"
+	StreamRetentionGDMAgeSecConfigKey        = "stream.retention.77.ageSeconds"
+	StreamRetentionMediaSnapshotsConfigKey   = "stream.retention.ff.snapshots"
+	StreamRetentionMediaAgeSecConfigKey      = "stream.retention.ff.ageSeconds"
+	// StreamRateLimitUserEventsPerMinuteConfigKey is the number of events a single user can add to a stream
+	// per minute. 0 disables the limit.
+	StreamRateLimitUserEventsPerMinuteConfigKey = "stream.rateLimit.user.eventsPerMinute"
+	StreamRateLimitUserBurstConfigKey           = "stream.rateLimit.user.burst"
+	// StreamRateLimitStreamEventsPerMinuteConfigKey is the number of events all users together can add
+	// to a stream per minute. 0 disables the limit.
+	StreamRateLimitStreamEventsPerMinuteConfigKey = "stream.rateLimit.stream.eventsPerMinute"
+	StreamRateLimitStreamBurstConfigKey           = "stream.rateLimit.stream.burst"
+	// StreamMessageEditWindowSecConfigKey is how long after creation a message can be edited by its creator.
+	// 0 allows editing at any time.
+	StreamMessageEditWindowSecConfigKey = "stream.messageEdit.windowSeconds"
 )
 
 // OnChainSettings holds the configuration settings that are stored on-chain.
@@ -65,6 +90,12 @@ type OnChainSettings struct {
 	StreamCachePollIntterval time.Duration `mapstructure:"stream.cacheExpirationPollIntervalMs"`
 
 	MembershipLimits MembershipLimitsSettings `mapstructure:",squash"`
+
+	Retention StreamRetentionSettings `mapstructure:",squash"`
+
+	RateLimits EventRateLimitSettings `mapstructure:",squash"`
+
+	MessageEditWindow time.Duration `mapstructure:"stream.messageEdit.windowSeconds"`
 }
 
 type MinSnapshotEventsSettings struct {
@@ -106,6 +137,52 @@ func (m MembershipLimitsSettings) ForType(streamType byte) uint64 {
 	}
 }
 
+// StreamRetentionSettings defines how long non-archive nodes keep historical miniblocks.
+// If both snapshots and age are 0 for a stream type, nothing is pruned.
+// If type specific values are both 0, default values are used.
+type StreamRetentionSettings struct {
+	DefaultSnapshots uint64        `mapstructure:"stream.retention.default.snapshots"`
+	DefaultAge       time.Duration `mapstructure:"stream.retention.default.ageSeconds"`
+	ChannelSnapshots uint64        `mapstructure:"stream.retention.20.snapshots"`
+	ChannelAge       time.Duration `mapstructure:"stream.retention.20.ageSeconds"`
+	DMSnapshots      uint64        `mapstructure:"stream.retention.88.snapshots"`
+	DMAge            time.Duration `mapstructure:"stream.retention.88.ageSeconds"`
+	GDMSnapshots     uint64        `mapstructure:"stream.retention.77.snapshots"`
+	GDMAge           time.Duration `mapstructure:"stream.retention.77.ageSeconds"`
+	MediaSnapshots   uint64        `mapstructure:"stream.retention.ff.snapshots"`
+	MediaAge         time.Duration `mapstructure:"stream.retention.ff.ageSeconds"`
+}
+
+// ForType returns the number of snapshots to keep and the minimum age of pruned miniblocks for the given stream type.
+func (r StreamRetentionSettings) ForType(streamType byte) (uint64, time.Duration) {
+	var snapshots uint64
+	var age time.Duration
+	switch streamType {
+	case shared.STREAM_CHANNEL_BIN:
+		snapshots, age = r.ChannelSnapshots, r.ChannelAge
+	case shared.STREAM_DM_CHANNEL_BIN:
+		snapshots, age = r.DMSnapshots, r.DMAge
+	case shared.STREAM_GDM_CHANNEL_BIN:
+		snapshots, age = r.GDMSnapshots, r.GDMAge
+	case shared.STREAM_MEDIA_BIN:
+		snapshots, age = r.MediaSnapshots, r.MediaAge
+	}
+	if snapshots == 0 && age == 0 {
+		return r.DefaultSnapshots, r.DefaultAge
+	}
+	return snapshots, age
+}
+
+// EventRateLimitSettings limits how fast events are added to streams.
+// Limits are token buckets refilled at the given rate up to the burst size.
+// If burst is 0, the number of events per minute is used as the burst size.
+type EventRateLimitSettings struct {
+	UserEventsPerMinute   uint64 `mapstructure:"stream.rateLimit.user.eventsPerMinute"`
+	UserBurst             uint64 `mapstructure:"stream.rateLimit.user.burst"`
+	StreamEventsPerMinute uint64 `mapstructure:"stream.rateLimit.stream.eventsPerMinute"`
+	StreamBurst           uint64 `mapstructure:"stream.rateLimit.stream.burst"`
+}
+
 func DefaultOnChainSettings() *OnChainSettings {
 	return &OnChainSettings{
 		MediaMaxChunkCount: 50,
@@ -131,6 +208,8 @@ func DefaultOnChainSettings() *OnChainSettings {
 			GDM: 48,
 			DM:  2,
 		},
+
+		MessageEditWindow: 24 * time.Hour,
 	}
 }
 
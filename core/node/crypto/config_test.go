@@ -13,6 +13,7 @@ import (
 
 	"github.com/river-build/river/core/contracts/river"
 	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/shared"
 )
 
 func TestOnChainConfigSettingMultipleActiveBlockValues(t *testing.T) {
@@ -116,6 +117,67 @@ func TestOnChainConfigSettingMultipleActiveBlockValues(t *testing.T) {
 	}
 }
 
+func TestOnChainConfigRetentionSettings(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	settings, err := makeOnChainConfig(ctx, nil, nil, 1)
+	require.NoError(err)
+
+	snapshots, age := settings.Get().Retention.ForType(shared.STREAM_CHANNEL_BIN)
+	require.Zero(snapshots)
+	require.Zero(age)
+
+	for key, value := range map[string][]byte{
+		StreamRetentionDefaultSnapshotsConfigKey: ABIEncodeUint64(10),
+		StreamRetentionChannelAgeSecConfigKey:    ABIEncodeInt64(3600),
+	} {
+		settings.applyEvent(ctx, &river.RiverConfigV1ConfigurationChanged{
+			Key:   HashSettingName(key),
+			Block: 1,
+			Value: value,
+		})
+	}
+
+	retention := settings.GetOnBlock(1).Retention
+	snapshots, age = retention.ForType(shared.STREAM_CHANNEL_BIN)
+	require.Zero(snapshots)
+	require.Equal(time.Hour, age)
+
+	snapshots, age = retention.ForType(shared.STREAM_USER_BIN)
+	require.EqualValues(10, snapshots)
+	require.Zero(age)
+}
+
+func TestOnChainConfigRateLimitSettings(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	settings, err := makeOnChainConfig(ctx, nil, nil, 1)
+	require.NoError(err)
+	require.Equal(EventRateLimitSettings{}, settings.Get().RateLimits)
+
+	for key, value := range map[string][]byte{
+		StreamRateLimitUserEventsPerMinuteConfigKey:   ABIEncodeUint64(30),
+		StreamRateLimitStreamEventsPerMinuteConfigKey: ABIEncodeUint64(600),
+		StreamRateLimitStreamBurstConfigKey:           ABIEncodeUint64(100),
+	} {
+		settings.applyEvent(ctx, &river.RiverConfigV1ConfigurationChanged{
+			Key:   HashSettingName(key),
+			Block: 1,
+			Value: value,
+		})
+	}
+
+	require.Equal(EventRateLimitSettings{
+		UserEventsPerMinute:   30,
+		StreamEventsPerMinute: 600,
+		StreamBurst:           100,
+	}, settings.GetOnBlock(1).RateLimits)
+}
+
 func TestSetOnChain(t *testing.T) {
 	require := require.New(t)
 	assert := assert.New(t)
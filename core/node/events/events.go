@@ -163,6 +163,31 @@ func Make_MemberPayload_DisplayName(displayName *EncryptedData) *StreamEvent_Mem
 	}
 }
 
+func Make_MemberPayload_Typing(isTyping bool, threadId []byte) *StreamEvent_MemberPayload {
+	return &StreamEvent_MemberPayload{
+		MemberPayload: &MemberPayload{
+			Content: &MemberPayload_Typing_{
+				Typing: &MemberPayload_Typing{
+					IsTyping: isTyping,
+					ThreadId: threadId,
+				},
+			},
+		},
+	}
+}
+
+func Make_MemberPayload_Presence(isOnline bool) *StreamEvent_MemberPayload {
+	return &StreamEvent_MemberPayload{
+		MemberPayload: &MemberPayload{
+			Content: &MemberPayload_Presence_{
+				Presence: &MemberPayload_Presence{
+					IsOnline: isOnline,
+				},
+			},
+		},
+	}
+}
+
 func Make_ChannelPayload_Inception(
 	streamId StreamId,
 	spaceId StreamId,
@@ -220,6 +245,77 @@ func Make_ChannelPayload_Message(content string) *StreamEvent_ChannelPayload {
 	}
 }
 
+// Make_ChannelPayload_Edit makes an edit of the message event with the given hash.
+func Make_ChannelPayload_Edit(eventId []byte, event *StreamEvent, content string) *StreamEvent_ChannelPayload {
+	return &StreamEvent_ChannelPayload{
+		ChannelPayload: &ChannelPayload{
+			Content: &ChannelPayload_Edit{
+				Edit: &MessageEdit{
+					EventId: eventId,
+					Event:   event,
+					Message: &EncryptedData{
+						Ciphertext: content,
+					},
+				},
+			},
+		},
+	}
+}
+
+func Make_ChannelPayload_ThreadReply(threadId []byte, content string) *StreamEvent_ChannelPayload {
+	return &StreamEvent_ChannelPayload{
+		ChannelPayload: &ChannelPayload{
+			Content: &ChannelPayload_ThreadReply_{
+				ThreadReply: &ChannelPayload_ThreadReply{
+					ThreadId: threadId,
+					Message: &EncryptedData{
+						Ciphertext: content,
+					},
+				},
+			},
+		},
+	}
+}
+
+func Make_ChannelPayload_Redaction(eventId []byte, threadId []byte) *StreamEvent_ChannelPayload {
+	return &StreamEvent_ChannelPayload{
+		ChannelPayload: &ChannelPayload{
+			Content: &ChannelPayload_Redaction_{
+				Redaction: &ChannelPayload_Redaction{
+					EventId:  eventId,
+					ThreadId: threadId,
+				},
+			},
+		},
+	}
+}
+
+func Make_ChannelPayload_Reaction(eventId []byte, reaction string) *StreamEvent_ChannelPayload {
+	return &StreamEvent_ChannelPayload{
+		ChannelPayload: &ChannelPayload{
+			Content: &ChannelPayload_Reaction{
+				Reaction: &Reaction{
+					EventId:  eventId,
+					Reaction: reaction,
+				},
+			},
+		},
+	}
+}
+
+func Make_ChannelPayload_RemoveReaction(eventId []byte, reaction string) *StreamEvent_ChannelPayload {
+	return &StreamEvent_ChannelPayload{
+		ChannelPayload: &ChannelPayload{
+			Content: &ChannelPayload_RemoveReaction{
+				RemoveReaction: &Reaction{
+					EventId:  eventId,
+					Reaction: reaction,
+				},
+			},
+		},
+	}
+}
+
 // todo delete and replace with Make_MemberPayload_Membership
 func Make_DmChannelPayload_Membership(op MembershipOp, userId string, initiatorId string) *StreamEvent_MemberPayload {
 	userAddress, err := AddressFromUserId(userId)
@@ -37,6 +37,23 @@ type RemoteMiniblockProvider interface {
 		streamId StreamId,
 		mb *Miniblock,
 	) error
+	// GetMbs returns miniblocks of the given stream from the given node,
+	// with numbers from fromInclusive to toExclusive.
+	// Returns MINIBLOCKS_PRUNED if the beginning of the range is pruned on the node.
+	GetMbs(
+		ctx context.Context,
+		node common.Address,
+		streamId StreamId,
+		fromInclusive int64,
+		toExclusive int64,
+	) ([]*Miniblock, error)
+	// GetLastSnapshotMbs returns miniblocks of the given stream from the given node
+	// starting from the last snapshot miniblock and the number of the first returned miniblock.
+	GetLastSnapshotMbs(
+		ctx context.Context,
+		node common.Address,
+		streamId StreamId,
+	) ([]*Miniblock, int64, error)
 }
 
 type MiniblockProducer interface {
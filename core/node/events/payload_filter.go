@@ -0,0 +1,73 @@
+package events
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+var (
+	streamEventPayloadOneof = (&StreamEvent{}).ProtoReflect().Descriptor().Oneofs().ByName("payload")
+	payloadContentOneofName = protoreflect.Name("content")
+)
+
+// PayloadTypeName returns names of the payload and content fields of the event as defined in protocol.proto,
+// e.g. "channel_payload" and "message". Content is empty if the payload has no content set.
+func PayloadTypeName(event *StreamEvent) (string, string) {
+	m := event.ProtoReflect()
+	payloadField := m.WhichOneof(streamEventPayloadOneof)
+	if payloadField == nil {
+		return "", ""
+	}
+	contentOneof := payloadField.Message().Oneofs().ByName(payloadContentOneofName)
+	if contentOneof == nil {
+		return string(payloadField.Name()), ""
+	}
+	contentField := m.Get(payloadField).Message().WhichOneof(contentOneof)
+	if contentField == nil {
+		return string(payloadField.Name()), ""
+	}
+	return string(payloadField.Name()), string(contentField.Name())
+}
+
+// PayloadTypeFilter matches events by payload type.
+// Empty filter matches all events.
+type PayloadTypeFilter map[string]struct{}
+
+// NewPayloadTypeFilter creates a filter from the list of payload types.
+// Each type is a payload field name of StreamEvent, e.g. "channel_payload",
+// optionally followed by a dot and a content field name of the payload, e.g. "channel_payload.message".
+func NewPayloadTypeFilter(types []string) (PayloadTypeFilter, error) {
+	filter := make(PayloadTypeFilter, len(types))
+	for _, t := range types {
+		payload, content, hasContent := strings.Cut(t, ".")
+		payloadField := streamEventPayloadOneof.Fields().ByName(protoreflect.Name(payload))
+		if payloadField == nil {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Unknown payload type", "payloadType", t)
+		}
+		if hasContent {
+			contentOneof := payloadField.Message().Oneofs().ByName(payloadContentOneofName)
+			if contentOneof == nil || contentOneof.Fields().ByName(protoreflect.Name(content)) == nil {
+				return nil, RiverError(Err_INVALID_ARGUMENT, "Unknown payload content type", "payloadType", t)
+			}
+		}
+		filter[t] = struct{}{}
+	}
+	return filter, nil
+}
+
+// Matches returns true if the event has one of the payload types of the filter.
+func (f PayloadTypeFilter) Matches(event *StreamEvent) bool {
+	if len(f) == 0 {
+		return true
+	}
+	payload, content := PayloadTypeName(event)
+	if _, ok := f[payload]; ok {
+		return true
+	}
+	_, ok := f[payload+"."+content]
+	return ok && content != ""
+}
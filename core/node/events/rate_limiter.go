@@ -0,0 +1,150 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// rateLimiterCleanupInterval is how often idle buckets are removed from the limiter.
+const rateLimiterCleanupInterval = time.Minute
+
+type userStreamKey struct {
+	streamId StreamId
+	user     common.Address
+}
+
+// EventRateLimiter limits how fast a single user adds events to a stream and
+// how fast all users together add events to a stream.
+// Limits are taken from the on-chain settings, so all nodes apply the same limits.
+type EventRateLimiter struct {
+	onChainConfig crypto.OnChainConfiguration
+
+	mu          sync.Mutex
+	users       map[userStreamKey]*rate.Limiter
+	streams     map[StreamId]*rate.Limiter
+	lastCleanup time.Time
+
+	throttledUser   prometheus.Counter
+	throttledStream prometheus.Counter
+}
+
+func NewEventRateLimiter(onChainConfig crypto.OnChainConfiguration, metrics infra.MetricsFactory) *EventRateLimiter {
+	throttled := metrics.NewCounterVecEx(
+		"event_rate_limiter_throttled",
+		"Number of events rejected by the event rate limiter",
+		"limit",
+	)
+	return &EventRateLimiter{
+		onChainConfig:   onChainConfig,
+		users:           make(map[userStreamKey]*rate.Limiter),
+		streams:         make(map[StreamId]*rate.Limiter),
+		throttledUser:   throttled.WithLabelValues("user"),
+		throttledStream: throttled.WithLabelValues("stream"),
+	}
+}
+
+// Allow takes a token for the event from the user and the stream buckets.
+// Returns RESOURCE_EXHAUSTED if any of the buckets is empty.
+func (l *EventRateLimiter) Allow(streamId StreamId, user common.Address) error {
+	return l.AllowAt(l.onChainConfig.Get().RateLimits, streamId, user, time.Now())
+}
+
+// AllowAt is Allow with explicit settings and time.
+func (l *EventRateLimiter) AllowAt(
+	settings crypto.EventRateLimitSettings,
+	streamId StreamId,
+	user common.Address,
+	now time.Time,
+) error {
+	if settings.UserEventsPerMinute == 0 && settings.StreamEventsPerMinute == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastCleanup) >= rateLimiterCleanupInterval {
+		l.cleanup(now)
+	}
+
+	var userLimiter, streamLimiter *rate.Limiter
+	if settings.UserEventsPerMinute > 0 {
+		key := userStreamKey{streamId: streamId, user: user}
+		userLimiter = getRateLimiter(l.users, key, settings.UserEventsPerMinute, settings.UserBurst, now)
+		if userLimiter.TokensAt(now) < 1 {
+			l.throttledUser.Inc()
+			return RiverError(Err_RESOURCE_EXHAUSTED, "User event rate limit exceeded").
+				Tags("streamId", streamId, "user", user, "eventsPerMinute", settings.UserEventsPerMinute)
+		}
+	}
+	if settings.StreamEventsPerMinute > 0 {
+		streamLimiter = getRateLimiter(l.streams, streamId, settings.StreamEventsPerMinute, settings.StreamBurst, now)
+		if streamLimiter.TokensAt(now) < 1 {
+			l.throttledStream.Inc()
+			return RiverError(Err_RESOURCE_EXHAUSTED, "Stream event rate limit exceeded").
+				Tags("streamId", streamId, "eventsPerMinute", settings.StreamEventsPerMinute)
+		}
+	}
+
+	// Tokens are taken only if both buckets have them.
+	if userLimiter != nil {
+		userLimiter.AllowN(now, 1)
+	}
+	if streamLimiter != nil {
+		streamLimiter.AllowN(now, 1)
+	}
+	return nil
+}
+
+// getRateLimiter returns the bucket for the key, creating it or updating its limits if settings were changed.
+func getRateLimiter[K comparable](
+	limiters map[K]*rate.Limiter,
+	key K,
+	eventsPerMinute uint64,
+	burst uint64,
+	now time.Time,
+) *rate.Limiter {
+	if burst == 0 {
+		burst = eventsPerMinute
+	}
+	limit := rate.Limit(float64(eventsPerMinute) / 60)
+
+	limiter, ok := limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(limit, int(burst))
+		limiters[key] = limiter
+		return limiter
+	}
+	if limiter.Limit() != limit {
+		limiter.SetLimitAt(now, limit)
+	}
+	if limiter.Burst() != int(burst) {
+		limiter.SetBurstAt(now, int(burst))
+	}
+	return limiter
+}
+
+// cleanup removes full buckets, they are recreated full on the next event.
+func (l *EventRateLimiter) cleanup(now time.Time) {
+	l.lastCleanup = now
+	for key, limiter := range l.users {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(l.users, key)
+		}
+	}
+	for key, limiter := range l.streams {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(l.streams, key)
+		}
+	}
+}
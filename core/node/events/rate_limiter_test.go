@@ -0,0 +1,75 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestEventRateLimiter(t *testing.T) {
+	require := require.New(t)
+
+	limiter := NewEventRateLimiter(nil, infra.NewMetricsFactory(nil, "", ""))
+	stream1 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	stream2 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	user1 := crypto.GetTestAddress()
+	user2 := crypto.GetTestAddress()
+	now := time.Now()
+
+	requireAllowed := func(settings crypto.EventRateLimitSettings, streamId StreamId, n int) {
+		for i := 0; i < n; i++ {
+			require.NoError(limiter.AllowAt(settings, streamId, user1, now), "event %d", i)
+		}
+	}
+	requireThrottled := func(settings crypto.EventRateLimitSettings, streamId StreamId) {
+		err := limiter.AllowAt(settings, streamId, user1, now)
+		require.Error(err)
+		require.Equal(Err_RESOURCE_EXHAUSTED, AsRiverError(err).Code)
+	}
+
+	// Limits are disabled.
+	requireAllowed(crypto.EventRateLimitSettings{}, stream1, 1000)
+
+	// User limit with burst equal to the rate.
+	settings := crypto.EventRateLimitSettings{UserEventsPerMinute: 60}
+	requireAllowed(settings, stream1, 60)
+	requireThrottled(settings, stream1)
+
+	// Other streams and users have own buckets.
+	requireAllowed(settings, stream2, 60)
+	require.NoError(limiter.AllowAt(settings, stream1, user2, now))
+
+	// Bucket is refilled with one event per second.
+	now = now.Add(time.Second)
+	requireAllowed(settings, stream1, 1)
+	requireThrottled(settings, stream1)
+
+	// Stream limit is shared by all users.
+	now = now.Add(time.Hour)
+	settings = crypto.EventRateLimitSettings{StreamEventsPerMinute: 10, StreamBurst: 3}
+	requireAllowed(settings, stream1, 2)
+	require.NoError(limiter.AllowAt(settings, stream1, user2, now))
+	requireThrottled(settings, stream1)
+	require.Error(limiter.AllowAt(settings, stream1, user2, now))
+
+	// Throttled user doesn't take tokens from the stream bucket.
+	now = now.Add(time.Hour)
+	settings = crypto.EventRateLimitSettings{UserEventsPerMinute: 1, StreamEventsPerMinute: 2}
+	requireAllowed(settings, stream2, 1)
+	requireThrottled(settings, stream2)
+	require.NoError(limiter.AllowAt(settings, stream2, user2, now))
+
+	// Full buckets are removed on cleanup.
+	now = now.Add(time.Hour)
+	require.NoError(limiter.AllowAt(settings, stream1, user1, now))
+	require.Len(limiter.users, 1)
+	require.Len(limiter.streams, 1)
+}
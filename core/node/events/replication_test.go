@@ -1,10 +1,21 @@
 package events
 
 import (
+	"context"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
 )
 
 func TestReplicatedMbProduction(t *testing.T) {
@@ -49,3 +60,209 @@ func TestReplicatedMbProduction(t *testing.T) {
 		)
 	}
 }
+
+func TestReplicatedStreamReconciliation(t *testing.T) {
+	ctx, tc := makeCacheTestContext(t, testParams{replFactor: 3, numInstances: 3})
+	require := tc.require
+
+	tc.initAllCaches(&MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+
+	streamId, streamNodes, prevMbHash := tc.createReplStream()
+
+	leader := tc.instancesByAddr[streamNodes[0]]
+	for range 3 {
+		tc.addReplEvent(streamId, prevMbHash, streamNodes)
+		hash := tc.makeReplMiniblock(streamId, streamNodes, false)
+		prevMbHash = hash[:]
+	}
+
+	leaderMBs, err := leader.params.Storage.ReadMiniblocks(ctx, streamId, 0, 100)
+	require.NoError(err)
+	require.Len(leaderMBs, 4)
+
+	replica := tc.instancesByAddr[streamNodes[2]]
+	require.EventuallyWithT(
+		func(tt *assert.CollectT) {
+			mbs, err := replica.params.Storage.ReadMiniblocks(ctx, streamId, 0, 100)
+			_ = assert.NoError(tt, err) && assert.Len(tt, mbs, 4)
+		},
+		5*time.Second,
+		10*time.Millisecond,
+	)
+
+	// Simulate a replica that lost its storage.
+	require.NoError(replica.params.Storage.DeleteStream(ctx, streamId))
+	replica.cache.ForceFlushAll(ctx)
+
+	_, view, err := replica.cache.GetStream(ctx, streamId)
+	require.NoError(err)
+	require.EqualValues(3, view.LastBlock().Num)
+
+	mbs, err := replica.params.Storage.ReadMiniblocks(ctx, streamId, 0, 100)
+	require.NoError(err)
+	require.EqualValues(leaderMBs, mbs)
+}
+
+func TestPrunedStreamReconciliation(t *testing.T) {
+	ctx, tc := makeCacheTestContext(t, testParams{replFactor: 2, numInstances: 3})
+	require := tc.require
+
+	tc.initAllCaches(&MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+
+	streamId, streamNodes, prevMbHash := tc.createReplStream()
+
+	leader := tc.instancesByAddr[streamNodes[0]]
+	for i := range 4 {
+		tc.addReplEvent(streamId, prevMbHash, streamNodes)
+		hash := tc.makeReplMiniblock(streamId, streamNodes, i < 3)
+		prevMbHash = hash[:]
+	}
+
+	genesis, err := leader.params.Storage.ReadMiniblocks(ctx, streamId, 0, 1)
+	require.NoError(err)
+	leaderMBs, err := leader.params.Storage.ReadMiniblocks(ctx, streamId, 3, 100)
+	require.NoError(err)
+	require.Len(leaderMBs, 2)
+
+	// Prune miniblocks before the last snapshot on all replicas.
+	for _, n := range streamNodes {
+		require.EventuallyWithT(
+			func(tt *assert.CollectT) {
+				mbs, err := tc.instancesByAddr[n].params.Storage.ReadMiniblocks(ctx, streamId, 0, 100)
+				_ = assert.NoError(tt, err) && assert.Len(tt, mbs, 5)
+			},
+			5*time.Second,
+			10*time.Millisecond,
+		)
+		pruned, err := tc.instancesByAddr[n].params.Storage.PruneMiniblocks(ctx, streamId, 3)
+		require.NoError(err)
+		require.EqualValues(3, pruned)
+	}
+
+	// New replica is created from the last snapshot since the genesis miniblock is pruned.
+	var newNode *cacheTestInstance
+	for addr, inst := range tc.instancesByAddr {
+		if !slices.Contains(streamNodes, addr) {
+			newNode = inst
+		}
+	}
+	require.NotNil(newNode)
+
+	deployer := tc.btc.DeployerBlockchain
+	pendingTx, err := deployer.TxPool.Submit(ctx, "PlaceStreamOnNode",
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return tc.btc.StreamRegistry.PlaceStreamOnNode(opts, streamId, newNode.params.Wallet.Address)
+		},
+	)
+	require.NoError(err)
+	receipt := <-pendingTx.Wait()
+	require.Equal(crypto.TransactionResultSuccess, receipt.Status)
+
+	require.EventuallyWithT(
+		func(tt *assert.CollectT) {
+			mbs, err := newNode.params.Storage.ReadMiniblocks(ctx, streamId, 3, 100)
+			_ = assert.NoError(tt, err) && assert.EqualValues(tt, leaderMBs, mbs)
+		},
+		10*time.Second,
+		10*time.Millisecond,
+	)
+	_, err = newNode.params.Storage.ReadMiniblocks(ctx, streamId, 0, 100)
+	require.Equal(Err_MINIBLOCKS_PRUNED, AsRiverError(err).Code)
+
+	// Replica that is behind the pruned miniblocks is recreated from the last snapshot.
+	replica := tc.instancesByAddr[streamNodes[1]]
+	require.NoError(replica.params.Storage.DeleteStream(ctx, streamId))
+	require.NoError(replica.params.Storage.CreateStreamStorage(ctx, streamId, genesis[0]))
+	replica.cache.ForceFlushAll(ctx)
+
+	stream, err := replica.cache.getStreamImpl(ctx, streamId)
+	require.NoError(err)
+
+	// Peer miniblocks that don't match the registry are rejected and local storage is kept.
+	err = stream.reconcile(ctx, 4, common.Hash{1})
+	require.Equal(Err_BAD_BLOCK, AsRiverError(err).Code)
+	mbs, err := replica.params.Storage.ReadMiniblocks(ctx, streamId, 0, 100)
+	require.NoError(err)
+	require.EqualValues(genesis, mbs)
+
+	require.NoError(stream.reconcile(ctx, 4, common.BytesToHash(prevMbHash)))
+
+	view, err := stream.getView(ctx)
+	require.NoError(err)
+	require.EqualValues(4, view.LastBlock().Num)
+
+	mbs, err = replica.params.Storage.ReadMiniblocks(ctx, streamId, 3, 100)
+	require.NoError(err)
+	require.EqualValues(leaderMBs, mbs)
+}
+
+func TestStreamPlacementUpdated(t *testing.T) {
+	ctx, tc := makeCacheTestContext(t, testParams{replFactor: 2, numInstances: 3})
+	require := tc.require
+
+	tc.initAllCaches(&MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+
+	streamId, streamNodes, prevMbHash := tc.createReplStream()
+	tc.addReplEvent(streamId, prevMbHash, streamNodes)
+	_, _, err := tc.instancesByAddr[streamNodes[0]].mbProducer.TestMakeMiniblock(ctx, streamId, false)
+	require.NoError(err)
+
+	var newNode *cacheTestInstance
+	for addr, inst := range tc.instancesByAddr {
+		if !slices.Contains(streamNodes, addr) {
+			newNode = inst
+		}
+	}
+	require.NotNil(newNode)
+	newNodeAddr := newNode.params.Wallet.Address
+
+	deployer := tc.btc.DeployerBlockchain
+	pendingTx, err := deployer.TxPool.Submit(ctx, "PlaceStreamOnNode",
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return tc.btc.StreamRegistry.PlaceStreamOnNode(opts, streamId, newNodeAddr)
+		},
+	)
+	require.NoError(err)
+	receipt := <-pendingTx.Wait()
+	require.Equal(crypto.TransactionResultSuccess, receipt.Status)
+
+	require.EventuallyWithT(
+		func(tt *assert.CollectT) {
+			mbs, err := newNode.params.Storage.ReadMiniblocks(ctx, streamId, 0, 100)
+			_ = assert.NoError(tt, err) && assert.Len(tt, mbs, 2)
+		},
+		10*time.Second,
+		10*time.Millisecond,
+	)
+
+	pendingTx, err = deployer.TxPool.Submit(ctx, "RemoveStreamFromNode",
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return tc.btc.StreamRegistry.RemoveStreamFromNode(opts, streamId, newNodeAddr)
+		},
+	)
+	require.NoError(err)
+	receipt = <-pendingTx.Wait()
+	require.Equal(crypto.TransactionResultSuccess, receipt.Status)
+
+	require.Eventually(
+		func() bool {
+			_, found := newNode.cache.cache.Load(streamId)
+			return !found
+		},
+		10*time.Second,
+		10*time.Millisecond,
+	)
+}
+
+func TestNeedsReconciliation(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	require.True(needsReconciliation(ctx, RiverError(Err_NOT_FOUND, "stream not found")))
+	require.False(needsReconciliation(ctx, RiverError(Err_DB_OPERATION_FAILURE, "storage failure")))
+	require.False(needsReconciliation(ctx, context.DeadlineExceeded))
+
+	cancel()
+	require.False(needsReconciliation(ctx, RiverError(Err_NOT_FOUND, "stream not found")))
+}
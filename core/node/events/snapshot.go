@@ -3,6 +3,7 @@ package events
 import (
 	"bytes"
 	"slices"
+	"strings"
 
 	"google.golang.org/protobuf/proto"
 
@@ -12,6 +13,17 @@ import (
 	"github.com/river-build/river/core/node/shared"
 )
 
+const (
+	// maxSnappedMessageEdits is the maximum number of edited messages which latest edits are kept in the snapshot.
+	maxSnappedMessageEdits = 100
+	// maxSnappedReactionMessages is the maximum number of messages which reactions are kept in the snapshot.
+	maxSnappedReactionMessages = 100
+	// maxSnappedReactionsPerMessage is the maximum number of different reactions to a message kept in the snapshot.
+	maxSnappedReactionsPerMessage = 20
+	// maxThreadPreviousReplies is the maximum number of replies before the last reply kept in the thread summary.
+	maxThreadPreviousReplies = 10
+)
+
 func Make_GenisisSnapshot(events []*ParsedEvent) (*Snapshot, error) {
 	if len(events) == 0 {
 		return nil, RiverError(Err_INVALID_ARGUMENT, "no events to make snapshot from")
@@ -160,11 +172,30 @@ func Update_Snapshot(iSnapshot *Snapshot, event *ParsedEvent, miniblockNum int64
 	case *StreamEvent_SpacePayload:
 		return update_Snapshot_Space(iSnapshot, payload.SpacePayload, eventNum)
 	case *StreamEvent_ChannelPayload:
-		return update_Snapshot_Channel(iSnapshot, payload.ChannelPayload)
+		return update_Snapshot_Channel(
+			iSnapshot,
+			payload.ChannelPayload,
+			event.Event.CreatorAddress,
+			eventNum,
+			event.Hash.Bytes(),
+		)
 	case *StreamEvent_DmChannelPayload:
-		return update_Snapshot_DmChannel(iSnapshot, payload.DmChannelPayload)
+		return update_Snapshot_DmChannel(
+			iSnapshot,
+			payload.DmChannelPayload,
+			event.Event.CreatorAddress,
+			eventNum,
+			event.Hash.Bytes(),
+		)
 	case *StreamEvent_GdmChannelPayload:
-		return update_Snapshot_GdmChannel(iSnapshot, payload.GdmChannelPayload, miniblockNum, event.Hash.Bytes())
+		return update_Snapshot_GdmChannel(
+			iSnapshot,
+			payload.GdmChannelPayload,
+			event.Event.CreatorAddress,
+			miniblockNum,
+			eventNum,
+			event.Hash.Bytes(),
+		)
 	case *StreamEvent_UserPayload:
 		return update_Snapshot_User(iSnapshot, payload.UserPayload)
 	case *StreamEvent_UserSettingsPayload:
@@ -204,7 +235,13 @@ func update_Snapshot_Space(iSnapshot *Snapshot, spacePayload *SpacePayload, even
 	}
 }
 
-func update_Snapshot_Channel(iSnapshot *Snapshot, channelPayload *ChannelPayload) error {
+func update_Snapshot_Channel(
+	iSnapshot *Snapshot,
+	channelPayload *ChannelPayload,
+	creatorAddress []byte,
+	eventNum int64,
+	eventHash []byte,
+) error {
 	snapshot := iSnapshot.Content.(*Snapshot_ChannelContent)
 	if snapshot == nil {
 		return RiverError(Err_INVALID_ARGUMENT, "blockheader snapshot is not a channel snapshot")
@@ -215,6 +252,57 @@ func update_Snapshot_Channel(iSnapshot *Snapshot, channelPayload *ChannelPayload
 		return RiverError(Err_INVALID_ARGUMENT, "cannot update blockheader with inception event")
 	case *ChannelPayload_Message:
 		return nil
+	case *ChannelPayload_Redaction_:
+		snapshot.ChannelContent.Edits = removeMessageEdit(snapshot.ChannelContent.Edits, content.Redaction.EventId)
+		snapshot.ChannelContent.Reactions = removeMessageReactions(
+			snapshot.ChannelContent.Reactions,
+			content.Redaction.EventId,
+		)
+		snapshot.ChannelContent.Threads = removeThreadSummary(
+			snapshot.ChannelContent.Threads,
+			content.Redaction.EventId,
+		)
+		if content.Redaction.ThreadId != nil {
+			snapshot.ChannelContent.Threads = applyThreadReplyRedaction(
+				snapshot.ChannelContent.Threads,
+				content.Redaction.ThreadId,
+				content.Redaction.EventId,
+			)
+		}
+		return nil
+	case *ChannelPayload_ThreadReply_:
+		snapshot.ChannelContent.Threads = applyThreadReply(
+			snapshot.ChannelContent.Threads,
+			content.ThreadReply,
+			creatorAddress,
+			eventNum,
+			eventHash,
+		)
+		return nil
+	case *ChannelPayload_Edit:
+		snapshot.ChannelContent.Edits = insertMessageEdit(
+			snapshot.ChannelContent.Edits,
+			makeSnappedMessageEdit(content.Edit, eventNum, eventHash),
+		)
+		return nil
+	case *ChannelPayload_Reaction:
+		snapshot.ChannelContent.Reactions = applyReaction(
+			snapshot.ChannelContent.Reactions,
+			content.Reaction,
+			creatorAddress,
+			eventNum,
+			true,
+		)
+		return nil
+	case *ChannelPayload_RemoveReaction:
+		snapshot.ChannelContent.Reactions = applyReaction(
+			snapshot.ChannelContent.Reactions,
+			content.RemoveReaction,
+			creatorAddress,
+			eventNum,
+			false,
+		)
+		return nil
 	default:
 		return RiverError(Err_INVALID_ARGUMENT, "unknown channel payload type %T", content)
 	}
@@ -223,6 +311,9 @@ func update_Snapshot_Channel(iSnapshot *Snapshot, channelPayload *ChannelPayload
 func update_Snapshot_DmChannel(
 	iSnapshot *Snapshot,
 	dmChannelPayload *DmChannelPayload,
+	creatorAddress []byte,
+	eventNum int64,
+	eventHash []byte,
 ) error {
 	snapshot := iSnapshot.Content.(*Snapshot_DmChannelContent)
 	if snapshot == nil {
@@ -233,6 +324,30 @@ func update_Snapshot_DmChannel(
 		return RiverError(Err_INVALID_ARGUMENT, "cannot update blockheader with inception event")
 	case *DmChannelPayload_Message:
 		return nil
+	case *DmChannelPayload_Edit:
+		snapshot.DmChannelContent.Edits = insertMessageEdit(
+			snapshot.DmChannelContent.Edits,
+			makeSnappedMessageEdit(content.Edit, eventNum, eventHash),
+		)
+		return nil
+	case *DmChannelPayload_Reaction:
+		snapshot.DmChannelContent.Reactions = applyReaction(
+			snapshot.DmChannelContent.Reactions,
+			content.Reaction,
+			creatorAddress,
+			eventNum,
+			true,
+		)
+		return nil
+	case *DmChannelPayload_RemoveReaction:
+		snapshot.DmChannelContent.Reactions = applyReaction(
+			snapshot.DmChannelContent.Reactions,
+			content.RemoveReaction,
+			creatorAddress,
+			eventNum,
+			false,
+		)
+		return nil
 	default:
 		return RiverError(Err_INVALID_ARGUMENT, "unknown dm channel payload type %T", content)
 	}
@@ -241,6 +356,8 @@ func update_Snapshot_DmChannel(
 func update_Snapshot_GdmChannel(
 	iSnapshot *Snapshot,
 	channelPayload *GdmChannelPayload,
+	creatorAddress []byte,
+	miniblockNum int64,
 	eventNum int64,
 	eventHash []byte,
 ) error {
@@ -253,10 +370,34 @@ func update_Snapshot_GdmChannel(
 	case *GdmChannelPayload_Inception_:
 		return RiverError(Err_INVALID_ARGUMENT, "cannot update blockheader with inception event")
 	case *GdmChannelPayload_ChannelProperties:
-		snapshot.GdmChannelContent.ChannelProperties = &WrappedEncryptedData{Data: content.ChannelProperties, EventNum: eventNum, EventHash: eventHash}
+		snapshot.GdmChannelContent.ChannelProperties = &WrappedEncryptedData{Data: content.ChannelProperties, EventNum: miniblockNum, EventHash: eventHash}
 		return nil
 	case *GdmChannelPayload_Message:
 		return nil
+	case *GdmChannelPayload_Edit:
+		snapshot.GdmChannelContent.Edits = insertMessageEdit(
+			snapshot.GdmChannelContent.Edits,
+			makeSnappedMessageEdit(content.Edit, eventNum, eventHash),
+		)
+		return nil
+	case *GdmChannelPayload_Reaction:
+		snapshot.GdmChannelContent.Reactions = applyReaction(
+			snapshot.GdmChannelContent.Reactions,
+			content.Reaction,
+			creatorAddress,
+			eventNum,
+			true,
+		)
+		return nil
+	case *GdmChannelPayload_RemoveReaction:
+		snapshot.GdmChannelContent.Reactions = applyReaction(
+			snapshot.GdmChannelContent.Reactions,
+			content.RemoveReaction,
+			creatorAddress,
+			eventNum,
+			false,
+		)
+		return nil
 	default:
 		return RiverError(Err_INVALID_ARGUMENT, "unknown channel payload type %T", channelPayload.Content)
 	}
@@ -487,6 +628,8 @@ func update_Snapshot_Member(
 		}
 		snapshot.Pins = snapPins
 		return nil
+	case *MemberPayload_Typing_, *MemberPayload_Presence_:
+		return RiverError(Err_INVALID_ARGUMENT, "ephemeral member payload can't be in a miniblock")
 	
 	default:
 		return RiverError(Err_INVALID_ARGUMENT, "unknown membership payload type %T", memberPayload.Content)
@@ -581,6 +724,205 @@ func insertChannel(
 	return channels
 }
 
+func makeSnappedMessageEdit(edit *MessageEdit, eventNum int64, eventHash []byte) *SnappedMessageEdit {
+	return &SnappedMessageEdit{
+		EventId: edit.EventId,
+		Message: &WrappedEncryptedData{Data: edit.Message, EventNum: eventNum, EventHash: eventHash},
+	}
+}
+
+// insertMessageEdit replaces the previous edit of the same message, so only the latest edit is kept.
+// If there are more than maxSnappedMessageEdits edited messages, the least recent edit is dropped.
+func insertMessageEdit(edits []*SnappedMessageEdit, edit *SnappedMessageEdit) []*SnappedMessageEdit {
+	edits = insertSorted(
+		edits,
+		edit,
+		bytes.Compare,
+		func(edit *SnappedMessageEdit) []byte {
+			return edit.EventId
+		},
+	)
+	if len(edits) > maxSnappedMessageEdits {
+		oldest := 0
+		for i, e := range edits {
+			if e.Message.EventNum < edits[oldest].Message.EventNum {
+				oldest = i
+			}
+		}
+		edits = append(edits[:oldest], edits[oldest+1:]...)
+	}
+	return edits
+}
+
+func removeMessageEdit(edits []*SnappedMessageEdit, eventId []byte) []*SnappedMessageEdit {
+	return removeSorted(
+		edits,
+		eventId,
+		bytes.Compare,
+		func(edit *SnappedMessageEdit) []byte {
+			return edit.EventId
+		},
+	)
+}
+
+// applyReaction adds or removes the reaction of the user to the message.
+// Reactions without users and messages without reactions are removed.
+// New reactions to a message with maxSnappedReactionsPerMessage reactions are not kept, and if there are more than
+// maxSnappedReactionMessages messages with reactions, reactions of the least recently reacted message are dropped.
+func applyReaction(
+	messages []*SnappedReactions,
+	reaction *Reaction,
+	userAddress []byte,
+	eventNum int64,
+	add bool,
+) []*SnappedReactions {
+	keyFn := func(message *SnappedReactions) []byte {
+		return message.EventId
+	}
+	message, err := findSorted(messages, reaction.EventId, bytes.Compare, keyFn)
+	if err != nil {
+		if !add {
+			return messages
+		}
+		message = &SnappedReactions{EventId: reaction.EventId}
+		messages = insertSorted(messages, message, bytes.Compare, keyFn)
+	}
+
+	reactionKeyFn := func(r *SnappedReactions_Reaction) string {
+		return r.Reaction
+	}
+	snapped, err := findSorted(message.Reactions, reaction.Reaction, strings.Compare, reactionKeyFn)
+	if err != nil {
+		if !add || len(message.Reactions) >= maxSnappedReactionsPerMessage {
+			return messages
+		}
+		snapped = &SnappedReactions_Reaction{Reaction: reaction.Reaction}
+		message.Reactions = insertSorted(message.Reactions, snapped, strings.Compare, reactionKeyFn)
+	}
+
+	index, found := slices.BinarySearchFunc(snapped.UserAddresses, userAddress, bytes.Compare)
+	if add && !found {
+		snapped.UserAddresses = slices.Insert(snapped.UserAddresses, index, userAddress)
+	} else if !add && found {
+		snapped.UserAddresses = slices.Delete(snapped.UserAddresses, index, index+1)
+	}
+	message.UpdatedAtEventNum = eventNum
+
+	if len(snapped.UserAddresses) == 0 {
+		message.Reactions = removeSorted(message.Reactions, reaction.Reaction, strings.Compare, reactionKeyFn)
+	}
+	if len(message.Reactions) == 0 {
+		return removeSorted(messages, reaction.EventId, bytes.Compare, keyFn)
+	}
+
+	if len(messages) > maxSnappedReactionMessages {
+		oldest := 0
+		for i, m := range messages {
+			if m.UpdatedAtEventNum < messages[oldest].UpdatedAtEventNum {
+				oldest = i
+			}
+		}
+		messages = append(messages[:oldest], messages[oldest+1:]...)
+	}
+	return messages
+}
+
+// applyThreadReply updates the summary of the thread with the reply.
+func applyThreadReply(
+	threads []*ChannelPayload_ThreadSummary,
+	reply *ChannelPayload_ThreadReply,
+	creatorAddress []byte,
+	eventNum int64,
+	eventHash []byte,
+) []*ChannelPayload_ThreadSummary {
+	keyFn := func(thread *ChannelPayload_ThreadSummary) []byte {
+		return thread.ThreadId
+	}
+	thread, err := findSorted(threads, reply.ThreadId, bytes.Compare, keyFn)
+	if err != nil {
+		thread = &ChannelPayload_ThreadSummary{ThreadId: reply.ThreadId}
+		threads = insertSorted(threads, thread, bytes.Compare, keyFn)
+	}
+	thread.ReplyCount++
+	if thread.LastReplyEventHash != nil {
+		thread.PreviousReplies = append(thread.PreviousReplies, &ChannelPayload_ThreadSummary_Reply{
+			EventHash: thread.LastReplyEventHash,
+			EventNum:  thread.LastReplyEventNum,
+		})
+		if len(thread.PreviousReplies) > maxThreadPreviousReplies {
+			thread.PreviousReplies = thread.PreviousReplies[1:]
+		}
+	}
+	thread.LastReplyEventHash = eventHash
+	thread.LastReplyEventNum = eventNum
+	index, found := slices.BinarySearchFunc(thread.Participants, creatorAddress, bytes.Compare)
+	if !found {
+		thread.Participants = slices.Insert(thread.Participants, index, creatorAddress)
+	}
+	return threads
+}
+
+// applyThreadReplyRedaction removes the redacted reply from the thread summary. If the last reply is redacted,
+// the latest previous reply becomes the last one. Participants are kept since they may have other replies.
+// Redacted replies are recorded in the summary, so repeated redactions of the same reply are ignored.
+// The summary is kept when all replies are redacted to keep the record of redacted replies.
+func applyThreadReplyRedaction(
+	threads []*ChannelPayload_ThreadSummary,
+	threadId []byte,
+	replyHash []byte,
+) []*ChannelPayload_ThreadSummary {
+	keyFn := func(thread *ChannelPayload_ThreadSummary) []byte {
+		return thread.ThreadId
+	}
+	thread, err := findSorted(threads, threadId, bytes.Compare, keyFn)
+	if err != nil {
+		return threads
+	}
+	index, redacted := slices.BinarySearchFunc(thread.RedactedReplies, replyHash, bytes.Compare)
+	if redacted || thread.ReplyCount <= 0 {
+		return threads
+	}
+	thread.RedactedReplies = slices.Insert(thread.RedactedReplies, index, replyHash)
+	thread.ReplyCount--
+
+	if bytes.Equal(thread.LastReplyEventHash, replyHash) {
+		thread.LastReplyEventHash = nil
+		thread.LastReplyEventNum = 0
+		if n := len(thread.PreviousReplies); n > 0 {
+			thread.LastReplyEventHash = thread.PreviousReplies[n-1].EventHash
+			thread.LastReplyEventNum = thread.PreviousReplies[n-1].EventNum
+			thread.PreviousReplies = thread.PreviousReplies[:n-1]
+		}
+		return threads
+	}
+	thread.PreviousReplies = slices.DeleteFunc(thread.PreviousReplies, func(r *ChannelPayload_ThreadSummary_Reply) bool {
+		return bytes.Equal(r.EventHash, replyHash)
+	})
+	return threads
+}
+
+func removeThreadSummary(threads []*ChannelPayload_ThreadSummary, threadId []byte) []*ChannelPayload_ThreadSummary {
+	return removeSorted(
+		threads,
+		threadId,
+		bytes.Compare,
+		func(thread *ChannelPayload_ThreadSummary) []byte {
+			return thread.ThreadId
+		},
+	)
+}
+
+func removeMessageReactions(messages []*SnappedReactions, eventId []byte) []*SnappedReactions {
+	return removeSorted(
+		messages,
+		eventId,
+		bytes.Compare,
+		func(message *SnappedReactions) []byte {
+			return message.EventId
+		},
+	)
+}
+
 func findMember(
 	members []*MemberPayload_Snapshot_Member,
 	memberAddress []byte,
@@ -1,12 +1,14 @@
 package events
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/river-build/river/core/node/base/test"
 	"github.com/river-build/river/core/node/crypto"
 	. "github.com/river-build/river/core/node/protocol"
 	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -245,3 +247,223 @@ func TestUpdateSnapshotFailsIfInception(t *testing.T) {
 	err = Update_Snapshot(snapshot, inception, 0, 1)
 	assert.Error(t, err)
 }
+
+func TestUpdateSnapshotMessageEdits(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	wallet, _ := crypto.NewWallet(ctx)
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	inception, err := MakeParsedEventWithPayload(
+		wallet,
+		Make_ChannelPayload_Inception(streamId, spaceId, nil),
+		nil,
+	)
+	require.NoError(err)
+	snapshot, err := Make_GenisisSnapshot([]*ParsedEvent{inception})
+	require.NoError(err)
+
+	edit := func(eventId []byte, content string) *ParsedEvent {
+		event, err := MakeParsedEventWithPayload(wallet, Make_ChannelPayload_Edit(eventId, nil, content), nil)
+		require.NoError(err)
+		return event
+	}
+	edits := func() []*SnappedMessageEdit {
+		return snapshot.Content.(*Snapshot_ChannelContent).ChannelContent.Edits
+	}
+
+	first := bytes.Repeat([]byte{2}, 32)
+	second := bytes.Repeat([]byte{1}, 32)
+	require.NoError(Update_Snapshot(snapshot, edit(first, "a"), 1, 3))
+	require.NoError(Update_Snapshot(snapshot, edit(second, "b"), 1, 4))
+	lastEdit := edit(first, "c")
+	require.NoError(Update_Snapshot(snapshot, lastEdit, 1, 5))
+
+	// Only the latest edit of each message is kept, sorted by event id.
+	require.Len(edits(), 2)
+	require.Equal(second, edits()[0].EventId)
+	require.Equal("b", edits()[0].Message.Data.Ciphertext)
+	require.Equal(first, edits()[1].EventId)
+	require.Equal("c", edits()[1].Message.Data.Ciphertext)
+	require.EqualValues(5, edits()[1].Message.EventNum)
+	require.Equal(lastEdit.Hash.Bytes(), edits()[1].Message.EventHash)
+
+	// Redaction removes edits of the message.
+	redaction, err := MakeParsedEventWithPayload(
+		wallet,
+		&StreamEvent_ChannelPayload{
+			ChannelPayload: &ChannelPayload{
+				Content: &ChannelPayload_Redaction_{Redaction: &ChannelPayload_Redaction{EventId: first}},
+			},
+		},
+		nil,
+	)
+	require.NoError(err)
+	require.NoError(Update_Snapshot(snapshot, redaction, 1, 6))
+	require.Len(edits(), 1)
+	require.Equal(second, edits()[0].EventId)
+
+	// The least recent edit is dropped when too many messages are edited.
+	for i := range maxSnappedMessageEdits {
+		eventId := bytes.Repeat([]byte{byte(i + 3)}, 32)
+		require.NoError(Update_Snapshot(snapshot, edit(eventId, "d"), 2, int64(7+i)))
+	}
+	require.Len(edits(), maxSnappedMessageEdits)
+	for _, e := range edits() {
+		require.NotEqual(second, e.EventId)
+	}
+}
+
+func TestUpdateSnapshotReactions(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	wallet1, _ := crypto.NewWallet(ctx)
+	wallet2, _ := crypto.NewWallet(ctx)
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	inception, err := MakeParsedEventWithPayload(
+		wallet1,
+		Make_ChannelPayload_Inception(streamId, spaceId, nil),
+		nil,
+	)
+	require.NoError(err)
+	snapshot, err := Make_GenisisSnapshot([]*ParsedEvent{inception})
+	require.NoError(err)
+
+	eventNum := int64(0)
+	apply := func(wallet *crypto.Wallet, payload IsStreamEvent_Payload) {
+		event, err := MakeParsedEventWithPayload(wallet, payload, nil)
+		require.NoError(err)
+		eventNum++
+		require.NoError(Update_Snapshot(snapshot, event, 1, eventNum))
+	}
+	reactions := func() []*SnappedReactions {
+		return snapshot.Content.(*Snapshot_ChannelContent).ChannelContent.Reactions
+	}
+
+	message := bytes.Repeat([]byte{1}, 32)
+	apply(wallet1, Make_ChannelPayload_Reaction(message, "👍"))
+	apply(wallet2, Make_ChannelPayload_Reaction(message, "👍"))
+	// Adding the same reaction twice has no effect.
+	apply(wallet2, Make_ChannelPayload_Reaction(message, "👍"))
+	apply(wallet2, Make_ChannelPayload_Reaction(message, "🎉"))
+	// Removing a reaction that wasn't added has no effect.
+	apply(wallet1, Make_ChannelPayload_RemoveReaction(message, "🎉"))
+
+	require.Len(reactions(), 1)
+	require.Equal(message, reactions()[0].EventId)
+	counts := map[string]int{}
+	for _, r := range reactions()[0].Reactions {
+		counts[r.Reaction] = len(r.UserAddresses)
+	}
+	require.Equal(map[string]int{"👍": 2, "🎉": 1}, counts)
+
+	apply(wallet1, Make_ChannelPayload_RemoveReaction(message, "👍"))
+	apply(wallet2, Make_ChannelPayload_RemoveReaction(message, "🎉"))
+	require.Len(reactions()[0].Reactions, 1)
+	require.Equal([][]byte{wallet2.Address.Bytes()}, reactions()[0].Reactions[0].UserAddresses)
+
+	apply(wallet2, Make_ChannelPayload_RemoveReaction(message, "👍"))
+	require.Empty(reactions())
+
+	// Reactions to a message are limited.
+	for i := range maxSnappedReactionsPerMessage + 1 {
+		apply(wallet1, Make_ChannelPayload_Reaction(message, string(rune('a'+i))))
+	}
+	require.Len(reactions()[0].Reactions, maxSnappedReactionsPerMessage)
+
+	// Reactions of the least recently reacted message are dropped.
+	for i := range maxSnappedReactionMessages {
+		apply(wallet1, Make_ChannelPayload_Reaction(bytes.Repeat([]byte{byte(i + 2)}, 32), "👍"))
+	}
+	require.Len(reactions(), maxSnappedReactionMessages)
+	for _, r := range reactions() {
+		require.NotEqual(message, r.EventId)
+	}
+}
+
+func TestUpdateSnapshotThreads(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	wallet1, _ := crypto.NewWallet(ctx)
+	wallet2, _ := crypto.NewWallet(ctx)
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	inception, err := MakeParsedEventWithPayload(
+		wallet1,
+		Make_ChannelPayload_Inception(streamId, spaceId, nil),
+		nil,
+	)
+	require.NoError(err)
+	snapshot, err := Make_GenisisSnapshot([]*ParsedEvent{inception})
+	require.NoError(err)
+
+	threadId := bytes.Repeat([]byte{1}, 32)
+	var replies []*ParsedEvent
+	for i, wallet := range []*crypto.Wallet{wallet1, wallet2, wallet1} {
+		reply, err := MakeParsedEventWithPayload(wallet, Make_ChannelPayload_ThreadReply(threadId, "reply"), nil)
+		require.NoError(err)
+		require.NoError(Update_Snapshot(snapshot, reply, 1, int64(10+i)))
+		replies = append(replies, reply)
+	}
+
+	threads := func() []*ChannelPayload_ThreadSummary {
+		return snapshot.Content.(*Snapshot_ChannelContent).ChannelContent.Threads
+	}
+	require.Len(threads(), 1)
+	require.Equal(threadId, threads()[0].ThreadId)
+	require.EqualValues(3, threads()[0].ReplyCount)
+	require.EqualValues(12, threads()[0].LastReplyEventNum)
+	require.Equal(replies[2].Hash.Bytes(), threads()[0].LastReplyEventHash)
+	require.Len(threads()[0].Participants, 2)
+	require.Len(threads()[0].PreviousReplies, 2)
+
+	redact := func(reply *ParsedEvent) {
+		redaction, err := MakeParsedEventWithPayload(
+			wallet1,
+			Make_ChannelPayload_Redaction(reply.Hash.Bytes(), threadId),
+			nil,
+		)
+		require.NoError(err)
+		require.NoError(Update_Snapshot(snapshot, redaction, 2, 20))
+	}
+
+	// Redacting the last reply makes the previous reply the last one.
+	redact(replies[2])
+	require.EqualValues(2, threads()[0].ReplyCount)
+	require.EqualValues(11, threads()[0].LastReplyEventNum)
+	require.Equal(replies[1].Hash.Bytes(), threads()[0].LastReplyEventHash)
+	require.Len(threads()[0].PreviousReplies, 1)
+
+	// Redacting the same reply again doesn't change the summary.
+	redact(replies[2])
+	require.EqualValues(2, threads()[0].ReplyCount)
+	require.Equal(replies[1].Hash.Bytes(), threads()[0].LastReplyEventHash)
+	require.Len(threads()[0].PreviousReplies, 1)
+
+	// Redacting an earlier reply keeps the last reply.
+	redact(replies[0])
+	require.EqualValues(1, threads()[0].ReplyCount)
+	require.Equal(replies[1].Hash.Bytes(), threads()[0].LastReplyEventHash)
+	require.Empty(threads()[0].PreviousReplies)
+	redact(replies[0])
+	require.EqualValues(1, threads()[0].ReplyCount)
+
+	// Thread summary is kept with the record of redacted replies when all replies are redacted.
+	redact(replies[1])
+	require.Len(threads(), 1)
+	require.EqualValues(0, threads()[0].ReplyCount)
+	require.Nil(threads()[0].LastReplyEventHash)
+	require.Len(threads()[0].RedactedReplies, 3)
+
+	// Repeated redaction doesn't affect replies added later.
+	reply, err := MakeParsedEventWithPayload(wallet2, Make_ChannelPayload_ThreadReply(threadId, "reply"), nil)
+	require.NoError(err)
+	require.NoError(Update_Snapshot(snapshot, reply, 3, 30))
+	redact(replies[1])
+	require.EqualValues(1, threads()[0].ReplyCount)
+	require.Equal(reply.Hash.Bytes(), threads()[0].LastReplyEventHash)
+}
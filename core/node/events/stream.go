@@ -33,8 +33,9 @@ type Stream interface {
 }
 
 type SyncResultReceiver interface {
-	// OnUpdate is called each time a new cookie is available for a stream
-	OnUpdate(r *StreamAndCookie)
+	// OnUpdate is called each time a new cookie is available for a stream.
+	// events are the parsed r.Events in the same order, so receivers don't need to decode envelopes again.
+	OnUpdate(r *StreamAndCookie, events []*ParsedEvent)
 	// OnSyncError is called when a sync subscription failed unrecoverable
 	OnSyncError(err error)
 	// OnStreamSyncDown is called when updates for a stream could not be given.
@@ -172,7 +173,7 @@ func (s *streamImpl) applyMiniblockImplNoLock(ctx context.Context, miniblock *Mi
 	s.view = newSV
 	newSyncCookie := s.view.SyncCookie(s.params.Wallet.Address)
 
-	s.notifySubscribers([]*Envelope{miniblock.headerEvent.Envelope}, newSyncCookie, prevSyncCookie)
+	s.notifySubscribers([]*ParsedEvent{miniblock.headerEvent}, newSyncCookie, prevSyncCookie)
 	return nil
 }
 
@@ -217,15 +218,9 @@ func (s *streamImpl) initFromBlockchain(ctx context.Context) error {
 	}
 	s.nodes = nodes
 
-	if record.LastMiniblockNum > 0 {
-		return RiverError(
-			Err_INTERNAL,
-			"initFromBlockchain: Stream is past genesis",
-			"streamId",
-			s.streamId,
-			"record",
-			record,
-		)
+	// Registry removes genesis miniblock once the stream is past genesis, fetch the stream from the other replicas.
+	if len(mb) == 0 || record.LastMiniblockNum > 0 {
+		return s.initFromPeersNoLock(ctx, int64(record.LastMiniblockNum), record.LastMiniblockHash)
 	}
 
 	err = s.params.Storage.CreateStreamStorage(ctx, s.streamId, mb)
@@ -347,22 +342,33 @@ func (s *streamImpl) AddEvent(ctx context.Context, event *ParsedEvent) error {
 }
 
 // caller must have a RW lock on s.mu
-func (s *streamImpl) notifySubscribers(envelopes []*Envelope, newSyncCookie *SyncCookie, prevSyncCookie *SyncCookie) {
+func (s *streamImpl) notifySubscribers(events []*ParsedEvent, newSyncCookie *SyncCookie, prevSyncCookie *SyncCookie) {
 	if s.receivers != nil && s.receivers.Cardinality() > 0 {
 		s.lastAccessedTime = time.Now()
 
+		envelopes := make([]*Envelope, len(events))
+		for i, e := range events {
+			envelopes[i] = e.Envelope
+		}
 		resp := &StreamAndCookie{
 			Events:         envelopes,
 			NextSyncCookie: newSyncCookie,
 		}
 		for receiver := range s.receivers.Iter() {
-			receiver.OnUpdate(resp)
+			receiver.OnUpdate(resp, events)
 		}
 	}
 }
 
 // Lock must be taken.
 func (s *streamImpl) addEventImpl(ctx context.Context, event *ParsedEvent) error {
+	if event.Event.Ephemeral {
+		// Ephemeral events are not stored and don't change the stream view, so the sync cookie stays the same.
+		syncCookie := s.view.SyncCookie(s.params.Wallet.Address)
+		s.notifySubscribers([]*ParsedEvent{event}, syncCookie, syncCookie)
+		return nil
+	}
+
 	envelopeBytes, err := event.GetEnvelopeBytes()
 	if err != nil {
 		return err
@@ -389,7 +395,7 @@ func (s *streamImpl) addEventImpl(ctx context.Context, event *ParsedEvent) error
 	s.view = newSV
 	newSyncCookie := s.view.SyncCookie(s.params.Wallet.Address)
 
-	s.notifySubscribers([]*Envelope{event.Envelope}, newSyncCookie, prevSyncCookie)
+	s.notifySubscribers([]*ParsedEvent{event}, newSyncCookie, prevSyncCookie)
 
 	return nil
 }
@@ -440,11 +446,10 @@ func (s *streamImpl) Sub(ctx context.Context, cookie *SyncCookie, receiver SyncR
 		}
 		s.receivers.Add(receiver)
 
-		envelopes := make([]*Envelope, 0, s.view.minipool.events.Len()-int(slot))
-		if slot < int64(s.view.minipool.events.Len()) {
-			for _, e := range s.view.minipool.events.Values[slot:] {
-				envelopes = append(envelopes, e.Envelope)
-			}
+		events := s.view.minipool.events.Values[slot:]
+		envelopes := make([]*Envelope, 0, len(events))
+		for _, e := range events {
+			envelopes = append(envelopes, e.Envelope)
 		}
 		// always send response, even if there are no events so that the client knows it's upToDate
 		receiver.OnUpdate(
@@ -452,6 +457,7 @@ func (s *streamImpl) Sub(ctx context.Context, cookie *SyncCookie, receiver SyncR
 				Events:         envelopes,
 				NextSyncCookie: s.view.SyncCookie(s.params.Wallet.Address),
 			},
+			events,
 		)
 		return nil
 	} else {
@@ -471,13 +477,16 @@ func (s *streamImpl) Sub(ctx context.Context, cookie *SyncCookie, receiver SyncR
 					Miniblocks:     s.view.MiniblocksFromLastSnapshot(),
 					SyncReset:      true,
 				},
+				s.view.minipool.events.Values,
 			)
 			return nil
 		}
 
 		// append events from blocks
+		events := make([]*ParsedEvent, 0, 16)
 		envelopes := make([]*Envelope, 0, 16)
 		err = s.view.forEachEvent(miniblockIndex, func(e *ParsedEvent, minibockNum int64, eventNum int64) (bool, error) {
+			events = append(events, e)
 			envelopes = append(envelopes, e.Envelope)
 			return true, nil
 		})
@@ -491,6 +500,7 @@ func (s *streamImpl) Sub(ctx context.Context, cookie *SyncCookie, receiver SyncR
 				Events:         envelopes,
 				NextSyncCookie: s.view.SyncCookie(s.params.Wallet.Address),
 			},
+			events,
 		)
 		return nil
 	}
@@ -522,6 +532,20 @@ func (s *streamImpl) ForceFlush(ctx context.Context) {
 	s.receivers = nil
 }
 
+// stopServing transitions Stream object to unloaded state after the local node is removed from the stream.
+// All subbed receivers receive a sync down message for the stream and are unsubscribed.
+func (s *streamImpl) stopServing() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.view = nil
+	if s.receivers != nil {
+		for r := range s.receivers.Iter() {
+			r.OnStreamSyncDown(s.streamId)
+		}
+	}
+	s.receivers = nil
+}
+
 func (s *streamImpl) canCreateMiniblock() bool {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
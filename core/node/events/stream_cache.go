@@ -29,6 +29,8 @@ type StreamCacheParams struct {
 	ChainMonitor            crypto.ChainMonitor // TODO: delete and use RiverChain.ChainMonitor
 	Metrics                 infra.MetricsFactory
 	RemoteMiniblockProvider RemoteMiniblockProvider
+	// PurgeRemovedStreams deletes local storage of streams when this node is removed from them.
+	PurgeRemovedStreams bool
 }
 
 type StreamCache interface {
@@ -51,6 +53,9 @@ type streamCacheImpl struct {
 
 	chainConfig crypto.OnChainConfiguration
 
+	// reconciler backfills local streams that are behind the registry from other replicas.
+	reconciler *streamReconciler
+
 	streamCacheSizeGauge     prometheus.Gauge
 	streamCacheUnloadedGauge prometheus.Gauge
 }
@@ -85,16 +90,20 @@ func NewStreamCache(
 		return nil, err
 	}
 
-	// TODO: read stream state from storage and schedule required reconciliations.
+	s.reconciler = newStreamReconciler(s)
 
+	// local streams with the last miniblock number as known by the registry
+	localStreams := make(map[*streamImpl]int64)
 	for _, stream := range streams {
 		nodes := NewStreamNodes(stream.Nodes, params.Wallet.Address)
 		if nodes.IsLocal() {
-			s.cache.Store(stream.StreamId, &streamImpl{
+			si := &streamImpl{
 				params:   params,
 				streamId: stream.StreamId,
 				nodes:    nodes,
-			})
+			}
+			s.cache.Store(stream.StreamId, si)
+			localStreams[si] = int64(stream.LastMiniblockNum)
 		}
 	}
 
@@ -109,6 +118,9 @@ func NewStreamCache(
 		return nil, err
 	}
 
+	s.reconciler.run(ctx)
+	go s.reconciler.scheduleBehind(ctx, localStreams)
+
 	go s.runCacheCleanup(ctx)
 
 	return s, nil
@@ -142,6 +154,11 @@ func (s *streamCacheImpl) onStreamLastMiniblockUpdated(
 
 	err = stream.PromoteCandidate(ctx, event.LastMiniblockHash, int64(event.LastMiniblockNum))
 	if err != nil {
+		if AsRiverError(err).Code == Err_NOT_FOUND {
+			// Candidate is not available locally, fetch miniblocks from other replicas.
+			s.reconciler.schedule(ctx, stream)
+			return
+		}
 		dlog.FromCtx(ctx).Error("onStreamLastMiniblockUpdated: failed to promote candidate", "err", err)
 	}
 }
@@ -150,6 +167,78 @@ func (s *streamCacheImpl) onStreamPlacementUpdated(
 	ctx context.Context,
 	event *river.StreamRegistryV1StreamPlacementUpdated,
 ) {
+	streamId := StreamId(event.StreamId)
+
+	if event.NodeAddress == s.params.Wallet.Address {
+		if event.IsAdded {
+			s.onLocalNodeAdded(ctx, streamId)
+		} else {
+			s.onLocalNodeRemoved(ctx, streamId)
+		}
+		return
+	}
+
+	entry, _ := s.cache.Load(streamId)
+	if entry == nil {
+		// Stream is not local, ignore.
+		return
+	}
+
+	if err := entry.(*streamImpl).nodes.Update(event.NodeAddress, event.IsAdded); err != nil {
+		dlog.FromCtx(ctx).Error("onStreamPlacementUpdated: failed to update stream nodes",
+			"streamId", streamId, "err", err)
+	}
+}
+
+// onLocalNodeAdded starts serving the stream after this node is placed on it.
+// Stream history is fetched from the existing replicas in the background.
+func (s *streamCacheImpl) onLocalNodeAdded(ctx context.Context, streamId StreamId) {
+	log := dlog.FromCtx(ctx)
+
+	record, err := s.params.Registry.GetStream(ctx, streamId)
+	if err != nil {
+		log.Error("onStreamPlacementUpdated: failed to get stream record", "streamId", streamId, "err", err)
+		return
+	}
+
+	nodes := NewStreamNodes(record.Nodes, s.params.Wallet.Address)
+	if !nodes.IsLocal() {
+		// Node is already removed again from the stream.
+		return
+	}
+
+	stream := &streamImpl{
+		params:           s.params,
+		streamId:         streamId,
+		nodes:            nodes,
+		lastAccessedTime: time.Now(),
+	}
+	if _, loaded := s.cache.LoadOrStore(streamId, stream); loaded {
+		return
+	}
+
+	log.Info("Stream placed on local node", "streamId", streamId, "lastMiniblockNum", record.LastMiniblockNum)
+	s.reconciler.schedule(ctx, stream)
+}
+
+// onLocalNodeRemoved stops serving the stream after this node is removed from it.
+// Subscribers receive a sync down message and local storage is deleted if PurgeRemovedStreams is set.
+func (s *streamCacheImpl) onLocalNodeRemoved(ctx context.Context, streamId StreamId) {
+	log := dlog.FromCtx(ctx)
+
+	entry, loaded := s.cache.LoadAndDelete(streamId)
+	if !loaded {
+		return
+	}
+	entry.(*streamImpl).stopServing()
+
+	log.Info("Stream removed from local node", "streamId", streamId)
+
+	if s.params.PurgeRemovedStreams {
+		if err := s.params.Storage.DeleteStream(ctx, streamId); err != nil && AsRiverError(err).Code != Err_NOT_FOUND {
+			log.Error("onStreamPlacementUpdated: failed to purge stream storage", "streamId", streamId, "err", err)
+		}
+	}
 }
 
 func (s *streamCacheImpl) Params() *StreamCacheParams {
@@ -229,18 +318,6 @@ func (s *streamCacheImpl) tryLoadStreamRecord(
 		)
 	}
 
-	if record.LastMiniblockNum > 0 {
-		// TODO: reconcile from other nodes.
-		return nil, nil, RiverError(
-			Err_INTERNAL,
-			"tryLoadStreamRecord: Stream is past genesis",
-			"streamId",
-			streamId,
-			"record",
-			record,
-		)
-	}
-
 	stream := &streamImpl{
 		params:           s.params,
 		streamId:         streamId,
@@ -253,7 +330,17 @@ func (s *streamCacheImpl) tryLoadStreamRecord(
 	defer stream.mu.Unlock()
 
 	entry, loaded := s.cache.LoadOrStore(streamId, stream)
-	if !loaded {
+	if !loaded && record.LastMiniblockNum > 0 {
+		// Stream is past genesis, loading it reconciles missing miniblocks from other replicas.
+		if !loadView {
+			return stream, nil, nil
+		}
+		if err = stream.loadInternal(ctx); err != nil {
+			s.reconciler.schedule(ctx, stream)
+			return nil, nil, err
+		}
+		return stream, stream.view, nil
+	} else if !loaded {
 		// Our stream won the race, put into storage.
 		err := s.params.Storage.CreateStreamStorage(ctx, streamId, mb)
 		if err != nil {
@@ -310,7 +397,9 @@ func (s *streamCacheImpl) GetStream(ctx context.Context, streamId StreamId) (Syn
 	if err == nil {
 		return stream, streamView, nil
 	} else {
-		// TODO: if stream is not present in local storage, schedule reconciliation.
+		if needsReconciliation(ctx, err) {
+			s.reconciler.schedule(ctx, stream)
+		}
 		return nil, nil, err
 	}
 }
@@ -202,7 +202,7 @@ type testStreamCacheViewEvictionSub struct {
 	streamErrors             []shared.StreamId
 }
 
-func (sub *testStreamCacheViewEvictionSub) OnUpdate(sac *protocol.StreamAndCookie) {
+func (sub *testStreamCacheViewEvictionSub) OnUpdate(sac *protocol.StreamAndCookie, _ []*ParsedEvent) {
 	sub.receivedStreamAndCookies = append(sub.receivedStreamAndCookies, sac)
 }
 
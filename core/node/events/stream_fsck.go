@@ -0,0 +1,314 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/nodes"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/registries"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+type StorageProblemType string
+
+const (
+	StorageProblemUnreadable         StorageProblemType = "unreadable"
+	StorageProblemMiniblockGap       StorageProblemType = "miniblock_gap"
+	StorageProblemBadMiniblock       StorageProblemType = "bad_miniblock"
+	StorageProblemHashChain          StorageProblemType = "hash_chain"
+	StorageProblemEventHashes        StorageProblemType = "event_hashes"
+	StorageProblemSnapshot           StorageProblemType = "snapshot"
+	StorageProblemMinipoolGeneration StorageProblemType = "minipool_generation"
+	StorageProblemMinipoolSlots      StorageProblemType = "minipool_slots"
+	StorageProblemBadEvent           StorageProblemType = "bad_event"
+	StorageProblemCandidate          StorageProblemType = "candidate"
+	StorageProblemOrphanedTable      StorageProblemType = "orphaned_table"
+)
+
+// StorageProblem is an inconsistency in the locally stored stream data found by CheckStreamStorage.
+type StorageProblem struct {
+	Type     StorageProblemType
+	StreamId StreamId
+	// MiniblockNum is the number of the miniblock, minipool generation or candidate the problem is found in,
+	// -1 if the problem is not related to a particular miniblock.
+	MiniblockNum int64
+	Message      string
+	Repaired     bool
+}
+
+func (p *StorageProblem) String() string {
+	if p.StreamId == (StreamId{}) {
+		return fmt.Sprintf("%s: %s", p.Type, p.Message)
+	}
+	if p.MiniblockNum >= 0 {
+		return fmt.Sprintf("%s %s mb=%d: %s", p.StreamId, p.Type, p.MiniblockNum, p.Message)
+	}
+	return fmt.Sprintf("%s %s: %s", p.StreamId, p.Type, p.Message)
+}
+
+type streamStorageChecker struct {
+	data     *storage.DebugReadStreamDataResult
+	problems []*StorageProblem
+}
+
+func (c *streamStorageChecker) report(t StorageProblemType, mbNum int64, format string, args ...any) {
+	c.problems = append(c.problems, &StorageProblem{
+		Type:         t,
+		StreamId:     c.data.StreamId,
+		MiniblockNum: mbNum,
+		Message:      fmt.Sprintf(format, args...),
+	})
+}
+
+// CheckStreamStorage verifies stream data as stored locally:
+// miniblocks are contiguous, parse, are linked by prev_miniblock_hash and their headers list contained events,
+// latest snapshot pointer is correct, minipool is at the next generation with contiguous slots
+// and there are no stale miniblock candidates.
+func CheckStreamStorage(data *storage.DebugReadStreamDataResult) []*StorageProblem {
+	c := &streamStorageChecker{data: data}
+	lastMbNum := c.checkMiniblocks()
+	if lastMbNum >= 0 {
+		c.checkMinipool(lastMbNum)
+		c.checkCandidates(lastMbNum)
+	}
+	return c.problems
+}
+
+// checkMiniblocks returns the number of the last stored miniblock or -1 if there are none.
+func (c *streamStorageChecker) checkMiniblocks() int64 {
+	mbs := c.data.Miniblocks
+	if len(mbs) == 0 {
+		c.report(StorageProblemMiniblockGap, -1, "no miniblocks stored")
+		return -1
+	}
+
+	var prev *MiniblockInfo
+	lastSnapshot := int64(-1)
+	for i, mb := range mbs {
+		if i > 0 && mb.MiniblockNumber != mbs[i-1].MiniblockNumber+1 {
+			c.report(
+				StorageProblemMiniblockGap,
+				mb.MiniblockNumber,
+				"expected miniblock %d, found %d",
+				mbs[i-1].MiniblockNumber+1,
+				mb.MiniblockNumber,
+			)
+			prev = nil
+		}
+
+		info, err := NewMiniblockInfoFromBytes(mb.Data, mb.MiniblockNumber)
+		if err != nil {
+			c.report(StorageProblemBadMiniblock, mb.MiniblockNumber, "%v", err)
+			prev = nil
+			continue
+		}
+		header := info.header()
+
+		if i == 0 && mb.MiniblockNumber > 0 && header.GetSnapshot() == nil {
+			// Miniblocks are only pruned up to a snapshot, so the stream can still be loaded.
+			c.report(StorageProblemMiniblockGap, mb.MiniblockNumber, "first stored miniblock has no snapshot")
+		}
+		if mb.MiniblockNumber == 0 && len(header.PrevMiniblockHash) > 0 {
+			c.report(StorageProblemHashChain, 0, "genesis miniblock has prev_miniblock_hash")
+		}
+		if prev != nil && !bytes.Equal(prev.Hash[:], header.PrevMiniblockHash) {
+			c.report(
+				StorageProblemHashChain,
+				mb.MiniblockNumber,
+				"prev_miniblock_hash %s doesn't match hash of miniblock %d %s",
+				common.BytesToHash(header.PrevMiniblockHash),
+				prev.Num,
+				prev.Hash,
+			)
+		}
+
+		if len(header.EventHashes) != len(info.events) {
+			c.report(
+				StorageProblemEventHashes,
+				mb.MiniblockNumber,
+				"header lists %d events, miniblock contains %d",
+				len(header.EventHashes),
+				len(info.events),
+			)
+		} else {
+			for j, e := range info.events {
+				if !bytes.Equal(header.EventHashes[j], e.Hash[:]) {
+					c.report(
+						StorageProblemEventHashes,
+						mb.MiniblockNumber,
+						"event %d hash %s doesn't match header event hash %s",
+						j,
+						e.Hash,
+						common.BytesToHash(header.EventHashes[j]),
+					)
+					break
+				}
+			}
+		}
+
+		if header.GetSnapshot() != nil {
+			lastSnapshot = mb.MiniblockNumber
+		}
+		prev = info
+	}
+
+	if lastSnapshot >= 0 && lastSnapshot != c.data.LatestSnapshotMiniblockNum {
+		c.report(
+			StorageProblemSnapshot,
+			c.data.LatestSnapshotMiniblockNum,
+			"latest snapshot miniblock is %d, but stored latest snapshot miniblock is %d",
+			lastSnapshot,
+			c.data.LatestSnapshotMiniblockNum,
+		)
+	}
+
+	return mbs[len(mbs)-1].MiniblockNumber
+}
+
+func (c *streamStorageChecker) checkMinipool(lastMbNum int64) {
+	expectedSlot := int64(0)
+	for _, e := range c.data.Events {
+		if e.Generation != lastMbNum+1 {
+			c.report(
+				StorageProblemMinipoolGeneration,
+				e.Generation,
+				"minipool generation is %d, expected %d",
+				e.Generation,
+				lastMbNum+1,
+			)
+			return
+		}
+		// Slot -1 is the minipool marker record without event.
+		if e.Slot < 0 {
+			continue
+		}
+		if e.Slot != expectedSlot {
+			c.report(StorageProblemMinipoolSlots, e.Generation, "expected minipool slot %d, found %d", expectedSlot, e.Slot)
+			return
+		}
+		expectedSlot++
+
+		var envelope Envelope
+		if err := proto.Unmarshal(e.Data, &envelope); err != nil {
+			c.report(StorageProblemBadEvent, e.Generation, "slot %d: %v", e.Slot, err)
+			continue
+		}
+		if _, err := ParseEvent(&envelope); err != nil {
+			c.report(StorageProblemBadEvent, e.Generation, "slot %d: %v", e.Slot, err)
+		}
+	}
+}
+
+func (c *streamStorageChecker) checkCandidates(lastMbNum int64) {
+	for _, candidate := range c.data.MbCandidates {
+		if candidate.MiniblockNumber <= lastMbNum {
+			c.report(
+				StorageProblemCandidate,
+				candidate.MiniblockNumber,
+				"candidate %s is not newer than the last miniblock %d",
+				candidate.Hash,
+				lastMbNum,
+			)
+			continue
+		}
+		info, err := NewMiniblockInfoFromBytesWithOpts(
+			candidate.Data,
+			NewMiniblockInfoFromProtoOpts{ExpectedBlockNumber: candidate.MiniblockNumber, DontParseEvents: true},
+		)
+		if err != nil {
+			c.report(StorageProblemCandidate, candidate.MiniblockNumber, "candidate %s: %v", candidate.Hash, err)
+			continue
+		}
+		if info.Hash != candidate.Hash {
+			c.report(
+				StorageProblemCandidate,
+				candidate.MiniblockNumber,
+				"candidate is stored as %s, but its hash is %s",
+				candidate.Hash,
+				info.Hash,
+			)
+		}
+	}
+}
+
+// RepairStreamStorage replaces local data of the stream with miniblocks fetched from the other replicas
+// up to and including the last miniblock in the registry record. Miniblocks are fetched the same way
+// as streams are reconciled: a peer is only used if its last miniblock matches the registry record,
+// and if the miniblocks are pruned on the peers the stream is recreated from the last snapshot of a peer.
+// Local data is replaced in a single transaction. Events of the local minipool in localData are kept
+// if they are not included in the fetched miniblocks. localData can be nil if local data is unreadable.
+// Returns the number of written miniblocks.
+func RepairStreamStorage(
+	ctx context.Context,
+	store storage.StreamStorage,
+	provider RemoteMiniblockProvider,
+	nodes StreamNodes,
+	record *registries.GetStreamResult,
+	localData *storage.DebugReadStreamDataResult,
+) (int, error) {
+	stream := &streamImpl{
+		params:   &StreamCacheParams{Storage: store, RemoteMiniblockProvider: provider},
+		streamId: record.StreamId,
+		nodes:    nodes,
+	}
+	res, err := stream.getReconciledMiniblocksFromPeers(
+		ctx,
+		nil,
+		int64(record.LastMiniblockNum),
+		record.LastMiniblockHash,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	writeMbs, err := reconciledWriteData(res.mbs)
+	if err != nil {
+		return 0, err
+	}
+	envelopes := localMinipoolEnvelopes(localData, res.mbs)
+	if err := store.ReplaceStreamStorage(ctx, record.StreamId, writeMbs, envelopes); err != nil {
+		return 0, err
+	}
+	return len(writeMbs), nil
+}
+
+// localMinipoolEnvelopes returns envelopes of valid local minipool events that are not included in mbs.
+// Minipool is dropped if it's older than the first miniblock in mbs,
+// since its events can be included in miniblocks that are not fetched.
+func localMinipoolEnvelopes(localData *storage.DebugReadStreamDataResult, mbs []*MiniblockInfo) [][]byte {
+	if localData == nil {
+		return nil
+	}
+
+	included := make(map[common.Hash]bool)
+	for _, mb := range mbs {
+		for _, e := range mb.events {
+			included[e.Hash] = true
+		}
+	}
+
+	var envelopes [][]byte
+	for _, e := range localData.Events {
+		// Slot -1 is the minipool marker record without event.
+		if e.Slot < 0 || e.Generation < mbs[0].Num {
+			continue
+		}
+		var envelope Envelope
+		if err := proto.Unmarshal(e.Data, &envelope); err != nil {
+			continue
+		}
+		parsed, err := ParseEvent(&envelope)
+		if err != nil || included[parsed.Hash] {
+			continue
+		}
+		included[parsed.Hash] = true
+		envelopes = append(envelopes, e.Data)
+	}
+	return envelopes
+}
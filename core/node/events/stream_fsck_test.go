@@ -0,0 +1,224 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/nodes"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/registries"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+type fsckTestStream struct {
+	t        *testing.T
+	wallet   *crypto.Wallet
+	streamId StreamId
+	protos   []*Miniblock
+	hashes   []common.Hash
+}
+
+func (s *fsckTestStream) join(prevMiniblockHash common.Hash) *Envelope {
+	e, err := MakeEnvelopeWithPayload(
+		s.wallet,
+		Make_UserPayload_Membership(MembershipOp_SO_JOIN, s.streamId, nil, nil),
+		prevMiniblockHash[:],
+	)
+	require.NoError(s.t, err)
+	return e
+}
+
+// makeMiniblock makes miniblock with the given events and header event hashes linked to prevHash.
+func (s *fsckTestStream) makeMiniblock(num int64, prevHash common.Hash, events []*Envelope, eventHashes [][]byte) *Miniblock {
+	header, err := MakeEnvelopeWithPayload(
+		s.wallet,
+		Make_MiniblockHeader(&MiniblockHeader{
+			MiniblockNum:      num,
+			PrevMiniblockHash: prevHash[:],
+			EventHashes:       eventHashes,
+			Content:           &MiniblockHeader_None{None: &emptypb.Empty{}},
+		}),
+		prevHash[:],
+	)
+	require.NoError(s.t, err)
+	return &Miniblock{Header: header, Events: events}
+}
+
+func (s *fsckTestStream) add(mb *Miniblock) {
+	s.protos = append(s.protos, mb)
+	s.hashes = append(s.hashes, common.BytesToHash(mb.Header.Hash))
+}
+
+func (s *fsckTestStream) bytes(m proto.Message) []byte {
+	data, err := proto.Marshal(m)
+	require.NoError(s.t, err)
+	return data
+}
+
+func (s *fsckTestStream) data() *storage.DebugReadStreamDataResult {
+	data := &storage.DebugReadStreamDataResult{StreamId: s.streamId}
+	for i, mb := range s.protos {
+		data.Miniblocks = append(data.Miniblocks, storage.MiniblockDescriptor{
+			MiniblockNumber: int64(i),
+			Data:            s.bytes(mb),
+		})
+	}
+	gen := int64(len(s.protos))
+	data.Events = []storage.EventDescriptor{
+		{Generation: gen, Slot: -1},
+		{Generation: gen, Slot: 0, Data: s.bytes(s.join(s.hashes[gen-1]))},
+	}
+	return data
+}
+
+func newFsckTestStream(t *testing.T) *fsckTestStream {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(t, err)
+	s := &fsckTestStream{t: t, wallet: wallet, streamId: UserStreamIdFromAddr(wallet.Address)}
+
+	inception, err := MakeEnvelopeWithPayload(wallet, Make_UserPayload_Inception(s.streamId, nil), nil)
+	require.NoError(t, err)
+	join := s.join(common.Hash{})
+	genesis, err := MakeGenesisMiniblock(wallet, []*ParsedEvent{parsedEvent(t, inception), parsedEvent(t, join)})
+	require.NoError(t, err)
+	s.add(genesis)
+
+	for i := int64(1); i < 3; i++ {
+		e := s.join(s.hashes[i-1])
+		s.add(s.makeMiniblock(i, s.hashes[i-1], []*Envelope{e}, [][]byte{e.Hash}))
+	}
+	return s
+}
+
+func problemTypes(problems []*StorageProblem) []StorageProblemType {
+	var types []StorageProblemType
+	for _, p := range problems {
+		types = append(types, p.Type)
+	}
+	return types
+}
+
+func TestCheckStreamStorage(t *testing.T) {
+	require := require.New(t)
+	s := newFsckTestStream(t)
+
+	require.Empty(CheckStreamStorage(s.data()))
+
+	data := s.data()
+	data.Miniblocks = append(data.Miniblocks[:1], data.Miniblocks[2:]...)
+	require.Contains(problemTypes(CheckStreamStorage(data)), StorageProblemMiniblockGap)
+
+	data = s.data()
+	e := s.join(s.hashes[0])
+	data.Miniblocks[2].Data = s.bytes(s.makeMiniblock(2, s.hashes[0], []*Envelope{e}, [][]byte{e.Hash}))
+	require.Equal([]StorageProblemType{StorageProblemHashChain}, problemTypes(CheckStreamStorage(data)))
+
+	data = s.data()
+	data.Miniblocks[2].Data = s.bytes(s.makeMiniblock(2, s.hashes[1], []*Envelope{e}, nil))
+	require.Equal([]StorageProblemType{StorageProblemEventHashes}, problemTypes(CheckStreamStorage(data)))
+
+	data = s.data()
+	data.Miniblocks[1].Data = []byte("garbage")
+	require.Equal([]StorageProblemType{StorageProblemBadMiniblock}, problemTypes(CheckStreamStorage(data)))
+
+	data = s.data()
+	data.LatestSnapshotMiniblockNum = 1
+	require.Equal([]StorageProblemType{StorageProblemSnapshot}, problemTypes(CheckStreamStorage(data)))
+
+	data = s.data()
+	data.Events[0].Generation = 2
+	require.Equal([]StorageProblemType{StorageProblemMinipoolGeneration}, problemTypes(CheckStreamStorage(data)))
+
+	data = s.data()
+	data.Events[1].Slot = 1
+	require.Equal([]StorageProblemType{StorageProblemMinipoolSlots}, problemTypes(CheckStreamStorage(data)))
+
+	data = s.data()
+	data.MbCandidates = []storage.MiniblockDescriptor{
+		{MiniblockNumber: 2, Hash: s.hashes[2], Data: data.Miniblocks[2].Data},
+	}
+	require.Equal([]StorageProblemType{StorageProblemCandidate}, problemTypes(CheckStreamStorage(data)))
+}
+
+// fsckTestProvider serves miniblocks of the test stream from the other replicas.
+type fsckTestProvider struct {
+	RemoteMiniblockProvider
+	mbs map[common.Address][]*Miniblock
+}
+
+func (p *fsckTestProvider) GetMbs(
+	ctx context.Context,
+	node common.Address,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([]*Miniblock, error) {
+	mbs := p.mbs[node]
+	return mbs[min(fromInclusive, int64(len(mbs))):min(toExclusive, int64(len(mbs)))], nil
+}
+
+func (p *fsckTestProvider) GetLastSnapshotMbs(
+	ctx context.Context,
+	node common.Address,
+	streamId StreamId,
+) ([]*Miniblock, int64, error) {
+	return p.mbs[node], 0, nil
+}
+
+func TestRepairStreamStorage(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+	s := newFsckTestStream(t)
+
+	store := storage.NewMemEventStore()
+	require.NoError(store.CreateStreamStorage(ctx, s.streamId, []byte("garbage")))
+	localData := s.data()
+
+	record := &registries.GetStreamResult{
+		StreamId:          s.streamId,
+		LastMiniblockNum:  2,
+		LastMiniblockHash: s.hashes[2],
+	}
+	local := common.Address{1}
+	good := common.Address{2}
+	forked := common.Address{3}
+	forkedMbs := append([]*Miniblock{}, s.protos...)
+	forkedMbs[2] = s.makeMiniblock(2, s.hashes[1], nil, nil)
+	provider := &fsckTestProvider{mbs: map[common.Address][]*Miniblock{good: s.protos, forked: forkedMbs}}
+
+	// Peer chain that doesn't match the registry is not written.
+	_, err := RepairStreamStorage(
+		ctx, store, provider, NewStreamNodes([]common.Address{local, forked}, local), record, localData,
+	)
+	require.Equal(Err_BAD_BLOCK, AsRiverError(err).Code)
+	data, err := store.DebugReadStreamData(ctx, s.streamId)
+	require.NoError(err)
+	require.Equal([]byte("garbage"), data.Miniblocks[0].Data)
+
+	written, err := RepairStreamStorage(
+		ctx, store, provider, NewStreamNodes([]common.Address{local, forked, good}, local), record, localData,
+	)
+	require.NoError(err)
+	require.Equal(3, written)
+
+	data, err = store.DebugReadStreamData(ctx, s.streamId)
+	require.NoError(err)
+	require.Len(data.Miniblocks, 3)
+	require.Empty(CheckStreamStorage(data))
+
+	// Local minipool event is kept.
+	require.Len(data.Events, 2)
+	require.Equal(localData.Events[1].Data, data.Events[1].Data)
+}
@@ -0,0 +1,222 @@
+package events
+
+import (
+	"context"
+	"slices"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+const (
+	DefaultStreamHistoryPageSize = 50
+	MaxStreamHistoryPageSize     = 500
+
+	// streamHistoryMaxMiniblocks limits the number of miniblocks scanned by a single history request,
+	// so requests with a selective payload type filter don't read the whole stream at once.
+	streamHistoryMaxMiniblocks = 1000
+
+	// streamHistoryReadBatch is the number of miniblocks read from storage at once.
+	streamHistoryReadBatch = 10
+)
+
+type streamHistoryReader struct {
+	store    storage.StreamStorage
+	streamId StreamId
+	req      *GetStreamHistoryRequest
+	filter   PayloadTypeFilter
+}
+
+// ReadStreamHistory returns a page of stream events older than the request cursor
+// from the miniblocks stored locally, lastMiniblockNum is the number of the last miniblock of the stream.
+// Minipool events are not returned.
+func ReadStreamHistory(
+	ctx context.Context,
+	store storage.StreamStorage,
+	streamId StreamId,
+	lastMiniblockNum int64,
+	req *GetStreamHistoryRequest,
+) (*GetStreamHistoryResponse, error) {
+	filter, err := NewPayloadTypeFilter(req.PayloadTypes)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = DefaultStreamHistoryPageSize
+	}
+	pageSize = min(pageSize, MaxStreamHistoryPageSize)
+
+	r := &streamHistoryReader{
+		store:    store,
+		streamId: streamId,
+		req:      req,
+		filter:   filter,
+	}
+
+	start, err := r.startMiniblock(ctx, lastMiniblockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &GetStreamHistoryResponse{}
+	scanned := 0
+	for num := start; ; {
+		if num < 0 {
+			resp.Terminus = true
+			break
+		}
+		mbs, err := r.readMiniblocksBefore(ctx, num)
+		if err != nil {
+			return nil, err
+		}
+		if len(mbs) == 0 {
+			// Older miniblocks are pruned.
+			resp.Terminus = true
+			break
+		}
+
+		full := false
+		for i := len(mbs) - 1; i >= 0 && !full; i-- {
+			mb := mbs[i]
+			resp.NextEventNum = mb.header().EventNumOffset
+			scanned++
+			for j := len(mb.events) - 1; j >= 0; j-- {
+				eventNum := mb.header().EventNumOffset + int64(j)
+				if !r.include(mb.events[j], eventNum) {
+					continue
+				}
+				resp.Events = append(resp.Events, &StreamHistoryEvent{
+					Event:        mb.events[j].Envelope,
+					MiniblockNum: mb.Num,
+					EventNum:     eventNum,
+				})
+				if len(resp.Events) >= pageSize {
+					resp.NextEventNum = eventNum
+					full = true
+					break
+				}
+			}
+		}
+		num = mbs[0].Num - 1
+		if full || scanned >= streamHistoryMaxMiniblocks {
+			break
+		}
+	}
+
+	slices.Reverse(resp.Events)
+	return resp, nil
+}
+
+func (r *streamHistoryReader) include(event *ParsedEvent, eventNum int64) bool {
+	switch cursor := r.req.Cursor.(type) {
+	case *GetStreamHistoryRequest_EventNum:
+		if eventNum >= cursor.EventNum {
+			return false
+		}
+	case *GetStreamHistoryRequest_CreatedBeforeEpochMs:
+		if event.Event.CreatedAtEpochMs >= cursor.CreatedBeforeEpochMs {
+			return false
+		}
+	}
+	return r.filter.Matches(event.Event)
+}
+
+// startMiniblock returns the number of the newest miniblock that may contain events older than the cursor,
+// or -1 if there are no such miniblocks.
+func (r *streamHistoryReader) startMiniblock(ctx context.Context, lastMiniblockNum int64) (int64, error) {
+	switch cursor := r.req.Cursor.(type) {
+	case *GetStreamHistoryRequest_EventNum:
+		// Last miniblock with events numbered below the cursor.
+		return r.searchMiniblocks(ctx, lastMiniblockNum, func(header *MiniblockHeader) bool {
+			return header.EventNumOffset < cursor.EventNum
+		})
+	case *GetStreamHistoryRequest_MiniblockNum:
+		return min(cursor.MiniblockNum-1, lastMiniblockNum), nil
+	case *GetStreamHistoryRequest_CreatedBeforeEpochMs:
+		// Events created before the cursor can be included into the first miniblock produced after the cursor.
+		num, err := r.searchMiniblocks(ctx, lastMiniblockNum, func(header *MiniblockHeader) bool {
+			return header.GetTimestamp().AsTime().UnixMilli() < cursor.CreatedBeforeEpochMs
+		})
+		if err != nil {
+			return -1, err
+		}
+		return min(num+1, lastMiniblockNum), nil
+	default:
+		return lastMiniblockNum, nil
+	}
+}
+
+// searchMiniblocks returns the number of the last miniblock in [0, lastMiniblockNum] for which before is true
+// or -1 if there is no such miniblock. before must be true for some prefix of the stream miniblocks.
+// Pruned miniblocks are considered to be before.
+func (r *streamHistoryReader) searchMiniblocks(
+	ctx context.Context,
+	lastMiniblockNum int64,
+	before func(header *MiniblockHeader) bool,
+) (int64, error) {
+	lo, hi := int64(-1), lastMiniblockNum+1
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		mbs, err := r.store.ReadMiniblocks(ctx, r.streamId, mid, mid+1)
+		if err != nil && AsRiverError(err).Code != Err_MINIBLOCKS_PRUNED {
+			return -1, err
+		}
+		isBefore := err != nil // pruned
+		if !isBefore {
+			if len(mbs) != 1 {
+				return -1, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblock not found", "miniblockNum", mid)
+			}
+			mb, err := NewMiniblockInfoFromBytesWithOpts(
+				mbs[0],
+				NewMiniblockInfoFromProtoOpts{ExpectedBlockNumber: mid, DontParseEvents: true},
+			)
+			if err != nil {
+				return -1, err
+			}
+			isBefore = before(mb.header())
+		}
+		if isBefore {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
+
+// readMiniblocksBefore returns a batch of miniblocks ending with the miniblock toInclusive in the stream order.
+// Pruned miniblocks are skipped, so the result is empty if toInclusive is pruned.
+func (r *streamHistoryReader) readMiniblocksBefore(ctx context.Context, toInclusive int64) ([]*MiniblockInfo, error) {
+	fromInclusive := max(0, toInclusive-streamHistoryReadBatch+1)
+	data, err := r.store.ReadMiniblocks(ctx, r.streamId, fromInclusive, toInclusive+1)
+	if err != nil {
+		if AsRiverError(err).Code != Err_MINIBLOCKS_PRUNED {
+			return nil, err
+		}
+		// Beginning of the batch is pruned, read remaining miniblocks one by one.
+		data = nil
+		for num := toInclusive; num >= fromInclusive; num-- {
+			mb, err := r.store.ReadMiniblocks(ctx, r.streamId, num, num+1)
+			if err != nil {
+				if AsRiverError(err).Code == Err_MINIBLOCKS_PRUNED {
+					break
+				}
+				return nil, err
+			}
+			data = append(mb, data...)
+		}
+		fromInclusive = toInclusive - int64(len(data)) + 1
+	}
+
+	mbs := make([]*MiniblockInfo, len(data))
+	for i, bytes := range data {
+		mbs[i], err = NewMiniblockInfoFromBytes(bytes, fromInclusive+int64(i))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return mbs, nil
+}
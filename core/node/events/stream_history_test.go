@@ -0,0 +1,171 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+// writeHistoryTestStream writes stream with 5 miniblocks to the store:
+// genesis miniblock with 2 membership events and 4 miniblocks with a message, a membership event and a message.
+// Miniblock 2 has a snapshot. Event numbers are 0-1, 3-5, 7-9, 11-13 and 15-17.
+func writeHistoryTestStream(t *testing.T, ctx context.Context, store storage.StreamStorage) StreamId {
+	require := require.New(t)
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	start := time.Now().Add(-time.Hour)
+	eventNumOffset := int64(0)
+	for num := int64(0); num < 5; num++ {
+		var payloads []IsStreamEvent_Payload
+		if num == 0 {
+			payloads = []IsStreamEvent_Payload{
+				Make_UserPayload_Membership(MembershipOp_SO_JOIN, streamId, nil, nil),
+				Make_UserPayload_Membership(MembershipOp_SO_JOIN, streamId, nil, nil),
+			}
+		} else {
+			payloads = []IsStreamEvent_Payload{
+				Make_ChannelPayload_Message("before"),
+				Make_UserPayload_Membership(MembershipOp_SO_JOIN, streamId, nil, nil),
+				Make_ChannelPayload_Message("after"),
+			}
+		}
+
+		mb := &Miniblock{}
+		var hashes [][]byte
+		for _, payload := range payloads {
+			envelope, err := MakeEnvelopeWithPayload(wallet, payload, nil)
+			require.NoError(err)
+			mb.Events = append(mb.Events, envelope)
+			hashes = append(hashes, envelope.Hash)
+		}
+		header := &MiniblockHeader{
+			MiniblockNum:   num,
+			Timestamp:      timestamppb.New(start.Add(time.Duration(num) * time.Minute)),
+			EventHashes:    hashes,
+			EventNumOffset: eventNumOffset,
+			Content:        &MiniblockHeader_None{None: &emptypb.Empty{}},
+		}
+		if num == 2 {
+			header.Snapshot = &Snapshot{}
+		}
+		mb.Header, err = MakeEnvelopeWithPayload(wallet, Make_MiniblockHeader(header), nil)
+		require.NoError(err)
+		eventNumOffset += int64(len(payloads)) + 1
+
+		data, err := proto.Marshal(mb)
+		require.NoError(err)
+		if num == 0 {
+			require.NoError(store.CreateStreamStorage(ctx, streamId, data))
+		} else {
+			require.NoError(store.WriteMiniblocks(
+				ctx,
+				streamId,
+				[]*storage.WriteMiniblockData{{Number: num, Snapshot: num == 2, Data: data}},
+				nil,
+			))
+		}
+	}
+	return streamId
+}
+
+func historyEventNums(resp *GetStreamHistoryResponse) []int64 {
+	nums := []int64{}
+	for _, e := range resp.Events {
+		nums = append(nums, e.EventNum)
+	}
+	return nums
+}
+
+func TestReadStreamHistory(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	store := storage.NewMemEventStore()
+	streamId := writeHistoryTestStream(t, ctx, store)
+
+	read := func(req *GetStreamHistoryRequest) *GetStreamHistoryResponse {
+		resp, err := ReadStreamHistory(ctx, store, streamId, 4, req)
+		require.NoError(err)
+		return resp
+	}
+
+	resp := read(&GetStreamHistoryRequest{PageSize: 4})
+	require.Equal([]int64{13, 15, 16, 17}, historyEventNums(resp))
+	require.EqualValues(3, resp.Events[0].MiniblockNum)
+	require.EqualValues(13, resp.NextEventNum)
+	require.False(resp.Terminus)
+
+	resp = read(&GetStreamHistoryRequest{Cursor: &GetStreamHistoryRequest_EventNum{EventNum: 13}, PageSize: 4})
+	require.Equal([]int64{8, 9, 11, 12}, historyEventNums(resp))
+	require.EqualValues(8, resp.NextEventNum)
+
+	resp = read(&GetStreamHistoryRequest{Cursor: &GetStreamHistoryRequest_EventNum{EventNum: 8}})
+	require.Equal([]int64{0, 1, 3, 4, 5, 7}, historyEventNums(resp))
+	require.True(resp.Terminus)
+
+	resp = read(&GetStreamHistoryRequest{Cursor: &GetStreamHistoryRequest_MiniblockNum{MiniblockNum: 2}})
+	require.Equal([]int64{0, 1, 3, 4, 5}, historyEventNums(resp))
+	require.True(resp.Terminus)
+
+	resp = read(&GetStreamHistoryRequest{
+		Cursor: &GetStreamHistoryRequest_CreatedBeforeEpochMs{
+			CreatedBeforeEpochMs: time.Now().Add(time.Hour).UnixMilli(),
+		},
+		PageSize: 2,
+	})
+	require.Equal([]int64{16, 17}, historyEventNums(resp))
+
+	resp = read(&GetStreamHistoryRequest{
+		Cursor: &GetStreamHistoryRequest_CreatedBeforeEpochMs{
+			CreatedBeforeEpochMs: time.Now().Add(-2 * time.Hour).UnixMilli(),
+		},
+	})
+	require.Empty(resp.Events)
+	require.True(resp.Terminus)
+
+	resp = read(&GetStreamHistoryRequest{PayloadTypes: []string{"channel_payload.message"}, PageSize: 5})
+	require.Equal([]int64{9, 11, 13, 15, 17}, historyEventNums(resp))
+	for _, e := range resp.Events {
+		parsed, err := ParseEvent(e.Event)
+		require.NoError(err)
+		require.NotNil(parsed.Event.GetChannelPayload().GetMessage())
+	}
+
+	resp = read(&GetStreamHistoryRequest{PayloadTypes: []string{"user_payload"}})
+	require.Equal([]int64{0, 1, 4, 8, 12, 16}, historyEventNums(resp))
+
+	_, err := ReadStreamHistory(ctx, store, streamId, 4, &GetStreamHistoryRequest{PayloadTypes: []string{"message"}})
+	require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+	_, err = ReadStreamHistory(
+		ctx, store, streamId, 4, &GetStreamHistoryRequest{PayloadTypes: []string{"channel_payload.join"}})
+	require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+
+	// Pruned miniblocks are not available on the node.
+	pruned, err := store.PruneMiniblocks(ctx, streamId, 2)
+	require.NoError(err)
+	require.EqualValues(2, pruned)
+
+	resp = read(&GetStreamHistoryRequest{Cursor: &GetStreamHistoryRequest_EventNum{EventNum: 9}})
+	require.Equal([]int64{7, 8}, historyEventNums(resp))
+	require.True(resp.Terminus)
+
+	resp = read(&GetStreamHistoryRequest{Cursor: &GetStreamHistoryRequest_EventNum{EventNum: 5}})
+	require.Empty(resp.Events)
+	require.True(resp.Terminus)
+}
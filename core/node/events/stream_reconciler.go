@@ -0,0 +1,545 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+const (
+	// reconcileBatchSize is the max number of miniblocks requested from a peer in a single call
+	// and written to storage in a single transaction.
+	reconcileBatchSize = 100
+
+	// reconcileWorkers is the number of streams that are reconciled in parallel in the background.
+	reconcileWorkers = 8
+
+	// reconcileQueueSize is the max number of streams waiting for background reconciliation.
+	reconcileQueueSize = 4096
+
+	// reconcileMaxMiniblocks is the max number of miniblocks fetched from a peer to bring a stream up to date.
+	// Fetched miniblocks are kept in memory until the last one is checked against the registry,
+	// streams that are further behind are recreated from the last snapshot of the peer.
+	reconcileMaxMiniblocks = 10 * reconcileBatchSize
+)
+
+// streamReconciler brings local streams that are behind the River registry up to date
+// by fetching missing miniblocks from the other stream replicas.
+//
+// Gaps are detected on startup by comparing local storage with the registry,
+// when the registry reports a miniblock for which there is no local candidate
+// and when a stream fails to load.
+type streamReconciler struct {
+	cache *streamCacheImpl
+
+	// pendingMu guards pending.
+	pendingMu sync.Mutex
+	// pending contains ids of streams that are scheduled or being reconciled.
+	// The value is true if the stream is scheduled again while it's being reconciled,
+	// so it's reconciled once more to catch up with miniblocks registered in the meantime.
+	pending map[StreamId]bool
+	queue   chan *streamImpl
+
+	reconciliations *infra.StatusCounterVec
+}
+
+func newStreamReconciler(cache *streamCacheImpl) *streamReconciler {
+	return &streamReconciler{
+		cache:   cache,
+		pending: make(map[StreamId]bool),
+		queue:   make(chan *streamImpl, reconcileQueueSize),
+		reconciliations: cache.params.Metrics.NewStatusCounterVecEx(
+			"stream_reconciliations", "Number of background stream reconciliations",
+		),
+	}
+}
+
+// run starts the background workers that process scheduled reconciliations.
+func (r *streamReconciler) run(ctx context.Context) {
+	for range reconcileWorkers {
+		go r.worker(ctx)
+	}
+}
+
+func (r *streamReconciler) worker(ctx context.Context) {
+	log := dlog.FromCtx(ctx)
+	for {
+		select {
+		case stream := <-r.queue:
+			for {
+				if err := r.reconcile(ctx, stream); err != nil {
+					r.reconciliations.IncFail()
+					log.Warn("streamReconciler: failed to reconcile stream", "streamId", stream.streamId, "err", err)
+				} else {
+					r.reconciliations.IncPass()
+				}
+				if !r.done(stream.streamId) || ctx.Err() != nil {
+					break
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// markPending marks the stream as pending and returns true if it should be added to the queue.
+// If the stream is already pending, it's reconciled again after the current reconciliation.
+func (r *streamReconciler) markPending(streamId StreamId) bool {
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+	if _, ok := r.pending[streamId]; ok {
+		r.pending[streamId] = true
+		return false
+	}
+	r.pending[streamId] = false
+	return true
+}
+
+// done is called after the stream is reconciled, it returns true if the stream should be reconciled again.
+func (r *streamReconciler) done(streamId StreamId) bool {
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+	if r.pending[streamId] {
+		r.pending[streamId] = false
+		return true
+	}
+	delete(r.pending, streamId)
+	return false
+}
+
+func (r *streamReconciler) unmarkPending(streamId StreamId) {
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+	delete(r.pending, streamId)
+}
+
+// schedule adds the given stream to the reconciliation queue.
+// If the stream is being reconciled, it's reconciled again once the current reconciliation is done.
+// It's a no-op if the stream is already scheduled or if the queue is full.
+func (r *streamReconciler) schedule(ctx context.Context, stream *streamImpl) {
+	if !r.markPending(stream.streamId) {
+		return
+	}
+
+	select {
+	case r.queue <- stream:
+	default:
+		r.unmarkPending(stream.streamId)
+		dlog.FromCtx(ctx).Warn("streamReconciler: queue full, reconciliation dropped", "streamId", stream.streamId)
+	}
+}
+
+// scheduleBehind reads the last miniblock number from local storage for each given stream and
+// schedules reconciliation for streams that are missing or behind the last miniblock number in the registry.
+func (r *streamReconciler) scheduleBehind(ctx context.Context, streams map[*streamImpl]int64) {
+	log := dlog.FromCtx(ctx)
+	for stream, registryLastMbNum := range streams {
+		if ctx.Err() != nil {
+			return
+		}
+
+		localLastMbNum, err := r.cache.params.Storage.GetLastMiniblockNumber(ctx, stream.streamId)
+		if err != nil && AsRiverError(err).Code != Err_NOT_FOUND {
+			log.Warn("streamReconciler: failed to read stream state", "streamId", stream.streamId, "err", err)
+			continue
+		}
+		if err == nil && localLastMbNum >= registryLastMbNum {
+			continue
+		}
+
+		if !r.markPending(stream.streamId) {
+			continue
+		}
+		select {
+		case r.queue <- stream:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// needsReconciliation returns true if the stream failed to load because it's missing in local storage,
+// which is repaired by reconciling the stream from its replicas. Cancelled requests and other failures,
+// such as transient storage errors, don't schedule reconciliation.
+func needsReconciliation(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	return AsRiverError(err).Code == Err_NOT_FOUND
+}
+
+func (r *streamReconciler) reconcile(ctx context.Context, stream *streamImpl) error {
+	record, err := r.cache.params.Registry.GetStream(ctx, stream.streamId)
+	if err != nil {
+		return err
+	}
+	return stream.reconcile(ctx, int64(record.LastMiniblockNum), record.LastMiniblockHash)
+}
+
+// reconciledMiniblocks are miniblocks fetched from a peer that end with the miniblock registered in the registry.
+type reconciledMiniblocks struct {
+	mbs []*MiniblockInfo
+	// replace is true if mbs start with the genesis or a snapshot miniblock and replace local storage of the stream,
+	// otherwise mbs follow the last local miniblock.
+	replace bool
+}
+
+// reconcile fetches miniblocks after the last local miniblock up to and including lastMbNum
+// from the other stream replicas and writes them to storage. The hash of the last fetched miniblock
+// must be equal to lastMbHash, as registered in the registry.
+// Miniblocks are fetched without holding s.mu, so the stream is served while it's being reconciled.
+func (s *streamImpl) reconcile(ctx context.Context, lastMbNum int64, lastMbHash common.Hash) error {
+	s.mu.Lock()
+	err := s.loadInternal(ctx)
+	var lastBlock *MiniblockInfo
+	if err == nil {
+		lastBlock = s.view.LastBlock()
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if lastBlock.Num >= lastMbNum {
+		return nil
+	}
+
+	log := dlog.FromCtx(ctx)
+	log.Info("Reconciling stream from peers",
+		"streamId", s.streamId, "localLastMbNum", lastBlock.Num, "lastMbNum", lastMbNum)
+
+	res, err := s.getReconciledMiniblocksFromPeers(ctx, lastBlock, lastMbNum, lastMbHash)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.applyReconciledMiniblocksNoLock(ctx, res)
+}
+
+// applyReconciledMiniblocksNoLock writes miniblocks fetched from peers to storage.
+// If the miniblocks follow the last local miniblock, they are appended to the stream and
+// minipool events that are not included in them are kept in the minipool.
+// Miniblocks that are applied locally while the miniblocks were fetched are skipped.
+// Otherwise local storage is replaced with the fetched miniblocks in a single transaction
+// and local minipool is dropped: events in it are either included in the fetched miniblocks or outdated.
+// After reconciliation the view is reloaded from storage and subscribers receive a sync reset.
+//
+// Caller must hold s.mu.
+func (s *streamImpl) applyReconciledMiniblocksNoLock(ctx context.Context, res *reconciledMiniblocks) error {
+	if err := s.loadInternal(ctx); err != nil {
+		return err
+	}
+	lastBlock := s.view.LastBlock()
+	if lastBlock.Num >= res.mbs[len(res.mbs)-1].Num {
+		return nil
+	}
+
+	if res.replace {
+		writeMbs, err := reconciledWriteData(res.mbs)
+		if err != nil {
+			return err
+		}
+		if err := s.params.Storage.ReplaceStreamStorage(ctx, s.streamId, writeMbs, nil); err != nil {
+			return err
+		}
+	} else {
+		mbs := res.mbs
+		// Skip miniblocks that are applied locally while the miniblocks were fetched.
+		if skip := lastBlock.Num + 1 - mbs[0].Num; skip > 0 {
+			if mbs[skip-1].Hash != lastBlock.Hash {
+				return RiverError(Err_BAD_BLOCK, "Local miniblock doesn't match peer miniblock",
+					"streamId", s.streamId,
+					"mbNum", lastBlock.Num,
+					"expected", mbs[skip-1].Hash,
+					"actual", lastBlock.Hash,
+				).Func("applyReconciledMiniblocksNoLock")
+			}
+			mbs = mbs[skip:]
+		} else if skip < 0 || !bytes.Equal(lastBlock.Hash[:], mbs[0].header().PrevMiniblockHash) {
+			return RiverError(Err_BAD_BLOCK, "Peer miniblock doesn't link to the last local miniblock",
+				"streamId", s.streamId,
+				"mbNum", mbs[0].Num,
+				"localLastMbNum", lastBlock.Num,
+			).Func("applyReconciledMiniblocksNoLock")
+		}
+
+		minipool := s.view.minipool.events.Copy(0)
+		for _, mb := range mbs {
+			for _, e := range mb.events {
+				if minipool.Has(e.Hash) {
+					minipool.Delete(e.Hash)
+				}
+			}
+		}
+		envelopes := make([][]byte, 0, minipool.Len())
+		for _, e := range minipool.Values {
+			b, err := e.GetEnvelopeBytes()
+			if err != nil {
+				return err
+			}
+			envelopes = append(envelopes, b)
+		}
+
+		writeMbs, err := reconciledWriteData(mbs)
+		if err != nil {
+			return err
+		}
+		if err := s.params.Storage.WriteMiniblocks(ctx, s.streamId, writeMbs, envelopes); err != nil {
+			return err
+		}
+	}
+
+	// Reload view from storage, subscribers are reset since their cookies refer to the old minipool.
+	s.view = nil
+	if err := s.loadInternal(ctx); err != nil {
+		return err
+	}
+
+	if s.receivers != nil && s.receivers.Cardinality() > 0 {
+		resp := &StreamAndCookie{
+			Events:         s.view.MinipoolEnvelopes(),
+			NextSyncCookie: s.view.SyncCookie(s.params.Wallet.Address),
+			Miniblocks:     s.view.MiniblocksFromLastSnapshot(),
+			SyncReset:      true,
+		}
+		for receiver := range s.receivers.Iter() {
+			receiver.OnUpdate(resp, s.view.minipool.events.Values)
+		}
+	}
+
+	dlog.FromCtx(ctx).Info("Stream reconciled", "streamId", s.streamId, "lastMbNum", s.view.LastBlock().Num)
+	return nil
+}
+
+// initFromPeersNoLock creates local storage of the stream from miniblocks fetched from the other replicas
+// up to and including lastMbNum and loads the view. The hash of the last fetched miniblock must be equal to lastMbHash.
+//
+// Caller must hold s.mu.
+func (s *streamImpl) initFromPeersNoLock(ctx context.Context, lastMbNum int64, lastMbHash common.Hash) error {
+	res, err := s.getReconciledMiniblocksFromPeers(ctx, nil, lastMbNum, lastMbHash)
+	if err != nil {
+		return err
+	}
+	writeMbs, err := reconciledWriteData(res.mbs)
+	if err != nil {
+		return err
+	}
+	if err := s.params.Storage.ReplaceStreamStorage(ctx, s.streamId, writeMbs, nil); err != nil {
+		return err
+	}
+	return s.loadInternal(ctx)
+}
+
+// reconciledWriteData converts fetched miniblocks to the storage format.
+func reconciledWriteData(mbs []*MiniblockInfo) ([]*storage.WriteMiniblockData, error) {
+	writeMbs := make([]*storage.WriteMiniblockData, len(mbs))
+	for i, mb := range mbs {
+		data, err := mb.ToBytes()
+		if err != nil {
+			return nil, err
+		}
+		writeMbs[i] = &storage.WriteMiniblockData{
+			Number:   mb.Num,
+			Snapshot: mb.header().GetSnapshot() != nil,
+			Data:     data,
+		}
+	}
+	return writeMbs, nil
+}
+
+// getReconciledMiniblocksFromPeers fetches miniblocks after lastBlock up to and including lastMbNum
+// from the sticky peer. If lastBlock is nil, miniblocks are fetched starting from genesis.
+// The peer is rejected and the next peer is tried if it fails, if its miniblocks don't link to lastBlock
+// or if the hash of its miniblock lastMbNum is not lastMbHash, so a replica with a broken or forked chain
+// can't replace local data.
+func (s *streamImpl) getReconciledMiniblocksFromPeers(
+	ctx context.Context,
+	lastBlock *MiniblockInfo,
+	lastMbNum int64,
+	lastMbHash common.Hash,
+) (*reconciledMiniblocks, error) {
+	if s.nodes.NumRemotes() == 0 {
+		return nil, RiverError(Err_UNAVAILABLE, "No peers to reconcile stream from", "streamId", s.streamId).
+			Func("getReconciledMiniblocksFromPeers")
+	}
+
+	var lastErr error
+	peer := s.nodes.GetStickyPeer()
+	for range s.nodes.NumRemotes() {
+		res, err := s.getReconciledMiniblocksFromPeer(ctx, peer, lastBlock, lastMbNum, lastMbHash)
+		if err == nil {
+			return res, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+		dlog.FromCtx(ctx).Warn("Failed to reconcile stream from peer", "streamId", s.streamId, "peer", peer, "err", err)
+		peer = s.nodes.AdvanceStickyPeer(peer)
+	}
+	return nil, AsRiverError(lastErr, Err_UNAVAILABLE).
+		Func("getReconciledMiniblocksFromPeers").
+		Tags("streamId", s.streamId, "lastMbNum", lastMbNum)
+}
+
+// getReconciledMiniblocksFromPeer fetches miniblocks after lastBlock up to and including lastMbNum from the given peer.
+// If the miniblocks are pruned on the peer or there are more than reconcileMaxMiniblocks of them,
+// miniblocks are fetched starting from the last snapshot of the peer instead and replace local storage.
+func (s *streamImpl) getReconciledMiniblocksFromPeer(
+	ctx context.Context,
+	peer common.Address,
+	lastBlock *MiniblockInfo,
+	lastMbNum int64,
+	lastMbHash common.Hash,
+) (*reconciledMiniblocks, error) {
+	var fromInclusive int64
+	var prevHash common.Hash
+	if lastBlock != nil {
+		fromInclusive = lastBlock.Num + 1
+		prevHash = lastBlock.Hash
+	}
+
+	res := &reconciledMiniblocks{replace: lastBlock == nil}
+	var err error
+	if lastMbNum-fromInclusive < reconcileMaxMiniblocks {
+		res.mbs, err = s.getMiniblocksFromPeer(ctx, peer, fromInclusive, lastMbNum, prevHash)
+	}
+	if err != nil && AsRiverError(err).Code != Err_MINIBLOCKS_PRUNED {
+		return nil, err
+	}
+	if res.mbs == nil {
+		dlog.FromCtx(ctx).Info("Reconciling stream from the last snapshot of peer",
+			"streamId", s.streamId, "peer", peer, "fromInclusive", fromInclusive, "lastMbNum", lastMbNum)
+		res.replace = true
+		if res.mbs, err = s.getLastSnapshotMiniblocksFromPeer(ctx, peer, lastMbNum); err != nil {
+			return nil, err
+		}
+	}
+
+	last := res.mbs[len(res.mbs)-1]
+	if last.Num != lastMbNum || last.Hash != lastMbHash {
+		return nil, RiverError(Err_BAD_BLOCK, "Peer miniblock doesn't match the registry",
+			"streamId", s.streamId,
+			"peer", peer,
+			"mbNum", last.Num,
+			"expectedMbNum", lastMbNum,
+			"expected", lastMbHash,
+			"actual", last.Hash,
+		).Func("getReconciledMiniblocksFromPeer")
+	}
+	return res, nil
+}
+
+// getLastSnapshotMiniblocksFromPeer fetches miniblocks starting from the last snapshot miniblock
+// up to and including lastMbNum from the given peer.
+func (s *streamImpl) getLastSnapshotMiniblocksFromPeer(
+	ctx context.Context,
+	peer common.Address,
+	lastMbNum int64,
+) ([]*MiniblockInfo, error) {
+	mbs, fromInclusive, err := s.params.RemoteMiniblockProvider.GetLastSnapshotMbs(ctx, peer, s.streamId)
+	if err != nil {
+		return nil, err
+	}
+	if len(mbs) > int(lastMbNum-fromInclusive+1) {
+		mbs = mbs[:max(0, lastMbNum-fromInclusive+1)]
+	}
+	if len(mbs) == 0 {
+		return nil, RiverError(Err_NOT_FOUND, "Peer returned no miniblocks", "peer", peer)
+	}
+
+	infos, err := s.parsePeerMiniblocks(mbs, fromInclusive, common.Hash{})
+	if err != nil {
+		return nil, err
+	}
+	if infos[0].header().GetSnapshot() == nil {
+		return nil, RiverError(Err_BAD_BLOCK, "Peer miniblock is not a snapshot",
+			"streamId", s.streamId, "mbNum", infos[0].Num).Func("getLastSnapshotMiniblocksFromPeer")
+	}
+
+	last := infos[len(infos)-1]
+	if last.Num < lastMbNum {
+		rest, err := s.getMiniblocksFromPeer(ctx, peer, last.Num+1, lastMbNum, last.Hash)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, rest...)
+	}
+	return infos, nil
+}
+
+// getMiniblocksFromPeer fetches miniblocks from fromInclusive up to and including toInclusive from the given peer
+// in batches of reconcileBatchSize. The first miniblock must link to prevHash unless it's zero.
+func (s *streamImpl) getMiniblocksFromPeer(
+	ctx context.Context,
+	peer common.Address,
+	fromInclusive int64,
+	toInclusive int64,
+	prevHash common.Hash,
+) ([]*MiniblockInfo, error) {
+	mbs := make([]*MiniblockInfo, 0, toInclusive-fromInclusive+1)
+	for fromInclusive <= toInclusive {
+		toExclusive := min(fromInclusive+reconcileBatchSize, toInclusive+1)
+
+		batch, err := s.params.RemoteMiniblockProvider.GetMbs(ctx, peer, s.streamId, fromInclusive, toExclusive)
+		if err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			return nil, RiverError(Err_NOT_FOUND, "Peer returned no miniblocks", "peer", peer)
+		}
+		if len(batch) > int(toExclusive-fromInclusive) {
+			batch = batch[:toExclusive-fromInclusive]
+		}
+
+		infos, err := s.parsePeerMiniblocks(batch, fromInclusive, prevHash)
+		if err != nil {
+			return nil, err
+		}
+		mbs = append(mbs, infos...)
+		prevHash = infos[len(infos)-1].Hash
+		fromInclusive += int64(len(infos))
+	}
+	return mbs, nil
+}
+
+// parsePeerMiniblocks parses miniblocks fetched from a peer starting with fromInclusive
+// and checks that they are linked by prev_miniblock_hash. The first miniblock must link to prevHash unless it's zero.
+func (s *streamImpl) parsePeerMiniblocks(
+	mbs []*Miniblock,
+	fromInclusive int64,
+	prevHash common.Hash,
+) ([]*MiniblockInfo, error) {
+	infos := make([]*MiniblockInfo, len(mbs))
+	for i, mb := range mbs {
+		mbInfo, err := NewMiniblockInfoFromProto(
+			mb,
+			NewMiniblockInfoFromProtoOpts{ExpectedBlockNumber: fromInclusive + int64(i)},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if (i > 0 || prevHash != (common.Hash{})) && !bytes.Equal(prevHash[:], mbInfo.header().PrevMiniblockHash) {
+			return nil, RiverError(Err_BAD_BLOCK, "Peer miniblock doesn't link to previous miniblock",
+				"streamId", s.streamId,
+				"mbNum", mbInfo.Num,
+				"expected", prevHash,
+				"actual", common.BytesToHash(mbInfo.header().PrevMiniblockHash),
+			).Func("parsePeerMiniblocks")
+		}
+		prevHash = mbInfo.Hash
+		infos[i] = mbInfo
+	}
+	return infos, nil
+}
@@ -49,6 +49,8 @@ type StreamView interface {
 		forceSnapshot bool,
 	) (*MiniblockProposal, error)
 	IsMember(userAddress []byte) (bool, error)
+	// GetEvent returns the event with the given hash if it's in the miniblocks of the view or in the minipool.
+	GetEvent(eventHash common.Hash) *ParsedEvent
 }
 
 func MakeStreamView(streamData *storage.ReadStreamFromLastSnapshotResult) (*streamViewImpl, error) {
@@ -636,6 +638,20 @@ func (r *streamViewImpl) IsMember(userAddress []byte) (bool, error) {
 	return membership == MembershipOp_SO_JOIN, nil
 }
 
+func (r *streamViewImpl) GetEvent(eventHash common.Hash) *ParsedEvent {
+	if event, ok := r.minipool.events.Get(eventHash); ok {
+		return event
+	}
+	var found *ParsedEvent
+	_ = r.forEachEvent(0, func(e *ParsedEvent, _ int64, _ int64) (bool, error) {
+		if e.Hash == eventHash {
+			found = e
+		}
+		return found == nil, nil
+	})
+	return found
+}
+
 func (r *streamViewImpl) StreamParentId() *StreamId {
 	streamIdBytes := GetStreamParentId(r.InceptionPayload())
 	if streamIdBytes == nil {
@@ -0,0 +1,93 @@
+package events
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+// SyncEventFilter selects the events of stream updates that are sent to the client in a streams sync session.
+type SyncEventFilter struct {
+	include         PayloadTypeFilter
+	exclude         PayloadTypeFilter
+	excludeCreators map[common.Address]struct{}
+}
+
+// NewSyncEventFilter creates a filter from its protocol definition. Returns nil for a nil filter.
+func NewSyncEventFilter(filter *SyncFilter) (*SyncEventFilter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+	include, err := NewPayloadTypeFilter(filter.IncludePayloadTypes)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := NewPayloadTypeFilter(filter.ExcludePayloadTypes)
+	if err != nil {
+		return nil, err
+	}
+	f := &SyncEventFilter{
+		include:         include,
+		exclude:         exclude,
+		excludeCreators: make(map[common.Address]struct{}, len(filter.ExcludeCreatorAddresses)),
+	}
+	for _, addr := range filter.ExcludeCreatorAddresses {
+		if len(addr) != common.AddressLength {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid creator address", "address", addr)
+		}
+		f.excludeCreators[common.BytesToAddress(addr)] = struct{}{}
+	}
+	return f, nil
+}
+
+// Matches returns true if the event is sent to the client. Nil filter matches all events.
+// Miniblock headers are always sent since the client needs them to follow the miniblocks of the stream.
+func (f *SyncEventFilter) Matches(event *StreamEvent) bool {
+	if f == nil || event.GetMiniblockHeader() != nil {
+		return true
+	}
+	if _, ok := f.excludeCreators[common.BytesToAddress(event.CreatorAddress)]; ok {
+		return false
+	}
+	if len(f.exclude) > 0 && f.exclude.Matches(event) {
+		return false
+	}
+	return f.include.Matches(event)
+}
+
+// Apply returns the stream update without the events that don't match the filter.
+// parsedEvents are the parsed update events if they are available, otherwise events are decoded from the envelopes.
+// The given update is not modified, it can be shared with other subscribers.
+func (f *SyncEventFilter) Apply(update *StreamAndCookie, parsedEvents []*ParsedEvent) *StreamAndCookie {
+	if f == nil || len(update.GetEvents()) == 0 {
+		return update
+	}
+	if len(parsedEvents) != len(update.Events) {
+		parsedEvents = nil
+	}
+	events := make([]*Envelope, 0, len(update.Events))
+	for i, envelope := range update.Events {
+		if parsedEvents != nil {
+			if f.Matches(parsedEvents[i].Event) {
+				events = append(events, envelope)
+			}
+			continue
+		}
+		var event StreamEvent
+		// Events that can't be decoded are sent, so the client can handle them as without the filter.
+		if err := proto.Unmarshal(envelope.Event, &event); err != nil || f.Matches(&event) {
+			events = append(events, envelope)
+		}
+	}
+	if len(events) == len(update.Events) {
+		return update
+	}
+	return &StreamAndCookie{
+		Events:         events,
+		NextSyncCookie: update.NextSyncCookie,
+		Miniblocks:     update.Miniblocks,
+		SyncReset:      update.SyncReset,
+	}
+}
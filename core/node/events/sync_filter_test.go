@@ -0,0 +1,103 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestSyncEventFilter(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	wallet1, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	wallet2, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	makeEnvelope := func(wallet *crypto.Wallet, payload IsStreamEvent_Payload) *Envelope {
+		envelope, err := MakeEnvelopeWithPayload(wallet, payload, nil)
+		require.NoError(err)
+		return envelope
+	}
+	message1 := makeEnvelope(wallet1, Make_ChannelPayload_Message("hello"))
+	message2 := makeEnvelope(wallet2, Make_ChannelPayload_Message("hi"))
+	join := makeEnvelope(
+		wallet2,
+		Make_MemberPayload_Membership(MembershipOp_SO_JOIN, wallet2.Address.Bytes(), wallet2.Address.Bytes(), nil),
+	)
+	solicitation := makeEnvelope(wallet2, &StreamEvent_MemberPayload{MemberPayload: &MemberPayload{
+		Content: &MemberPayload_KeySolicitation_{
+			KeySolicitation: &MemberPayload_KeySolicitation{DeviceKey: "device"},
+		},
+	}})
+
+	update := &StreamAndCookie{
+		Events:         []*Envelope{message1, message2, join, solicitation},
+		NextSyncCookie: &SyncCookie{StreamId: streamId[:]},
+	}
+
+	// parsed events give the same result as decoding envelopes
+	apply := func(filter *SyncFilter) []*Envelope {
+		f, err := NewSyncEventFilter(filter)
+		require.NoError(err)
+		parsedEvents, err := ParseEvents(update.Events)
+		require.NoError(err)
+		filtered := f.Apply(update, nil).Events
+		require.Equal(filtered, f.Apply(update, parsedEvents).Events)
+		return filtered
+	}
+
+	require.Equal(update.Events, apply(nil))
+	require.Equal(update.Events, apply(&SyncFilter{}))
+	require.Equal(
+		[]*Envelope{message1, message2, join},
+		apply(&SyncFilter{ExcludePayloadTypes: []string{"member_payload.key_solicitation"}}),
+	)
+	require.Equal(
+		[]*Envelope{message1, message2},
+		apply(&SyncFilter{IncludePayloadTypes: []string{"channel_payload"}}),
+	)
+	require.Equal(
+		[]*Envelope{message2, join},
+		apply(&SyncFilter{
+			ExcludePayloadTypes:     []string{"member_payload.key_solicitation"},
+			ExcludeCreatorAddresses: [][]byte{wallet1.Address.Bytes()},
+		}),
+	)
+	// update is not modified
+	require.Len(update.Events, 4)
+
+	_, err = NewSyncEventFilter(&SyncFilter{ExcludePayloadTypes: []string{"channel_payload.unknown"}})
+	require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+	_, err = NewSyncEventFilter(&SyncFilter{ExcludeCreatorAddresses: [][]byte{{1, 2, 3}}})
+	require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+
+	// miniblock headers are never filtered out
+	header := makeEnvelope(wallet1, Make_MiniblockHeader(&MiniblockHeader{MiniblockNum: 1}))
+	update = &StreamAndCookie{
+		Events:         []*Envelope{message1, header, join},
+		NextSyncCookie: &SyncCookie{StreamId: streamId[:]},
+	}
+	require.Equal(
+		[]*Envelope{header, join},
+		apply(&SyncFilter{IncludePayloadTypes: []string{"member_payload"}}),
+	)
+	require.Equal(
+		[]*Envelope{message1, header},
+		apply(&SyncFilter{ExcludePayloadTypes: []string{"member_payload", "miniblock_header"}}),
+	)
+	require.Equal(
+		[]*Envelope{header, join},
+		apply(&SyncFilter{ExcludeCreatorAddresses: [][]byte{wallet1.Address.Bytes()}}),
+	)
+}
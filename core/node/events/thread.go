@@ -0,0 +1,91 @@
+package events
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+const (
+	// threadMaxMiniblocks limits the number of miniblocks scanned by a single thread request.
+	threadMaxMiniblocks = 1000
+
+	// threadReadBatch is the number of miniblocks read from storage at once.
+	threadReadBatch = 10
+)
+
+// IsThreadReply returns true if the event is a reply to the thread with the given root event hash.
+func IsThreadReply(event *StreamEvent, threadId []byte) bool {
+	reply := event.GetChannelPayload().GetThreadReply()
+	return reply != nil && bytes.Equal(reply.ThreadId, threadId)
+}
+
+// ReadThread returns replies to the thread from the miniblocks stored locally starting with fromInclusive.
+// Once the last miniblock of the view is read, replies from the view minipool are returned as well
+// and the response is marked as terminus.
+func ReadThread(
+	ctx context.Context,
+	store storage.StreamStorage,
+	streamId StreamId,
+	view StreamView,
+	threadId []byte,
+	fromInclusive int64,
+) (*GetThreadResponse, error) {
+	lastBlock := view.LastBlock()
+	limit := fromInclusive + threadMaxMiniblocks
+
+	resp := &GetThreadResponse{}
+	num := fromInclusive
+	for num <= lastBlock.Num && num < limit {
+		toExclusive := min(num+threadReadBatch, lastBlock.Num+1, limit)
+		data, err := store.ReadMiniblocks(ctx, streamId, num, toExclusive)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == 0 {
+			return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks not found").
+				Tags("fromInclusive", num, "toExclusive", toExclusive)
+		}
+		for i, mbData := range data {
+			mb, err := NewMiniblockInfoFromBytes(mbData, num+int64(i))
+			if err != nil {
+				return nil, err
+			}
+			for j, e := range mb.events {
+				if IsThreadReply(e.Event, threadId) {
+					resp.Replies = append(resp.Replies, &StreamHistoryEvent{
+						Event:        e.Envelope,
+						MiniblockNum: mb.Num,
+						EventNum:     mb.header().EventNumOffset + int64(j),
+					})
+				}
+			}
+		}
+		num += int64(len(data))
+	}
+	resp.NextMiniblockNum = num
+
+	if num > lastBlock.Num {
+		// Miniblock header is the last event of the miniblock.
+		eventNum := lastBlock.header().EventNumOffset + int64(len(lastBlock.events)) + 1
+		for i, envelope := range view.MinipoolEnvelopes() {
+			parsed, err := ParseEvent(envelope)
+			if err != nil {
+				return nil, err
+			}
+			if IsThreadReply(parsed.Event, threadId) {
+				resp.Replies = append(resp.Replies, &StreamHistoryEvent{
+					Event:        envelope,
+					MiniblockNum: -1,
+					EventNum:     eventNum + int64(i),
+				})
+			}
+		}
+		resp.Terminus = true
+	}
+	return resp, nil
+}
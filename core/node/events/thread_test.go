@@ -0,0 +1,127 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/crypto"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestReadThread(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	store := storage.NewMemEventStore()
+
+	makeEnvelope := func(payload IsStreamEvent_Payload) *Envelope {
+		envelope, err := MakeEnvelopeWithPayload(wallet, payload, nil)
+		require.NoError(err)
+		return envelope
+	}
+	inception := makeEnvelope(Make_ChannelPayload_Inception(streamId, spaceId, nil))
+	root := makeEnvelope(Make_ChannelPayload_Message("root"))
+	other := makeEnvelope(Make_ChannelPayload_Message("other"))
+
+	// Genesis miniblock: inception and root, event numbers 0-1.
+	parsedInception, err := ParseEvent(inception)
+	require.NoError(err)
+	parsedRoot, err := ParseEvent(root)
+	require.NoError(err)
+	genesisHeader, err := Make_GenesisMiniblockHeader([]*ParsedEvent{parsedInception, parsedRoot})
+	require.NoError(err)
+	genesis := &Miniblock{
+		Header: makeEnvelope(Make_MiniblockHeader(genesisHeader)),
+		Events: []*Envelope{inception, root},
+	}
+	data, err := proto.Marshal(genesis)
+	require.NoError(err)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, data))
+	miniblocks := [][]byte{data}
+
+	writeMiniblock := func(num int64, eventNumOffset int64, events ...*Envelope) {
+		mb := &Miniblock{Events: events}
+		var hashes [][]byte
+		for _, e := range events {
+			hashes = append(hashes, e.Hash)
+		}
+		mb.Header = makeEnvelope(Make_MiniblockHeader(&MiniblockHeader{
+			MiniblockNum:   num,
+			Timestamp:      timestamppb.New(time.Now()),
+			EventHashes:    hashes,
+			EventNumOffset: eventNumOffset,
+			Content:        &MiniblockHeader_None{None: &emptypb.Empty{}},
+		}))
+		data, err := proto.Marshal(mb)
+		require.NoError(err)
+		require.NoError(store.WriteMiniblocks(
+			ctx,
+			streamId,
+			[]*storage.WriteMiniblockData{{Number: num, Data: data}},
+			nil,
+		))
+		miniblocks = append(miniblocks, data)
+	}
+
+	// Miniblock 1: event numbers 3-5, miniblock 2: event number 7, minipool: event numbers 9-10.
+	reply1 := makeEnvelope(Make_ChannelPayload_ThreadReply(root.Hash, "reply 1"))
+	reply2 := makeEnvelope(Make_ChannelPayload_ThreadReply(root.Hash, "reply 2"))
+	reply3 := makeEnvelope(Make_ChannelPayload_ThreadReply(root.Hash, "reply 3"))
+	writeMiniblock(1, 3, reply1, other, makeEnvelope(Make_ChannelPayload_ThreadReply(other.Hash, "other")))
+	writeMiniblock(2, 7, reply2)
+
+	var minipool [][]byte
+	for _, e := range []*Envelope{reply3, makeEnvelope(Make_ChannelPayload_Message("unrelated"))} {
+		data, err := proto.Marshal(e)
+		require.NoError(err)
+		minipool = append(minipool, data)
+	}
+	view, err := MakeStreamView(&storage.ReadStreamFromLastSnapshotResult{
+		Miniblocks:        miniblocks,
+		MinipoolEnvelopes: minipool,
+	})
+	require.NoError(err)
+
+	resp, err := ReadThread(ctx, store, streamId, view, root.Hash, 0)
+	require.NoError(err)
+	require.True(resp.Terminus)
+	require.EqualValues(3, resp.NextMiniblockNum)
+	require.Len(resp.Replies, 3)
+	for i, expected := range []struct {
+		envelope     *Envelope
+		miniblockNum int64
+		eventNum     int64
+	}{
+		{reply1, 1, 3},
+		{reply2, 2, 7},
+		{reply3, -1, 9},
+	} {
+		require.Equal(expected.envelope.Hash, resp.Replies[i].Event.Hash)
+		require.Equal(expected.miniblockNum, resp.Replies[i].MiniblockNum)
+		require.Equal(expected.eventNum, resp.Replies[i].EventNum)
+	}
+
+	resp, err = ReadThread(ctx, store, streamId, view, root.Hash, 2)
+	require.NoError(err)
+	require.Len(resp.Replies, 2)
+	require.Equal(reply2.Hash, resp.Replies[0].Event.Hash)
+
+	resp, err = ReadThread(ctx, store, streamId, view, other.Hash, 0)
+	require.NoError(err)
+	require.Len(resp.Replies, 1)
+	require.EqualValues(5, resp.Replies[0].EventNum)
+}
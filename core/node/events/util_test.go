@@ -4,8 +4,10 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
@@ -93,8 +95,7 @@ func makeCacheTestContext(t *testing.T, p testParams) (context.Context, *cacheTe
 		bc := btc.GetBlockchain(ctx, i)
 		bc.StartChainMonitor(ctx)
 
-		pg := storage.NewTestPgStore(ctx)
-		t.Cleanup(pg.Close)
+		store := storage.NewMemEventStore()
 
 		cfg := btc.RegistryConfig()
 		registry, err := registries.NewRiverRegistryContract(ctx, bc, &cfg)
@@ -105,10 +106,10 @@ func makeCacheTestContext(t *testing.T, p testParams) (context.Context, *cacheTe
 		nr, err := LoadNodeRegistry(ctx, registry, bc.Wallet.Address, blockNumber, bc.ChainMonitor, nil)
 		ctc.require.NoError(err)
 
-		sr := NewStreamRegistry(bc.Wallet.Address, nr, registry, btc.OnChainConfig)
+		sr := NewStreamRegistry(bc.Wallet.Address, nr, registry, btc.OnChainConfig, blockNumber, bc.ChainMonitor)
 
 		params := &StreamCacheParams{
-			Storage:                 pg.Storage,
+			Storage:                 store,
 			Wallet:                  bc.Wallet,
 			RiverChain:              bc,
 			Registry:                registry,
@@ -252,6 +253,33 @@ func (ctc *cacheTestContext) makeMiniblock(inst int, streamId StreamId, forceSna
 	return h, n
 }
 
+// makeReplMiniblock produces the next miniblock on the stream leader and waits until it's applied on all replicas,
+// otherwise the replicas may propose the next miniblock on top of the stale state.
+func (ctc *cacheTestContext) makeReplMiniblock(
+	streamId StreamId,
+	nodes []common.Address,
+	forceSnapshot bool,
+) common.Hash {
+	h, n, err := ctc.instancesByAddr[nodes[0]].mbProducer.TestMakeMiniblock(ctc.ctx, streamId, forceSnapshot)
+	ctc.require.NoError(err)
+
+	for _, node := range nodes {
+		ctc.require.EventuallyWithT(
+			func(tt *assert.CollectT) {
+				stream, err := ctc.instancesByAddr[node].cache.GetSyncStream(ctc.ctx, streamId)
+				if !assert.NoError(tt, err) {
+					return
+				}
+				view, err := stream.GetView(ctc.ctx)
+				_ = assert.NoError(tt, err) && assert.EqualValues(tt, n, view.LastBlock().Num)
+			},
+			5*time.Second,
+			10*time.Millisecond,
+		)
+	}
+	return h
+}
+
 func (ctc *cacheTestContext) GetMbProposal(
 	ctx context.Context,
 	node common.Address,
@@ -293,6 +321,45 @@ func (ctc *cacheTestContext) SaveMbCandidate(
 	return stream.SaveMiniblockCandidate(ctx, mb)
 }
 
+func (ctc *cacheTestContext) GetMbs(
+	ctx context.Context,
+	node common.Address,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([]*Miniblock, error) {
+	inst := ctc.instancesByAddr[node]
+
+	stream, err := inst.cache.getStreamImpl(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	mbs, _, err := stream.GetMiniblocks(ctx, fromInclusive, toExclusive)
+	return mbs, err
+}
+
+func (ctc *cacheTestContext) GetLastSnapshotMbs(
+	ctx context.Context,
+	node common.Address,
+	streamId StreamId,
+) ([]*Miniblock, int64, error) {
+	inst := ctc.instancesByAddr[node]
+
+	stream, err := inst.cache.getStreamImpl(ctx, streamId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	view, err := stream.getView(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	mbs := view.MiniblocksFromLastSnapshot()
+	return mbs, view.LastBlock().Num - int64(len(mbs)) + 1, nil
+}
+
 func setOnChainStreamConfig(t *testing.T, ctx context.Context, btc *crypto.BlockchainTestContext, p testParams) {
 	if p.replFactor != 0 {
 		btc.SetConfigValue(
@@ -0,0 +1,185 @@
+package nodes
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/river-build/river/core/contracts/river"
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/registries"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// StreamPlacementChange is a single PlaceStreamOnNode (IsAdded) or RemoveStreamFromNode operation.
+type StreamPlacementChange struct {
+	StreamId StreamId
+	Node     common.Address
+	IsAdded  bool
+}
+
+type RebalanceParams struct {
+	// ReplicationFactor is the target number of nodes for each stream.
+	ReplicationFactor int
+	// MaxStreamsPerNode is the max number of streams placed on a single node.
+	// If 0, streams are spread evenly over operational nodes.
+	MaxStreamsPerNode int
+}
+
+// PlanStreamRebalance computes placement changes that move the given streams to operational nodes,
+// bring each stream to the replication factor and keep the number of streams per node within capacity.
+//
+// Changes for a stream are ordered with additions first. A stream always keeps at least one of its
+// current operational nodes, so new nodes are able to fetch stream history from it. Because of this
+// moving streams with a single replica takes two rebalance runs.
+// If a stream has no operational nodes, non-operational nodes are kept until the next rebalance.
+func PlanStreamRebalance(
+	streams []*registries.GetStreamResult,
+	nodes []registries.NodeRecord,
+	params RebalanceParams,
+) ([]*StreamPlacementChange, error) {
+	var eligible []common.Address
+	for _, n := range nodes {
+		if n.Status == river.NodeStatus_Operational {
+			eligible = append(eligible, n.NodeAddress)
+		}
+	}
+	if len(eligible) == 0 {
+		return nil, RiverError(Err_BAD_CONFIG, "No operational nodes").Func("PlanStreamRebalance")
+	}
+	slices.SortFunc(eligible, func(a, b common.Address) int { return bytes.Compare(a[:], b[:]) })
+
+	replFactor := min(params.ReplicationFactor, len(eligible))
+	if replFactor <= 0 {
+		return nil, RiverError(Err_BAD_CONFIG, "Invalid replication factor",
+			"replicationFactor", params.ReplicationFactor).Func("PlanStreamRebalance")
+	}
+
+	required := len(streams) * replFactor
+	capacity := params.MaxStreamsPerNode
+	if capacity <= 0 {
+		capacity = (required + len(eligible) - 1) / len(eligible)
+	} else if capacity*len(eligible) < required {
+		return nil, RiverError(Err_BAD_CONFIG, "Not enough node capacity for streams",
+			"maxStreamsPerNode", capacity,
+			"operationalNodes", len(eligible),
+			"streams", len(streams),
+			"replicationFactor", replFactor,
+		).Func("PlanStreamRebalance")
+	}
+
+	load := make(map[common.Address]int, len(eligible))
+	for _, n := range eligible {
+		load[n] = 0
+	}
+	for _, s := range streams {
+		for _, n := range s.Nodes {
+			if _, ok := load[n]; ok {
+				load[n]++
+			}
+		}
+	}
+
+	// leastLoaded returns the operational node with the least streams that is not in exclude.
+	leastLoaded := func(exclude []common.Address) (common.Address, bool) {
+		var (
+			best  common.Address
+			found bool
+		)
+		for _, n := range eligible {
+			if slices.Contains(exclude, n) {
+				continue
+			}
+			if !found || load[n] < load[best] {
+				best = n
+				found = true
+			}
+		}
+		return best, found
+	}
+
+	sorted := slices.Clone(streams)
+	slices.SortFunc(sorted, func(a, b *registries.GetStreamResult) int {
+		return bytes.Compare(a.StreamId[:], b.StreamId[:])
+	})
+
+	var changes []*StreamPlacementChange
+	for _, s := range sorted {
+		var kept, removed, added []common.Address
+		for _, n := range s.Nodes {
+			if _, ok := load[n]; ok {
+				kept = append(kept, n)
+			} else {
+				removed = append(removed, n)
+			}
+		}
+
+		// Nobody else has the stream data, keep non-operational nodes for now.
+		if len(kept) == 0 {
+			removed = nil
+		}
+
+		// Drop extra replicas from the most loaded nodes.
+		for len(kept) > replFactor {
+			i := 0
+			for j, n := range kept {
+				if load[n] > load[kept[i]] {
+					i = j
+				}
+			}
+			load[kept[i]]--
+			removed = append(removed, kept[i])
+			kept = slices.Delete(kept, i, i+1)
+		}
+
+		// Move replicas from overloaded nodes. If the stream has a single current replica,
+		// it's only copied to the new node and the extra replica is dropped by the next rebalance.
+		for i := 0; i < len(kept); {
+			n := kept[i]
+			if load[n] <= capacity {
+				i++
+				continue
+			}
+			dst, ok := leastLoaded(slices.Concat(s.Nodes, added))
+			if !ok || load[dst]+1 > capacity {
+				i++
+				continue
+			}
+			load[dst]++
+			added = append(added, dst)
+			if len(kept) == 1 {
+				break
+			}
+			load[n]--
+			removed = append(removed, n)
+			kept = slices.Delete(kept, i, i+1)
+		}
+
+		// Add missing replicas.
+		for len(kept)+len(added) < replFactor {
+			dst, ok := leastLoaded(slices.Concat(s.Nodes, added))
+			if !ok {
+				break
+			}
+			if params.MaxStreamsPerNode > 0 && load[dst] >= capacity {
+				return nil, RiverError(Err_BAD_CONFIG, "Not enough node capacity for stream",
+					"streamId", s.StreamId,
+					"maxStreamsPerNode", capacity,
+				).Func("PlanStreamRebalance")
+			}
+			load[dst]++
+			added = append(added, dst)
+		}
+
+		for _, n := range added {
+			changes = append(changes, &StreamPlacementChange{StreamId: s.StreamId, Node: n, IsAdded: true})
+		}
+		for _, n := range removed {
+			changes = append(changes, &StreamPlacementChange{StreamId: s.StreamId, Node: n, IsAdded: false})
+		}
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,160 @@
+package nodes_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/contracts/river"
+	"github.com/river-build/river/core/node/nodes"
+	"github.com/river-build/river/core/node/registries"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func makeRebalanceNodes(statuses ...uint8) []registries.NodeRecord {
+	records := make([]registries.NodeRecord, len(statuses))
+	for i, s := range statuses {
+		records[i] = registries.NodeRecord{
+			NodeAddress: common.BytesToAddress([]byte{byte(i + 1)}),
+			Status:      s,
+		}
+	}
+	return records
+}
+
+// applyPlacementChanges applies changes to streams and returns number of streams per node.
+func applyPlacementChanges(
+	t *testing.T,
+	streams []*registries.GetStreamResult,
+	changes []*nodes.StreamPlacementChange,
+) map[common.Address]int {
+	byId := make(map[StreamId]*registries.GetStreamResult, len(streams))
+	for _, s := range streams {
+		byId[s.StreamId] = s
+	}
+	for _, c := range changes {
+		s := byId[c.StreamId]
+		require.NotNil(t, s)
+		if c.IsAdded {
+			require.NotContains(t, s.Nodes, c.Node)
+			s.Nodes = append(s.Nodes, c.Node)
+		} else {
+			i := -1
+			for j, n := range s.Nodes {
+				if n == c.Node {
+					i = j
+				}
+			}
+			require.GreaterOrEqual(t, i, 0)
+			s.Nodes = append(s.Nodes[:i], s.Nodes[i+1:]...)
+		}
+	}
+
+	load := make(map[common.Address]int)
+	for _, s := range streams {
+		for _, n := range s.Nodes {
+			load[n]++
+		}
+	}
+	return load
+}
+
+func TestPlanStreamRebalance(t *testing.T) {
+	require := require.New(t)
+
+	records := makeRebalanceNodes(
+		river.NodeStatus_Operational,
+		river.NodeStatus_Operational,
+		river.NodeStatus_Operational,
+		river.NodeStatus_Departing,
+	)
+	n0, n1, n2, departing := records[0].NodeAddress, records[1].NodeAddress, records[2].NodeAddress, records[3].NodeAddress
+
+	// All streams are on the first two nodes and the departing node, third node is new.
+	var streams []*registries.GetStreamResult
+	for range 12 {
+		streams = append(streams, &registries.GetStreamResult{
+			StreamId: testutils.FakeStreamId(STREAM_CHANNEL_BIN),
+			Nodes:    []common.Address{n0, n1, departing},
+		})
+	}
+
+	changes, err := nodes.PlanStreamRebalance(streams, records, nodes.RebalanceParams{ReplicationFactor: 2})
+	require.NoError(err)
+	require.NotEmpty(changes)
+
+	load := applyPlacementChanges(t, streams, changes)
+	require.Zero(load[departing])
+	require.Equal(8, load[n0])
+	require.Equal(8, load[n1])
+	require.Equal(8, load[n2])
+	for _, s := range streams {
+		require.Len(s.Nodes, 2)
+	}
+
+	// Balanced placement requires no changes.
+	changes, err = nodes.PlanStreamRebalance(streams, records, nodes.RebalanceParams{ReplicationFactor: 2})
+	require.NoError(err)
+	require.Empty(changes)
+}
+
+func TestPlanStreamRebalanceKeepsDataSource(t *testing.T) {
+	require := require.New(t)
+
+	records := makeRebalanceNodes(river.NodeStatus_Operational, river.NodeStatus_Failed)
+	failed := records[1].NodeAddress
+
+	streams := []*registries.GetStreamResult{{
+		StreamId: testutils.FakeStreamId(STREAM_CHANNEL_BIN),
+		Nodes:    []common.Address{failed},
+	}}
+
+	changes, err := nodes.PlanStreamRebalance(streams, records, nodes.RebalanceParams{ReplicationFactor: 3})
+	require.NoError(err)
+	require.Len(changes, 1)
+	require.True(changes[0].IsAdded)
+	require.Equal(records[0].NodeAddress, changes[0].Node)
+}
+
+func TestPlanStreamRebalanceCapacity(t *testing.T) {
+	records := makeRebalanceNodes(river.NodeStatus_Operational, river.NodeStatus_Operational)
+
+	var streams []*registries.GetStreamResult
+	for range 5 {
+		streams = append(streams, &registries.GetStreamResult{
+			StreamId: testutils.FakeStreamId(STREAM_CHANNEL_BIN),
+			Nodes:    []common.Address{records[0].NodeAddress},
+		})
+	}
+
+	_, err := nodes.PlanStreamRebalance(
+		streams,
+		records,
+		nodes.RebalanceParams{ReplicationFactor: 1, MaxStreamsPerNode: 2},
+	)
+	require.Error(t, err)
+
+	changes, err := nodes.PlanStreamRebalance(
+		streams,
+		records,
+		nodes.RebalanceParams{ReplicationFactor: 1, MaxStreamsPerNode: 3},
+	)
+	require.NoError(t, err)
+	applyPlacementChanges(t, streams, changes)
+
+	// Single replica streams are copied first and the old replica is removed by the next run.
+	changes, err = nodes.PlanStreamRebalance(
+		streams,
+		records,
+		nodes.RebalanceParams{ReplicationFactor: 1, MaxStreamsPerNode: 3},
+	)
+	require.NoError(t, err)
+	load := applyPlacementChanges(t, streams, changes)
+	require.LessOrEqual(t, load[records[0].NodeAddress], 3)
+	require.LessOrEqual(t, load[records[1].NodeAddress], 3)
+	for _, s := range streams {
+		require.Len(t, s.Nodes, 1)
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/river-build/river/core/contracts/river"
 	. "github.com/river-build/river/core/node/base"
 	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/dlog"
 	. "github.com/river-build/river/core/node/protocol"
 	"github.com/river-build/river/core/node/registries"
 	. "github.com/river-build/river/core/node/shared"
@@ -43,13 +45,37 @@ func NewStreamRegistry(
 	nodeRegistry NodeRegistry,
 	contract *registries.RiverRegistryContract,
 	onChainConfig crypto.OnChainConfiguration,
+	appliedBlockNum crypto.BlockNumber,
+	chainMonitor crypto.ChainMonitor,
 ) *streamRegistryImpl {
-	return &streamRegistryImpl{
+	sr := &streamRegistryImpl{
 		localNodeAddress: localNodeAddress,
 		nodeRegistry:     nodeRegistry,
 		onChainConfig:    onChainConfig,
 		contract:         contract,
 	}
+
+	chainMonitor.OnContractWithTopicsEvent(
+		appliedBlockNum+1,
+		contract.Address,
+		[][]common.Hash{{contract.StreamRegistryAbi.Events[river.Event_StreamPlacementUpdated].ID}},
+		sr.onStreamPlacementUpdated,
+	)
+
+	return sr
+}
+
+// onStreamPlacementUpdated drops cached stream nodes when stream placement changes.
+// Nodes are read from the contract on the next GetStreamInfo call.
+func (sr *streamRegistryImpl) onStreamPlacementUpdated(ctx context.Context, log types.Log) {
+	parsed, err := sr.contract.ParseEvent(ctx, sr.contract.StreamRegistry.BoundContract(), sr.contract.StreamEventInfo, log)
+	if err != nil {
+		dlog.FromCtx(ctx).Error("Failed to parse event", "err", err, "log", log)
+		return
+	}
+	if e, ok := parsed.(*river.StreamRegistryV1StreamPlacementUpdated); ok {
+		sr.streamNodeCache.Delete(StreamId(e.StreamId))
+	}
 }
 
 func (sr *streamRegistryImpl) GetStreamInfo(ctx context.Context, streamId StreamId) (StreamNodes, error) {
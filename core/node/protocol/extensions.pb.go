@@ -16,6 +16,8 @@ type IsUserDeviceKeyPayload_Content = isUserDeviceKeyPayload_Content
 type IsMediaPayload_Content = isMediaPayload_Content
 type IsSnapshot_Content = isSnapshot_Content
 type IsGetStreamExResponse_Data = isGetStreamExResponse_Data
+type IsGetStreamHistoryRequest_Cursor = isGetStreamHistoryRequest_Cursor
+type IsSyncCommand_Command = isSyncCommand_Command
 
 type IsInceptionPayload interface {
 	isInceptionPayload()
@@ -295,6 +295,101 @@ func (*NewEventReceivedResponse) Descriptor() ([]byte, []int) {
 	return file_internode_proto_rawDescGZIP(), []int{4}
 }
 
+type NewEventsReceivedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*NewEventReceivedRequest `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *NewEventsReceivedRequest) Reset() {
+	*x = NewEventsReceivedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internode_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewEventsReceivedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewEventsReceivedRequest) ProtoMessage() {}
+
+func (x *NewEventsReceivedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internode_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewEventsReceivedRequest.ProtoReflect.Descriptor instead.
+func (*NewEventsReceivedRequest) Descriptor() ([]byte, []int) {
+	return file_internode_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NewEventsReceivedRequest) GetEvents() []*NewEventReceivedRequest {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type NewEventsReceivedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results contains a result for each event in the request order.
+	Results []*AddEventResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *NewEventsReceivedResponse) Reset() {
+	*x = NewEventsReceivedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internode_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewEventsReceivedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewEventsReceivedResponse) ProtoMessage() {}
+
+func (x *NewEventsReceivedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internode_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewEventsReceivedResponse.ProtoReflect.Descriptor instead.
+func (*NewEventsReceivedResponse) Descriptor() ([]byte, []int) {
+	return file_internode_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NewEventsReceivedResponse) GetResults() []*AddEventResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type NewEventInPoolRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -306,7 +401,7 @@ type NewEventInPoolRequest struct {
 func (x *NewEventInPoolRequest) Reset() {
 	*x = NewEventInPoolRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internode_proto_msgTypes[5]
+		mi := &file_internode_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -319,7 +414,7 @@ func (x *NewEventInPoolRequest) String() string {
 func (*NewEventInPoolRequest) ProtoMessage() {}
 
 func (x *NewEventInPoolRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internode_proto_msgTypes[5]
+	mi := &file_internode_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +427,7 @@ func (x *NewEventInPoolRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewEventInPoolRequest.ProtoReflect.Descriptor instead.
 func (*NewEventInPoolRequest) Descriptor() ([]byte, []int) {
-	return file_internode_proto_rawDescGZIP(), []int{5}
+	return file_internode_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *NewEventInPoolRequest) GetHash() []byte {
@@ -351,7 +446,7 @@ type NewEventInPoolResponse struct {
 func (x *NewEventInPoolResponse) Reset() {
 	*x = NewEventInPoolResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internode_proto_msgTypes[6]
+		mi := &file_internode_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -364,7 +459,7 @@ func (x *NewEventInPoolResponse) String() string {
 func (*NewEventInPoolResponse) ProtoMessage() {}
 
 func (x *NewEventInPoolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internode_proto_msgTypes[6]
+	mi := &file_internode_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +472,7 @@ func (x *NewEventInPoolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewEventInPoolResponse.ProtoReflect.Descriptor instead.
 func (*NewEventInPoolResponse) Descriptor() ([]byte, []int) {
-	return file_internode_proto_rawDescGZIP(), []int{6}
+	return file_internode_proto_rawDescGZIP(), []int{8}
 }
 
 type ProposeMiniblockRequest struct {
@@ -392,7 +487,7 @@ type ProposeMiniblockRequest struct {
 func (x *ProposeMiniblockRequest) Reset() {
 	*x = ProposeMiniblockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internode_proto_msgTypes[7]
+		mi := &file_internode_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -405,7 +500,7 @@ func (x *ProposeMiniblockRequest) String() string {
 func (*ProposeMiniblockRequest) ProtoMessage() {}
 
 func (x *ProposeMiniblockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internode_proto_msgTypes[7]
+	mi := &file_internode_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +513,7 @@ func (x *ProposeMiniblockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposeMiniblockRequest.ProtoReflect.Descriptor instead.
 func (*ProposeMiniblockRequest) Descriptor() ([]byte, []int) {
-	return file_internode_proto_rawDescGZIP(), []int{7}
+	return file_internode_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ProposeMiniblockRequest) GetStreamId() []byte {
@@ -446,7 +541,7 @@ type ProposeMiniblockResponse struct {
 func (x *ProposeMiniblockResponse) Reset() {
 	*x = ProposeMiniblockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internode_proto_msgTypes[8]
+		mi := &file_internode_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -459,7 +554,7 @@ func (x *ProposeMiniblockResponse) String() string {
 func (*ProposeMiniblockResponse) ProtoMessage() {}
 
 func (x *ProposeMiniblockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internode_proto_msgTypes[8]
+	mi := &file_internode_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +567,7 @@ func (x *ProposeMiniblockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposeMiniblockResponse.ProtoReflect.Descriptor instead.
 func (*ProposeMiniblockResponse) Descriptor() ([]byte, []int) {
-	return file_internode_proto_rawDescGZIP(), []int{8}
+	return file_internode_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProposeMiniblockResponse) GetProposal() *MiniblockProposal {
@@ -494,7 +589,7 @@ type SaveMiniblockCandidateRequest struct {
 func (x *SaveMiniblockCandidateRequest) Reset() {
 	*x = SaveMiniblockCandidateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internode_proto_msgTypes[9]
+		mi := &file_internode_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -507,7 +602,7 @@ func (x *SaveMiniblockCandidateRequest) String() string {
 func (*SaveMiniblockCandidateRequest) ProtoMessage() {}
 
 func (x *SaveMiniblockCandidateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internode_proto_msgTypes[9]
+	mi := &file_internode_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +615,7 @@ func (x *SaveMiniblockCandidateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveMiniblockCandidateRequest.ProtoReflect.Descriptor instead.
 func (*SaveMiniblockCandidateRequest) Descriptor() ([]byte, []int) {
-	return file_internode_proto_rawDescGZIP(), []int{9}
+	return file_internode_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SaveMiniblockCandidateRequest) GetStreamId() []byte {
@@ -546,7 +641,7 @@ type SaveMiniblockCandidateResponse struct {
 func (x *SaveMiniblockCandidateResponse) Reset() {
 	*x = SaveMiniblockCandidateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internode_proto_msgTypes[10]
+		mi := &file_internode_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -559,7 +654,7 @@ func (x *SaveMiniblockCandidateResponse) String() string {
 func (*SaveMiniblockCandidateResponse) ProtoMessage() {}
 
 func (x *SaveMiniblockCandidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internode_proto_msgTypes[10]
+	mi := &file_internode_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +667,7 @@ func (x *SaveMiniblockCandidateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveMiniblockCandidateResponse.ProtoReflect.Descriptor instead.
 func (*SaveMiniblockCandidateResponse) Descriptor() ([]byte, []int) {
-	return file_internode_proto_rawDescGZIP(), []int{10}
+	return file_internode_proto_rawDescGZIP(), []int{12}
 }
 
 var File_internode_proto protoreflect.FileDescriptor
@@ -612,64 +707,79 @@ var file_internode_proto_rawDesc = []byte{
 	0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
 	0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x22, 0x1a, 0x0a, 0x18, 0x4e, 0x65, 0x77,
 	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x49, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x22, 0x18, 0x0a, 0x16, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e,
-	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x17,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x12, 0x64, 0x65, 0x62, 0x75, 0x67, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x50, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x6e,
-	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x6c, 0x0a, 0x1d, 0x53, 0x61, 0x76, 0x65,
-	0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x09, 0x6d, 0x69, 0x6e,
-	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x69,
-	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbb, 0x03, 0x0a, 0x0a, 0x4e, 0x6f, 0x64,
-	0x65, 0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x36, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x19, 0x4e, 0x65, 0x77,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x4e, 0x65, 0x77,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x18, 0x0a, 0x16, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x68, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x64, 0x65, 0x62, 0x75, 0x67, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x50, 0x0a, 0x18, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x6c, 0x0a, 0x1d,
+	0x53, 0x61, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x6d, 0x69,
+	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x61,
+	0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x93, 0x04, 0x0a,
+	0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x4e, 0x65,
+	0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1e,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x77,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4e, 0x65,
+	0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x4e, 0x65, 0x77, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x50, 0x6f, 0x6f, 0x6c,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
-	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x4e,
-	0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1c, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e,
-	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x50, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x69,
-	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x69,
-	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x65, 0x0a, 0x16, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43,
-	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2d, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x2f, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x4e, 0x65, 0x77, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x53,
+	0x61, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x61,
+	0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -684,44 +794,51 @@ func file_internode_proto_rawDescGZIP() []byte {
 	return file_internode_proto_rawDescData
 }
 
-var file_internode_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_internode_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_internode_proto_goTypes = []interface{}{
 	(*MiniblockProposal)(nil),              // 0: river.MiniblockProposal
 	(*AllocateStreamRequest)(nil),          // 1: river.AllocateStreamRequest
 	(*AllocateStreamResponse)(nil),         // 2: river.AllocateStreamResponse
 	(*NewEventReceivedRequest)(nil),        // 3: river.NewEventReceivedRequest
 	(*NewEventReceivedResponse)(nil),       // 4: river.NewEventReceivedResponse
-	(*NewEventInPoolRequest)(nil),          // 5: river.NewEventInPoolRequest
-	(*NewEventInPoolResponse)(nil),         // 6: river.NewEventInPoolResponse
-	(*ProposeMiniblockRequest)(nil),        // 7: river.ProposeMiniblockRequest
-	(*ProposeMiniblockResponse)(nil),       // 8: river.ProposeMiniblockResponse
-	(*SaveMiniblockCandidateRequest)(nil),  // 9: river.SaveMiniblockCandidateRequest
-	(*SaveMiniblockCandidateResponse)(nil), // 10: river.SaveMiniblockCandidateResponse
-	(*Miniblock)(nil),                      // 11: river.Miniblock
-	(*SyncCookie)(nil),                     // 12: river.SyncCookie
-	(*Envelope)(nil),                       // 13: river.Envelope
+	(*NewEventsReceivedRequest)(nil),       // 5: river.NewEventsReceivedRequest
+	(*NewEventsReceivedResponse)(nil),      // 6: river.NewEventsReceivedResponse
+	(*NewEventInPoolRequest)(nil),          // 7: river.NewEventInPoolRequest
+	(*NewEventInPoolResponse)(nil),         // 8: river.NewEventInPoolResponse
+	(*ProposeMiniblockRequest)(nil),        // 9: river.ProposeMiniblockRequest
+	(*ProposeMiniblockResponse)(nil),       // 10: river.ProposeMiniblockResponse
+	(*SaveMiniblockCandidateRequest)(nil),  // 11: river.SaveMiniblockCandidateRequest
+	(*SaveMiniblockCandidateResponse)(nil), // 12: river.SaveMiniblockCandidateResponse
+	(*Miniblock)(nil),                      // 13: river.Miniblock
+	(*SyncCookie)(nil),                     // 14: river.SyncCookie
+	(*Envelope)(nil),                       // 15: river.Envelope
+	(*AddEventResponse)(nil),               // 16: river.AddEventResponse
 }
 var file_internode_proto_depIdxs = []int32{
-	11, // 0: river.AllocateStreamRequest.miniblock:type_name -> river.Miniblock
-	12, // 1: river.AllocateStreamResponse.sync_cookie:type_name -> river.SyncCookie
-	13, // 2: river.NewEventReceivedRequest.event:type_name -> river.Envelope
-	0,  // 3: river.ProposeMiniblockResponse.proposal:type_name -> river.MiniblockProposal
-	11, // 4: river.SaveMiniblockCandidateRequest.miniblock:type_name -> river.Miniblock
-	1,  // 5: river.NodeToNode.AllocateStream:input_type -> river.AllocateStreamRequest
-	3,  // 6: river.NodeToNode.NewEventReceived:input_type -> river.NewEventReceivedRequest
-	5,  // 7: river.NodeToNode.NewEventInPool:input_type -> river.NewEventInPoolRequest
-	7,  // 8: river.NodeToNode.ProposeMiniblock:input_type -> river.ProposeMiniblockRequest
-	9,  // 9: river.NodeToNode.SaveMiniblockCandidate:input_type -> river.SaveMiniblockCandidateRequest
-	2,  // 10: river.NodeToNode.AllocateStream:output_type -> river.AllocateStreamResponse
-	4,  // 11: river.NodeToNode.NewEventReceived:output_type -> river.NewEventReceivedResponse
-	6,  // 12: river.NodeToNode.NewEventInPool:output_type -> river.NewEventInPoolResponse
-	8,  // 13: river.NodeToNode.ProposeMiniblock:output_type -> river.ProposeMiniblockResponse
-	10, // 14: river.NodeToNode.SaveMiniblockCandidate:output_type -> river.SaveMiniblockCandidateResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	13, // 0: river.AllocateStreamRequest.miniblock:type_name -> river.Miniblock
+	14, // 1: river.AllocateStreamResponse.sync_cookie:type_name -> river.SyncCookie
+	15, // 2: river.NewEventReceivedRequest.event:type_name -> river.Envelope
+	3,  // 3: river.NewEventsReceivedRequest.events:type_name -> river.NewEventReceivedRequest
+	16, // 4: river.NewEventsReceivedResponse.results:type_name -> river.AddEventResponse
+	0,  // 5: river.ProposeMiniblockResponse.proposal:type_name -> river.MiniblockProposal
+	13, // 6: river.SaveMiniblockCandidateRequest.miniblock:type_name -> river.Miniblock
+	1,  // 7: river.NodeToNode.AllocateStream:input_type -> river.AllocateStreamRequest
+	3,  // 8: river.NodeToNode.NewEventReceived:input_type -> river.NewEventReceivedRequest
+	5,  // 9: river.NodeToNode.NewEventsReceived:input_type -> river.NewEventsReceivedRequest
+	7,  // 10: river.NodeToNode.NewEventInPool:input_type -> river.NewEventInPoolRequest
+	9,  // 11: river.NodeToNode.ProposeMiniblock:input_type -> river.ProposeMiniblockRequest
+	11, // 12: river.NodeToNode.SaveMiniblockCandidate:input_type -> river.SaveMiniblockCandidateRequest
+	2,  // 13: river.NodeToNode.AllocateStream:output_type -> river.AllocateStreamResponse
+	4,  // 14: river.NodeToNode.NewEventReceived:output_type -> river.NewEventReceivedResponse
+	6,  // 15: river.NodeToNode.NewEventsReceived:output_type -> river.NewEventsReceivedResponse
+	8,  // 16: river.NodeToNode.NewEventInPool:output_type -> river.NewEventInPoolResponse
+	10, // 17: river.NodeToNode.ProposeMiniblock:output_type -> river.ProposeMiniblockResponse
+	12, // 18: river.NodeToNode.SaveMiniblockCandidate:output_type -> river.SaveMiniblockCandidateResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_internode_proto_init() }
@@ -792,7 +909,7 @@ func file_internode_proto_init() {
 			}
 		}
 		file_internode_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewEventInPoolRequest); i {
+			switch v := v.(*NewEventsReceivedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -804,7 +921,7 @@ func file_internode_proto_init() {
 			}
 		}
 		file_internode_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewEventInPoolResponse); i {
+			switch v := v.(*NewEventsReceivedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -816,7 +933,7 @@ func file_internode_proto_init() {
 			}
 		}
 		file_internode_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProposeMiniblockRequest); i {
+			switch v := v.(*NewEventInPoolRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -828,7 +945,7 @@ func file_internode_proto_init() {
 			}
 		}
 		file_internode_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProposeMiniblockResponse); i {
+			switch v := v.(*NewEventInPoolResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -840,7 +957,7 @@ func file_internode_proto_init() {
 			}
 		}
 		file_internode_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveMiniblockCandidateRequest); i {
+			switch v := v.(*ProposeMiniblockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -852,6 +969,30 @@ func file_internode_proto_init() {
 			}
 		}
 		file_internode_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposeMiniblockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internode_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveMiniblockCandidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internode_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SaveMiniblockCandidateResponse); i {
 			case 0:
 				return &v.state
@@ -870,7 +1011,7 @@ func file_internode_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internode_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
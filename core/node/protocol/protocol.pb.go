@@ -284,6 +284,7 @@ const (
 	Err_MINIPOOL_MISSING_EVENTS       Err = 60
 	Err_STREAM_LAST_BLOCK_MISMATCH    Err = 61
 	Err_DOWNSTREAM_NETWORK_ERROR      Err = 62
+	Err_MINIBLOCKS_PRUNED             Err = 63
 )
 
 // Enum value maps for Err.
@@ -352,6 +353,7 @@ var (
 		60: "MINIPOOL_MISSING_EVENTS",
 		61: "STREAM_LAST_BLOCK_MISMATCH",
 		62: "DOWNSTREAM_NETWORK_ERROR",
+		63: "MINIBLOCKS_PRUNED",
 	}
 	Err_value = map[string]int32{
 		"ERR_UNSPECIFIED":               0,
@@ -417,6 +419,7 @@ var (
 		"MINIPOOL_MISSING_EVENTS":       60,
 		"STREAM_LAST_BLOCK_MISMATCH":    61,
 		"DOWNSTREAM_NETWORK_ERROR":      62,
+		"MINIBLOCKS_PRUNED":             63,
 	}
 )
 
@@ -622,6 +625,10 @@ type StreamEvent struct {
 	CreatedAtEpochMs int64 `protobuf:"varint,5,opt,name=created_at_epoch_ms,json=createdAtEpochMs,proto3" json:"created_at_epoch_ms,omitempty"`
 	// * DelegateExpiry is the time when the delegate signature expires.
 	DelegateExpiryEpochMs int64 `protobuf:"varint,6,opt,name=delegate_expiry_epoch_ms,json=delegateExpiryEpochMs,proto3" json:"delegate_expiry_epoch_ms,omitempty"`
+	// * Ephemeral events are validated and delivered to the current stream subscribers,
+	// but are not stored and are never included into miniblocks.
+	// Only MemberPayload.Typing and MemberPayload.Presence can be ephemeral, and they can only be ephemeral.
+	Ephemeral bool `protobuf:"varint,7,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
 	// * Variable-type payload.
 	// Payloads should obey the following rules:
 	//   - payloads should have their own unique type
@@ -721,6 +728,13 @@ func (x *StreamEvent) GetDelegateExpiryEpochMs() int64 {
 	return 0
 }
 
+func (x *StreamEvent) GetEphemeral() bool {
+	if x != nil {
+		return x.Ephemeral
+	}
+	return false
+}
+
 func (m *StreamEvent) GetPayload() isStreamEvent_Payload {
 	if m != nil {
 		return m.Payload
@@ -1032,6 +1046,8 @@ type MemberPayload struct {
 	//	*MemberPayload_Nft_
 	//	*MemberPayload_Pin_
 	//	*MemberPayload_Unpin_
+	//	*MemberPayload_Typing_
+	//	*MemberPayload_Presence_
 	Content isMemberPayload_Content `protobuf_oneof:"content"`
 }
 
@@ -1137,6 +1153,20 @@ func (x *MemberPayload) GetUnpin() *MemberPayload_Unpin {
 	return nil
 }
 
+func (x *MemberPayload) GetTyping() *MemberPayload_Typing {
+	if x, ok := x.GetContent().(*MemberPayload_Typing_); ok {
+		return x.Typing
+	}
+	return nil
+}
+
+func (x *MemberPayload) GetPresence() *MemberPayload_Presence {
+	if x, ok := x.GetContent().(*MemberPayload_Presence_); ok {
+		return x.Presence
+	}
+	return nil
+}
+
 type isMemberPayload_Content interface {
 	isMemberPayload_Content()
 }
@@ -1177,6 +1207,14 @@ type MemberPayload_Unpin_ struct {
 	Unpin *MemberPayload_Unpin `protobuf:"bytes,9,opt,name=unpin,proto3,oneof"`
 }
 
+type MemberPayload_Typing_ struct {
+	Typing *MemberPayload_Typing `protobuf:"bytes,10,opt,name=typing,proto3,oneof"` // ephemeral only
+}
+
+type MemberPayload_Presence_ struct {
+	Presence *MemberPayload_Presence `protobuf:"bytes,11,opt,name=presence,proto3,oneof"` // ephemeral only
+}
+
 func (*MemberPayload_Membership_) isMemberPayload_Content() {}
 
 func (*MemberPayload_KeySolicitation_) isMemberPayload_Content() {}
@@ -1195,6 +1233,10 @@ func (*MemberPayload_Pin_) isMemberPayload_Content() {}
 
 func (*MemberPayload_Unpin_) isMemberPayload_Content() {}
 
+func (*MemberPayload_Typing_) isMemberPayload_Content() {}
+
+func (*MemberPayload_Presence_) isMemberPayload_Content() {}
+
 // *
 // SpacePayload
 type SpacePayload struct {
@@ -1290,6 +1332,10 @@ type ChannelPayload struct {
 	//	*ChannelPayload_Inception_
 	//	*ChannelPayload_Message
 	//	*ChannelPayload_Redaction_
+	//	*ChannelPayload_Edit
+	//	*ChannelPayload_Reaction
+	//	*ChannelPayload_RemoveReaction
+	//	*ChannelPayload_ThreadReply_
 	Content isChannelPayload_Content `protobuf_oneof:"content"`
 }
 
@@ -1353,6 +1399,34 @@ func (x *ChannelPayload) GetRedaction() *ChannelPayload_Redaction {
 	return nil
 }
 
+func (x *ChannelPayload) GetEdit() *MessageEdit {
+	if x, ok := x.GetContent().(*ChannelPayload_Edit); ok {
+		return x.Edit
+	}
+	return nil
+}
+
+func (x *ChannelPayload) GetReaction() *Reaction {
+	if x, ok := x.GetContent().(*ChannelPayload_Reaction); ok {
+		return x.Reaction
+	}
+	return nil
+}
+
+func (x *ChannelPayload) GetRemoveReaction() *Reaction {
+	if x, ok := x.GetContent().(*ChannelPayload_RemoveReaction); ok {
+		return x.RemoveReaction
+	}
+	return nil
+}
+
+func (x *ChannelPayload) GetThreadReply() *ChannelPayload_ThreadReply {
+	if x, ok := x.GetContent().(*ChannelPayload_ThreadReply_); ok {
+		return x.ThreadReply
+	}
+	return nil
+}
+
 type isChannelPayload_Content interface {
 	isChannelPayload_Content()
 }
@@ -1369,12 +1443,36 @@ type ChannelPayload_Redaction_ struct {
 	Redaction *ChannelPayload_Redaction `protobuf:"bytes,3,opt,name=redaction,proto3,oneof"`
 }
 
+type ChannelPayload_Edit struct {
+	Edit *MessageEdit `protobuf:"bytes,4,opt,name=edit,proto3,oneof"`
+}
+
+type ChannelPayload_Reaction struct {
+	Reaction *Reaction `protobuf:"bytes,5,opt,name=reaction,proto3,oneof"`
+}
+
+type ChannelPayload_RemoveReaction struct {
+	RemoveReaction *Reaction `protobuf:"bytes,6,opt,name=remove_reaction,json=removeReaction,proto3,oneof"`
+}
+
+type ChannelPayload_ThreadReply_ struct {
+	ThreadReply *ChannelPayload_ThreadReply `protobuf:"bytes,7,opt,name=thread_reply,json=threadReply,proto3,oneof"`
+}
+
 func (*ChannelPayload_Inception_) isChannelPayload_Content() {}
 
 func (*ChannelPayload_Message) isChannelPayload_Content() {}
 
 func (*ChannelPayload_Redaction_) isChannelPayload_Content() {}
 
+func (*ChannelPayload_Edit) isChannelPayload_Content() {}
+
+func (*ChannelPayload_Reaction) isChannelPayload_Content() {}
+
+func (*ChannelPayload_RemoveReaction) isChannelPayload_Content() {}
+
+func (*ChannelPayload_ThreadReply_) isChannelPayload_Content() {}
+
 // *
 // DmChannelPayload
 type DmChannelPayload struct {
@@ -1386,6 +1484,9 @@ type DmChannelPayload struct {
 	//
 	//	*DmChannelPayload_Inception_
 	//	*DmChannelPayload_Message
+	//	*DmChannelPayload_Edit
+	//	*DmChannelPayload_Reaction
+	//	*DmChannelPayload_RemoveReaction
 	Content isDmChannelPayload_Content `protobuf_oneof:"content"`
 }
 
@@ -1442,6 +1543,27 @@ func (x *DmChannelPayload) GetMessage() *EncryptedData {
 	return nil
 }
 
+func (x *DmChannelPayload) GetEdit() *MessageEdit {
+	if x, ok := x.GetContent().(*DmChannelPayload_Edit); ok {
+		return x.Edit
+	}
+	return nil
+}
+
+func (x *DmChannelPayload) GetReaction() *Reaction {
+	if x, ok := x.GetContent().(*DmChannelPayload_Reaction); ok {
+		return x.Reaction
+	}
+	return nil
+}
+
+func (x *DmChannelPayload) GetRemoveReaction() *Reaction {
+	if x, ok := x.GetContent().(*DmChannelPayload_RemoveReaction); ok {
+		return x.RemoveReaction
+	}
+	return nil
+}
+
 type isDmChannelPayload_Content interface {
 	isDmChannelPayload_Content()
 }
@@ -1454,10 +1576,28 @@ type DmChannelPayload_Message struct {
 	Message *EncryptedData `protobuf:"bytes,3,opt,name=message,proto3,oneof"`
 }
 
+type DmChannelPayload_Edit struct {
+	Edit *MessageEdit `protobuf:"bytes,4,opt,name=edit,proto3,oneof"`
+}
+
+type DmChannelPayload_Reaction struct {
+	Reaction *Reaction `protobuf:"bytes,5,opt,name=reaction,proto3,oneof"`
+}
+
+type DmChannelPayload_RemoveReaction struct {
+	RemoveReaction *Reaction `protobuf:"bytes,6,opt,name=remove_reaction,json=removeReaction,proto3,oneof"`
+}
+
 func (*DmChannelPayload_Inception_) isDmChannelPayload_Content() {}
 
 func (*DmChannelPayload_Message) isDmChannelPayload_Content() {}
 
+func (*DmChannelPayload_Edit) isDmChannelPayload_Content() {}
+
+func (*DmChannelPayload_Reaction) isDmChannelPayload_Content() {}
+
+func (*DmChannelPayload_RemoveReaction) isDmChannelPayload_Content() {}
+
 // *
 // GdmChannelPayload
 type GdmChannelPayload struct {
@@ -1470,6 +1610,9 @@ type GdmChannelPayload struct {
 	//	*GdmChannelPayload_Inception_
 	//	*GdmChannelPayload_Message
 	//	*GdmChannelPayload_ChannelProperties
+	//	*GdmChannelPayload_Edit
+	//	*GdmChannelPayload_Reaction
+	//	*GdmChannelPayload_RemoveReaction
 	Content isGdmChannelPayload_Content `protobuf_oneof:"content"`
 }
 
@@ -1533,6 +1676,27 @@ func (x *GdmChannelPayload) GetChannelProperties() *EncryptedData {
 	return nil
 }
 
+func (x *GdmChannelPayload) GetEdit() *MessageEdit {
+	if x, ok := x.GetContent().(*GdmChannelPayload_Edit); ok {
+		return x.Edit
+	}
+	return nil
+}
+
+func (x *GdmChannelPayload) GetReaction() *Reaction {
+	if x, ok := x.GetContent().(*GdmChannelPayload_Reaction); ok {
+		return x.Reaction
+	}
+	return nil
+}
+
+func (x *GdmChannelPayload) GetRemoveReaction() *Reaction {
+	if x, ok := x.GetContent().(*GdmChannelPayload_RemoveReaction); ok {
+		return x.RemoveReaction
+	}
+	return nil
+}
+
 type isGdmChannelPayload_Content interface {
 	isGdmChannelPayload_Content()
 }
@@ -1549,12 +1713,30 @@ type GdmChannelPayload_ChannelProperties struct {
 	ChannelProperties *EncryptedData `protobuf:"bytes,3,opt,name=channel_properties,json=channelProperties,proto3,oneof"`
 }
 
+type GdmChannelPayload_Edit struct {
+	Edit *MessageEdit `protobuf:"bytes,4,opt,name=edit,proto3,oneof"`
+}
+
+type GdmChannelPayload_Reaction struct {
+	Reaction *Reaction `protobuf:"bytes,5,opt,name=reaction,proto3,oneof"`
+}
+
+type GdmChannelPayload_RemoveReaction struct {
+	RemoveReaction *Reaction `protobuf:"bytes,6,opt,name=remove_reaction,json=removeReaction,proto3,oneof"`
+}
+
 func (*GdmChannelPayload_Inception_) isGdmChannelPayload_Content() {}
 
 func (*GdmChannelPayload_Message) isGdmChannelPayload_Content() {}
 
 func (*GdmChannelPayload_ChannelProperties) isGdmChannelPayload_Content() {}
 
+func (*GdmChannelPayload_Edit) isGdmChannelPayload_Content() {}
+
+func (*GdmChannelPayload_Reaction) isGdmChannelPayload_Content() {}
+
+func (*GdmChannelPayload_RemoveReaction) isGdmChannelPayload_Content() {}
+
 // *
 // UserPayload
 type UserPayload struct {
@@ -2495,20 +2677,24 @@ func (x *WrappedEncryptedData) GetEventHash() []byte {
 	return nil
 }
 
-type SyncCookie struct {
+// *
+// MessageEdit replaces the content of a message previously added to the same stream.
+// Only the creator of the message can edit it within the message edit window.
+type MessageEdit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeAddress       []byte `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
-	StreamId          []byte `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	MinipoolGen       int64  `protobuf:"varint,3,opt,name=minipool_gen,json=minipoolGen,proto3" json:"minipool_gen,omitempty"`
-	MinipoolSlot      int64  `protobuf:"varint,4,opt,name=minipool_slot,json=minipoolSlot,proto3" json:"minipool_slot,omitempty"`
-	PrevMiniblockHash []byte `protobuf:"bytes,5,opt,name=prev_miniblock_hash,json=prevMiniblockHash,proto3" json:"prev_miniblock_hash,omitempty"`
+	// hash of the edited message event
+	EventId []byte `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// edited message event, hash of the event must match event_id
+	Event *StreamEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// new content of the message
+	Message *EncryptedData `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SyncCookie) Reset() {
-	*x = SyncCookie{}
+func (x *MessageEdit) Reset() {
+	*x = MessageEdit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2516,13 +2702,13 @@ func (x *SyncCookie) Reset() {
 	}
 }
 
-func (x *SyncCookie) String() string {
+func (x *MessageEdit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncCookie) ProtoMessage() {}
+func (*MessageEdit) ProtoMessage() {}
 
-func (x *SyncCookie) ProtoReflect() protoreflect.Message {
+func (x *MessageEdit) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2534,60 +2720,47 @@ func (x *SyncCookie) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncCookie.ProtoReflect.Descriptor instead.
-func (*SyncCookie) Descriptor() ([]byte, []int) {
+// Deprecated: Use MessageEdit.ProtoReflect.Descriptor instead.
+func (*MessageEdit) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SyncCookie) GetNodeAddress() []byte {
+func (x *MessageEdit) GetEventId() []byte {
 	if x != nil {
-		return x.NodeAddress
+		return x.EventId
 	}
 	return nil
 }
 
-func (x *SyncCookie) GetStreamId() []byte {
+func (x *MessageEdit) GetEvent() *StreamEvent {
 	if x != nil {
-		return x.StreamId
+		return x.Event
 	}
 	return nil
 }
 
-func (x *SyncCookie) GetMinipoolGen() int64 {
-	if x != nil {
-		return x.MinipoolGen
-	}
-	return 0
-}
-
-func (x *SyncCookie) GetMinipoolSlot() int64 {
-	if x != nil {
-		return x.MinipoolSlot
-	}
-	return 0
-}
-
-func (x *SyncCookie) GetPrevMiniblockHash() []byte {
+func (x *MessageEdit) GetMessage() *EncryptedData {
 	if x != nil {
-		return x.PrevMiniblockHash
+		return x.Message
 	}
 	return nil
 }
 
-type StreamAndCookie struct {
+// *
+// SnappedMessageEdit is the latest edit of a message.
+type SnappedMessageEdit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events         []*Envelope `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	NextSyncCookie *SyncCookie `protobuf:"bytes,2,opt,name=next_sync_cookie,json=nextSyncCookie,proto3" json:"next_sync_cookie,omitempty"`
-	// if non-empty, contains all blocks since the latest snapshot, miniblocks[0].header is the latest snapshot
-	Miniblocks []*Miniblock `protobuf:"bytes,3,rep,name=miniblocks,proto3" json:"miniblocks,omitempty"`
-	SyncReset  bool         `protobuf:"varint,4,opt,name=sync_reset,json=syncReset,proto3" json:"sync_reset,omitempty"`
+	// hash of the edited message event
+	EventId []byte `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// latest content of the message
+	Message *WrappedEncryptedData `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *StreamAndCookie) Reset() {
-	*x = StreamAndCookie{}
+func (x *SnappedMessageEdit) Reset() {
+	*x = SnappedMessageEdit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2595,13 +2768,13 @@ func (x *StreamAndCookie) Reset() {
 	}
 }
 
-func (x *StreamAndCookie) String() string {
+func (x *SnappedMessageEdit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamAndCookie) ProtoMessage() {}
+func (*SnappedMessageEdit) ProtoMessage() {}
 
-func (x *StreamAndCookie) ProtoReflect() protoreflect.Message {
+func (x *SnappedMessageEdit) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2613,49 +2786,40 @@ func (x *StreamAndCookie) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamAndCookie.ProtoReflect.Descriptor instead.
-func (*StreamAndCookie) Descriptor() ([]byte, []int) {
+// Deprecated: Use SnappedMessageEdit.ProtoReflect.Descriptor instead.
+func (*SnappedMessageEdit) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *StreamAndCookie) GetEvents() []*Envelope {
-	if x != nil {
-		return x.Events
-	}
-	return nil
-}
-
-func (x *StreamAndCookie) GetNextSyncCookie() *SyncCookie {
+func (x *SnappedMessageEdit) GetEventId() []byte {
 	if x != nil {
-		return x.NextSyncCookie
+		return x.EventId
 	}
 	return nil
 }
 
-func (x *StreamAndCookie) GetMiniblocks() []*Miniblock {
+func (x *SnappedMessageEdit) GetMessage() *WrappedEncryptedData {
 	if x != nil {
-		return x.Miniblocks
+		return x.Message
 	}
 	return nil
 }
 
-func (x *StreamAndCookie) GetSyncReset() bool {
-	if x != nil {
-		return x.SyncReset
-	}
-	return false
-}
-
-type GetStreamExRequest struct {
+// *
+// Reaction of the event creator to a message, e.g. an emoji.
+// Adding the same reaction twice or removing a reaction that wasn't added has no effect.
+type Reaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// hash of the message event
+	EventId  []byte `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Reaction string `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
 }
 
-func (x *GetStreamExRequest) Reset() {
-	*x = GetStreamExRequest{}
+func (x *Reaction) Reset() {
+	*x = Reaction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2663,13 +2827,13 @@ func (x *GetStreamExRequest) Reset() {
 	}
 }
 
-func (x *GetStreamExRequest) String() string {
+func (x *Reaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamExRequest) ProtoMessage() {}
+func (*Reaction) ProtoMessage() {}
 
-func (x *GetStreamExRequest) ProtoReflect() protoreflect.Message {
+func (x *Reaction) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2681,28 +2845,43 @@ func (x *GetStreamExRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamExRequest.ProtoReflect.Descriptor instead.
-func (*GetStreamExRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetStreamExRequest) GetStreamId() []byte {
+func (x *Reaction) GetEventId() []byte {
 	if x != nil {
-		return x.StreamId
+		return x.EventId
 	}
 	return nil
 }
 
-type Minipool struct {
+func (x *Reaction) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+// *
+// SnappedReactions aggregates reactions to a message.
+// Snapshots keep reactions of at most 100 most recently reacted messages and at most 20 reactions per message.
+type SnappedReactions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events []*Envelope `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// hash of the message event
+	EventId []byte `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// reactions: sorted by reaction
+	Reactions []*SnappedReactions_Reaction `protobuf:"bytes,2,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// event number of the last reaction to the message
+	UpdatedAtEventNum int64 `protobuf:"varint,3,opt,name=updated_at_event_num,json=updatedAtEventNum,proto3" json:"updated_at_event_num,omitempty"`
 }
 
-func (x *Minipool) Reset() {
-	*x = Minipool{}
+func (x *SnappedReactions) Reset() {
+	*x = SnappedReactions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2710,13 +2889,13 @@ func (x *Minipool) Reset() {
 	}
 }
 
-func (x *Minipool) String() string {
+func (x *SnappedReactions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Minipool) ProtoMessage() {}
+func (*SnappedReactions) ProtoMessage() {}
 
-func (x *Minipool) ProtoReflect() protoreflect.Message {
+func (x *SnappedReactions) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2728,36 +2907,46 @@ func (x *Minipool) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Minipool.ProtoReflect.Descriptor instead.
-func (*Minipool) Descriptor() ([]byte, []int) {
+// Deprecated: Use SnappedReactions.ProtoReflect.Descriptor instead.
+func (*SnappedReactions) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Minipool) GetEvents() []*Envelope {
+func (x *SnappedReactions) GetEventId() []byte {
 	if x != nil {
-		return x.Events
+		return x.EventId
 	}
 	return nil
 }
 
-// GetStreamExResponse is a stream of raw data that represents the current state of the requested stream.
-// These responses represent streams that are not expected to change once finalized, and have a optimized code path
-// for retrieval. Response may potentially be very large, and are streamed back to the client. The client is expected
-// to martial the raw data back into protobuf messages.
-type GetStreamExResponse struct {
+func (x *SnappedReactions) GetReactions() []*SnappedReactions_Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *SnappedReactions) GetUpdatedAtEventNum() int64 {
+	if x != nil {
+		return x.UpdatedAtEventNum
+	}
+	return 0
+}
+
+type SyncCookie struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//
-	//	*GetStreamExResponse_Miniblock
-	//	*GetStreamExResponse_Minipool
-	Data isGetStreamExResponse_Data `protobuf_oneof:"data"`
+	NodeAddress       []byte `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
+	StreamId          []byte `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	MinipoolGen       int64  `protobuf:"varint,3,opt,name=minipool_gen,json=minipoolGen,proto3" json:"minipool_gen,omitempty"`
+	MinipoolSlot      int64  `protobuf:"varint,4,opt,name=minipool_slot,json=minipoolSlot,proto3" json:"minipool_slot,omitempty"`
+	PrevMiniblockHash []byte `protobuf:"bytes,5,opt,name=prev_miniblock_hash,json=prevMiniblockHash,proto3" json:"prev_miniblock_hash,omitempty"`
 }
 
-func (x *GetStreamExResponse) Reset() {
-	*x = GetStreamExResponse{}
+func (x *SyncCookie) Reset() {
+	*x = SyncCookie{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2765,13 +2954,13 @@ func (x *GetStreamExResponse) Reset() {
 	}
 }
 
-func (x *GetStreamExResponse) String() string {
+func (x *SyncCookie) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamExResponse) ProtoMessage() {}
+func (*SyncCookie) ProtoMessage() {}
 
-func (x *GetStreamExResponse) ProtoReflect() protoreflect.Message {
+func (x *SyncCookie) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2783,60 +2972,60 @@ func (x *GetStreamExResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamExResponse.ProtoReflect.Descriptor instead.
-func (*GetStreamExResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncCookie.ProtoReflect.Descriptor instead.
+func (*SyncCookie) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{23}
 }
 
-func (m *GetStreamExResponse) GetData() isGetStreamExResponse_Data {
-	if m != nil {
-		return m.Data
+func (x *SyncCookie) GetNodeAddress() []byte {
+	if x != nil {
+		return x.NodeAddress
 	}
 	return nil
 }
 
-func (x *GetStreamExResponse) GetMiniblock() *Miniblock {
-	if x, ok := x.GetData().(*GetStreamExResponse_Miniblock); ok {
-		return x.Miniblock
+func (x *SyncCookie) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
 	}
 	return nil
 }
 
-func (x *GetStreamExResponse) GetMinipool() *Minipool {
-	if x, ok := x.GetData().(*GetStreamExResponse_Minipool); ok {
-		return x.Minipool
+func (x *SyncCookie) GetMinipoolGen() int64 {
+	if x != nil {
+		return x.MinipoolGen
 	}
-	return nil
+	return 0
 }
 
-type isGetStreamExResponse_Data interface {
-	isGetStreamExResponse_Data()
-}
-
-type GetStreamExResponse_Miniblock struct {
-	Miniblock *Miniblock `protobuf:"bytes,1,opt,name=miniblock,proto3,oneof"`
+func (x *SyncCookie) GetMinipoolSlot() int64 {
+	if x != nil {
+		return x.MinipoolSlot
+	}
+	return 0
 }
 
-type GetStreamExResponse_Minipool struct {
-	Minipool *Minipool `protobuf:"bytes,2,opt,name=minipool,proto3,oneof"`
+func (x *SyncCookie) GetPrevMiniblockHash() []byte {
+	if x != nil {
+		return x.PrevMiniblockHash
+	}
+	return nil
 }
 
-func (*GetStreamExResponse_Miniblock) isGetStreamExResponse_Data() {}
-
-func (*GetStreamExResponse_Minipool) isGetStreamExResponse_Data() {}
-
-type CreateStreamRequest struct {
+type StreamAndCookie struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events   []*Envelope       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	StreamId []byte            `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"` // stream_id should match the stream_id in the inception payload of the first event
-	Metadata map[string][]byte `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Events         []*Envelope `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextSyncCookie *SyncCookie `protobuf:"bytes,2,opt,name=next_sync_cookie,json=nextSyncCookie,proto3" json:"next_sync_cookie,omitempty"`
+	// if non-empty, contains all blocks since the latest snapshot, miniblocks[0].header is the latest snapshot
+	Miniblocks []*Miniblock `protobuf:"bytes,3,rep,name=miniblocks,proto3" json:"miniblocks,omitempty"`
+	SyncReset  bool         `protobuf:"varint,4,opt,name=sync_reset,json=syncReset,proto3" json:"sync_reset,omitempty"`
 }
 
-func (x *CreateStreamRequest) Reset() {
-	*x = CreateStreamRequest{}
+func (x *StreamAndCookie) Reset() {
+	*x = StreamAndCookie{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2844,13 +3033,13 @@ func (x *CreateStreamRequest) Reset() {
 	}
 }
 
-func (x *CreateStreamRequest) String() string {
+func (x *StreamAndCookie) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStreamRequest) ProtoMessage() {}
+func (*StreamAndCookie) ProtoMessage() {}
 
-func (x *CreateStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamAndCookie) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2862,43 +3051,58 @@ func (x *CreateStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStreamRequest.ProtoReflect.Descriptor instead.
-func (*CreateStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamAndCookie.ProtoReflect.Descriptor instead.
+func (*StreamAndCookie) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateStreamRequest) GetEvents() []*Envelope {
+func (x *StreamAndCookie) GetEvents() []*Envelope {
 	if x != nil {
 		return x.Events
 	}
 	return nil
 }
 
-func (x *CreateStreamRequest) GetStreamId() []byte {
+func (x *StreamAndCookie) GetNextSyncCookie() *SyncCookie {
 	if x != nil {
-		return x.StreamId
+		return x.NextSyncCookie
 	}
 	return nil
 }
 
-func (x *CreateStreamRequest) GetMetadata() map[string][]byte {
+func (x *StreamAndCookie) GetMiniblocks() []*Miniblock {
 	if x != nil {
-		return x.Metadata
+		return x.Miniblocks
 	}
 	return nil
 }
 
-type CreateStreamResponse struct {
+func (x *StreamAndCookie) GetSyncReset() bool {
+	if x != nil {
+		return x.SyncReset
+	}
+	return false
+}
+
+type GetStreamExRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// all events in current minipool and cookie allowing to sync from the end of the stream
-	Stream *StreamAndCookie `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	StreamId []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// Number of the first miniblock to return.
+	// Interrupted download can be resumed by setting it to the number of the last received miniblock + 1.
+	FromInclusive int64 `protobuf:"varint,2,opt,name=from_inclusive,json=fromInclusive,proto3" json:"from_inclusive,omitempty"`
+	// Miniblocks up to, but not including, to_exclusive are returned.
+	// If not set, all miniblocks up to the last one are returned.
+	ToExclusive int64 `protobuf:"varint,3,opt,name=to_exclusive,json=toExclusive,proto3" json:"to_exclusive,omitempty"`
+	// If set, minipool is returned after the last miniblock of the stream.
+	// Minipool is not returned if to_exclusive is set.
+	IncludeMinipool bool `protobuf:"varint,4,opt,name=include_minipool,json=includeMinipool,proto3" json:"include_minipool,omitempty"`
 }
 
-func (x *CreateStreamResponse) Reset() {
-	*x = CreateStreamResponse{}
+func (x *GetStreamExRequest) Reset() {
+	*x = GetStreamExRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2906,13 +3110,13 @@ func (x *CreateStreamResponse) Reset() {
 	}
 }
 
-func (x *CreateStreamResponse) String() string {
+func (x *GetStreamExRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStreamResponse) ProtoMessage() {}
+func (*GetStreamExRequest) ProtoMessage() {}
 
-func (x *CreateStreamResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStreamExRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2924,30 +3128,49 @@ func (x *CreateStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStreamResponse.ProtoReflect.Descriptor instead.
-func (*CreateStreamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamExRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamExRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateStreamResponse) GetStream() *StreamAndCookie {
+func (x *GetStreamExRequest) GetStreamId() []byte {
 	if x != nil {
-		return x.Stream
+		return x.StreamId
 	}
 	return nil
 }
 
-type GetStreamRequest struct {
+func (x *GetStreamExRequest) GetFromInclusive() int64 {
+	if x != nil {
+		return x.FromInclusive
+	}
+	return 0
+}
+
+func (x *GetStreamExRequest) GetToExclusive() int64 {
+	if x != nil {
+		return x.ToExclusive
+	}
+	return 0
+}
+
+func (x *GetStreamExRequest) GetIncludeMinipool() bool {
+	if x != nil {
+		return x.IncludeMinipool
+	}
+	return false
+}
+
+type Minipool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	// if optional is true and stream doesn't exist, response will be a nil stream instead of ERROR NOT_FOUND
-	Optional bool `protobuf:"varint,2,opt,name=optional,proto3" json:"optional,omitempty"`
+	Events []*Envelope `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *GetStreamRequest) Reset() {
-	*x = GetStreamRequest{}
+func (x *Minipool) Reset() {
+	*x = Minipool{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2955,13 +3178,13 @@ func (x *GetStreamRequest) Reset() {
 	}
 }
 
-func (x *GetStreamRequest) String() string {
+func (x *Minipool) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamRequest) ProtoMessage() {}
+func (*Minipool) ProtoMessage() {}
 
-func (x *GetStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *Minipool) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2973,36 +3196,36 @@ func (x *GetStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Minipool.ProtoReflect.Descriptor instead.
+func (*Minipool) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetStreamRequest) GetStreamId() []byte {
+func (x *Minipool) GetEvents() []*Envelope {
 	if x != nil {
-		return x.StreamId
+		return x.Events
 	}
 	return nil
 }
 
-func (x *GetStreamRequest) GetOptional() bool {
-	if x != nil {
-		return x.Optional
-	}
-	return false
-}
-
-type GetStreamResponse struct {
+// GetStreamExResponse is a stream of raw data that represents the current state of the requested stream.
+// These responses represent streams that are not expected to change once finalized, and have a optimized code path
+// for retrieval. Response may potentially be very large, and are streamed back to the client. The client is expected
+// to martial the raw data back into protobuf messages.
+type GetStreamExResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// all events in current minipool and cookie allowing to sync from the end of the stream
-	Stream *StreamAndCookie `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// Types that are assignable to Data:
+	//
+	//	*GetStreamExResponse_Miniblock
+	//	*GetStreamExResponse_Minipool
+	Data isGetStreamExResponse_Data `protobuf_oneof:"data"`
 }
 
-func (x *GetStreamResponse) Reset() {
-	*x = GetStreamResponse{}
+func (x *GetStreamExResponse) Reset() {
+	*x = GetStreamExResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3010,13 +3233,13 @@ func (x *GetStreamResponse) Reset() {
 	}
 }
 
-func (x *GetStreamResponse) String() string {
+func (x *GetStreamExResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamResponse) ProtoMessage() {}
+func (*GetStreamExResponse) ProtoMessage() {}
 
-func (x *GetStreamResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStreamExResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3028,30 +3251,60 @@ func (x *GetStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamResponse.ProtoReflect.Descriptor instead.
-func (*GetStreamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamExResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamExResponse) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetStreamResponse) GetStream() *StreamAndCookie {
-	if x != nil {
-		return x.Stream
+func (m *GetStreamExResponse) GetData() isGetStreamExResponse_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-type GetMiniblocksRequest struct {
+func (x *GetStreamExResponse) GetMiniblock() *Miniblock {
+	if x, ok := x.GetData().(*GetStreamExResponse_Miniblock); ok {
+		return x.Miniblock
+	}
+	return nil
+}
+
+func (x *GetStreamExResponse) GetMinipool() *Minipool {
+	if x, ok := x.GetData().(*GetStreamExResponse_Minipool); ok {
+		return x.Minipool
+	}
+	return nil
+}
+
+type isGetStreamExResponse_Data interface {
+	isGetStreamExResponse_Data()
+}
+
+type GetStreamExResponse_Miniblock struct {
+	Miniblock *Miniblock `protobuf:"bytes,1,opt,name=miniblock,proto3,oneof"`
+}
+
+type GetStreamExResponse_Minipool struct {
+	Minipool *Minipool `protobuf:"bytes,2,opt,name=minipool,proto3,oneof"`
+}
+
+func (*GetStreamExResponse_Miniblock) isGetStreamExResponse_Data() {}
+
+func (*GetStreamExResponse_Minipool) isGetStreamExResponse_Data() {}
+
+type CreateStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId      []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	FromInclusive int64  `protobuf:"varint,2,opt,name=fromInclusive,proto3" json:"fromInclusive,omitempty"`
-	ToExclusive   int64  `protobuf:"varint,3,opt,name=toExclusive,proto3" json:"toExclusive,omitempty"`
+	Events   []*Envelope       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	StreamId []byte            `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"` // stream_id should match the stream_id in the inception payload of the first event
+	Metadata map[string][]byte `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *GetMiniblocksRequest) Reset() {
-	*x = GetMiniblocksRequest{}
+func (x *CreateStreamRequest) Reset() {
+	*x = CreateStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3059,13 +3312,13 @@ func (x *GetMiniblocksRequest) Reset() {
 	}
 }
 
-func (x *GetMiniblocksRequest) String() string {
+func (x *CreateStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMiniblocksRequest) ProtoMessage() {}
+func (*CreateStreamRequest) ProtoMessage() {}
 
-func (x *GetMiniblocksRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3077,44 +3330,43 @@ func (x *GetMiniblocksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMiniblocksRequest.ProtoReflect.Descriptor instead.
-func (*GetMiniblocksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateStreamRequest.ProtoReflect.Descriptor instead.
+func (*CreateStreamRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetMiniblocksRequest) GetStreamId() []byte {
+func (x *CreateStreamRequest) GetEvents() []*Envelope {
 	if x != nil {
-		return x.StreamId
+		return x.Events
 	}
 	return nil
 }
 
-func (x *GetMiniblocksRequest) GetFromInclusive() int64 {
+func (x *CreateStreamRequest) GetStreamId() []byte {
 	if x != nil {
-		return x.FromInclusive
+		return x.StreamId
 	}
-	return 0
+	return nil
 }
 
-func (x *GetMiniblocksRequest) GetToExclusive() int64 {
+func (x *CreateStreamRequest) GetMetadata() map[string][]byte {
 	if x != nil {
-		return x.ToExclusive
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type GetMiniblocksResponse struct {
+type CreateStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Miniblocks []*Miniblock `protobuf:"bytes,1,rep,name=miniblocks,proto3" json:"miniblocks,omitempty"`
-	// terminus: true if there are no more blocks to fetch because they've been garbage collected, or you've reached block 0
-	Terminus bool `protobuf:"varint,2,opt,name=terminus,proto3" json:"terminus,omitempty"`
+	// all events in current minipool and cookie allowing to sync from the end of the stream
+	Stream *StreamAndCookie `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
 }
 
-func (x *GetMiniblocksResponse) Reset() {
-	*x = GetMiniblocksResponse{}
+func (x *CreateStreamResponse) Reset() {
+	*x = CreateStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3122,13 +3374,13 @@ func (x *GetMiniblocksResponse) Reset() {
 	}
 }
 
-func (x *GetMiniblocksResponse) String() string {
+func (x *CreateStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMiniblocksResponse) ProtoMessage() {}
+func (*CreateStreamResponse) ProtoMessage() {}
 
-func (x *GetMiniblocksResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3140,35 +3392,30 @@ func (x *GetMiniblocksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMiniblocksResponse.ProtoReflect.Descriptor instead.
-func (*GetMiniblocksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateStreamResponse.ProtoReflect.Descriptor instead.
+func (*CreateStreamResponse) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetMiniblocksResponse) GetMiniblocks() []*Miniblock {
+func (x *CreateStreamResponse) GetStream() *StreamAndCookie {
 	if x != nil {
-		return x.Miniblocks
+		return x.Stream
 	}
 	return nil
 }
 
-func (x *GetMiniblocksResponse) GetTerminus() bool {
-	if x != nil {
-		return x.Terminus
-	}
-	return false
-}
-
-type GetLastMiniblockHashRequest struct {
+type GetStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	StreamId []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// if optional is true and stream doesn't exist, response will be a nil stream instead of ERROR NOT_FOUND
+	Optional bool `protobuf:"varint,2,opt,name=optional,proto3" json:"optional,omitempty"`
 }
 
-func (x *GetLastMiniblockHashRequest) Reset() {
-	*x = GetLastMiniblockHashRequest{}
+func (x *GetStreamRequest) Reset() {
+	*x = GetStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3176,13 +3423,13 @@ func (x *GetLastMiniblockHashRequest) Reset() {
 	}
 }
 
-func (x *GetLastMiniblockHashRequest) String() string {
+func (x *GetStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastMiniblockHashRequest) ProtoMessage() {}
+func (*GetStreamRequest) ProtoMessage() {}
 
-func (x *GetLastMiniblockHashRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3194,29 +3441,36 @@ func (x *GetLastMiniblockHashRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastMiniblockHashRequest.ProtoReflect.Descriptor instead.
-func (*GetLastMiniblockHashRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetLastMiniblockHashRequest) GetStreamId() []byte {
+func (x *GetStreamRequest) GetStreamId() []byte {
 	if x != nil {
 		return x.StreamId
 	}
 	return nil
 }
 
-type GetLastMiniblockHashResponse struct {
+func (x *GetStreamRequest) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+type GetStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash         []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	MiniblockNum int64  `protobuf:"varint,2,opt,name=miniblock_num,json=miniblockNum,proto3" json:"miniblock_num,omitempty"`
+	// all events in current minipool and cookie allowing to sync from the end of the stream
+	Stream *StreamAndCookie `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
 }
 
-func (x *GetLastMiniblockHashResponse) Reset() {
-	*x = GetLastMiniblockHashResponse{}
+func (x *GetStreamResponse) Reset() {
+	*x = GetStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3224,13 +3478,13 @@ func (x *GetLastMiniblockHashResponse) Reset() {
 	}
 }
 
-func (x *GetLastMiniblockHashResponse) String() string {
+func (x *GetStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastMiniblockHashResponse) ProtoMessage() {}
+func (*GetStreamResponse) ProtoMessage() {}
 
-func (x *GetLastMiniblockHashResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3242,37 +3496,31 @@ func (x *GetLastMiniblockHashResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastMiniblockHashResponse.ProtoReflect.Descriptor instead.
-func (*GetLastMiniblockHashResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamResponse) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetLastMiniblockHashResponse) GetHash() []byte {
+func (x *GetStreamResponse) GetStream() *StreamAndCookie {
 	if x != nil {
-		return x.Hash
+		return x.Stream
 	}
 	return nil
 }
 
-func (x *GetLastMiniblockHashResponse) GetMiniblockNum() int64 {
-	if x != nil {
-		return x.MiniblockNum
-	}
-	return 0
-}
-
-type AddEventRequest struct {
+// GetStreamsRequest returns multiple streams at once, the number of stream ids is limited by the node configuration.
+type GetStreamsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId []byte    `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Event    *Envelope `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
-	Optional bool      `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"` // if true, response will contain non nil error if event didn't pass validation
+	StreamIds [][]byte `protobuf:"bytes,1,rep,name=stream_ids,json=streamIds,proto3" json:"stream_ids,omitempty"`
+	// if optional is true, streams that don't exist are returned without stream and error
+	Optional bool `protobuf:"varint,2,opt,name=optional,proto3" json:"optional,omitempty"`
 }
 
-func (x *AddEventRequest) Reset() {
-	*x = AddEventRequest{}
+func (x *GetStreamsRequest) Reset() {
+	*x = GetStreamsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3280,13 +3528,13 @@ func (x *AddEventRequest) Reset() {
 	}
 }
 
-func (x *AddEventRequest) String() string {
+func (x *GetStreamsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventRequest) ProtoMessage() {}
+func (*GetStreamsRequest) ProtoMessage() {}
 
-func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStreamsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3298,42 +3546,36 @@ func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
-func (*AddEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamsRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamsRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *AddEventRequest) GetStreamId() []byte {
-	if x != nil {
-		return x.StreamId
-	}
-	return nil
-}
-
-func (x *AddEventRequest) GetEvent() *Envelope {
+func (x *GetStreamsRequest) GetStreamIds() [][]byte {
 	if x != nil {
-		return x.Event
+		return x.StreamIds
 	}
 	return nil
 }
 
-func (x *AddEventRequest) GetOptional() bool {
+func (x *GetStreamsRequest) GetOptional() bool {
 	if x != nil {
 		return x.Optional
 	}
 	return false
 }
 
-type AddEventResponse struct {
+type GetStreamsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error *AddEventResponse_Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // only set if AddEventRequest.optional is true
+	// results contains a result for each stream id in the request order
+	Results []*GetStreamsResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *AddEventResponse) Reset() {
-	*x = AddEventResponse{}
+func (x *GetStreamsResponse) Reset() {
+	*x = GetStreamsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3341,13 +3583,13 @@ func (x *AddEventResponse) Reset() {
 	}
 }
 
-func (x *AddEventResponse) String() string {
+func (x *GetStreamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventResponse) ProtoMessage() {}
+func (*GetStreamsResponse) ProtoMessage() {}
 
-func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStreamsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3359,30 +3601,30 @@ func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
-func (*AddEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamsResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamsResponse) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *AddEventResponse) GetError() *AddEventResponse_Error {
+func (x *GetStreamsResponse) GetResults() []*GetStreamsResponse_Result {
 	if x != nil {
-		return x.Error
+		return x.Results
 	}
 	return nil
 }
 
-// SyncStreamsRequest is a request to start a streams sync session.
-type SyncStreamsRequest struct {
+type GetMiniblocksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sync_pos is the list of streams and positions in those streams to receive updates from.
-	SyncPos []*SyncCookie `protobuf:"bytes,1,rep,name=sync_pos,json=syncPos,proto3" json:"sync_pos,omitempty"`
+	StreamId      []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	FromInclusive int64  `protobuf:"varint,2,opt,name=fromInclusive,proto3" json:"fromInclusive,omitempty"`
+	ToExclusive   int64  `protobuf:"varint,3,opt,name=toExclusive,proto3" json:"toExclusive,omitempty"`
 }
 
-func (x *SyncStreamsRequest) Reset() {
-	*x = SyncStreamsRequest{}
+func (x *GetMiniblocksRequest) Reset() {
+	*x = GetMiniblocksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3390,13 +3632,13 @@ func (x *SyncStreamsRequest) Reset() {
 	}
 }
 
-func (x *SyncStreamsRequest) String() string {
+func (x *GetMiniblocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncStreamsRequest) ProtoMessage() {}
+func (*GetMiniblocksRequest) ProtoMessage() {}
 
-func (x *SyncStreamsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMiniblocksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3408,42 +3650,44 @@ func (x *SyncStreamsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncStreamsRequest.ProtoReflect.Descriptor instead.
-func (*SyncStreamsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMiniblocksRequest.ProtoReflect.Descriptor instead.
+func (*GetMiniblocksRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SyncStreamsRequest) GetSyncPos() []*SyncCookie {
+func (x *GetMiniblocksRequest) GetStreamId() []byte {
 	if x != nil {
-		return x.SyncPos
+		return x.StreamId
 	}
 	return nil
 }
 
-// SyncStreamsResponse is a stream of updates that the client receives for streams it subscribed to within a streams
-// sync session.
-type SyncStreamsResponse struct {
+func (x *GetMiniblocksRequest) GetFromInclusive() int64 {
+	if x != nil {
+		return x.FromInclusive
+	}
+	return 0
+}
+
+func (x *GetMiniblocksRequest) GetToExclusive() int64 {
+	if x != nil {
+		return x.ToExclusive
+	}
+	return 0
+}
+
+type GetMiniblocksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sync_id is the id of the sync session.
-	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
-	// sync_op marks the type of update.
-	SyncOp SyncOp `protobuf:"varint,2,opt,name=sync_op,json=syncOp,proto3,enum=river.SyncOp" json:"sync_op,omitempty"`
-	// stream indicates an update of a stream.
-	// only set when sync_op = SYNC_UPDATE
-	Stream *StreamAndCookie `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
-	// pong_nonce is returned after a ping request was made to the sync session through PingSync.
-	// Set with the ping value from the PingSync request when sync_op = SYNC_PONG
-	PongNonce string `protobuf:"bytes,4,opt,name=pong_nonce,json=pongNonce,proto3" json:"pong_nonce,omitempty"`
-	// stream_id is set when sync_op = SYNC_DOWN and indicates it will not receive updates anymore for this stream.
-	// If the client is still is interested in updates for this stream it must re-add the stream to the sync session.
-	StreamId []byte `protobuf:"bytes,5,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Miniblocks []*Miniblock `protobuf:"bytes,1,rep,name=miniblocks,proto3" json:"miniblocks,omitempty"`
+	// terminus: true if there are no more blocks to fetch because they've been garbage collected, or you've reached block 0
+	Terminus bool `protobuf:"varint,2,opt,name=terminus,proto3" json:"terminus,omitempty"`
 }
 
-func (x *SyncStreamsResponse) Reset() {
-	*x = SyncStreamsResponse{}
+func (x *GetMiniblocksResponse) Reset() {
+	*x = GetMiniblocksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3451,13 +3695,13 @@ func (x *SyncStreamsResponse) Reset() {
 	}
 }
 
-func (x *SyncStreamsResponse) String() string {
+func (x *GetMiniblocksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncStreamsResponse) ProtoMessage() {}
+func (*GetMiniblocksResponse) ProtoMessage() {}
 
-func (x *SyncStreamsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMiniblocksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3469,60 +3713,36 @@ func (x *SyncStreamsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncStreamsResponse.ProtoReflect.Descriptor instead.
-func (*SyncStreamsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMiniblocksResponse.ProtoReflect.Descriptor instead.
+func (*GetMiniblocksResponse) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SyncStreamsResponse) GetSyncId() string {
-	if x != nil {
-		return x.SyncId
-	}
-	return ""
-}
-
-func (x *SyncStreamsResponse) GetSyncOp() SyncOp {
-	if x != nil {
-		return x.SyncOp
-	}
-	return SyncOp_SYNC_UNSPECIFIED
-}
-
-func (x *SyncStreamsResponse) GetStream() *StreamAndCookie {
+func (x *GetMiniblocksResponse) GetMiniblocks() []*Miniblock {
 	if x != nil {
-		return x.Stream
+		return x.Miniblocks
 	}
 	return nil
 }
 
-func (x *SyncStreamsResponse) GetPongNonce() string {
-	if x != nil {
-		return x.PongNonce
-	}
-	return ""
-}
-
-func (x *SyncStreamsResponse) GetStreamId() []byte {
+func (x *GetMiniblocksResponse) GetTerminus() bool {
 	if x != nil {
-		return x.StreamId
+		return x.Terminus
 	}
-	return nil
+	return false
 }
 
-// AddStreamToSyncRequest is a request to add a stream to an existing streams sync session.
-type AddStreamToSyncRequest struct {
+type GetEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sync_id is the id of the sync session.
-	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
-	// sync_pos identifies the stream and position in the stream to receive updates from.
-	SyncPos *SyncCookie `protobuf:"bytes,2,opt,name=sync_pos,json=syncPos,proto3" json:"sync_pos,omitempty"`
+	StreamId  []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	EventHash []byte `protobuf:"bytes,2,opt,name=event_hash,json=eventHash,proto3" json:"event_hash,omitempty"`
 }
 
-func (x *AddStreamToSyncRequest) Reset() {
-	*x = AddStreamToSyncRequest{}
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3530,13 +3750,13 @@ func (x *AddStreamToSyncRequest) Reset() {
 	}
 }
 
-func (x *AddStreamToSyncRequest) String() string {
+func (x *GetEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddStreamToSyncRequest) ProtoMessage() {}
+func (*GetEventRequest) ProtoMessage() {}
 
-func (x *AddStreamToSyncRequest) ProtoReflect() protoreflect.Message {
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3548,33 +3768,39 @@ func (x *AddStreamToSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddStreamToSyncRequest.ProtoReflect.Descriptor instead.
-func (*AddStreamToSyncRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *AddStreamToSyncRequest) GetSyncId() string {
+func (x *GetEventRequest) GetStreamId() []byte {
 	if x != nil {
-		return x.SyncId
+		return x.StreamId
 	}
-	return ""
+	return nil
 }
 
-func (x *AddStreamToSyncRequest) GetSyncPos() *SyncCookie {
+func (x *GetEventRequest) GetEventHash() []byte {
 	if x != nil {
-		return x.SyncPos
+		return x.EventHash
 	}
 	return nil
 }
 
-type AddStreamToSyncResponse struct {
+type GetEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Event *Envelope `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// number of the miniblock the event is included in
+	MiniblockNum int64 `protobuf:"varint,2,opt,name=miniblock_num,json=miniblockNum,proto3" json:"miniblock_num,omitempty"`
+	// number of the event in the stream, see MiniblockHeader.event_num_offset
+	EventNum int64 `protobuf:"varint,3,opt,name=event_num,json=eventNum,proto3" json:"event_num,omitempty"`
 }
 
-func (x *AddStreamToSyncResponse) Reset() {
-	*x = AddStreamToSyncResponse{}
+func (x *GetEventResponse) Reset() {
+	*x = GetEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3582,13 +3808,13 @@ func (x *AddStreamToSyncResponse) Reset() {
 	}
 }
 
-func (x *AddStreamToSyncResponse) String() string {
+func (x *GetEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddStreamToSyncResponse) ProtoMessage() {}
+func (*GetEventResponse) ProtoMessage() {}
 
-func (x *AddStreamToSyncResponse) ProtoReflect() protoreflect.Message {
+func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3600,25 +3826,57 @@ func (x *AddStreamToSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddStreamToSyncResponse.ProtoReflect.Descriptor instead.
-func (*AddStreamToSyncResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
+func (*GetEventResponse) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{37}
 }
 
-// RemoveStreamFromSyncRequest stops the client to receive updates from this stream in the sync session.
-// Note that due to buffering in the stream it is possible still receives several updates for this stream after it was
-// removed.
-type RemoveStreamFromSyncRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetEventResponse) GetEvent() *Envelope {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
 
-	SyncId   string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
-	StreamId []byte `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+func (x *GetEventResponse) GetMiniblockNum() int64 {
+	if x != nil {
+		return x.MiniblockNum
+	}
+	return 0
 }
 
-func (x *RemoveStreamFromSyncRequest) Reset() {
-	*x = RemoveStreamFromSyncRequest{}
+func (x *GetEventResponse) GetEventNum() int64 {
+	if x != nil {
+		return x.EventNum
+	}
+	return 0
+}
+
+type GetStreamHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// Events older than the cursor are returned.
+	// If cursor is not set, paging starts from the last miniblock of the stream.
+	//
+	// Types that are assignable to Cursor:
+	//
+	//	*GetStreamHistoryRequest_EventNum
+	//	*GetStreamHistoryRequest_MiniblockNum
+	//	*GetStreamHistoryRequest_CreatedBeforeEpochMs
+	Cursor isGetStreamHistoryRequest_Cursor `protobuf_oneof:"cursor"`
+	// maximum number of events to return, server default is used if not set
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// If not empty, only events of the given payload types are returned.
+	// Type is a payload field name of StreamEvent, e.g. "channel_payload",
+	// optionally followed by a dot and a content field name, e.g. "channel_payload.message".
+	PayloadTypes []string `protobuf:"bytes,6,rep,name=payload_types,json=payloadTypes,proto3" json:"payload_types,omitempty"`
+}
+
+func (x *GetStreamHistoryRequest) Reset() {
+	*x = GetStreamHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3626,13 +3884,13 @@ func (x *RemoveStreamFromSyncRequest) Reset() {
 	}
 }
 
-func (x *RemoveStreamFromSyncRequest) String() string {
+func (x *GetStreamHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveStreamFromSyncRequest) ProtoMessage() {}
+func (*GetStreamHistoryRequest) ProtoMessage() {}
 
-func (x *RemoveStreamFromSyncRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStreamHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3644,33 +3902,97 @@ func (x *RemoveStreamFromSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveStreamFromSyncRequest.ProtoReflect.Descriptor instead.
-func (*RemoveStreamFromSyncRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RemoveStreamFromSyncRequest) GetSyncId() string {
+func (x *GetStreamHistoryRequest) GetStreamId() []byte {
 	if x != nil {
-		return x.SyncId
+		return x.StreamId
 	}
-	return ""
+	return nil
 }
 
-func (x *RemoveStreamFromSyncRequest) GetStreamId() []byte {
+func (m *GetStreamHistoryRequest) GetCursor() isGetStreamHistoryRequest_Cursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (x *GetStreamHistoryRequest) GetEventNum() int64 {
+	if x, ok := x.GetCursor().(*GetStreamHistoryRequest_EventNum); ok {
+		return x.EventNum
+	}
+	return 0
+}
+
+func (x *GetStreamHistoryRequest) GetMiniblockNum() int64 {
+	if x, ok := x.GetCursor().(*GetStreamHistoryRequest_MiniblockNum); ok {
+		return x.MiniblockNum
+	}
+	return 0
+}
+
+func (x *GetStreamHistoryRequest) GetCreatedBeforeEpochMs() int64 {
+	if x, ok := x.GetCursor().(*GetStreamHistoryRequest_CreatedBeforeEpochMs); ok {
+		return x.CreatedBeforeEpochMs
+	}
+	return 0
+}
+
+func (x *GetStreamHistoryRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.StreamId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetStreamHistoryRequest) GetPayloadTypes() []string {
+	if x != nil {
+		return x.PayloadTypes
 	}
 	return nil
 }
 
-type RemoveStreamFromSyncResponse struct {
+type isGetStreamHistoryRequest_Cursor interface {
+	isGetStreamHistoryRequest_Cursor()
+}
+
+type GetStreamHistoryRequest_EventNum struct {
+	// return events with numbers lower than event_num
+	EventNum int64 `protobuf:"varint,2,opt,name=event_num,json=eventNum,proto3,oneof"`
+}
+
+type GetStreamHistoryRequest_MiniblockNum struct {
+	// return events from miniblocks with numbers lower than miniblock_num
+	MiniblockNum int64 `protobuf:"varint,3,opt,name=miniblock_num,json=miniblockNum,proto3,oneof"`
+}
+
+type GetStreamHistoryRequest_CreatedBeforeEpochMs struct {
+	// return events created before the given time
+	CreatedBeforeEpochMs int64 `protobuf:"varint,4,opt,name=created_before_epoch_ms,json=createdBeforeEpochMs,proto3,oneof"`
+}
+
+func (*GetStreamHistoryRequest_EventNum) isGetStreamHistoryRequest_Cursor() {}
+
+func (*GetStreamHistoryRequest_MiniblockNum) isGetStreamHistoryRequest_Cursor() {}
+
+func (*GetStreamHistoryRequest_CreatedBeforeEpochMs) isGetStreamHistoryRequest_Cursor() {}
+
+type StreamHistoryEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Event        *Envelope `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	MiniblockNum int64     `protobuf:"varint,2,opt,name=miniblock_num,json=miniblockNum,proto3" json:"miniblock_num,omitempty"`
+	EventNum     int64     `protobuf:"varint,3,opt,name=event_num,json=eventNum,proto3" json:"event_num,omitempty"`
 }
 
-func (x *RemoveStreamFromSyncResponse) Reset() {
-	*x = RemoveStreamFromSyncResponse{}
+func (x *StreamHistoryEvent) Reset() {
+	*x = StreamHistoryEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3678,13 +4000,13 @@ func (x *RemoveStreamFromSyncResponse) Reset() {
 	}
 }
 
-func (x *RemoveStreamFromSyncResponse) String() string {
+func (x *StreamHistoryEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveStreamFromSyncResponse) ProtoMessage() {}
+func (*StreamHistoryEvent) ProtoMessage() {}
 
-func (x *RemoveStreamFromSyncResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamHistoryEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3696,23 +4018,48 @@ func (x *RemoveStreamFromSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveStreamFromSyncResponse.ProtoReflect.Descriptor instead.
-func (*RemoveStreamFromSyncResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamHistoryEvent.ProtoReflect.Descriptor instead.
+func (*StreamHistoryEvent) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{39}
 }
 
-// CancelSyncRequest cancels the sync session.
-type CancelSyncRequest struct {
+func (x *StreamHistoryEvent) GetEvent() *Envelope {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *StreamHistoryEvent) GetMiniblockNum() int64 {
+	if x != nil {
+		return x.MiniblockNum
+	}
+	return 0
+}
+
+func (x *StreamHistoryEvent) GetEventNum() int64 {
+	if x != nil {
+		return x.EventNum
+	}
+	return 0
+}
+
+type GetStreamHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sync_id is the unique id of the sync session.
-	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// events in the stream order, i.e. the oldest first
+	Events []*StreamHistoryEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// pass as event_num cursor to get the next page
+	NextEventNum int64 `protobuf:"varint,2,opt,name=next_event_num,json=nextEventNum,proto3" json:"next_event_num,omitempty"`
+	// true if there are no older events on this node: the beginning of the stream is reached
+	// or older miniblocks are pruned and should be read from an archive node
+	Terminus bool `protobuf:"varint,3,opt,name=terminus,proto3" json:"terminus,omitempty"`
 }
 
-func (x *CancelSyncRequest) Reset() {
-	*x = CancelSyncRequest{}
+func (x *GetStreamHistoryResponse) Reset() {
+	*x = GetStreamHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3720,13 +4067,13 @@ func (x *CancelSyncRequest) Reset() {
 	}
 }
 
-func (x *CancelSyncRequest) String() string {
+func (x *GetStreamHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelSyncRequest) ProtoMessage() {}
+func (*GetStreamHistoryResponse) ProtoMessage() {}
 
-func (x *CancelSyncRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStreamHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3738,26 +4085,47 @@ func (x *CancelSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelSyncRequest.ProtoReflect.Descriptor instead.
-func (*CancelSyncRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CancelSyncRequest) GetSyncId() string {
+func (x *GetStreamHistoryResponse) GetEvents() []*StreamHistoryEvent {
 	if x != nil {
-		return x.SyncId
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-type CancelSyncResponse struct {
+func (x *GetStreamHistoryResponse) GetNextEventNum() int64 {
+	if x != nil {
+		return x.NextEventNum
+	}
+	return 0
+}
+
+func (x *GetStreamHistoryResponse) GetTerminus() bool {
+	if x != nil {
+		return x.Terminus
+	}
+	return false
+}
+
+type GetThreadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// hash of the thread root event
+	ThreadId []byte `protobuf:"bytes,2,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	// return replies from miniblocks with numbers greater or equal to from_miniblock_num,
+	// pass next_miniblock_num of the previous response to get the next page
+	FromMiniblockNum int64 `protobuf:"varint,3,opt,name=from_miniblock_num,json=fromMiniblockNum,proto3" json:"from_miniblock_num,omitempty"`
 }
 
-func (x *CancelSyncResponse) Reset() {
-	*x = CancelSyncResponse{}
+func (x *GetThreadRequest) Reset() {
+	*x = GetThreadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3765,13 +4133,13 @@ func (x *CancelSyncResponse) Reset() {
 	}
 }
 
-func (x *CancelSyncResponse) String() string {
+func (x *GetThreadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelSyncResponse) ProtoMessage() {}
+func (*GetThreadRequest) ProtoMessage() {}
 
-func (x *CancelSyncResponse) ProtoReflect() protoreflect.Message {
+func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3783,25 +4151,47 @@ func (x *CancelSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelSyncResponse.ProtoReflect.Descriptor instead.
-func (*CancelSyncResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{41}
 }
 
-// PingSyncRequest is a request to receive a pong in the sync session stream.
-type PingSyncRequest struct {
+func (x *GetThreadRequest) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *GetThreadRequest) GetThreadId() []byte {
+	if x != nil {
+		return x.ThreadId
+	}
+	return nil
+}
+
+func (x *GetThreadRequest) GetFromMiniblockNum() int64 {
+	if x != nil {
+		return x.FromMiniblockNum
+	}
+	return 0
+}
+
+type GetThreadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sync_id is the unique id of the sync session.
-	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
-	// nonce is the pong to return in the sync session stream.
-	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// replies in the stream order, replies from the minipool have miniblock_num set to -1
+	Replies []*StreamHistoryEvent `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
+	// pass as from_miniblock_num to get the next page
+	NextMiniblockNum int64 `protobuf:"varint,2,opt,name=next_miniblock_num,json=nextMiniblockNum,proto3" json:"next_miniblock_num,omitempty"`
+	// true if all replies including the minipool are returned
+	Terminus bool `protobuf:"varint,3,opt,name=terminus,proto3" json:"terminus,omitempty"`
 }
 
-func (x *PingSyncRequest) Reset() {
-	*x = PingSyncRequest{}
+func (x *GetThreadResponse) Reset() {
+	*x = GetThreadResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3809,13 +4199,13 @@ func (x *PingSyncRequest) Reset() {
 	}
 }
 
-func (x *PingSyncRequest) String() string {
+func (x *GetThreadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingSyncRequest) ProtoMessage() {}
+func (*GetThreadResponse) ProtoMessage() {}
 
-func (x *PingSyncRequest) ProtoReflect() protoreflect.Message {
+func (x *GetThreadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3827,33 +4217,42 @@ func (x *PingSyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingSyncRequest.ProtoReflect.Descriptor instead.
-func (*PingSyncRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadResponse) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *PingSyncRequest) GetSyncId() string {
+func (x *GetThreadResponse) GetReplies() []*StreamHistoryEvent {
 	if x != nil {
-		return x.SyncId
+		return x.Replies
 	}
-	return ""
+	return nil
 }
 
-func (x *PingSyncRequest) GetNonce() string {
+func (x *GetThreadResponse) GetNextMiniblockNum() int64 {
 	if x != nil {
-		return x.Nonce
+		return x.NextMiniblockNum
 	}
-	return ""
+	return 0
 }
 
-type PingSyncResponse struct {
+func (x *GetThreadResponse) GetTerminus() bool {
+	if x != nil {
+		return x.Terminus
+	}
+	return false
+}
+
+type GetLastMiniblockHashRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (x *PingSyncResponse) Reset() {
-	*x = PingSyncResponse{}
+func (x *GetLastMiniblockHashRequest) Reset() {
+	*x = GetLastMiniblockHashRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3861,13 +4260,13 @@ func (x *PingSyncResponse) Reset() {
 	}
 }
 
-func (x *PingSyncResponse) String() string {
+func (x *GetLastMiniblockHashRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingSyncResponse) ProtoMessage() {}
+func (*GetLastMiniblockHashRequest) ProtoMessage() {}
 
-func (x *PingSyncResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLastMiniblockHashRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3879,21 +4278,29 @@ func (x *PingSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingSyncResponse.ProtoReflect.Descriptor instead.
-func (*PingSyncResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLastMiniblockHashRequest.ProtoReflect.Descriptor instead.
+func (*GetLastMiniblockHashRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{43}
 }
 
-type InfoRequest struct {
+func (x *GetLastMiniblockHashRequest) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+type GetLastMiniblockHashResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Debug []string `protobuf:"bytes,1,rep,name=debug,proto3" json:"debug,omitempty"`
+	Hash         []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	MiniblockNum int64  `protobuf:"varint,2,opt,name=miniblock_num,json=miniblockNum,proto3" json:"miniblock_num,omitempty"`
 }
 
-func (x *InfoRequest) Reset() {
-	*x = InfoRequest{}
+func (x *GetLastMiniblockHashResponse) Reset() {
+	*x = GetLastMiniblockHashResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3901,13 +4308,13 @@ func (x *InfoRequest) Reset() {
 	}
 }
 
-func (x *InfoRequest) String() string {
+func (x *GetLastMiniblockHashResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InfoRequest) ProtoMessage() {}
+func (*GetLastMiniblockHashResponse) ProtoMessage() {}
 
-func (x *InfoRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLastMiniblockHashResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3919,30 +4326,37 @@ func (x *InfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InfoRequest.ProtoReflect.Descriptor instead.
-func (*InfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLastMiniblockHashResponse.ProtoReflect.Descriptor instead.
+func (*GetLastMiniblockHashResponse) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *InfoRequest) GetDebug() []string {
+func (x *GetLastMiniblockHashResponse) GetHash() []byte {
 	if x != nil {
-		return x.Debug
+		return x.Hash
 	}
 	return nil
 }
 
-type InfoResponse struct {
+func (x *GetLastMiniblockHashResponse) GetMiniblockNum() int64 {
+	if x != nil {
+		return x.MiniblockNum
+	}
+	return 0
+}
+
+type AddEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Graffiti  string                 `protobuf:"bytes,1,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	Version   string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	StreamId []byte    `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Event    *Envelope `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Optional bool      `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"` // if true, response will contain non nil error if event didn't pass validation
 }
 
-func (x *InfoResponse) Reset() {
-	*x = InfoResponse{}
+func (x *AddEventRequest) Reset() {
+	*x = AddEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3950,13 +4364,13 @@ func (x *InfoResponse) Reset() {
 	}
 }
 
-func (x *InfoResponse) String() string {
+func (x *AddEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InfoResponse) ProtoMessage() {}
+func (*AddEventRequest) ProtoMessage() {}
 
-func (x *InfoResponse) ProtoReflect() protoreflect.Message {
+func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3968,43 +4382,42 @@ func (x *InfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InfoResponse.ProtoReflect.Descriptor instead.
-func (*InfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
+func (*AddEventRequest) Descriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *InfoResponse) GetGraffiti() string {
+func (x *AddEventRequest) GetStreamId() []byte {
 	if x != nil {
-		return x.Graffiti
+		return x.StreamId
 	}
-	return ""
+	return nil
 }
 
-func (x *InfoResponse) GetStartTime() *timestamppb.Timestamp {
+func (x *AddEventRequest) GetEvent() *Envelope {
 	if x != nil {
-		return x.StartTime
+		return x.Event
 	}
 	return nil
 }
 
-func (x *InfoResponse) GetVersion() string {
+func (x *AddEventRequest) GetOptional() bool {
 	if x != nil {
-		return x.Version
+		return x.Optional
 	}
-	return ""
+	return false
 }
 
-type MemberPayload_Snapshot struct {
+type AddEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Joined []*MemberPayload_Snapshot_Member `protobuf:"bytes,1,rep,name=joined,proto3" json:"joined,omitempty"`
-	Pins   []*MemberPayload_SnappedPin      `protobuf:"bytes,2,rep,name=pins,proto3" json:"pins,omitempty"`
+	Error *AddEventResponse_Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // only set if AddEventRequest.optional is true
 }
 
-func (x *MemberPayload_Snapshot) Reset() {
-	*x = MemberPayload_Snapshot{}
+func (x *AddEventResponse) Reset() {
+	*x = AddEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4012,13 +4425,13 @@ func (x *MemberPayload_Snapshot) Reset() {
 	}
 }
 
-func (x *MemberPayload_Snapshot) String() string {
+func (x *AddEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPayload_Snapshot) ProtoMessage() {}
+func (*AddEventResponse) ProtoMessage() {}
 
-func (x *MemberPayload_Snapshot) ProtoReflect() protoreflect.Message {
+func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4030,38 +4443,33 @@ func (x *MemberPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*MemberPayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4, 0}
-}
-
-func (x *MemberPayload_Snapshot) GetJoined() []*MemberPayload_Snapshot_Member {
-	if x != nil {
-		return x.Joined
-	}
-	return nil
+// Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
+func (*AddEventResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *MemberPayload_Snapshot) GetPins() []*MemberPayload_SnappedPin {
+func (x *AddEventResponse) GetError() *AddEventResponse_Error {
 	if x != nil {
-		return x.Pins
+		return x.Error
 	}
 	return nil
 }
 
-type MemberPayload_Membership struct {
+// ValidateEventRequest evaluates stream rules for the event as AddEvent does, but the event is not added.
+// If create_stream_events are set, stream creation is evaluated as CreateStream does instead, but the stream is not created.
+type ValidateEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Op               MembershipOp `protobuf:"varint,1,opt,name=op,proto3,enum=river.MembershipOp" json:"op,omitempty"`
-	UserAddress      []byte       `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
-	InitiatorAddress []byte       `protobuf:"bytes,3,opt,name=initiator_address,json=initiatorAddress,proto3" json:"initiator_address,omitempty"`
-	StreamParentId   []byte       `protobuf:"bytes,4,opt,name=stream_parent_id,json=streamParentId,proto3,oneof" json:"stream_parent_id,omitempty"`
+	StreamId             []byte            `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Event                *Envelope         `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	CreateStreamEvents   []*Envelope       `protobuf:"bytes,3,rep,name=create_stream_events,json=createStreamEvents,proto3" json:"create_stream_events,omitempty"`
+	CreateStreamMetadata map[string][]byte `protobuf:"bytes,4,rep,name=create_stream_metadata,json=createStreamMetadata,proto3" json:"create_stream_metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *MemberPayload_Membership) Reset() {
-	*x = MemberPayload_Membership{}
+func (x *ValidateEventRequest) Reset() {
+	*x = ValidateEventRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4069,13 +4477,13 @@ func (x *MemberPayload_Membership) Reset() {
 	}
 }
 
-func (x *MemberPayload_Membership) String() string {
+func (x *ValidateEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPayload_Membership) ProtoMessage() {}
+func (*ValidateEventRequest) ProtoMessage() {}
 
-func (x *MemberPayload_Membership) ProtoReflect() protoreflect.Message {
+func (x *ValidateEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4087,52 +4495,68 @@ func (x *MemberPayload_Membership) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPayload_Membership.ProtoReflect.Descriptor instead.
-func (*MemberPayload_Membership) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use ValidateEventRequest.ProtoReflect.Descriptor instead.
+func (*ValidateEventRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *MemberPayload_Membership) GetOp() MembershipOp {
+func (x *ValidateEventRequest) GetStreamId() []byte {
 	if x != nil {
-		return x.Op
+		return x.StreamId
 	}
-	return MembershipOp_SO_UNSPECIFIED
+	return nil
 }
 
-func (x *MemberPayload_Membership) GetUserAddress() []byte {
+func (x *ValidateEventRequest) GetEvent() *Envelope {
 	if x != nil {
-		return x.UserAddress
+		return x.Event
 	}
 	return nil
 }
 
-func (x *MemberPayload_Membership) GetInitiatorAddress() []byte {
+func (x *ValidateEventRequest) GetCreateStreamEvents() []*Envelope {
 	if x != nil {
-		return x.InitiatorAddress
+		return x.CreateStreamEvents
 	}
 	return nil
 }
 
-func (x *MemberPayload_Membership) GetStreamParentId() []byte {
+func (x *ValidateEventRequest) GetCreateStreamMetadata() map[string][]byte {
 	if x != nil {
-		return x.StreamParentId
+		return x.CreateStreamMetadata
 	}
 	return nil
 }
 
-type MemberPayload_KeySolicitation struct {
+type ValidateEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeviceKey   string   `protobuf:"bytes,1,opt,name=device_key,json=deviceKey,proto3" json:"device_key,omitempty"`          // requesters device_key
-	FallbackKey string   `protobuf:"bytes,2,opt,name=fallback_key,json=fallbackKey,proto3" json:"fallback_key,omitempty"`    // requesters fallback_key
-	IsNewDevice bool     `protobuf:"varint,3,opt,name=is_new_device,json=isNewDevice,proto3" json:"is_new_device,omitempty"` // true if this is a new device, session_ids will be empty
-	SessionIds  []string `protobuf:"bytes,4,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
-}
-
-func (x *MemberPayload_KeySolicitation) Reset() {
-	*x = MemberPayload_KeySolicitation{}
+	// true if the event would be added by AddEvent, false if it is rejected or would be a no-op.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// result of the stream rules, false without error if adding the event would be a no-op.
+	CanAddEvent bool `protobuf:"varint,2,opt,name=can_add_event,json=canAddEvent,proto3" json:"can_add_event,omitempty"`
+	// true if no chain auth is required or at least one of chain_auths is entitled.
+	Entitled bool `protobuf:"varint,3,opt,name=entitled,proto3" json:"entitled,omitempty"`
+	// set if the event is rejected.
+	Error *AddEventResponse_Error `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// checks in the evaluation order.
+	Checks []*ValidateEventResponse_Check `protobuf:"bytes,5,rep,name=checks,proto3" json:"checks,omitempty"`
+	// on-chain requirements, at least one of them must be satisfied.
+	ChainAuths []*ValidateEventResponse_ChainAuth `protobuf:"bytes,6,rep,name=chain_auths,json=chainAuths,proto3" json:"chain_auths,omitempty"`
+	// event that AddEvent would add to another stream before the event.
+	RequiredParentEvent *ValidateEventResponse_DerivedEvent `protobuf:"bytes,7,opt,name=required_parent_event,json=requiredParentEvent,proto3" json:"required_parent_event,omitempty"`
+	// event that AddEvent would add to another stream if entitlement checks fail.
+	OnChainAuthFailureEvent *ValidateEventResponse_DerivedEvent `protobuf:"bytes,8,opt,name=on_chain_auth_failure_event,json=onChainAuthFailureEvent,proto3" json:"on_chain_auth_failure_event,omitempty"`
+	// result of the stream creation rules if create_stream_events are set.
+	CanCreateStream bool `protobuf:"varint,9,opt,name=can_create_stream,json=canCreateStream,proto3" json:"can_create_stream,omitempty"`
+	// events that CreateStream would add to other streams after the stream is created.
+	DerivedEvents []*ValidateEventResponse_DerivedEvent `protobuf:"bytes,10,rep,name=derived_events,json=derivedEvents,proto3" json:"derived_events,omitempty"`
+}
+
+func (x *ValidateEventResponse) Reset() {
+	*x = ValidateEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4140,13 +4564,13 @@ func (x *MemberPayload_KeySolicitation) Reset() {
 	}
 }
 
-func (x *MemberPayload_KeySolicitation) String() string {
+func (x *ValidateEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPayload_KeySolicitation) ProtoMessage() {}
+func (*ValidateEventResponse) ProtoMessage() {}
 
-func (x *MemberPayload_KeySolicitation) ProtoReflect() protoreflect.Message {
+func (x *ValidateEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4158,51 +4582,94 @@ func (x *MemberPayload_KeySolicitation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPayload_KeySolicitation.ProtoReflect.Descriptor instead.
-func (*MemberPayload_KeySolicitation) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4, 2}
+// Deprecated: Use ValidateEventResponse.ProtoReflect.Descriptor instead.
+func (*ValidateEventResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *MemberPayload_KeySolicitation) GetDeviceKey() string {
+func (x *ValidateEventResponse) GetValid() bool {
 	if x != nil {
-		return x.DeviceKey
+		return x.Valid
 	}
-	return ""
+	return false
 }
 
-func (x *MemberPayload_KeySolicitation) GetFallbackKey() string {
+func (x *ValidateEventResponse) GetCanAddEvent() bool {
 	if x != nil {
-		return x.FallbackKey
+		return x.CanAddEvent
 	}
-	return ""
+	return false
 }
 
-func (x *MemberPayload_KeySolicitation) GetIsNewDevice() bool {
+func (x *ValidateEventResponse) GetEntitled() bool {
 	if x != nil {
-		return x.IsNewDevice
+		return x.Entitled
 	}
 	return false
 }
 
-func (x *MemberPayload_KeySolicitation) GetSessionIds() []string {
+func (x *ValidateEventResponse) GetError() *AddEventResponse_Error {
 	if x != nil {
-		return x.SessionIds
+		return x.Error
 	}
 	return nil
 }
 
-type MemberPayload_KeyFulfillment struct {
+func (x *ValidateEventResponse) GetChecks() []*ValidateEventResponse_Check {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *ValidateEventResponse) GetChainAuths() []*ValidateEventResponse_ChainAuth {
+	if x != nil {
+		return x.ChainAuths
+	}
+	return nil
+}
+
+func (x *ValidateEventResponse) GetRequiredParentEvent() *ValidateEventResponse_DerivedEvent {
+	if x != nil {
+		return x.RequiredParentEvent
+	}
+	return nil
+}
+
+func (x *ValidateEventResponse) GetOnChainAuthFailureEvent() *ValidateEventResponse_DerivedEvent {
+	if x != nil {
+		return x.OnChainAuthFailureEvent
+	}
+	return nil
+}
+
+func (x *ValidateEventResponse) GetCanCreateStream() bool {
+	if x != nil {
+		return x.CanCreateStream
+	}
+	return false
+}
+
+func (x *ValidateEventResponse) GetDerivedEvents() []*ValidateEventResponse_DerivedEvent {
+	if x != nil {
+		return x.DerivedEvents
+	}
+	return nil
+}
+
+// AddEventsRequest adds multiple events to one or more streams.
+// Events are validated and added in the request order, so events of the same stream
+// should be ordered in the same way as they would be added by separate AddEvent calls.
+type AddEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserAddress []byte   `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
-	DeviceKey   string   `protobuf:"bytes,2,opt,name=device_key,json=deviceKey,proto3" json:"device_key,omitempty"`
-	SessionIds  []string `protobuf:"bytes,3,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
+	Events []*AddEventsRequest_Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *MemberPayload_KeyFulfillment) Reset() {
-	*x = MemberPayload_KeyFulfillment{}
+func (x *AddEventsRequest) Reset() {
+	*x = AddEventsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4210,13 +4677,13 @@ func (x *MemberPayload_KeyFulfillment) Reset() {
 	}
 }
 
-func (x *MemberPayload_KeyFulfillment) String() string {
+func (x *AddEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPayload_KeyFulfillment) ProtoMessage() {}
+func (*AddEventsRequest) ProtoMessage() {}
 
-func (x *MemberPayload_KeyFulfillment) ProtoReflect() protoreflect.Message {
+func (x *AddEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4228,44 +4695,30 @@ func (x *MemberPayload_KeyFulfillment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPayload_KeyFulfillment.ProtoReflect.Descriptor instead.
-func (*MemberPayload_KeyFulfillment) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4, 3}
-}
-
-func (x *MemberPayload_KeyFulfillment) GetUserAddress() []byte {
-	if x != nil {
-		return x.UserAddress
-	}
-	return nil
-}
-
-func (x *MemberPayload_KeyFulfillment) GetDeviceKey() string {
-	if x != nil {
-		return x.DeviceKey
-	}
-	return ""
+// Deprecated: Use AddEventsRequest.ProtoReflect.Descriptor instead.
+func (*AddEventsRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *MemberPayload_KeyFulfillment) GetSessionIds() []string {
+func (x *AddEventsRequest) GetEvents() []*AddEventsRequest_Event {
 	if x != nil {
-		return x.SessionIds
+		return x.Events
 	}
 	return nil
 }
 
-type MemberPayload_Nft struct {
+type AddEventsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId         int32  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	ContractAddress []byte `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	TokenId         []byte `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// results contains a result for each event in the request order.
+	// Error is set if event was not added.
+	Results []*AddEventResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *MemberPayload_Nft) Reset() {
-	*x = MemberPayload_Nft{}
+func (x *AddEventsResponse) Reset() {
+	*x = AddEventsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4273,13 +4726,13 @@ func (x *MemberPayload_Nft) Reset() {
 	}
 }
 
-func (x *MemberPayload_Nft) String() string {
+func (x *AddEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPayload_Nft) ProtoMessage() {}
+func (*AddEventsResponse) ProtoMessage() {}
 
-func (x *MemberPayload_Nft) ProtoReflect() protoreflect.Message {
+func (x *AddEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4291,43 +4744,39 @@ func (x *MemberPayload_Nft) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPayload_Nft.ProtoReflect.Descriptor instead.
-func (*MemberPayload_Nft) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4, 4}
-}
-
-func (x *MemberPayload_Nft) GetChainId() int32 {
-	if x != nil {
-		return x.ChainId
-	}
-	return 0
-}
-
-func (x *MemberPayload_Nft) GetContractAddress() []byte {
-	if x != nil {
-		return x.ContractAddress
-	}
-	return nil
+// Deprecated: Use AddEventsResponse.ProtoReflect.Descriptor instead.
+func (*AddEventsResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *MemberPayload_Nft) GetTokenId() []byte {
+func (x *AddEventsResponse) GetResults() []*AddEventResponse {
 	if x != nil {
-		return x.TokenId
+		return x.Results
 	}
 	return nil
 }
 
-type MemberPayload_SnappedPin struct {
+// SyncFilter limits the events that are sent to the client in a streams sync session.
+// Each payload type is a payload field name of StreamEvent, e.g. "channel_payload",
+// optionally followed by a dot and a content field name of the payload, e.g. "channel_payload.message".
+// Miniblocks sent on sync reset and miniblock header events are not filtered,
+// the client needs the headers to follow the miniblocks of the stream.
+type SyncFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreatorAddress []byte             `protobuf:"bytes,1,opt,name=creator_address,json=creatorAddress,proto3" json:"creator_address,omitempty"`
-	Pin            *MemberPayload_Pin `protobuf:"bytes,2,opt,name=pin,proto3" json:"pin,omitempty"`
+	// include_payload_types is the list of payload types to send, all payload types are sent if empty.
+	IncludePayloadTypes []string `protobuf:"bytes,1,rep,name=include_payload_types,json=includePayloadTypes,proto3" json:"include_payload_types,omitempty"`
+	// exclude_payload_types is the list of payload types that are not sent.
+	ExcludePayloadTypes []string `protobuf:"bytes,2,rep,name=exclude_payload_types,json=excludePayloadTypes,proto3" json:"exclude_payload_types,omitempty"`
+	// exclude_creator_addresses is the list of addresses which events are not sent,
+	// e.g. the client's own address.
+	ExcludeCreatorAddresses [][]byte `protobuf:"bytes,3,rep,name=exclude_creator_addresses,json=excludeCreatorAddresses,proto3" json:"exclude_creator_addresses,omitempty"`
 }
 
-func (x *MemberPayload_SnappedPin) Reset() {
-	*x = MemberPayload_SnappedPin{}
+func (x *SyncFilter) Reset() {
+	*x = SyncFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4335,13 +4784,13 @@ func (x *MemberPayload_SnappedPin) Reset() {
 	}
 }
 
-func (x *MemberPayload_SnappedPin) String() string {
+func (x *SyncFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPayload_SnappedPin) ProtoMessage() {}
+func (*SyncFilter) ProtoMessage() {}
 
-func (x *MemberPayload_SnappedPin) ProtoReflect() protoreflect.Message {
+func (x *SyncFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4353,36 +4802,44 @@ func (x *MemberPayload_SnappedPin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPayload_SnappedPin.ProtoReflect.Descriptor instead.
-func (*MemberPayload_SnappedPin) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4, 5}
+// Deprecated: Use SyncFilter.ProtoReflect.Descriptor instead.
+func (*SyncFilter) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *MemberPayload_SnappedPin) GetCreatorAddress() []byte {
+func (x *SyncFilter) GetIncludePayloadTypes() []string {
 	if x != nil {
-		return x.CreatorAddress
+		return x.IncludePayloadTypes
 	}
 	return nil
 }
 
-func (x *MemberPayload_SnappedPin) GetPin() *MemberPayload_Pin {
+func (x *SyncFilter) GetExcludePayloadTypes() []string {
 	if x != nil {
-		return x.Pin
+		return x.ExcludePayloadTypes
 	}
 	return nil
 }
 
-type MemberPayload_Pin struct {
+func (x *SyncFilter) GetExcludeCreatorAddresses() [][]byte {
+	if x != nil {
+		return x.ExcludeCreatorAddresses
+	}
+	return nil
+}
+
+// StreamSyncFilter is a filter for a single stream in the sync session.
+type StreamSyncFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventId []byte       `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Event   *StreamEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	StreamId []byte      `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Filter   *SyncFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *MemberPayload_Pin) Reset() {
-	*x = MemberPayload_Pin{}
+func (x *StreamSyncFilter) Reset() {
+	*x = StreamSyncFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4390,13 +4847,13 @@ func (x *MemberPayload_Pin) Reset() {
 	}
 }
 
-func (x *MemberPayload_Pin) String() string {
+func (x *StreamSyncFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPayload_Pin) ProtoMessage() {}
+func (*StreamSyncFilter) ProtoMessage() {}
 
-func (x *MemberPayload_Pin) ProtoReflect() protoreflect.Message {
+func (x *StreamSyncFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4408,35 +4865,41 @@ func (x *MemberPayload_Pin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPayload_Pin.ProtoReflect.Descriptor instead.
-func (*MemberPayload_Pin) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4, 6}
+// Deprecated: Use StreamSyncFilter.ProtoReflect.Descriptor instead.
+func (*StreamSyncFilter) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *MemberPayload_Pin) GetEventId() []byte {
+func (x *StreamSyncFilter) GetStreamId() []byte {
 	if x != nil {
-		return x.EventId
+		return x.StreamId
 	}
 	return nil
 }
 
-func (x *MemberPayload_Pin) GetEvent() *StreamEvent {
+func (x *StreamSyncFilter) GetFilter() *SyncFilter {
 	if x != nil {
-		return x.Event
+		return x.Filter
 	}
 	return nil
 }
 
-type MemberPayload_Unpin struct {
+// SyncStreamsRequest is a request to start a streams sync session.
+type SyncStreamsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventId []byte `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// sync_pos is the list of streams and positions in those streams to receive updates from.
+	SyncPos []*SyncCookie `protobuf:"bytes,1,rep,name=sync_pos,json=syncPos,proto3" json:"sync_pos,omitempty"`
+	// filter is applied to events of all streams in the sync session.
+	Filter *SyncFilter `protobuf:"bytes,2,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// stream_filters replace filter for the given streams.
+	StreamFilters []*StreamSyncFilter `protobuf:"bytes,3,rep,name=stream_filters,json=streamFilters,proto3" json:"stream_filters,omitempty"`
 }
 
-func (x *MemberPayload_Unpin) Reset() {
-	*x = MemberPayload_Unpin{}
+func (x *SyncStreamsRequest) Reset() {
+	*x = SyncStreamsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4444,13 +4907,13 @@ func (x *MemberPayload_Unpin) Reset() {
 	}
 }
 
-func (x *MemberPayload_Unpin) String() string {
+func (x *SyncStreamsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPayload_Unpin) ProtoMessage() {}
+func (*SyncStreamsRequest) ProtoMessage() {}
 
-func (x *MemberPayload_Unpin) ProtoReflect() protoreflect.Message {
+func (x *SyncStreamsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4462,35 +4925,56 @@ func (x *MemberPayload_Unpin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPayload_Unpin.ProtoReflect.Descriptor instead.
-func (*MemberPayload_Unpin) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4, 7}
+// Deprecated: Use SyncStreamsRequest.ProtoReflect.Descriptor instead.
+func (*SyncStreamsRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *MemberPayload_Unpin) GetEventId() []byte {
+func (x *SyncStreamsRequest) GetSyncPos() []*SyncCookie {
 	if x != nil {
-		return x.EventId
+		return x.SyncPos
 	}
 	return nil
 }
 
-type MemberPayload_Snapshot_Member struct {
+func (x *SyncStreamsRequest) GetFilter() *SyncFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SyncStreamsRequest) GetStreamFilters() []*StreamSyncFilter {
+	if x != nil {
+		return x.StreamFilters
+	}
+	return nil
+}
+
+// SyncStreamsResponse is a stream of updates that the client receives for streams it subscribed to within a streams
+// sync session.
+type SyncStreamsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserAddress   []byte                           `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
-	MiniblockNum  int64                            `protobuf:"varint,2,opt,name=miniblock_num,json=miniblockNum,proto3" json:"miniblock_num,omitempty"`
-	EventNum      int64                            `protobuf:"varint,3,opt,name=event_num,json=eventNum,proto3" json:"event_num,omitempty"`
-	Solicitations []*MemberPayload_KeySolicitation `protobuf:"bytes,4,rep,name=solicitations,proto3" json:"solicitations,omitempty"`
-	Username      *WrappedEncryptedData            `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	DisplayName   *WrappedEncryptedData            `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	EnsAddress    []byte                           `protobuf:"bytes,7,opt,name=ens_address,json=ensAddress,proto3" json:"ens_address,omitempty"`
-	Nft           *MemberPayload_Nft               `protobuf:"bytes,8,opt,name=nft,proto3" json:"nft,omitempty"`
+	// sync_id is the id of the sync session.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// sync_op marks the type of update.
+	SyncOp SyncOp `protobuf:"varint,2,opt,name=sync_op,json=syncOp,proto3,enum=river.SyncOp" json:"sync_op,omitempty"`
+	// stream indicates an update of a stream.
+	// only set when sync_op = SYNC_UPDATE
+	Stream *StreamAndCookie `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	// pong_nonce is returned after a ping request was made to the sync session through PingSync.
+	// Set with the ping value from the PingSync request when sync_op = SYNC_PONG
+	PongNonce string `protobuf:"bytes,4,opt,name=pong_nonce,json=pongNonce,proto3" json:"pong_nonce,omitempty"`
+	// stream_id is set when sync_op = SYNC_DOWN and indicates it will not receive updates anymore for this stream.
+	// If the client is still is interested in updates for this stream it must re-add the stream to the sync session.
+	StreamId []byte `protobuf:"bytes,5,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (x *MemberPayload_Snapshot_Member) Reset() {
-	*x = MemberPayload_Snapshot_Member{}
+func (x *SyncStreamsResponse) Reset() {
+	*x = SyncStreamsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4498,13 +4982,13 @@ func (x *MemberPayload_Snapshot_Member) Reset() {
 	}
 }
 
-func (x *MemberPayload_Snapshot_Member) String() string {
+func (x *SyncStreamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberPayload_Snapshot_Member) ProtoMessage() {}
+func (*SyncStreamsResponse) ProtoMessage() {}
 
-func (x *MemberPayload_Snapshot_Member) ProtoReflect() protoreflect.Message {
+func (x *SyncStreamsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4516,80 +5000,62 @@ func (x *MemberPayload_Snapshot_Member) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberPayload_Snapshot_Member.ProtoReflect.Descriptor instead.
-func (*MemberPayload_Snapshot_Member) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4, 0, 0}
-}
-
-func (x *MemberPayload_Snapshot_Member) GetUserAddress() []byte {
-	if x != nil {
-		return x.UserAddress
-	}
-	return nil
-}
-
-func (x *MemberPayload_Snapshot_Member) GetMiniblockNum() int64 {
-	if x != nil {
-		return x.MiniblockNum
-	}
-	return 0
-}
-
-func (x *MemberPayload_Snapshot_Member) GetEventNum() int64 {
-	if x != nil {
-		return x.EventNum
-	}
-	return 0
+// Deprecated: Use SyncStreamsResponse.ProtoReflect.Descriptor instead.
+func (*SyncStreamsResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *MemberPayload_Snapshot_Member) GetSolicitations() []*MemberPayload_KeySolicitation {
+func (x *SyncStreamsResponse) GetSyncId() string {
 	if x != nil {
-		return x.Solicitations
+		return x.SyncId
 	}
-	return nil
+	return ""
 }
 
-func (x *MemberPayload_Snapshot_Member) GetUsername() *WrappedEncryptedData {
+func (x *SyncStreamsResponse) GetSyncOp() SyncOp {
 	if x != nil {
-		return x.Username
+		return x.SyncOp
 	}
-	return nil
+	return SyncOp_SYNC_UNSPECIFIED
 }
 
-func (x *MemberPayload_Snapshot_Member) GetDisplayName() *WrappedEncryptedData {
+func (x *SyncStreamsResponse) GetStream() *StreamAndCookie {
 	if x != nil {
-		return x.DisplayName
+		return x.Stream
 	}
 	return nil
 }
 
-func (x *MemberPayload_Snapshot_Member) GetEnsAddress() []byte {
+func (x *SyncStreamsResponse) GetPongNonce() string {
 	if x != nil {
-		return x.EnsAddress
+		return x.PongNonce
 	}
-	return nil
+	return ""
 }
 
-func (x *MemberPayload_Snapshot_Member) GetNft() *MemberPayload_Nft {
+func (x *SyncStreamsResponse) GetStreamId() []byte {
 	if x != nil {
-		return x.Nft
+		return x.StreamId
 	}
 	return nil
 }
 
-type SpacePayload_Snapshot struct {
+// AddStreamToSyncRequest is a request to add a stream to an existing streams sync session.
+type AddStreamToSyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// inception
-	Inception *SpacePayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
-	// channels: sorted by channel_id
-	Channels []*SpacePayload_ChannelMetadata `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	// sync_id is the id of the sync session.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// sync_pos identifies the stream and position in the stream to receive updates from.
+	SyncPos *SyncCookie `protobuf:"bytes,2,opt,name=sync_pos,json=syncPos,proto3" json:"sync_pos,omitempty"`
+	// filter replaces the sync session filter for the stream.
+	Filter *SyncFilter `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
 }
 
-func (x *SpacePayload_Snapshot) Reset() {
-	*x = SpacePayload_Snapshot{}
+func (x *AddStreamToSyncRequest) Reset() {
+	*x = AddStreamToSyncRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4597,13 +5063,13 @@ func (x *SpacePayload_Snapshot) Reset() {
 	}
 }
 
-func (x *SpacePayload_Snapshot) String() string {
+func (x *AddStreamToSyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpacePayload_Snapshot) ProtoMessage() {}
+func (*AddStreamToSyncRequest) ProtoMessage() {}
 
-func (x *SpacePayload_Snapshot) ProtoReflect() protoreflect.Message {
+func (x *AddStreamToSyncRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4615,36 +5081,40 @@ func (x *SpacePayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpacePayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*SpacePayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use AddStreamToSyncRequest.ProtoReflect.Descriptor instead.
+func (*AddStreamToSyncRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *SpacePayload_Snapshot) GetInception() *SpacePayload_Inception {
+func (x *AddStreamToSyncRequest) GetSyncId() string {
 	if x != nil {
-		return x.Inception
+		return x.SyncId
+	}
+	return ""
+}
+
+func (x *AddStreamToSyncRequest) GetSyncPos() *SyncCookie {
+	if x != nil {
+		return x.SyncPos
 	}
 	return nil
 }
 
-func (x *SpacePayload_Snapshot) GetChannels() []*SpacePayload_ChannelMetadata {
+func (x *AddStreamToSyncRequest) GetFilter() *SyncFilter {
 	if x != nil {
-		return x.Channels
+		return x.Filter
 	}
 	return nil
 }
 
-type SpacePayload_Inception struct {
+type AddStreamToSyncResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
 }
 
-func (x *SpacePayload_Inception) Reset() {
-	*x = SpacePayload_Inception{}
+func (x *AddStreamToSyncResponse) Reset() {
+	*x = AddStreamToSyncResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4652,13 +5122,13 @@ func (x *SpacePayload_Inception) Reset() {
 	}
 }
 
-func (x *SpacePayload_Inception) String() string {
+func (x *AddStreamToSyncResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpacePayload_Inception) ProtoMessage() {}
+func (*AddStreamToSyncResponse) ProtoMessage() {}
 
-func (x *SpacePayload_Inception) ProtoReflect() protoreflect.Message {
+func (x *AddStreamToSyncResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4670,38 +5140,25 @@ func (x *SpacePayload_Inception) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpacePayload_Inception.ProtoReflect.Descriptor instead.
-func (*SpacePayload_Inception) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{5, 1}
-}
-
-func (x *SpacePayload_Inception) GetStreamId() []byte {
-	if x != nil {
-		return x.StreamId
-	}
-	return nil
-}
-
-func (x *SpacePayload_Inception) GetSettings() *StreamSettings {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
+// Deprecated: Use AddStreamToSyncResponse.ProtoReflect.Descriptor instead.
+func (*AddStreamToSyncResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{56}
 }
 
-type SpacePayload_ChannelMetadata struct {
+// RemoveStreamFromSyncRequest stops the client to receive updates from this stream in the sync session.
+// Note that due to buffering in the stream it is possible still receives several updates for this stream after it was
+// removed.
+type RemoveStreamFromSyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Op                ChannelOp `protobuf:"varint,1,opt,name=op,proto3,enum=river.ChannelOp" json:"op,omitempty"`
-	ChannelId         []byte    `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	OriginEvent       *EventRef `protobuf:"bytes,3,opt,name=origin_event,json=originEvent,proto3" json:"origin_event,omitempty"`
-	UpdatedAtEventNum int64     `protobuf:"varint,6,opt,name=updated_at_event_num,json=updatedAtEventNum,proto3" json:"updated_at_event_num,omitempty"`
+	SyncId   string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	StreamId []byte `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (x *SpacePayload_ChannelMetadata) Reset() {
-	*x = SpacePayload_ChannelMetadata{}
+func (x *RemoveStreamFromSyncRequest) Reset() {
+	*x = RemoveStreamFromSyncRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4709,13 +5166,13 @@ func (x *SpacePayload_ChannelMetadata) Reset() {
 	}
 }
 
-func (x *SpacePayload_ChannelMetadata) String() string {
+func (x *RemoveStreamFromSyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpacePayload_ChannelMetadata) ProtoMessage() {}
+func (*RemoveStreamFromSyncRequest) ProtoMessage() {}
 
-func (x *SpacePayload_ChannelMetadata) ProtoReflect() protoreflect.Message {
+func (x *RemoveStreamFromSyncRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4727,51 +5184,33 @@ func (x *SpacePayload_ChannelMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpacePayload_ChannelMetadata.ProtoReflect.Descriptor instead.
-func (*SpacePayload_ChannelMetadata) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{5, 2}
-}
-
-func (x *SpacePayload_ChannelMetadata) GetOp() ChannelOp {
-	if x != nil {
-		return x.Op
-	}
-	return ChannelOp_CO_UNSPECIFIED
+// Deprecated: Use RemoveStreamFromSyncRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStreamFromSyncRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SpacePayload_ChannelMetadata) GetChannelId() []byte {
+func (x *RemoveStreamFromSyncRequest) GetSyncId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.SyncId
 	}
-	return nil
+	return ""
 }
 
-func (x *SpacePayload_ChannelMetadata) GetOriginEvent() *EventRef {
+func (x *RemoveStreamFromSyncRequest) GetStreamId() []byte {
 	if x != nil {
-		return x.OriginEvent
+		return x.StreamId
 	}
 	return nil
 }
 
-func (x *SpacePayload_ChannelMetadata) GetUpdatedAtEventNum() int64 {
-	if x != nil {
-		return x.UpdatedAtEventNum
-	}
-	return 0
-}
-
-type SpacePayload_ChannelUpdate struct {
+type RemoveStreamFromSyncResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Op          ChannelOp `protobuf:"varint,1,opt,name=op,proto3,enum=river.ChannelOp" json:"op,omitempty"`
-	ChannelId   []byte    `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	OriginEvent *EventRef `protobuf:"bytes,3,opt,name=origin_event,json=originEvent,proto3" json:"origin_event,omitempty"`
 }
 
-func (x *SpacePayload_ChannelUpdate) Reset() {
-	*x = SpacePayload_ChannelUpdate{}
+func (x *RemoveStreamFromSyncResponse) Reset() {
+	*x = RemoveStreamFromSyncResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4779,13 +5218,13 @@ func (x *SpacePayload_ChannelUpdate) Reset() {
 	}
 }
 
-func (x *SpacePayload_ChannelUpdate) String() string {
+func (x *RemoveStreamFromSyncResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpacePayload_ChannelUpdate) ProtoMessage() {}
+func (*RemoveStreamFromSyncResponse) ProtoMessage() {}
 
-func (x *SpacePayload_ChannelUpdate) ProtoReflect() protoreflect.Message {
+func (x *RemoveStreamFromSyncResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4797,43 +5236,23 @@ func (x *SpacePayload_ChannelUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpacePayload_ChannelUpdate.ProtoReflect.Descriptor instead.
-func (*SpacePayload_ChannelUpdate) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{5, 3}
-}
-
-func (x *SpacePayload_ChannelUpdate) GetOp() ChannelOp {
-	if x != nil {
-		return x.Op
-	}
-	return ChannelOp_CO_UNSPECIFIED
-}
-
-func (x *SpacePayload_ChannelUpdate) GetChannelId() []byte {
-	if x != nil {
-		return x.ChannelId
-	}
-	return nil
-}
-
-func (x *SpacePayload_ChannelUpdate) GetOriginEvent() *EventRef {
-	if x != nil {
-		return x.OriginEvent
-	}
-	return nil
+// Deprecated: Use RemoveStreamFromSyncResponse.ProtoReflect.Descriptor instead.
+func (*RemoveStreamFromSyncResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{58}
 }
 
-type ChannelPayload_Snapshot struct {
+// CancelSyncRequest cancels the sync session.
+type CancelSyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// inception
-	Inception *ChannelPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	// sync_id is the unique id of the sync session.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
 }
 
-func (x *ChannelPayload_Snapshot) Reset() {
-	*x = ChannelPayload_Snapshot{}
+func (x *CancelSyncRequest) Reset() {
+	*x = CancelSyncRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4841,13 +5260,13 @@ func (x *ChannelPayload_Snapshot) Reset() {
 	}
 }
 
-func (x *ChannelPayload_Snapshot) String() string {
+func (x *CancelSyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelPayload_Snapshot) ProtoMessage() {}
+func (*CancelSyncRequest) ProtoMessage() {}
 
-func (x *ChannelPayload_Snapshot) ProtoReflect() protoreflect.Message {
+func (x *CancelSyncRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4859,30 +5278,26 @@ func (x *ChannelPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelPayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*ChannelPayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use CancelSyncRequest.ProtoReflect.Descriptor instead.
+func (*CancelSyncRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ChannelPayload_Snapshot) GetInception() *ChannelPayload_Inception {
+func (x *CancelSyncRequest) GetSyncId() string {
 	if x != nil {
-		return x.Inception
+		return x.SyncId
 	}
-	return nil
+	return ""
 }
 
-type ChannelPayload_Inception struct {
+type CancelSyncResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	SpaceId  []byte          `protobuf:"bytes,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	Settings *StreamSettings `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
 }
 
-func (x *ChannelPayload_Inception) Reset() {
-	*x = ChannelPayload_Inception{}
+func (x *CancelSyncResponse) Reset() {
+	*x = CancelSyncResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4890,13 +5305,13 @@ func (x *ChannelPayload_Inception) Reset() {
 	}
 }
 
-func (x *ChannelPayload_Inception) String() string {
+func (x *CancelSyncResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelPayload_Inception) ProtoMessage() {}
+func (*CancelSyncResponse) ProtoMessage() {}
 
-func (x *ChannelPayload_Inception) ProtoReflect() protoreflect.Message {
+func (x *CancelSyncResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4908,42 +5323,25 @@ func (x *ChannelPayload_Inception) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelPayload_Inception.ProtoReflect.Descriptor instead.
-func (*ChannelPayload_Inception) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{6, 1}
-}
-
-func (x *ChannelPayload_Inception) GetStreamId() []byte {
-	if x != nil {
-		return x.StreamId
-	}
-	return nil
-}
-
-func (x *ChannelPayload_Inception) GetSpaceId() []byte {
-	if x != nil {
-		return x.SpaceId
-	}
-	return nil
-}
-
-func (x *ChannelPayload_Inception) GetSettings() *StreamSettings {
-	if x != nil {
-		return x.Settings
-	}
-	return nil
+// Deprecated: Use CancelSyncResponse.ProtoReflect.Descriptor instead.
+func (*CancelSyncResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{60}
 }
 
-type ChannelPayload_Redaction struct {
+// PingSyncRequest is a request to receive a pong in the sync session stream.
+type PingSyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventId []byte `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// sync_id is the unique id of the sync session.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
+	// nonce is the pong to return in the sync session stream.
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *ChannelPayload_Redaction) Reset() {
-	*x = ChannelPayload_Redaction{}
+func (x *PingSyncRequest) Reset() {
+	*x = PingSyncRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4951,13 +5349,13 @@ func (x *ChannelPayload_Redaction) Reset() {
 	}
 }
 
-func (x *ChannelPayload_Redaction) String() string {
+func (x *PingSyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelPayload_Redaction) ProtoMessage() {}
+func (*PingSyncRequest) ProtoMessage() {}
 
-func (x *ChannelPayload_Redaction) ProtoReflect() protoreflect.Message {
+func (x *PingSyncRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4969,28 +5367,33 @@ func (x *ChannelPayload_Redaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelPayload_Redaction.ProtoReflect.Descriptor instead.
-func (*ChannelPayload_Redaction) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{6, 2}
+// Deprecated: Use PingSyncRequest.ProtoReflect.Descriptor instead.
+func (*PingSyncRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ChannelPayload_Redaction) GetEventId() []byte {
+func (x *PingSyncRequest) GetSyncId() string {
 	if x != nil {
-		return x.EventId
+		return x.SyncId
 	}
-	return nil
+	return ""
 }
 
-type DmChannelPayload_Snapshot struct {
+func (x *PingSyncRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type PingSyncResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Inception *DmChannelPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
 }
 
-func (x *DmChannelPayload_Snapshot) Reset() {
-	*x = DmChannelPayload_Snapshot{}
+func (x *PingSyncResponse) Reset() {
+	*x = PingSyncResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4998,13 +5401,13 @@ func (x *DmChannelPayload_Snapshot) Reset() {
 	}
 }
 
-func (x *DmChannelPayload_Snapshot) String() string {
+func (x *PingSyncResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DmChannelPayload_Snapshot) ProtoMessage() {}
+func (*PingSyncResponse) ProtoMessage() {}
 
-func (x *DmChannelPayload_Snapshot) ProtoReflect() protoreflect.Message {
+func (x *PingSyncResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5016,31 +5419,25 @@ func (x *DmChannelPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DmChannelPayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*DmChannelPayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{7, 0}
-}
-
-func (x *DmChannelPayload_Snapshot) GetInception() *DmChannelPayload_Inception {
-	if x != nil {
-		return x.Inception
-	}
-	return nil
+// Deprecated: Use PingSyncResponse.ProtoReflect.Descriptor instead.
+func (*PingSyncResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{62}
 }
 
-type DmChannelPayload_Inception struct {
+// ResumeSyncRequest is a request to continue a streams sync session after the client reconnected.
+// Streams are synced from the positions that were last delivered to the client in the session,
+// so the client doesn't need to send the sync cookies again.
+type ResumeSyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId           []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	FirstPartyAddress  []byte          `protobuf:"bytes,2,opt,name=first_party_address,json=firstPartyAddress,proto3" json:"first_party_address,omitempty"`
-	SecondPartyAddress []byte          `protobuf:"bytes,3,opt,name=second_party_address,json=secondPartyAddress,proto3" json:"second_party_address,omitempty"`
-	Settings           *StreamSettings `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+	// sync_id is the unique id of the sync session.
+	SyncId string `protobuf:"bytes,1,opt,name=sync_id,json=syncId,proto3" json:"sync_id,omitempty"`
 }
 
-func (x *DmChannelPayload_Inception) Reset() {
-	*x = DmChannelPayload_Inception{}
+func (x *ResumeSyncRequest) Reset() {
+	*x = ResumeSyncRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5048,13 +5445,13 @@ func (x *DmChannelPayload_Inception) Reset() {
 	}
 }
 
-func (x *DmChannelPayload_Inception) String() string {
+func (x *ResumeSyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DmChannelPayload_Inception) ProtoMessage() {}
+func (*ResumeSyncRequest) ProtoMessage() {}
 
-func (x *DmChannelPayload_Inception) ProtoReflect() protoreflect.Message {
+func (x *ResumeSyncRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5066,50 +5463,36 @@ func (x *DmChannelPayload_Inception) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DmChannelPayload_Inception.ProtoReflect.Descriptor instead.
-func (*DmChannelPayload_Inception) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{7, 1}
-}
-
-func (x *DmChannelPayload_Inception) GetStreamId() []byte {
-	if x != nil {
-		return x.StreamId
-	}
-	return nil
-}
-
-func (x *DmChannelPayload_Inception) GetFirstPartyAddress() []byte {
-	if x != nil {
-		return x.FirstPartyAddress
-	}
-	return nil
-}
-
-func (x *DmChannelPayload_Inception) GetSecondPartyAddress() []byte {
-	if x != nil {
-		return x.SecondPartyAddress
-	}
-	return nil
+// Deprecated: Use ResumeSyncRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSyncRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *DmChannelPayload_Inception) GetSettings() *StreamSettings {
+func (x *ResumeSyncRequest) GetSyncId() string {
 	if x != nil {
-		return x.Settings
+		return x.SyncId
 	}
-	return nil
+	return ""
 }
 
-type GdmChannelPayload_Snapshot struct {
+// SyncCommand is sent in-band by clients of the WebSocket sync transport to change their sync session.
+// The sync_id fields of the requests are set by the node to the id of the WebSocket sync session.
+type SyncCommand struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Inception         *GdmChannelPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
-	ChannelProperties *WrappedEncryptedData        `protobuf:"bytes,2,opt,name=channel_properties,json=channelProperties,proto3" json:"channel_properties,omitempty"`
+	// Types that are assignable to Command:
+	//
+	//	*SyncCommand_AddStream
+	//	*SyncCommand_RemoveStream
+	//	*SyncCommand_Ping
+	//	*SyncCommand_Cancel
+	Command isSyncCommand_Command `protobuf_oneof:"command"`
 }
 
-func (x *GdmChannelPayload_Snapshot) Reset() {
-	*x = GdmChannelPayload_Snapshot{}
+func (x *SyncCommand) Reset() {
+	*x = SyncCommand{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5117,13 +5500,13 @@ func (x *GdmChannelPayload_Snapshot) Reset() {
 	}
 }
 
-func (x *GdmChannelPayload_Snapshot) String() string {
+func (x *SyncCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GdmChannelPayload_Snapshot) ProtoMessage() {}
+func (*SyncCommand) ProtoMessage() {}
 
-func (x *GdmChannelPayload_Snapshot) ProtoReflect() protoreflect.Message {
+func (x *SyncCommand) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5135,37 +5518,91 @@ func (x *GdmChannelPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GdmChannelPayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*GdmChannelPayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use SyncCommand.ProtoReflect.Descriptor instead.
+func (*SyncCommand) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GdmChannelPayload_Snapshot) GetInception() *GdmChannelPayload_Inception {
-	if x != nil {
-		return x.Inception
+func (m *SyncCommand) GetCommand() isSyncCommand_Command {
+	if m != nil {
+		return m.Command
 	}
 	return nil
 }
 
-func (x *GdmChannelPayload_Snapshot) GetChannelProperties() *WrappedEncryptedData {
-	if x != nil {
-		return x.ChannelProperties
+func (x *SyncCommand) GetAddStream() *AddStreamToSyncRequest {
+	if x, ok := x.GetCommand().(*SyncCommand_AddStream); ok {
+		return x.AddStream
 	}
 	return nil
 }
 
-type GdmChannelPayload_Inception struct {
+func (x *SyncCommand) GetRemoveStream() *RemoveStreamFromSyncRequest {
+	if x, ok := x.GetCommand().(*SyncCommand_RemoveStream); ok {
+		return x.RemoveStream
+	}
+	return nil
+}
+
+func (x *SyncCommand) GetPing() *PingSyncRequest {
+	if x, ok := x.GetCommand().(*SyncCommand_Ping); ok {
+		return x.Ping
+	}
+	return nil
+}
+
+func (x *SyncCommand) GetCancel() *CancelSyncRequest {
+	if x, ok := x.GetCommand().(*SyncCommand_Cancel); ok {
+		return x.Cancel
+	}
+	return nil
+}
+
+type isSyncCommand_Command interface {
+	isSyncCommand_Command()
+}
+
+type SyncCommand_AddStream struct {
+	AddStream *AddStreamToSyncRequest `protobuf:"bytes,1,opt,name=add_stream,json=addStream,proto3,oneof"`
+}
+
+type SyncCommand_RemoveStream struct {
+	RemoveStream *RemoveStreamFromSyncRequest `protobuf:"bytes,2,opt,name=remove_stream,json=removeStream,proto3,oneof"`
+}
+
+type SyncCommand_Ping struct {
+	Ping *PingSyncRequest `protobuf:"bytes,3,opt,name=ping,proto3,oneof"`
+}
+
+type SyncCommand_Cancel struct {
+	Cancel *CancelSyncRequest `protobuf:"bytes,4,opt,name=cancel,proto3,oneof"`
+}
+
+func (*SyncCommand_AddStream) isSyncCommand_Command() {}
+
+func (*SyncCommand_RemoveStream) isSyncCommand_Command() {}
+
+func (*SyncCommand_Ping) isSyncCommand_Command() {}
+
+func (*SyncCommand_Cancel) isSyncCommand_Command() {}
+
+// SyncSession is the state of a streams sync session that is stored by the node, so the session can be resumed
+// with ResumeSync after the client reconnected or the node restarted.
+type SyncSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId          []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	ChannelProperties *EncryptedData  `protobuf:"bytes,2,opt,name=channel_properties,json=channelProperties,proto3" json:"channel_properties,omitempty"`
-	Settings          *StreamSettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	// sync_pos is the list of streams in the session and positions last delivered to the client.
+	SyncPos []*SyncCookie `protobuf:"bytes,1,rep,name=sync_pos,json=syncPos,proto3" json:"sync_pos,omitempty"`
+	// filter is the filter of the sync session.
+	Filter *SyncFilter `protobuf:"bytes,2,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// stream_filters are the filters of individual streams in the sync session.
+	StreamFilters []*StreamSyncFilter `protobuf:"bytes,3,rep,name=stream_filters,json=streamFilters,proto3" json:"stream_filters,omitempty"`
 }
 
-func (x *GdmChannelPayload_Inception) Reset() {
-	*x = GdmChannelPayload_Inception{}
+func (x *SyncSession) Reset() {
+	*x = SyncSession{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5173,13 +5610,13 @@ func (x *GdmChannelPayload_Inception) Reset() {
 	}
 }
 
-func (x *GdmChannelPayload_Inception) String() string {
+func (x *SyncSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GdmChannelPayload_Inception) ProtoMessage() {}
+func (*SyncSession) ProtoMessage() {}
 
-func (x *GdmChannelPayload_Inception) ProtoReflect() protoreflect.Message {
+func (x *SyncSession) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5191,45 +5628,42 @@ func (x *GdmChannelPayload_Inception) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GdmChannelPayload_Inception.ProtoReflect.Descriptor instead.
-func (*GdmChannelPayload_Inception) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use SyncSession.ProtoReflect.Descriptor instead.
+func (*SyncSession) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GdmChannelPayload_Inception) GetStreamId() []byte {
+func (x *SyncSession) GetSyncPos() []*SyncCookie {
 	if x != nil {
-		return x.StreamId
+		return x.SyncPos
 	}
 	return nil
 }
 
-func (x *GdmChannelPayload_Inception) GetChannelProperties() *EncryptedData {
+func (x *SyncSession) GetFilter() *SyncFilter {
 	if x != nil {
-		return x.ChannelProperties
+		return x.Filter
 	}
 	return nil
 }
 
-func (x *GdmChannelPayload_Inception) GetSettings() *StreamSettings {
+func (x *SyncSession) GetStreamFilters() []*StreamSyncFilter {
 	if x != nil {
-		return x.Settings
+		return x.StreamFilters
 	}
 	return nil
 }
 
-type UserPayload_Snapshot struct {
+type InfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// inception
-	Inception *UserPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
-	// memberships, sorted by stream_id
-	Memberships []*UserPayload_UserMembership `protobuf:"bytes,2,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	Debug []string `protobuf:"bytes,1,rep,name=debug,proto3" json:"debug,omitempty"`
 }
 
-func (x *UserPayload_Snapshot) Reset() {
-	*x = UserPayload_Snapshot{}
+func (x *InfoRequest) Reset() {
+	*x = InfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5237,13 +5671,13 @@ func (x *UserPayload_Snapshot) Reset() {
 	}
 }
 
-func (x *UserPayload_Snapshot) String() string {
+func (x *InfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPayload_Snapshot) ProtoMessage() {}
+func (*InfoRequest) ProtoMessage() {}
 
-func (x *UserPayload_Snapshot) ProtoReflect() protoreflect.Message {
+func (x *InfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5255,36 +5689,30 @@ func (x *UserPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserPayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*UserPayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use InfoRequest.ProtoReflect.Descriptor instead.
+func (*InfoRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *UserPayload_Snapshot) GetInception() *UserPayload_Inception {
+func (x *InfoRequest) GetDebug() []string {
 	if x != nil {
-		return x.Inception
+		return x.Debug
 	}
 	return nil
 }
 
-func (x *UserPayload_Snapshot) GetMemberships() []*UserPayload_UserMembership {
-	if x != nil {
-		return x.Memberships
-	}
-	return nil
-}
-
-type UserPayload_Inception struct {
+type InfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	Graffiti  string                 `protobuf:"bytes,1,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Version   string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *UserPayload_Inception) Reset() {
-	*x = UserPayload_Inception{}
+func (x *InfoResponse) Reset() {
+	*x = InfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5292,13 +5720,13 @@ func (x *UserPayload_Inception) Reset() {
 	}
 }
 
-func (x *UserPayload_Inception) String() string {
+func (x *InfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPayload_Inception) ProtoMessage() {}
+func (*InfoResponse) ProtoMessage() {}
 
-func (x *UserPayload_Inception) ProtoReflect() protoreflect.Message {
+func (x *InfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5310,39 +5738,43 @@ func (x *UserPayload_Inception) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserPayload_Inception.ProtoReflect.Descriptor instead.
-func (*UserPayload_Inception) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{9, 1}
+// Deprecated: Use InfoResponse.ProtoReflect.Descriptor instead.
+func (*InfoResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *UserPayload_Inception) GetStreamId() []byte {
+func (x *InfoResponse) GetGraffiti() string {
 	if x != nil {
-		return x.StreamId
+		return x.Graffiti
 	}
-	return nil
+	return ""
 }
 
-func (x *UserPayload_Inception) GetSettings() *StreamSettings {
+func (x *InfoResponse) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Settings
+		return x.StartTime
 	}
 	return nil
 }
 
-// update own membership
-type UserPayload_UserMembership struct {
+func (x *InfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type MemberPayload_Snapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId       []byte       `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Op             MembershipOp `protobuf:"varint,2,opt,name=op,proto3,enum=river.MembershipOp" json:"op,omitempty"`
-	Inviter        []byte       `protobuf:"bytes,3,opt,name=inviter,proto3,oneof" json:"inviter,omitempty"`
-	StreamParentId []byte       `protobuf:"bytes,4,opt,name=stream_parent_id,json=streamParentId,proto3,oneof" json:"stream_parent_id,omitempty"`
+	Joined []*MemberPayload_Snapshot_Member `protobuf:"bytes,1,rep,name=joined,proto3" json:"joined,omitempty"`
+	Pins   []*MemberPayload_SnappedPin      `protobuf:"bytes,2,rep,name=pins,proto3" json:"pins,omitempty"`
 }
 
-func (x *UserPayload_UserMembership) Reset() {
-	*x = UserPayload_UserMembership{}
+func (x *MemberPayload_Snapshot) Reset() {
+	*x = MemberPayload_Snapshot{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5350,13 +5782,13 @@ func (x *UserPayload_UserMembership) Reset() {
 	}
 }
 
-func (x *UserPayload_UserMembership) String() string {
+func (x *MemberPayload_Snapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPayload_UserMembership) ProtoMessage() {}
+func (*MemberPayload_Snapshot) ProtoMessage() {}
 
-func (x *UserPayload_UserMembership) ProtoReflect() protoreflect.Message {
+func (x *MemberPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5368,53 +5800,38 @@ func (x *UserPayload_UserMembership) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserPayload_UserMembership.ProtoReflect.Descriptor instead.
-func (*UserPayload_UserMembership) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{9, 2}
-}
-
-func (x *UserPayload_UserMembership) GetStreamId() []byte {
-	if x != nil {
-		return x.StreamId
-	}
-	return nil
-}
-
-func (x *UserPayload_UserMembership) GetOp() MembershipOp {
-	if x != nil {
-		return x.Op
-	}
-	return MembershipOp_SO_UNSPECIFIED
+// Deprecated: Use MemberPayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*MemberPayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (x *UserPayload_UserMembership) GetInviter() []byte {
+func (x *MemberPayload_Snapshot) GetJoined() []*MemberPayload_Snapshot_Member {
 	if x != nil {
-		return x.Inviter
+		return x.Joined
 	}
 	return nil
 }
 
-func (x *UserPayload_UserMembership) GetStreamParentId() []byte {
+func (x *MemberPayload_Snapshot) GetPins() []*MemberPayload_SnappedPin {
 	if x != nil {
-		return x.StreamParentId
+		return x.Pins
 	}
 	return nil
 }
 
-// update someone else's membership
-type UserPayload_UserMembershipAction struct {
+type MemberPayload_Membership struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId       []byte       `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	UserId         []byte       `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Op             MembershipOp `protobuf:"varint,3,opt,name=op,proto3,enum=river.MembershipOp" json:"op,omitempty"`
-	StreamParentId []byte       `protobuf:"bytes,4,opt,name=stream_parent_id,json=streamParentId,proto3,oneof" json:"stream_parent_id,omitempty"`
+	Op               MembershipOp `protobuf:"varint,1,opt,name=op,proto3,enum=river.MembershipOp" json:"op,omitempty"`
+	UserAddress      []byte       `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	InitiatorAddress []byte       `protobuf:"bytes,3,opt,name=initiator_address,json=initiatorAddress,proto3" json:"initiator_address,omitempty"`
+	StreamParentId   []byte       `protobuf:"bytes,4,opt,name=stream_parent_id,json=streamParentId,proto3,oneof" json:"stream_parent_id,omitempty"`
 }
 
-func (x *UserPayload_UserMembershipAction) Reset() {
-	*x = UserPayload_UserMembershipAction{}
+func (x *MemberPayload_Membership) Reset() {
+	*x = MemberPayload_Membership{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5422,13 +5839,13 @@ func (x *UserPayload_UserMembershipAction) Reset() {
 	}
 }
 
-func (x *UserPayload_UserMembershipAction) String() string {
+func (x *MemberPayload_Membership) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserPayload_UserMembershipAction) ProtoMessage() {}
+func (*MemberPayload_Membership) ProtoMessage() {}
 
-func (x *UserPayload_UserMembershipAction) ProtoReflect() protoreflect.Message {
+func (x *MemberPayload_Membership) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5440,51 +5857,52 @@ func (x *UserPayload_UserMembershipAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserPayload_UserMembershipAction.ProtoReflect.Descriptor instead.
-func (*UserPayload_UserMembershipAction) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{9, 3}
+// Deprecated: Use MemberPayload_Membership.ProtoReflect.Descriptor instead.
+func (*MemberPayload_Membership) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 1}
 }
 
-func (x *UserPayload_UserMembershipAction) GetStreamId() []byte {
+func (x *MemberPayload_Membership) GetOp() MembershipOp {
 	if x != nil {
-		return x.StreamId
+		return x.Op
 	}
-	return nil
+	return MembershipOp_SO_UNSPECIFIED
 }
 
-func (x *UserPayload_UserMembershipAction) GetUserId() []byte {
+func (x *MemberPayload_Membership) GetUserAddress() []byte {
 	if x != nil {
-		return x.UserId
+		return x.UserAddress
 	}
 	return nil
 }
 
-func (x *UserPayload_UserMembershipAction) GetOp() MembershipOp {
+func (x *MemberPayload_Membership) GetInitiatorAddress() []byte {
 	if x != nil {
-		return x.Op
+		return x.InitiatorAddress
 	}
-	return MembershipOp_SO_UNSPECIFIED
+	return nil
 }
 
-func (x *UserPayload_UserMembershipAction) GetStreamParentId() []byte {
+func (x *MemberPayload_Membership) GetStreamParentId() []byte {
 	if x != nil {
 		return x.StreamParentId
 	}
 	return nil
 }
 
-type UserInboxPayload_Snapshot struct {
+type MemberPayload_KeySolicitation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Inception *UserInboxPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
-	// deviceKey: miniblockNum that the ack was snapshotted
-	DeviceSummary map[string]*UserInboxPayload_Snapshot_DeviceSummary `protobuf:"bytes,2,rep,name=device_summary,json=deviceSummary,proto3" json:"device_summary,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DeviceKey   string   `protobuf:"bytes,1,opt,name=device_key,json=deviceKey,proto3" json:"device_key,omitempty"`          // requesters device_key
+	FallbackKey string   `protobuf:"bytes,2,opt,name=fallback_key,json=fallbackKey,proto3" json:"fallback_key,omitempty"`    // requesters fallback_key
+	IsNewDevice bool     `protobuf:"varint,3,opt,name=is_new_device,json=isNewDevice,proto3" json:"is_new_device,omitempty"` // true if this is a new device, session_ids will be empty
+	SessionIds  []string `protobuf:"bytes,4,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
 }
 
-func (x *UserInboxPayload_Snapshot) Reset() {
-	*x = UserInboxPayload_Snapshot{}
+func (x *MemberPayload_KeySolicitation) Reset() {
+	*x = MemberPayload_KeySolicitation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5492,13 +5910,13 @@ func (x *UserInboxPayload_Snapshot) Reset() {
 	}
 }
 
-func (x *UserInboxPayload_Snapshot) String() string {
+func (x *MemberPayload_KeySolicitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInboxPayload_Snapshot) ProtoMessage() {}
+func (*MemberPayload_KeySolicitation) ProtoMessage() {}
 
-func (x *UserInboxPayload_Snapshot) ProtoReflect() protoreflect.Message {
+func (x *MemberPayload_KeySolicitation) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5510,36 +5928,51 @@ func (x *UserInboxPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInboxPayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*UserInboxPayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use MemberPayload_KeySolicitation.ProtoReflect.Descriptor instead.
+func (*MemberPayload_KeySolicitation) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 2}
 }
 
-func (x *UserInboxPayload_Snapshot) GetInception() *UserInboxPayload_Inception {
+func (x *MemberPayload_KeySolicitation) GetDeviceKey() string {
 	if x != nil {
-		return x.Inception
+		return x.DeviceKey
 	}
-	return nil
+	return ""
 }
 
-func (x *UserInboxPayload_Snapshot) GetDeviceSummary() map[string]*UserInboxPayload_Snapshot_DeviceSummary {
+func (x *MemberPayload_KeySolicitation) GetFallbackKey() string {
 	if x != nil {
-		return x.DeviceSummary
+		return x.FallbackKey
+	}
+	return ""
+}
+
+func (x *MemberPayload_KeySolicitation) GetIsNewDevice() bool {
+	if x != nil {
+		return x.IsNewDevice
+	}
+	return false
+}
+
+func (x *MemberPayload_KeySolicitation) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
 	}
 	return nil
 }
 
-type UserInboxPayload_Inception struct {
+type MemberPayload_KeyFulfillment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	UserAddress []byte   `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	DeviceKey   string   `protobuf:"bytes,2,opt,name=device_key,json=deviceKey,proto3" json:"device_key,omitempty"`
+	SessionIds  []string `protobuf:"bytes,3,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
 }
 
-func (x *UserInboxPayload_Inception) Reset() {
-	*x = UserInboxPayload_Inception{}
+func (x *MemberPayload_KeyFulfillment) Reset() {
+	*x = MemberPayload_KeyFulfillment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5547,13 +5980,13 @@ func (x *UserInboxPayload_Inception) Reset() {
 	}
 }
 
-func (x *UserInboxPayload_Inception) String() string {
+func (x *MemberPayload_KeyFulfillment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInboxPayload_Inception) ProtoMessage() {}
+func (*MemberPayload_KeyFulfillment) ProtoMessage() {}
 
-func (x *UserInboxPayload_Inception) ProtoReflect() protoreflect.Message {
+func (x *MemberPayload_KeyFulfillment) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5565,39 +5998,44 @@ func (x *UserInboxPayload_Inception) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInboxPayload_Inception.ProtoReflect.Descriptor instead.
-func (*UserInboxPayload_Inception) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{10, 1}
+// Deprecated: Use MemberPayload_KeyFulfillment.ProtoReflect.Descriptor instead.
+func (*MemberPayload_KeyFulfillment) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 3}
 }
 
-func (x *UserInboxPayload_Inception) GetStreamId() []byte {
+func (x *MemberPayload_KeyFulfillment) GetUserAddress() []byte {
 	if x != nil {
-		return x.StreamId
+		return x.UserAddress
 	}
 	return nil
 }
 
-func (x *UserInboxPayload_Inception) GetSettings() *StreamSettings {
+func (x *MemberPayload_KeyFulfillment) GetDeviceKey() string {
 	if x != nil {
-		return x.Settings
+		return x.DeviceKey
+	}
+	return ""
+}
+
+func (x *MemberPayload_KeyFulfillment) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
 	}
 	return nil
 }
 
-type UserInboxPayload_GroupEncryptionSessions struct {
+type MemberPayload_Nft struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId   []byte   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	SenderKey  string   `protobuf:"bytes,2,opt,name=sender_key,json=senderKey,proto3" json:"sender_key,omitempty"`
-	SessionIds []string `protobuf:"bytes,3,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
-	// deviceKey: per device ciphertext of encrypted session keys that match session_ids
-	Ciphertexts map[string]string `protobuf:"bytes,4,rep,name=ciphertexts,proto3" json:"ciphertexts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ChainId         int32  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ContractAddress []byte `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TokenId         []byte `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func (x *UserInboxPayload_GroupEncryptionSessions) Reset() {
-	*x = UserInboxPayload_GroupEncryptionSessions{}
+func (x *MemberPayload_Nft) Reset() {
+	*x = MemberPayload_Nft{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5605,13 +6043,13 @@ func (x *UserInboxPayload_GroupEncryptionSessions) Reset() {
 	}
 }
 
-func (x *UserInboxPayload_GroupEncryptionSessions) String() string {
+func (x *MemberPayload_Nft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInboxPayload_GroupEncryptionSessions) ProtoMessage() {}
+func (*MemberPayload_Nft) ProtoMessage() {}
 
-func (x *UserInboxPayload_GroupEncryptionSessions) ProtoReflect() protoreflect.Message {
+func (x *MemberPayload_Nft) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5623,50 +6061,43 @@ func (x *UserInboxPayload_GroupEncryptionSessions) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInboxPayload_GroupEncryptionSessions.ProtoReflect.Descriptor instead.
-func (*UserInboxPayload_GroupEncryptionSessions) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{10, 2}
+// Deprecated: Use MemberPayload_Nft.ProtoReflect.Descriptor instead.
+func (*MemberPayload_Nft) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 4}
 }
 
-func (x *UserInboxPayload_GroupEncryptionSessions) GetStreamId() []byte {
+func (x *MemberPayload_Nft) GetChainId() int32 {
 	if x != nil {
-		return x.StreamId
+		return x.ChainId
 	}
-	return nil
+	return 0
 }
 
-func (x *UserInboxPayload_GroupEncryptionSessions) GetSenderKey() string {
+func (x *MemberPayload_Nft) GetContractAddress() []byte {
 	if x != nil {
-		return x.SenderKey
-	}
-	return ""
-}
-
-func (x *UserInboxPayload_GroupEncryptionSessions) GetSessionIds() []string {
-	if x != nil {
-		return x.SessionIds
+		return x.ContractAddress
 	}
 	return nil
 }
 
-func (x *UserInboxPayload_GroupEncryptionSessions) GetCiphertexts() map[string]string {
+func (x *MemberPayload_Nft) GetTokenId() []byte {
 	if x != nil {
-		return x.Ciphertexts
+		return x.TokenId
 	}
 	return nil
 }
 
-type UserInboxPayload_Ack struct {
+type MemberPayload_SnappedPin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeviceKey    string `protobuf:"bytes,1,opt,name=device_key,json=deviceKey,proto3" json:"device_key,omitempty"`
-	MiniblockNum int64  `protobuf:"varint,2,opt,name=miniblock_num,json=miniblockNum,proto3" json:"miniblock_num,omitempty"`
+	CreatorAddress []byte             `protobuf:"bytes,1,opt,name=creator_address,json=creatorAddress,proto3" json:"creator_address,omitempty"`
+	Pin            *MemberPayload_Pin `protobuf:"bytes,2,opt,name=pin,proto3" json:"pin,omitempty"`
 }
 
-func (x *UserInboxPayload_Ack) Reset() {
-	*x = UserInboxPayload_Ack{}
+func (x *MemberPayload_SnappedPin) Reset() {
+	*x = MemberPayload_SnappedPin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5674,13 +6105,13 @@ func (x *UserInboxPayload_Ack) Reset() {
 	}
 }
 
-func (x *UserInboxPayload_Ack) String() string {
+func (x *MemberPayload_SnappedPin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInboxPayload_Ack) ProtoMessage() {}
+func (*MemberPayload_SnappedPin) ProtoMessage() {}
 
-func (x *UserInboxPayload_Ack) ProtoReflect() protoreflect.Message {
+func (x *MemberPayload_SnappedPin) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5692,41 +6123,36 @@ func (x *UserInboxPayload_Ack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInboxPayload_Ack.ProtoReflect.Descriptor instead.
-func (*UserInboxPayload_Ack) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{10, 3}
+// Deprecated: Use MemberPayload_SnappedPin.ProtoReflect.Descriptor instead.
+func (*MemberPayload_SnappedPin) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 5}
 }
 
-func (x *UserInboxPayload_Ack) GetDeviceKey() string {
+func (x *MemberPayload_SnappedPin) GetCreatorAddress() []byte {
 	if x != nil {
-		return x.DeviceKey
+		return x.CreatorAddress
 	}
-	return ""
+	return nil
 }
 
-func (x *UserInboxPayload_Ack) GetMiniblockNum() int64 {
+func (x *MemberPayload_SnappedPin) GetPin() *MemberPayload_Pin {
 	if x != nil {
-		return x.MiniblockNum
+		return x.Pin
 	}
-	return 0
+	return nil
 }
 
-type UserInboxPayload_Snapshot_DeviceSummary struct {
+type MemberPayload_Pin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// *
-	// UpperBound = latest to device event sent from other client per deviceKey
-	// LowerBound = latest ack sent by stream owner per deviceKey
-	// on ack, if UpperBound <= LowerBound then delete this deviceKey entry from the record
-	// on ack or new session, if any device’s lower bound < N generations ago, delete the deviceKey entry from the record
-	LowerBound int64 `protobuf:"varint,1,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
-	UpperBound int64 `protobuf:"varint,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	EventId []byte       `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Event   *StreamEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *UserInboxPayload_Snapshot_DeviceSummary) Reset() {
-	*x = UserInboxPayload_Snapshot_DeviceSummary{}
+func (x *MemberPayload_Pin) Reset() {
+	*x = MemberPayload_Pin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protocol_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5734,13 +6160,13 @@ func (x *UserInboxPayload_Snapshot_DeviceSummary) Reset() {
 	}
 }
 
-func (x *UserInboxPayload_Snapshot_DeviceSummary) String() string {
+func (x *MemberPayload_Pin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInboxPayload_Snapshot_DeviceSummary) ProtoMessage() {}
+func (*MemberPayload_Pin) ProtoMessage() {}
 
-func (x *UserInboxPayload_Snapshot_DeviceSummary) ProtoReflect() protoreflect.Message {
+func (x *MemberPayload_Pin) ProtoReflect() protoreflect.Message {
 	mi := &file_protocol_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5752,54 +6178,50 @@ func (x *UserInboxPayload_Snapshot_DeviceSummary) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInboxPayload_Snapshot_DeviceSummary.ProtoReflect.Descriptor instead.
-func (*UserInboxPayload_Snapshot_DeviceSummary) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{10, 0, 0}
+// Deprecated: Use MemberPayload_Pin.ProtoReflect.Descriptor instead.
+func (*MemberPayload_Pin) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 6}
 }
 
-func (x *UserInboxPayload_Snapshot_DeviceSummary) GetLowerBound() int64 {
+func (x *MemberPayload_Pin) GetEventId() []byte {
 	if x != nil {
-		return x.LowerBound
+		return x.EventId
 	}
-	return 0
+	return nil
 }
 
-func (x *UserInboxPayload_Snapshot_DeviceSummary) GetUpperBound() int64 {
+func (x *MemberPayload_Pin) GetEvent() *StreamEvent {
 	if x != nil {
-		return x.UpperBound
+		return x.Event
 	}
-	return 0
+	return nil
 }
 
-type UserSettingsPayload_Snapshot struct {
+type MemberPayload_Unpin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// inception
-	Inception *UserSettingsPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
-	// fullyReadMarkers: sorted by stream_id
-	FullyReadMarkers []*UserSettingsPayload_FullyReadMarkers    `protobuf:"bytes,2,rep,name=fully_read_markers,json=fullyReadMarkers,proto3" json:"fully_read_markers,omitempty"`
-	UserBlocksList   []*UserSettingsPayload_Snapshot_UserBlocks `protobuf:"bytes,3,rep,name=user_blocks_list,json=userBlocksList,proto3" json:"user_blocks_list,omitempty"`
+	EventId []byte `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 }
 
-func (x *UserSettingsPayload_Snapshot) Reset() {
-	*x = UserSettingsPayload_Snapshot{}
+func (x *MemberPayload_Unpin) Reset() {
+	*x = MemberPayload_Unpin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[77]
+		mi := &file_protocol_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserSettingsPayload_Snapshot) String() string {
+func (x *MemberPayload_Unpin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSettingsPayload_Snapshot) ProtoMessage() {}
+func (*MemberPayload_Unpin) ProtoMessage() {}
 
-func (x *UserSettingsPayload_Snapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[77]
+func (x *MemberPayload_Unpin) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5810,58 +6232,45 @@ func (x *UserSettingsPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSettingsPayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*UserSettingsPayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{11, 0}
-}
-
-func (x *UserSettingsPayload_Snapshot) GetInception() *UserSettingsPayload_Inception {
-	if x != nil {
-		return x.Inception
-	}
-	return nil
-}
-
-func (x *UserSettingsPayload_Snapshot) GetFullyReadMarkers() []*UserSettingsPayload_FullyReadMarkers {
-	if x != nil {
-		return x.FullyReadMarkers
-	}
-	return nil
+// Deprecated: Use MemberPayload_Unpin.ProtoReflect.Descriptor instead.
+func (*MemberPayload_Unpin) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 7}
 }
 
-func (x *UserSettingsPayload_Snapshot) GetUserBlocksList() []*UserSettingsPayload_Snapshot_UserBlocks {
+func (x *MemberPayload_Unpin) GetEventId() []byte {
 	if x != nil {
-		return x.UserBlocksList
+		return x.EventId
 	}
 	return nil
 }
 
-type UserSettingsPayload_Inception struct {
+// Typing is sent as an ephemeral event while the member is typing a message.
+type MemberPayload_Typing struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	IsTyping bool   `protobuf:"varint,1,opt,name=is_typing,json=isTyping,proto3" json:"is_typing,omitempty"`
+	ThreadId []byte `protobuf:"bytes,2,opt,name=thread_id,json=threadId,proto3,oneof" json:"thread_id,omitempty"` // set if the member is typing a reply in a thread
 }
 
-func (x *UserSettingsPayload_Inception) Reset() {
-	*x = UserSettingsPayload_Inception{}
+func (x *MemberPayload_Typing) Reset() {
+	*x = MemberPayload_Typing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[78]
+		mi := &file_protocol_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserSettingsPayload_Inception) String() string {
+func (x *MemberPayload_Typing) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSettingsPayload_Inception) ProtoMessage() {}
+func (*MemberPayload_Typing) ProtoMessage() {}
 
-func (x *UserSettingsPayload_Inception) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[78]
+func (x *MemberPayload_Typing) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5872,50 +6281,51 @@ func (x *UserSettingsPayload_Inception) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSettingsPayload_Inception.ProtoReflect.Descriptor instead.
-func (*UserSettingsPayload_Inception) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{11, 1}
+// Deprecated: Use MemberPayload_Typing.ProtoReflect.Descriptor instead.
+func (*MemberPayload_Typing) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 8}
 }
 
-func (x *UserSettingsPayload_Inception) GetStreamId() []byte {
+func (x *MemberPayload_Typing) GetIsTyping() bool {
 	if x != nil {
-		return x.StreamId
+		return x.IsTyping
 	}
-	return nil
+	return false
 }
 
-func (x *UserSettingsPayload_Inception) GetSettings() *StreamSettings {
+func (x *MemberPayload_Typing) GetThreadId() []byte {
 	if x != nil {
-		return x.Settings
+		return x.ThreadId
 	}
 	return nil
 }
 
-type UserSettingsPayload_MarkerContent struct {
+// Presence is sent as an ephemeral event when the presence of the member changes.
+type MemberPayload_Presence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	IsOnline bool `protobuf:"varint,1,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
 }
 
-func (x *UserSettingsPayload_MarkerContent) Reset() {
-	*x = UserSettingsPayload_MarkerContent{}
+func (x *MemberPayload_Presence) Reset() {
+	*x = MemberPayload_Presence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[79]
+		mi := &file_protocol_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserSettingsPayload_MarkerContent) String() string {
+func (x *MemberPayload_Presence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSettingsPayload_MarkerContent) ProtoMessage() {}
+func (*MemberPayload_Presence) ProtoMessage() {}
 
-func (x *UserSettingsPayload_MarkerContent) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[79]
+func (x *MemberPayload_Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5926,44 +6336,50 @@ func (x *UserSettingsPayload_MarkerContent) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSettingsPayload_MarkerContent.ProtoReflect.Descriptor instead.
-func (*UserSettingsPayload_MarkerContent) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{11, 2}
+// Deprecated: Use MemberPayload_Presence.ProtoReflect.Descriptor instead.
+func (*MemberPayload_Presence) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 9}
 }
 
-func (x *UserSettingsPayload_MarkerContent) GetData() string {
+func (x *MemberPayload_Presence) GetIsOnline() bool {
 	if x != nil {
-		return x.Data
+		return x.IsOnline
 	}
-	return ""
+	return false
 }
 
-type UserSettingsPayload_FullyReadMarkers struct {
+type MemberPayload_Snapshot_Member struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId []byte                             `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Content  *UserSettingsPayload_MarkerContent `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	UserAddress   []byte                           `protobuf:"bytes,1,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	MiniblockNum  int64                            `protobuf:"varint,2,opt,name=miniblock_num,json=miniblockNum,proto3" json:"miniblock_num,omitempty"`
+	EventNum      int64                            `protobuf:"varint,3,opt,name=event_num,json=eventNum,proto3" json:"event_num,omitempty"`
+	Solicitations []*MemberPayload_KeySolicitation `protobuf:"bytes,4,rep,name=solicitations,proto3" json:"solicitations,omitempty"`
+	Username      *WrappedEncryptedData            `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	DisplayName   *WrappedEncryptedData            `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	EnsAddress    []byte                           `protobuf:"bytes,7,opt,name=ens_address,json=ensAddress,proto3" json:"ens_address,omitempty"`
+	Nft           *MemberPayload_Nft               `protobuf:"bytes,8,opt,name=nft,proto3" json:"nft,omitempty"`
 }
 
-func (x *UserSettingsPayload_FullyReadMarkers) Reset() {
-	*x = UserSettingsPayload_FullyReadMarkers{}
+func (x *MemberPayload_Snapshot_Member) Reset() {
+	*x = MemberPayload_Snapshot_Member{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[80]
+		mi := &file_protocol_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserSettingsPayload_FullyReadMarkers) String() string {
+func (x *MemberPayload_Snapshot_Member) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSettingsPayload_FullyReadMarkers) ProtoMessage() {}
+func (*MemberPayload_Snapshot_Member) ProtoMessage() {}
 
-func (x *UserSettingsPayload_FullyReadMarkers) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[80]
+func (x *MemberPayload_Snapshot_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5974,115 +6390,95 @@ func (x *UserSettingsPayload_FullyReadMarkers) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSettingsPayload_FullyReadMarkers.ProtoReflect.Descriptor instead.
-func (*UserSettingsPayload_FullyReadMarkers) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{11, 3}
+// Deprecated: Use MemberPayload_Snapshot_Member.ProtoReflect.Descriptor instead.
+func (*MemberPayload_Snapshot_Member) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4, 0, 0}
 }
 
-func (x *UserSettingsPayload_FullyReadMarkers) GetStreamId() []byte {
+func (x *MemberPayload_Snapshot_Member) GetUserAddress() []byte {
 	if x != nil {
-		return x.StreamId
+		return x.UserAddress
 	}
 	return nil
 }
 
-func (x *UserSettingsPayload_FullyReadMarkers) GetContent() *UserSettingsPayload_MarkerContent {
+func (x *MemberPayload_Snapshot_Member) GetMiniblockNum() int64 {
 	if x != nil {
-		return x.Content
+		return x.MiniblockNum
 	}
-	return nil
-}
-
-type UserSettingsPayload_UserBlock struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId    []byte `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	IsBlocked bool   `protobuf:"varint,2,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`
-	EventNum  int64  `protobuf:"varint,3,opt,name=event_num,json=eventNum,proto3" json:"event_num,omitempty"`
+	return 0
 }
 
-func (x *UserSettingsPayload_UserBlock) Reset() {
-	*x = UserSettingsPayload_UserBlock{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[81]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MemberPayload_Snapshot_Member) GetEventNum() int64 {
+	if x != nil {
+		return x.EventNum
 	}
+	return 0
 }
 
-func (x *UserSettingsPayload_UserBlock) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UserSettingsPayload_UserBlock) ProtoMessage() {}
-
-func (x *UserSettingsPayload_UserBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[81]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MemberPayload_Snapshot_Member) GetSolicitations() []*MemberPayload_KeySolicitation {
+	if x != nil {
+		return x.Solicitations
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UserSettingsPayload_UserBlock.ProtoReflect.Descriptor instead.
-func (*UserSettingsPayload_UserBlock) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{11, 4}
+	return nil
 }
 
-func (x *UserSettingsPayload_UserBlock) GetUserId() []byte {
+func (x *MemberPayload_Snapshot_Member) GetUsername() *WrappedEncryptedData {
 	if x != nil {
-		return x.UserId
+		return x.Username
 	}
 	return nil
 }
 
-func (x *UserSettingsPayload_UserBlock) GetIsBlocked() bool {
+func (x *MemberPayload_Snapshot_Member) GetDisplayName() *WrappedEncryptedData {
 	if x != nil {
-		return x.IsBlocked
+		return x.DisplayName
 	}
-	return false
+	return nil
 }
 
-func (x *UserSettingsPayload_UserBlock) GetEventNum() int64 {
+func (x *MemberPayload_Snapshot_Member) GetEnsAddress() []byte {
 	if x != nil {
-		return x.EventNum
+		return x.EnsAddress
 	}
-	return 0
+	return nil
 }
 
-// for a specific blocked user, there might be multiple block or unblock events
-type UserSettingsPayload_Snapshot_UserBlocks struct {
+func (x *MemberPayload_Snapshot_Member) GetNft() *MemberPayload_Nft {
+	if x != nil {
+		return x.Nft
+	}
+	return nil
+}
+
+type SpacePayload_Snapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId []byte                                           `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Blocks []*UserSettingsPayload_Snapshot_UserBlocks_Block `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// inception
+	Inception *SpacePayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	// channels: sorted by channel_id
+	Channels []*SpacePayload_ChannelMetadata `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
 }
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks) Reset() {
-	*x = UserSettingsPayload_Snapshot_UserBlocks{}
+func (x *SpacePayload_Snapshot) Reset() {
+	*x = SpacePayload_Snapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[82]
+		mi := &file_protocol_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks) String() string {
+func (x *SpacePayload_Snapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSettingsPayload_Snapshot_UserBlocks) ProtoMessage() {}
+func (*SpacePayload_Snapshot) ProtoMessage() {}
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[82]
+func (x *SpacePayload_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6093,51 +6489,51 @@ func (x *UserSettingsPayload_Snapshot_UserBlocks) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSettingsPayload_Snapshot_UserBlocks.ProtoReflect.Descriptor instead.
-func (*UserSettingsPayload_Snapshot_UserBlocks) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{11, 0, 0}
+// Deprecated: Use SpacePayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*SpacePayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks) GetUserId() []byte {
+func (x *SpacePayload_Snapshot) GetInception() *SpacePayload_Inception {
 	if x != nil {
-		return x.UserId
+		return x.Inception
 	}
 	return nil
 }
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks) GetBlocks() []*UserSettingsPayload_Snapshot_UserBlocks_Block {
+func (x *SpacePayload_Snapshot) GetChannels() []*SpacePayload_ChannelMetadata {
 	if x != nil {
-		return x.Blocks
+		return x.Channels
 	}
 	return nil
 }
 
-type UserSettingsPayload_Snapshot_UserBlocks_Block struct {
+type SpacePayload_Inception struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsBlocked bool  `protobuf:"varint,1,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`
-	EventNum  int64 `protobuf:"varint,2,opt,name=event_num,json=eventNum,proto3" json:"event_num,omitempty"`
+	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
 }
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) Reset() {
-	*x = UserSettingsPayload_Snapshot_UserBlocks_Block{}
+func (x *SpacePayload_Inception) Reset() {
+	*x = SpacePayload_Inception{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[83]
+		mi := &file_protocol_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) String() string {
+func (x *SpacePayload_Inception) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSettingsPayload_Snapshot_UserBlocks_Block) ProtoMessage() {}
+func (*SpacePayload_Inception) ProtoMessage() {}
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[83]
+func (x *SpacePayload_Inception) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6148,53 +6544,53 @@ func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSettingsPayload_Snapshot_UserBlocks_Block.ProtoReflect.Descriptor instead.
-func (*UserSettingsPayload_Snapshot_UserBlocks_Block) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{11, 0, 0, 0}
+// Deprecated: Use SpacePayload_Inception.ProtoReflect.Descriptor instead.
+func (*SpacePayload_Inception) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{5, 1}
 }
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) GetIsBlocked() bool {
+func (x *SpacePayload_Inception) GetStreamId() []byte {
 	if x != nil {
-		return x.IsBlocked
+		return x.StreamId
 	}
-	return false
+	return nil
 }
 
-func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) GetEventNum() int64 {
+func (x *SpacePayload_Inception) GetSettings() *StreamSettings {
 	if x != nil {
-		return x.EventNum
+		return x.Settings
 	}
-	return 0
+	return nil
 }
 
-type UserDeviceKeyPayload_Snapshot struct {
+type SpacePayload_ChannelMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// inception
-	Inception *UserDeviceKeyPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
-	// device keys for this user, unique by device_key, capped at N, most recent last
-	EncryptionDevices []*UserDeviceKeyPayload_EncryptionDevice `protobuf:"bytes,2,rep,name=encryption_devices,json=encryptionDevices,proto3" json:"encryption_devices,omitempty"`
+	Op                ChannelOp `protobuf:"varint,1,opt,name=op,proto3,enum=river.ChannelOp" json:"op,omitempty"`
+	ChannelId         []byte    `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OriginEvent       *EventRef `protobuf:"bytes,3,opt,name=origin_event,json=originEvent,proto3" json:"origin_event,omitempty"`
+	UpdatedAtEventNum int64     `protobuf:"varint,6,opt,name=updated_at_event_num,json=updatedAtEventNum,proto3" json:"updated_at_event_num,omitempty"`
 }
 
-func (x *UserDeviceKeyPayload_Snapshot) Reset() {
-	*x = UserDeviceKeyPayload_Snapshot{}
+func (x *SpacePayload_ChannelMetadata) Reset() {
+	*x = SpacePayload_ChannelMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[84]
+		mi := &file_protocol_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserDeviceKeyPayload_Snapshot) String() string {
+func (x *SpacePayload_ChannelMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserDeviceKeyPayload_Snapshot) ProtoMessage() {}
+func (*SpacePayload_ChannelMetadata) ProtoMessage() {}
 
-func (x *UserDeviceKeyPayload_Snapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[84]
+func (x *SpacePayload_ChannelMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6205,51 +6601,66 @@ func (x *UserDeviceKeyPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserDeviceKeyPayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*UserDeviceKeyPayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{12, 0}
+// Deprecated: Use SpacePayload_ChannelMetadata.ProtoReflect.Descriptor instead.
+func (*SpacePayload_ChannelMetadata) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{5, 2}
 }
 
-func (x *UserDeviceKeyPayload_Snapshot) GetInception() *UserDeviceKeyPayload_Inception {
+func (x *SpacePayload_ChannelMetadata) GetOp() ChannelOp {
 	if x != nil {
-		return x.Inception
+		return x.Op
+	}
+	return ChannelOp_CO_UNSPECIFIED
+}
+
+func (x *SpacePayload_ChannelMetadata) GetChannelId() []byte {
+	if x != nil {
+		return x.ChannelId
 	}
 	return nil
 }
 
-func (x *UserDeviceKeyPayload_Snapshot) GetEncryptionDevices() []*UserDeviceKeyPayload_EncryptionDevice {
+func (x *SpacePayload_ChannelMetadata) GetOriginEvent() *EventRef {
 	if x != nil {
-		return x.EncryptionDevices
+		return x.OriginEvent
 	}
 	return nil
 }
 
-type UserDeviceKeyPayload_Inception struct {
+func (x *SpacePayload_ChannelMetadata) GetUpdatedAtEventNum() int64 {
+	if x != nil {
+		return x.UpdatedAtEventNum
+	}
+	return 0
+}
+
+type SpacePayload_ChannelUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	Op          ChannelOp `protobuf:"varint,1,opt,name=op,proto3,enum=river.ChannelOp" json:"op,omitempty"`
+	ChannelId   []byte    `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	OriginEvent *EventRef `protobuf:"bytes,3,opt,name=origin_event,json=originEvent,proto3" json:"origin_event,omitempty"`
 }
 
-func (x *UserDeviceKeyPayload_Inception) Reset() {
-	*x = UserDeviceKeyPayload_Inception{}
+func (x *SpacePayload_ChannelUpdate) Reset() {
+	*x = SpacePayload_ChannelUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[85]
+		mi := &file_protocol_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserDeviceKeyPayload_Inception) String() string {
+func (x *SpacePayload_ChannelUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserDeviceKeyPayload_Inception) ProtoMessage() {}
+func (*SpacePayload_ChannelUpdate) ProtoMessage() {}
 
-func (x *UserDeviceKeyPayload_Inception) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[85]
+func (x *SpacePayload_ChannelUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6260,51 +6671,64 @@ func (x *UserDeviceKeyPayload_Inception) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserDeviceKeyPayload_Inception.ProtoReflect.Descriptor instead.
-func (*UserDeviceKeyPayload_Inception) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{12, 1}
+// Deprecated: Use SpacePayload_ChannelUpdate.ProtoReflect.Descriptor instead.
+func (*SpacePayload_ChannelUpdate) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{5, 3}
 }
 
-func (x *UserDeviceKeyPayload_Inception) GetStreamId() []byte {
+func (x *SpacePayload_ChannelUpdate) GetOp() ChannelOp {
 	if x != nil {
-		return x.StreamId
+		return x.Op
+	}
+	return ChannelOp_CO_UNSPECIFIED
+}
+
+func (x *SpacePayload_ChannelUpdate) GetChannelId() []byte {
+	if x != nil {
+		return x.ChannelId
 	}
 	return nil
 }
 
-func (x *UserDeviceKeyPayload_Inception) GetSettings() *StreamSettings {
+func (x *SpacePayload_ChannelUpdate) GetOriginEvent() *EventRef {
 	if x != nil {
-		return x.Settings
+		return x.OriginEvent
 	}
 	return nil
 }
 
-type UserDeviceKeyPayload_EncryptionDevice struct {
+type ChannelPayload_Snapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeviceKey   string `protobuf:"bytes,1,opt,name=device_key,json=deviceKey,proto3" json:"device_key,omitempty"`
-	FallbackKey string `protobuf:"bytes,2,opt,name=fallback_key,json=fallbackKey,proto3" json:"fallback_key,omitempty"`
+	// inception
+	Inception *ChannelPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	// latest edits of at most 100 most recently edited messages: sorted by event_id
+	Edits []*SnappedMessageEdit `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
+	// message reactions: sorted by event_id
+	Reactions []*SnappedReactions `protobuf:"bytes,3,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// thread summaries: sorted by thread_id
+	Threads []*ChannelPayload_ThreadSummary `protobuf:"bytes,4,rep,name=threads,proto3" json:"threads,omitempty"`
 }
 
-func (x *UserDeviceKeyPayload_EncryptionDevice) Reset() {
-	*x = UserDeviceKeyPayload_EncryptionDevice{}
+func (x *ChannelPayload_Snapshot) Reset() {
+	*x = ChannelPayload_Snapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[86]
+		mi := &file_protocol_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserDeviceKeyPayload_EncryptionDevice) String() string {
+func (x *ChannelPayload_Snapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserDeviceKeyPayload_EncryptionDevice) ProtoMessage() {}
+func (*ChannelPayload_Snapshot) ProtoMessage() {}
 
-func (x *UserDeviceKeyPayload_EncryptionDevice) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[86]
+func (x *ChannelPayload_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6315,50 +6739,66 @@ func (x *UserDeviceKeyPayload_EncryptionDevice) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserDeviceKeyPayload_EncryptionDevice.ProtoReflect.Descriptor instead.
-func (*UserDeviceKeyPayload_EncryptionDevice) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{12, 2}
+// Deprecated: Use ChannelPayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*ChannelPayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{6, 0}
 }
 
-func (x *UserDeviceKeyPayload_EncryptionDevice) GetDeviceKey() string {
+func (x *ChannelPayload_Snapshot) GetInception() *ChannelPayload_Inception {
 	if x != nil {
-		return x.DeviceKey
+		return x.Inception
 	}
-	return ""
+	return nil
 }
 
-func (x *UserDeviceKeyPayload_EncryptionDevice) GetFallbackKey() string {
+func (x *ChannelPayload_Snapshot) GetEdits() []*SnappedMessageEdit {
 	if x != nil {
-		return x.FallbackKey
+		return x.Edits
 	}
-	return ""
+	return nil
 }
 
-type MediaPayload_Snapshot struct {
+func (x *ChannelPayload_Snapshot) GetReactions() []*SnappedReactions {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ChannelPayload_Snapshot) GetThreads() []*ChannelPayload_ThreadSummary {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+type ChannelPayload_Inception struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Inception *MediaPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	SpaceId  []byte          `protobuf:"bytes,3,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Settings *StreamSettings `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
 }
 
-func (x *MediaPayload_Snapshot) Reset() {
-	*x = MediaPayload_Snapshot{}
+func (x *ChannelPayload_Inception) Reset() {
+	*x = ChannelPayload_Inception{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[87]
+		mi := &file_protocol_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MediaPayload_Snapshot) String() string {
+func (x *ChannelPayload_Inception) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MediaPayload_Snapshot) ProtoMessage() {}
+func (*ChannelPayload_Inception) ProtoMessage() {}
 
-func (x *MediaPayload_Snapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[87]
+func (x *ChannelPayload_Inception) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6369,48 +6809,59 @@ func (x *MediaPayload_Snapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MediaPayload_Snapshot.ProtoReflect.Descriptor instead.
-func (*MediaPayload_Snapshot) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use ChannelPayload_Inception.ProtoReflect.Descriptor instead.
+func (*ChannelPayload_Inception) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{6, 1}
 }
 
-func (x *MediaPayload_Snapshot) GetInception() *MediaPayload_Inception {
+func (x *ChannelPayload_Inception) GetStreamId() []byte {
 	if x != nil {
-		return x.Inception
+		return x.StreamId
 	}
 	return nil
 }
 
-type MediaPayload_Inception struct {
+func (x *ChannelPayload_Inception) GetSpaceId() []byte {
+	if x != nil {
+		return x.SpaceId
+	}
+	return nil
+}
+
+func (x *ChannelPayload_Inception) GetSettings() *StreamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ChannelPayload_Redaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId   []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	ChannelId  []byte          `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"`
-	ChunkCount int32           `protobuf:"varint,3,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
-	Settings   *StreamSettings `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
-	SpaceId    []byte          `protobuf:"bytes,5,opt,name=space_id,json=spaceId,proto3,oneof" json:"space_id,omitempty"`
-	UserId     []byte          `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	EventId []byte `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// hash of the thread root message event, must be set if the redacted event is a thread reply
+	ThreadId []byte `protobuf:"bytes,2,opt,name=thread_id,json=threadId,proto3,oneof" json:"thread_id,omitempty"`
 }
 
-func (x *MediaPayload_Inception) Reset() {
-	*x = MediaPayload_Inception{}
+func (x *ChannelPayload_Redaction) Reset() {
+	*x = ChannelPayload_Redaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[88]
+		mi := &file_protocol_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MediaPayload_Inception) String() string {
+func (x *ChannelPayload_Redaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MediaPayload_Inception) ProtoMessage() {}
+func (*ChannelPayload_Redaction) ProtoMessage() {}
 
-func (x *MediaPayload_Inception) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[88]
+func (x *ChannelPayload_Redaction) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6421,79 +6872,208 @@ func (x *MediaPayload_Inception) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MediaPayload_Inception.ProtoReflect.Descriptor instead.
-func (*MediaPayload_Inception) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{13, 1}
+// Deprecated: Use ChannelPayload_Redaction.ProtoReflect.Descriptor instead.
+func (*ChannelPayload_Redaction) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{6, 2}
 }
 
-func (x *MediaPayload_Inception) GetStreamId() []byte {
+func (x *ChannelPayload_Redaction) GetEventId() []byte {
 	if x != nil {
-		return x.StreamId
+		return x.EventId
 	}
 	return nil
 }
 
-func (x *MediaPayload_Inception) GetChannelId() []byte {
+func (x *ChannelPayload_Redaction) GetThreadId() []byte {
 	if x != nil {
-		return x.ChannelId
+		return x.ThreadId
 	}
 	return nil
 }
 
-func (x *MediaPayload_Inception) GetChunkCount() int32 {
+type ChannelPayload_ThreadReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hash of the thread root message event
+	ThreadId []byte         `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Message  *EncryptedData `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ChannelPayload_ThreadReply) Reset() {
+	*x = ChannelPayload_ThreadReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelPayload_ThreadReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPayload_ThreadReply) ProtoMessage() {}
+
+func (x *ChannelPayload_ThreadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPayload_ThreadReply.ProtoReflect.Descriptor instead.
+func (*ChannelPayload_ThreadReply) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{6, 3}
+}
+
+func (x *ChannelPayload_ThreadReply) GetThreadId() []byte {
 	if x != nil {
-		return x.ChunkCount
+		return x.ThreadId
+	}
+	return nil
+}
+
+func (x *ChannelPayload_ThreadReply) GetMessage() *EncryptedData {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ChannelPayload_ThreadSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hash of the thread root message event
+	ThreadId   []byte `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	ReplyCount int64  `protobuf:"varint,2,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	// not set if the last reply is redacted and there are no previous replies in the summary
+	LastReplyEventHash []byte `protobuf:"bytes,3,opt,name=last_reply_event_hash,json=lastReplyEventHash,proto3" json:"last_reply_event_hash,omitempty"`
+	LastReplyEventNum  int64  `protobuf:"varint,4,opt,name=last_reply_event_num,json=lastReplyEventNum,proto3" json:"last_reply_event_num,omitempty"`
+	// users who replied to the thread: sorted
+	Participants [][]byte `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	// up to 10 replies before the last reply in the stream order,
+	// the latest of them becomes the last reply when the last reply is redacted
+	PreviousReplies []*ChannelPayload_ThreadSummary_Reply `protobuf:"bytes,6,rep,name=previous_replies,json=previousReplies,proto3" json:"previous_replies,omitempty"`
+	// hashes of redacted replies: sorted, repeated redactions of the same reply don't change the summary
+	RedactedReplies [][]byte `protobuf:"bytes,7,rep,name=redacted_replies,json=redactedReplies,proto3" json:"redacted_replies,omitempty"`
+}
+
+func (x *ChannelPayload_ThreadSummary) Reset() {
+	*x = ChannelPayload_ThreadSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelPayload_ThreadSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPayload_ThreadSummary) ProtoMessage() {}
+
+func (x *ChannelPayload_ThreadSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPayload_ThreadSummary.ProtoReflect.Descriptor instead.
+func (*ChannelPayload_ThreadSummary) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{6, 4}
+}
+
+func (x *ChannelPayload_ThreadSummary) GetThreadId() []byte {
+	if x != nil {
+		return x.ThreadId
+	}
+	return nil
+}
+
+func (x *ChannelPayload_ThreadSummary) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
 	}
 	return 0
 }
 
-func (x *MediaPayload_Inception) GetSettings() *StreamSettings {
+func (x *ChannelPayload_ThreadSummary) GetLastReplyEventHash() []byte {
 	if x != nil {
-		return x.Settings
+		return x.LastReplyEventHash
 	}
 	return nil
 }
 
-func (x *MediaPayload_Inception) GetSpaceId() []byte {
+func (x *ChannelPayload_ThreadSummary) GetLastReplyEventNum() int64 {
 	if x != nil {
-		return x.SpaceId
+		return x.LastReplyEventNum
+	}
+	return 0
+}
+
+func (x *ChannelPayload_ThreadSummary) GetParticipants() [][]byte {
+	if x != nil {
+		return x.Participants
 	}
 	return nil
 }
 
-func (x *MediaPayload_Inception) GetUserId() []byte {
+func (x *ChannelPayload_ThreadSummary) GetPreviousReplies() []*ChannelPayload_ThreadSummary_Reply {
 	if x != nil {
-		return x.UserId
+		return x.PreviousReplies
 	}
 	return nil
 }
 
-type MediaPayload_Chunk struct {
+func (x *ChannelPayload_ThreadSummary) GetRedactedReplies() [][]byte {
+	if x != nil {
+		return x.RedactedReplies
+	}
+	return nil
+}
+
+type ChannelPayload_ThreadSummary_Reply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data       []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	ChunkIndex int32  `protobuf:"varint,2,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	EventHash []byte `protobuf:"bytes,1,opt,name=event_hash,json=eventHash,proto3" json:"event_hash,omitempty"`
+	EventNum  int64  `protobuf:"varint,2,opt,name=event_num,json=eventNum,proto3" json:"event_num,omitempty"`
 }
 
-func (x *MediaPayload_Chunk) Reset() {
-	*x = MediaPayload_Chunk{}
+func (x *ChannelPayload_ThreadSummary_Reply) Reset() {
+	*x = ChannelPayload_ThreadSummary_Reply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[89]
+		mi := &file_protocol_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MediaPayload_Chunk) String() string {
+func (x *ChannelPayload_ThreadSummary_Reply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MediaPayload_Chunk) ProtoMessage() {}
+func (*ChannelPayload_ThreadSummary_Reply) ProtoMessage() {}
 
-func (x *MediaPayload_Chunk) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[89]
+func (x *ChannelPayload_ThreadSummary_Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6504,52 +7084,54 @@ func (x *MediaPayload_Chunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MediaPayload_Chunk.ProtoReflect.Descriptor instead.
-func (*MediaPayload_Chunk) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{13, 2}
+// Deprecated: Use ChannelPayload_ThreadSummary_Reply.ProtoReflect.Descriptor instead.
+func (*ChannelPayload_ThreadSummary_Reply) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{6, 4, 0}
 }
 
-func (x *MediaPayload_Chunk) GetData() []byte {
+func (x *ChannelPayload_ThreadSummary_Reply) GetEventHash() []byte {
 	if x != nil {
-		return x.Data
+		return x.EventHash
 	}
 	return nil
 }
 
-func (x *MediaPayload_Chunk) GetChunkIndex() int32 {
+func (x *ChannelPayload_ThreadSummary_Reply) GetEventNum() int64 {
 	if x != nil {
-		return x.ChunkIndex
+		return x.EventNum
 	}
 	return 0
 }
 
-type AddEventResponse_Error struct {
+type DmChannelPayload_Snapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  Err      `protobuf:"varint,1,opt,name=code,proto3,enum=river.Err" json:"code,omitempty"`
-	Msg   string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Funcs []string `protobuf:"bytes,3,rep,name=funcs,proto3" json:"funcs,omitempty"`
+	Inception *DmChannelPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	// latest edits of at most 100 most recently edited messages: sorted by event_id
+	Edits []*SnappedMessageEdit `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
+	// message reactions: sorted by event_id
+	Reactions []*SnappedReactions `protobuf:"bytes,3,rep,name=reactions,proto3" json:"reactions,omitempty"`
 }
 
-func (x *AddEventResponse_Error) Reset() {
-	*x = AddEventResponse_Error{}
+func (x *DmChannelPayload_Snapshot) Reset() {
+	*x = DmChannelPayload_Snapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[91]
+		mi := &file_protocol_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddEventResponse_Error) String() string {
+func (x *DmChannelPayload_Snapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventResponse_Error) ProtoMessage() {}
+func (*DmChannelPayload_Snapshot) ProtoMessage() {}
 
-func (x *AddEventResponse_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[91]
+func (x *DmChannelPayload_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6560,1056 +7142,3509 @@ func (x *AddEventResponse_Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventResponse_Error.ProtoReflect.Descriptor instead.
-func (*AddEventResponse_Error) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{33, 0}
+// Deprecated: Use DmChannelPayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*DmChannelPayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{7, 0}
 }
 
-func (x *AddEventResponse_Error) GetCode() Err {
+func (x *DmChannelPayload_Snapshot) GetInception() *DmChannelPayload_Inception {
 	if x != nil {
-		return x.Code
+		return x.Inception
 	}
-	return Err_ERR_UNSPECIFIED
+	return nil
 }
 
-func (x *AddEventResponse_Error) GetMsg() string {
+func (x *DmChannelPayload_Snapshot) GetEdits() []*SnappedMessageEdit {
 	if x != nil {
-		return x.Msg
+		return x.Edits
 	}
-	return ""
+	return nil
 }
 
-func (x *AddEventResponse_Error) GetFuncs() []string {
+func (x *DmChannelPayload_Snapshot) GetReactions() []*SnappedReactions {
 	if x != nil {
-		return x.Funcs
+		return x.Reactions
 	}
 	return nil
 }
 
-var File_protocol_proto protoreflect.FileDescriptor
+type DmChannelPayload_Inception struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_protocol_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x05, 0x72, 0x69, 0x76, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5d, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x68,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xaa, 0x08, 0x0a, 0x0b, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x53, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76,
-	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x4d, 0x69, 0x6e,
-	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
-	0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x73, 0x12, 0x37, 0x0a, 0x18,
-	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15,
-	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x45, 0x70,
-	0x6f, 0x63, 0x68, 0x4d, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0e, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x65, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x40, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x50, 0x0a, 0x15, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x13, 0x75,
-	0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x54, 0x0a, 0x17, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x6a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x48, 0x00, 0x52, 0x14, 0x75, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65,
-	0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x47, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x78, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x6b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52,
-	0x10, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52,
-	0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x47, 0x0a,
-	0x12, 0x64, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x2e, 0x44, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x4a, 0x0a, 0x13, 0x67, 0x64, 0x6d, 0x5f, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x6e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x64, 0x6d, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52,
-	0x11, 0x67, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x16, 0x0a,
-	0x14, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0xa4, 0x03, 0x0a, 0x0f, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e,
-	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x2e,
-	0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x65,
-	0x76, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x01,
-	0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
-	0x10, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75,
-	0x6d, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x65, 0x76, 0x5f,
-	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x70, 0x72,
-	0x65, 0x76, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x64,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04,
-	0x6e, 0x6f, 0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xb7, 0x0e, 0x0a,
-	0x0d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x41,
-	0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x68, 0x69, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x12, 0x51, 0x0a, 0x10, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x00, 0x52, 0x0f, 0x6b, 0x65, 0x79, 0x53, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x75, 0x6c, 0x66,
-	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65,
-	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70,
-	0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
-	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x65, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6e, 0x73, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x6e, 0x66, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4e, 0x66, 0x74, 0x48, 0x00, 0x52,
-	0x03, 0x6e, 0x66, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x50, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x70,
-	0x69, 0x6e, 0x12, 0x32, 0x0a, 0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x48, 0x00, 0x52,
-	0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x1a, 0xff, 0x03, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65,
-	0x64, 0x12, 0x33, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x65, 0x64, 0x50, 0x69, 0x6e,
-	0x52, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x1a, 0xff, 0x02, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
-	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x4a, 0x0a, 0x0d, 0x73, 0x6f, 0x6c, 0x69, 0x63, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x64, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
-	0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x65, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x03,
-	0x6e, 0x66, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x4e, 0x66, 0x74, 0x52, 0x03, 0x6e, 0x66, 0x74, 0x1a, 0xc5, 0x01, 0x0a, 0x0a, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x23, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x21, 0x0a, 0x0c,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x10,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x1a, 0x98, 0x01, 0x0a, 0x0f, 0x4b, 0x65, 0x79, 0x53, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6e, 0x65, 0x77,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
-	0x73, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x1a, 0x73, 0x0a, 0x0e, 0x4b,
-	0x65, 0x79, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73,
-	0x1a, 0x66, 0x0a, 0x03, 0x4e, 0x66, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x1a, 0x61, 0x0a, 0x0a, 0x53, 0x6e, 0x61, 0x70,
-	0x70, 0x65, 0x64, 0x50, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x2a, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x2e, 0x50, 0x69, 0x6e, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x1a, 0x4a, 0x0a, 0x03, 0x50,
-	0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a,
-	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x22, 0x0a, 0x05, 0x55, 0x6e, 0x70, 0x69, 0x6e,
-	0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xd8, 0x05, 0x0a, 0x0c, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63,
-	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x88, 0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x3f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x1a, 0x5b, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0xc3, 0x01,
-	0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x20, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x52,
-	0x02, 0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x49, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08,
-	0x05, 0x10, 0x06, 0x1a, 0x90, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x10, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x0b, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
-	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x22, 0xc7, 0x03, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49,
-	0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x72, 0x65, 0x64, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x2e, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x72,
-	0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x49, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70,
+	StreamId           []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	FirstPartyAddress  []byte          `protobuf:"bytes,2,opt,name=first_party_address,json=firstPartyAddress,proto3" json:"first_party_address,omitempty"`
+	SecondPartyAddress []byte          `protobuf:"bytes,3,opt,name=second_party_address,json=secondPartyAddress,proto3" json:"second_party_address,omitempty"`
+	Settings           *StreamSettings `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *DmChannelPayload_Inception) Reset() {
+	*x = DmChannelPayload_Inception{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DmChannelPayload_Inception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DmChannelPayload_Inception) ProtoMessage() {}
+
+func (x *DmChannelPayload_Inception) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DmChannelPayload_Inception.ProtoReflect.Descriptor instead.
+func (*DmChannelPayload_Inception) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *DmChannelPayload_Inception) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *DmChannelPayload_Inception) GetFirstPartyAddress() []byte {
+	if x != nil {
+		return x.FirstPartyAddress
+	}
+	return nil
+}
+
+func (x *DmChannelPayload_Inception) GetSecondPartyAddress() []byte {
+	if x != nil {
+		return x.SecondPartyAddress
+	}
+	return nil
+}
+
+func (x *DmChannelPayload_Inception) GetSettings() *StreamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GdmChannelPayload_Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inception         *GdmChannelPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	ChannelProperties *WrappedEncryptedData        `protobuf:"bytes,2,opt,name=channel_properties,json=channelProperties,proto3" json:"channel_properties,omitempty"`
+	// latest edits of at most 100 most recently edited messages: sorted by event_id
+	Edits []*SnappedMessageEdit `protobuf:"bytes,3,rep,name=edits,proto3" json:"edits,omitempty"`
+	// message reactions: sorted by event_id
+	Reactions []*SnappedReactions `protobuf:"bytes,4,rep,name=reactions,proto3" json:"reactions,omitempty"`
+}
+
+func (x *GdmChannelPayload_Snapshot) Reset() {
+	*x = GdmChannelPayload_Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GdmChannelPayload_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GdmChannelPayload_Snapshot) ProtoMessage() {}
+
+func (x *GdmChannelPayload_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GdmChannelPayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*GdmChannelPayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *GdmChannelPayload_Snapshot) GetInception() *GdmChannelPayload_Inception {
+	if x != nil {
+		return x.Inception
+	}
+	return nil
+}
+
+func (x *GdmChannelPayload_Snapshot) GetChannelProperties() *WrappedEncryptedData {
+	if x != nil {
+		return x.ChannelProperties
+	}
+	return nil
+}
+
+func (x *GdmChannelPayload_Snapshot) GetEdits() []*SnappedMessageEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+func (x *GdmChannelPayload_Snapshot) GetReactions() []*SnappedReactions {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type GdmChannelPayload_Inception struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId          []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	ChannelProperties *EncryptedData  `protobuf:"bytes,2,opt,name=channel_properties,json=channelProperties,proto3" json:"channel_properties,omitempty"`
+	Settings          *StreamSettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GdmChannelPayload_Inception) Reset() {
+	*x = GdmChannelPayload_Inception{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GdmChannelPayload_Inception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GdmChannelPayload_Inception) ProtoMessage() {}
+
+func (x *GdmChannelPayload_Inception) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GdmChannelPayload_Inception.ProtoReflect.Descriptor instead.
+func (*GdmChannelPayload_Inception) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *GdmChannelPayload_Inception) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *GdmChannelPayload_Inception) GetChannelProperties() *EncryptedData {
+	if x != nil {
+		return x.ChannelProperties
+	}
+	return nil
+}
+
+func (x *GdmChannelPayload_Inception) GetSettings() *StreamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UserPayload_Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// inception
+	Inception *UserPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	// memberships, sorted by stream_id
+	Memberships []*UserPayload_UserMembership `protobuf:"bytes,2,rep,name=memberships,proto3" json:"memberships,omitempty"`
+}
+
+func (x *UserPayload_Snapshot) Reset() {
+	*x = UserPayload_Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserPayload_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPayload_Snapshot) ProtoMessage() {}
+
+func (x *UserPayload_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*UserPayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *UserPayload_Snapshot) GetInception() *UserPayload_Inception {
+	if x != nil {
+		return x.Inception
+	}
+	return nil
+}
+
+func (x *UserPayload_Snapshot) GetMemberships() []*UserPayload_UserMembership {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+type UserPayload_Inception struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UserPayload_Inception) Reset() {
+	*x = UserPayload_Inception{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserPayload_Inception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPayload_Inception) ProtoMessage() {}
+
+func (x *UserPayload_Inception) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPayload_Inception.ProtoReflect.Descriptor instead.
+func (*UserPayload_Inception) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *UserPayload_Inception) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *UserPayload_Inception) GetSettings() *StreamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// update own membership
+type UserPayload_UserMembership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId       []byte       `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Op             MembershipOp `protobuf:"varint,2,opt,name=op,proto3,enum=river.MembershipOp" json:"op,omitempty"`
+	Inviter        []byte       `protobuf:"bytes,3,opt,name=inviter,proto3,oneof" json:"inviter,omitempty"`
+	StreamParentId []byte       `protobuf:"bytes,4,opt,name=stream_parent_id,json=streamParentId,proto3,oneof" json:"stream_parent_id,omitempty"`
+}
+
+func (x *UserPayload_UserMembership) Reset() {
+	*x = UserPayload_UserMembership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserPayload_UserMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPayload_UserMembership) ProtoMessage() {}
+
+func (x *UserPayload_UserMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPayload_UserMembership.ProtoReflect.Descriptor instead.
+func (*UserPayload_UserMembership) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{9, 2}
+}
+
+func (x *UserPayload_UserMembership) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *UserPayload_UserMembership) GetOp() MembershipOp {
+	if x != nil {
+		return x.Op
+	}
+	return MembershipOp_SO_UNSPECIFIED
+}
+
+func (x *UserPayload_UserMembership) GetInviter() []byte {
+	if x != nil {
+		return x.Inviter
+	}
+	return nil
+}
+
+func (x *UserPayload_UserMembership) GetStreamParentId() []byte {
+	if x != nil {
+		return x.StreamParentId
+	}
+	return nil
+}
+
+// update someone else's membership
+type UserPayload_UserMembershipAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId       []byte       `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	UserId         []byte       `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Op             MembershipOp `protobuf:"varint,3,opt,name=op,proto3,enum=river.MembershipOp" json:"op,omitempty"`
+	StreamParentId []byte       `protobuf:"bytes,4,opt,name=stream_parent_id,json=streamParentId,proto3,oneof" json:"stream_parent_id,omitempty"`
+}
+
+func (x *UserPayload_UserMembershipAction) Reset() {
+	*x = UserPayload_UserMembershipAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserPayload_UserMembershipAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPayload_UserMembershipAction) ProtoMessage() {}
+
+func (x *UserPayload_UserMembershipAction) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPayload_UserMembershipAction.ProtoReflect.Descriptor instead.
+func (*UserPayload_UserMembershipAction) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{9, 3}
+}
+
+func (x *UserPayload_UserMembershipAction) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *UserPayload_UserMembershipAction) GetUserId() []byte {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserPayload_UserMembershipAction) GetOp() MembershipOp {
+	if x != nil {
+		return x.Op
+	}
+	return MembershipOp_SO_UNSPECIFIED
+}
+
+func (x *UserPayload_UserMembershipAction) GetStreamParentId() []byte {
+	if x != nil {
+		return x.StreamParentId
+	}
+	return nil
+}
+
+type UserInboxPayload_Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inception *UserInboxPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	// deviceKey: miniblockNum that the ack was snapshotted
+	DeviceSummary map[string]*UserInboxPayload_Snapshot_DeviceSummary `protobuf:"bytes,2,rep,name=device_summary,json=deviceSummary,proto3" json:"device_summary,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UserInboxPayload_Snapshot) Reset() {
+	*x = UserInboxPayload_Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInboxPayload_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInboxPayload_Snapshot) ProtoMessage() {}
+
+func (x *UserInboxPayload_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInboxPayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*UserInboxPayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *UserInboxPayload_Snapshot) GetInception() *UserInboxPayload_Inception {
+	if x != nil {
+		return x.Inception
+	}
+	return nil
+}
+
+func (x *UserInboxPayload_Snapshot) GetDeviceSummary() map[string]*UserInboxPayload_Snapshot_DeviceSummary {
+	if x != nil {
+		return x.DeviceSummary
+	}
+	return nil
+}
+
+type UserInboxPayload_Inception struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UserInboxPayload_Inception) Reset() {
+	*x = UserInboxPayload_Inception{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInboxPayload_Inception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInboxPayload_Inception) ProtoMessage() {}
+
+func (x *UserInboxPayload_Inception) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInboxPayload_Inception.ProtoReflect.Descriptor instead.
+func (*UserInboxPayload_Inception) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *UserInboxPayload_Inception) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *UserInboxPayload_Inception) GetSettings() *StreamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UserInboxPayload_GroupEncryptionSessions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId   []byte   `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	SenderKey  string   `protobuf:"bytes,2,opt,name=sender_key,json=senderKey,proto3" json:"sender_key,omitempty"`
+	SessionIds []string `protobuf:"bytes,3,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
+	// deviceKey: per device ciphertext of encrypted session keys that match session_ids
+	Ciphertexts map[string]string `protobuf:"bytes,4,rep,name=ciphertexts,proto3" json:"ciphertexts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UserInboxPayload_GroupEncryptionSessions) Reset() {
+	*x = UserInboxPayload_GroupEncryptionSessions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInboxPayload_GroupEncryptionSessions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInboxPayload_GroupEncryptionSessions) ProtoMessage() {}
+
+func (x *UserInboxPayload_GroupEncryptionSessions) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInboxPayload_GroupEncryptionSessions.ProtoReflect.Descriptor instead.
+func (*UserInboxPayload_GroupEncryptionSessions) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *UserInboxPayload_GroupEncryptionSessions) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *UserInboxPayload_GroupEncryptionSessions) GetSenderKey() string {
+	if x != nil {
+		return x.SenderKey
+	}
+	return ""
+}
+
+func (x *UserInboxPayload_GroupEncryptionSessions) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+func (x *UserInboxPayload_GroupEncryptionSessions) GetCiphertexts() map[string]string {
+	if x != nil {
+		return x.Ciphertexts
+	}
+	return nil
+}
+
+type UserInboxPayload_Ack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceKey    string `protobuf:"bytes,1,opt,name=device_key,json=deviceKey,proto3" json:"device_key,omitempty"`
+	MiniblockNum int64  `protobuf:"varint,2,opt,name=miniblock_num,json=miniblockNum,proto3" json:"miniblock_num,omitempty"`
+}
+
+func (x *UserInboxPayload_Ack) Reset() {
+	*x = UserInboxPayload_Ack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInboxPayload_Ack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInboxPayload_Ack) ProtoMessage() {}
+
+func (x *UserInboxPayload_Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInboxPayload_Ack.ProtoReflect.Descriptor instead.
+func (*UserInboxPayload_Ack) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{10, 3}
+}
+
+func (x *UserInboxPayload_Ack) GetDeviceKey() string {
+	if x != nil {
+		return x.DeviceKey
+	}
+	return ""
+}
+
+func (x *UserInboxPayload_Ack) GetMiniblockNum() int64 {
+	if x != nil {
+		return x.MiniblockNum
+	}
+	return 0
+}
+
+type UserInboxPayload_Snapshot_DeviceSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// *
+	// UpperBound = latest to device event sent from other client per deviceKey
+	// LowerBound = latest ack sent by stream owner per deviceKey
+	// on ack, if UpperBound <= LowerBound then delete this deviceKey entry from the record
+	// on ack or new session, if any device’s lower bound < N generations ago, delete the deviceKey entry from the record
+	LowerBound int64 `protobuf:"varint,1,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound int64 `protobuf:"varint,2,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+}
+
+func (x *UserInboxPayload_Snapshot_DeviceSummary) Reset() {
+	*x = UserInboxPayload_Snapshot_DeviceSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserInboxPayload_Snapshot_DeviceSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInboxPayload_Snapshot_DeviceSummary) ProtoMessage() {}
+
+func (x *UserInboxPayload_Snapshot_DeviceSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInboxPayload_Snapshot_DeviceSummary.ProtoReflect.Descriptor instead.
+func (*UserInboxPayload_Snapshot_DeviceSummary) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{10, 0, 0}
+}
+
+func (x *UserInboxPayload_Snapshot_DeviceSummary) GetLowerBound() int64 {
+	if x != nil {
+		return x.LowerBound
+	}
+	return 0
+}
+
+func (x *UserInboxPayload_Snapshot_DeviceSummary) GetUpperBound() int64 {
+	if x != nil {
+		return x.UpperBound
+	}
+	return 0
+}
+
+type UserSettingsPayload_Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// inception
+	Inception *UserSettingsPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	// fullyReadMarkers: sorted by stream_id
+	FullyReadMarkers []*UserSettingsPayload_FullyReadMarkers    `protobuf:"bytes,2,rep,name=fully_read_markers,json=fullyReadMarkers,proto3" json:"fully_read_markers,omitempty"`
+	UserBlocksList   []*UserSettingsPayload_Snapshot_UserBlocks `protobuf:"bytes,3,rep,name=user_blocks_list,json=userBlocksList,proto3" json:"user_blocks_list,omitempty"`
+}
+
+func (x *UserSettingsPayload_Snapshot) Reset() {
+	*x = UserSettingsPayload_Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSettingsPayload_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettingsPayload_Snapshot) ProtoMessage() {}
+
+func (x *UserSettingsPayload_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettingsPayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*UserSettingsPayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *UserSettingsPayload_Snapshot) GetInception() *UserSettingsPayload_Inception {
+	if x != nil {
+		return x.Inception
+	}
+	return nil
+}
+
+func (x *UserSettingsPayload_Snapshot) GetFullyReadMarkers() []*UserSettingsPayload_FullyReadMarkers {
+	if x != nil {
+		return x.FullyReadMarkers
+	}
+	return nil
+}
+
+func (x *UserSettingsPayload_Snapshot) GetUserBlocksList() []*UserSettingsPayload_Snapshot_UserBlocks {
+	if x != nil {
+		return x.UserBlocksList
+	}
+	return nil
+}
+
+type UserSettingsPayload_Inception struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UserSettingsPayload_Inception) Reset() {
+	*x = UserSettingsPayload_Inception{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSettingsPayload_Inception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettingsPayload_Inception) ProtoMessage() {}
+
+func (x *UserSettingsPayload_Inception) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettingsPayload_Inception.ProtoReflect.Descriptor instead.
+func (*UserSettingsPayload_Inception) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *UserSettingsPayload_Inception) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *UserSettingsPayload_Inception) GetSettings() *StreamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UserSettingsPayload_MarkerContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UserSettingsPayload_MarkerContent) Reset() {
+	*x = UserSettingsPayload_MarkerContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSettingsPayload_MarkerContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettingsPayload_MarkerContent) ProtoMessage() {}
+
+func (x *UserSettingsPayload_MarkerContent) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettingsPayload_MarkerContent.ProtoReflect.Descriptor instead.
+func (*UserSettingsPayload_MarkerContent) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{11, 2}
+}
+
+func (x *UserSettingsPayload_MarkerContent) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type UserSettingsPayload_FullyReadMarkers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte                             `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Content  *UserSettingsPayload_MarkerContent `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *UserSettingsPayload_FullyReadMarkers) Reset() {
+	*x = UserSettingsPayload_FullyReadMarkers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSettingsPayload_FullyReadMarkers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettingsPayload_FullyReadMarkers) ProtoMessage() {}
+
+func (x *UserSettingsPayload_FullyReadMarkers) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettingsPayload_FullyReadMarkers.ProtoReflect.Descriptor instead.
+func (*UserSettingsPayload_FullyReadMarkers) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{11, 3}
+}
+
+func (x *UserSettingsPayload_FullyReadMarkers) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *UserSettingsPayload_FullyReadMarkers) GetContent() *UserSettingsPayload_MarkerContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UserSettingsPayload_UserBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    []byte `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsBlocked bool   `protobuf:"varint,2,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`
+	EventNum  int64  `protobuf:"varint,3,opt,name=event_num,json=eventNum,proto3" json:"event_num,omitempty"`
+}
+
+func (x *UserSettingsPayload_UserBlock) Reset() {
+	*x = UserSettingsPayload_UserBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSettingsPayload_UserBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettingsPayload_UserBlock) ProtoMessage() {}
+
+func (x *UserSettingsPayload_UserBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettingsPayload_UserBlock.ProtoReflect.Descriptor instead.
+func (*UserSettingsPayload_UserBlock) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{11, 4}
+}
+
+func (x *UserSettingsPayload_UserBlock) GetUserId() []byte {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserSettingsPayload_UserBlock) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *UserSettingsPayload_UserBlock) GetEventNum() int64 {
+	if x != nil {
+		return x.EventNum
+	}
+	return 0
+}
+
+// for a specific blocked user, there might be multiple block or unblock events
+type UserSettingsPayload_Snapshot_UserBlocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId []byte                                           `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Blocks []*UserSettingsPayload_Snapshot_UserBlocks_Block `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks) Reset() {
+	*x = UserSettingsPayload_Snapshot_UserBlocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettingsPayload_Snapshot_UserBlocks) ProtoMessage() {}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettingsPayload_Snapshot_UserBlocks.ProtoReflect.Descriptor instead.
+func (*UserSettingsPayload_Snapshot_UserBlocks) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{11, 0, 0}
+}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks) GetUserId() []byte {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks) GetBlocks() []*UserSettingsPayload_Snapshot_UserBlocks_Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type UserSettingsPayload_Snapshot_UserBlocks_Block struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsBlocked bool  `protobuf:"varint,1,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`
+	EventNum  int64 `protobuf:"varint,2,opt,name=event_num,json=eventNum,proto3" json:"event_num,omitempty"`
+}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) Reset() {
+	*x = UserSettingsPayload_Snapshot_UserBlocks_Block{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettingsPayload_Snapshot_UserBlocks_Block) ProtoMessage() {}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettingsPayload_Snapshot_UserBlocks_Block.ProtoReflect.Descriptor instead.
+func (*UserSettingsPayload_Snapshot_UserBlocks_Block) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{11, 0, 0, 0}
+}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *UserSettingsPayload_Snapshot_UserBlocks_Block) GetEventNum() int64 {
+	if x != nil {
+		return x.EventNum
+	}
+	return 0
+}
+
+type UserDeviceKeyPayload_Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// inception
+	Inception *UserDeviceKeyPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+	// device keys for this user, unique by device_key, capped at N, most recent last
+	EncryptionDevices []*UserDeviceKeyPayload_EncryptionDevice `protobuf:"bytes,2,rep,name=encryption_devices,json=encryptionDevices,proto3" json:"encryption_devices,omitempty"`
+}
+
+func (x *UserDeviceKeyPayload_Snapshot) Reset() {
+	*x = UserDeviceKeyPayload_Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDeviceKeyPayload_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeviceKeyPayload_Snapshot) ProtoMessage() {}
+
+func (x *UserDeviceKeyPayload_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeviceKeyPayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*UserDeviceKeyPayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *UserDeviceKeyPayload_Snapshot) GetInception() *UserDeviceKeyPayload_Inception {
+	if x != nil {
+		return x.Inception
+	}
+	return nil
+}
+
+func (x *UserDeviceKeyPayload_Snapshot) GetEncryptionDevices() []*UserDeviceKeyPayload_EncryptionDevice {
+	if x != nil {
+		return x.EncryptionDevices
+	}
+	return nil
+}
+
+type UserDeviceKeyPayload_Inception struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Settings *StreamSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UserDeviceKeyPayload_Inception) Reset() {
+	*x = UserDeviceKeyPayload_Inception{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDeviceKeyPayload_Inception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeviceKeyPayload_Inception) ProtoMessage() {}
+
+func (x *UserDeviceKeyPayload_Inception) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeviceKeyPayload_Inception.ProtoReflect.Descriptor instead.
+func (*UserDeviceKeyPayload_Inception) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *UserDeviceKeyPayload_Inception) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *UserDeviceKeyPayload_Inception) GetSettings() *StreamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UserDeviceKeyPayload_EncryptionDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceKey   string `protobuf:"bytes,1,opt,name=device_key,json=deviceKey,proto3" json:"device_key,omitempty"`
+	FallbackKey string `protobuf:"bytes,2,opt,name=fallback_key,json=fallbackKey,proto3" json:"fallback_key,omitempty"`
+}
+
+func (x *UserDeviceKeyPayload_EncryptionDevice) Reset() {
+	*x = UserDeviceKeyPayload_EncryptionDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDeviceKeyPayload_EncryptionDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDeviceKeyPayload_EncryptionDevice) ProtoMessage() {}
+
+func (x *UserDeviceKeyPayload_EncryptionDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDeviceKeyPayload_EncryptionDevice.ProtoReflect.Descriptor instead.
+func (*UserDeviceKeyPayload_EncryptionDevice) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{12, 2}
+}
+
+func (x *UserDeviceKeyPayload_EncryptionDevice) GetDeviceKey() string {
+	if x != nil {
+		return x.DeviceKey
+	}
+	return ""
+}
+
+func (x *UserDeviceKeyPayload_EncryptionDevice) GetFallbackKey() string {
+	if x != nil {
+		return x.FallbackKey
+	}
+	return ""
+}
+
+type MediaPayload_Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inception *MediaPayload_Inception `protobuf:"bytes,1,opt,name=inception,proto3" json:"inception,omitempty"`
+}
+
+func (x *MediaPayload_Snapshot) Reset() {
+	*x = MediaPayload_Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaPayload_Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaPayload_Snapshot) ProtoMessage() {}
+
+func (x *MediaPayload_Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaPayload_Snapshot.ProtoReflect.Descriptor instead.
+func (*MediaPayload_Snapshot) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *MediaPayload_Snapshot) GetInception() *MediaPayload_Inception {
+	if x != nil {
+		return x.Inception
+	}
+	return nil
+}
+
+type MediaPayload_Inception struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId   []byte          `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	ChannelId  []byte          `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"`
+	ChunkCount int32           `protobuf:"varint,3,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	Settings   *StreamSettings `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+	SpaceId    []byte          `protobuf:"bytes,5,opt,name=space_id,json=spaceId,proto3,oneof" json:"space_id,omitempty"`
+	UserId     []byte          `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+}
+
+func (x *MediaPayload_Inception) Reset() {
+	*x = MediaPayload_Inception{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaPayload_Inception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaPayload_Inception) ProtoMessage() {}
+
+func (x *MediaPayload_Inception) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaPayload_Inception.ProtoReflect.Descriptor instead.
+func (*MediaPayload_Inception) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *MediaPayload_Inception) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *MediaPayload_Inception) GetChannelId() []byte {
+	if x != nil {
+		return x.ChannelId
+	}
+	return nil
+}
+
+func (x *MediaPayload_Inception) GetChunkCount() int32 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *MediaPayload_Inception) GetSettings() *StreamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *MediaPayload_Inception) GetSpaceId() []byte {
+	if x != nil {
+		return x.SpaceId
+	}
+	return nil
+}
+
+func (x *MediaPayload_Inception) GetUserId() []byte {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type MediaPayload_Chunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data       []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ChunkIndex int32  `protobuf:"varint,2,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+}
+
+func (x *MediaPayload_Chunk) Reset() {
+	*x = MediaPayload_Chunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MediaPayload_Chunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaPayload_Chunk) ProtoMessage() {}
+
+func (x *MediaPayload_Chunk) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaPayload_Chunk.ProtoReflect.Descriptor instead.
+func (*MediaPayload_Chunk) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{13, 2}
+}
+
+func (x *MediaPayload_Chunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MediaPayload_Chunk) GetChunkIndex() int32 {
+	if x != nil {
+		return x.ChunkIndex
+	}
+	return 0
+}
+
+type SnappedReactions_Reaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reaction string `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	// users with this reaction: sorted
+	UserAddresses [][]byte `protobuf:"bytes,2,rep,name=user_addresses,json=userAddresses,proto3" json:"user_addresses,omitempty"`
+}
+
+func (x *SnappedReactions_Reaction) Reset() {
+	*x = SnappedReactions_Reaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnappedReactions_Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnappedReactions_Reaction) ProtoMessage() {}
+
+func (x *SnappedReactions_Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnappedReactions_Reaction.ProtoReflect.Descriptor instead.
+func (*SnappedReactions_Reaction) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *SnappedReactions_Reaction) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *SnappedReactions_Reaction) GetUserAddresses() [][]byte {
+	if x != nil {
+		return x.UserAddresses
+	}
+	return nil
+}
+
+type GetStreamsResponse_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  Err      `protobuf:"varint,1,opt,name=code,proto3,enum=river.Err" json:"code,omitempty"`
+	Msg   string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Funcs []string `protobuf:"bytes,3,rep,name=funcs,proto3" json:"funcs,omitempty"`
+}
+
+func (x *GetStreamsResponse_Error) Reset() {
+	*x = GetStreamsResponse_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamsResponse_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamsResponse_Error) ProtoMessage() {}
+
+func (x *GetStreamsResponse_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamsResponse_Error.ProtoReflect.Descriptor instead.
+func (*GetStreamsResponse_Error) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *GetStreamsResponse_Error) GetCode() Err {
+	if x != nil {
+		return x.Code
+	}
+	return Err_ERR_UNSPECIFIED
+}
+
+func (x *GetStreamsResponse_Error) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetStreamsResponse_Error) GetFuncs() []string {
+	if x != nil {
+		return x.Funcs
+	}
+	return nil
+}
+
+type GetStreamsResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// all events in current minipool and cookie allowing to sync from the end of the stream
+	Stream *StreamAndCookie `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	// set if the stream could not be loaded
+	Error *GetStreamsResponse_Error `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetStreamsResponse_Result) Reset() {
+	*x = GetStreamsResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamsResponse_Result) ProtoMessage() {}
+
+func (x *GetStreamsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamsResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetStreamsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{33, 1}
+}
+
+func (x *GetStreamsResponse_Result) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *GetStreamsResponse_Result) GetStream() *StreamAndCookie {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+func (x *GetStreamsResponse_Result) GetError() *GetStreamsResponse_Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type AddEventResponse_Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  Err      `protobuf:"varint,1,opt,name=code,proto3,enum=river.Err" json:"code,omitempty"`
+	Msg   string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Funcs []string `protobuf:"bytes,3,rep,name=funcs,proto3" json:"funcs,omitempty"`
+}
+
+func (x *AddEventResponse_Error) Reset() {
+	*x = AddEventResponse_Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEventResponse_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEventResponse_Error) ProtoMessage() {}
+
+func (x *AddEventResponse_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEventResponse_Error.ProtoReflect.Descriptor instead.
+func (*AddEventResponse_Error) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *AddEventResponse_Error) GetCode() Err {
+	if x != nil {
+		return x.Code
+	}
+	return Err_ERR_UNSPECIFIED
+}
+
+func (x *AddEventResponse_Error) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *AddEventResponse_Error) GetFuncs() []string {
+	if x != nil {
+		return x.Funcs
+	}
+	return nil
+}
+
+type ValidateEventResponse_Check struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // set if the check returned an error
+}
+
+func (x *ValidateEventResponse_Check) Reset() {
+	*x = ValidateEventResponse_Check{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateEventResponse_Check) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateEventResponse_Check) ProtoMessage() {}
+
+func (x *ValidateEventResponse_Check) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateEventResponse_Check.ProtoReflect.Descriptor instead.
+func (*ValidateEventResponse_Check) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *ValidateEventResponse_Check) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ValidateEventResponse_Check) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *ValidateEventResponse_Check) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ValidateEventResponse_ChainAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind       string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	SpaceId    []byte `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	ChannelId  []byte `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Principal  []byte `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	Permission string `protobuf:"bytes,5,opt,name=permission,proto3" json:"permission,omitempty"`
+	Entitled   bool   `protobuf:"varint,6,opt,name=entitled,proto3" json:"entitled,omitempty"`
+	Error      string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"` // set if the entitlement check failed
+}
+
+func (x *ValidateEventResponse_ChainAuth) Reset() {
+	*x = ValidateEventResponse_ChainAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateEventResponse_ChainAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateEventResponse_ChainAuth) ProtoMessage() {}
+
+func (x *ValidateEventResponse_ChainAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateEventResponse_ChainAuth.ProtoReflect.Descriptor instead.
+func (*ValidateEventResponse_ChainAuth) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{48, 1}
+}
+
+func (x *ValidateEventResponse_ChainAuth) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ValidateEventResponse_ChainAuth) GetSpaceId() []byte {
+	if x != nil {
+		return x.SpaceId
+	}
+	return nil
+}
+
+func (x *ValidateEventResponse_ChainAuth) GetChannelId() []byte {
+	if x != nil {
+		return x.ChannelId
+	}
+	return nil
+}
+
+func (x *ValidateEventResponse_ChainAuth) GetPrincipal() []byte {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *ValidateEventResponse_ChainAuth) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ValidateEventResponse_ChainAuth) GetEntitled() bool {
+	if x != nil {
+		return x.Entitled
+	}
+	return false
+}
+
+func (x *ValidateEventResponse_ChainAuth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ValidateEventResponse_DerivedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte       `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Event    *StreamEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"` // only payload is set
+}
+
+func (x *ValidateEventResponse_DerivedEvent) Reset() {
+	*x = ValidateEventResponse_DerivedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateEventResponse_DerivedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateEventResponse_DerivedEvent) ProtoMessage() {}
+
+func (x *ValidateEventResponse_DerivedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateEventResponse_DerivedEvent.ProtoReflect.Descriptor instead.
+func (*ValidateEventResponse_DerivedEvent) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{48, 2}
+}
+
+func (x *ValidateEventResponse_DerivedEvent) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *ValidateEventResponse_DerivedEvent) GetEvent() *StreamEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type AddEventsRequest_Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId []byte    `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Event    *Envelope `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *AddEventsRequest_Event) Reset() {
+	*x = AddEventsRequest_Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEventsRequest_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEventsRequest_Event) ProtoMessage() {}
+
+func (x *AddEventsRequest_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEventsRequest_Event.ProtoReflect.Descriptor instead.
+func (*AddEventsRequest_Event) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *AddEventsRequest_Event) GetStreamId() []byte {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *AddEventsRequest_Event) GetEvent() *Envelope {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+var File_protocol_proto protoreflect.FileDescriptor
+
+var file_protocol_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x05, 0x72, 0x69, 0x76, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5d, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xc8, 0x08, 0x0a, 0x0b, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x53, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76,
+	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x4d, 0x69, 0x6e,
+	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
+	0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x73, 0x12, 0x37, 0x0a, 0x18,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
+	0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65,
+	0x72, 0x61, 0x6c, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x40, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48,
+	0x00, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x50,
+	0x0a, 0x15, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x13, 0x75, 0x73, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x54, 0x0a, 0x17, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x6a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00,
+	0x52, 0x14, 0x75, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x47, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x6e, 0x62, 0x6f, 0x78, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x6b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x10, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x3a, 0x0a, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x47, 0x0a, 0x12, 0x64,
+	0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x44, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x48, 0x00, 0x52, 0x10, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x4a, 0x0a, 0x13, 0x67, 0x64, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x6e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x64, 0x6d, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x11, 0x67,
+	0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x16, 0x0a, 0x14, 0x5f,
+	0x70, 0x72, 0x65, 0x76, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0xa4, 0x03, 0x0a, 0x0f, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x13,
+	0x70, 0x72, 0x65, 0x76, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x4d,
+	0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x01, 0x52, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x10, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x70, 0x72, 0x65, 0x76,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f,
+	0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xab, 0x10, 0x0a, 0x0d, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x41, 0x0a, 0x0a,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12,
+	0x51, 0x0a, 0x10, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x4b, 0x65, 0x79, 0x53, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x0f, 0x6b, 0x65, 0x79, 0x53, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0b, 0x65, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6e, 0x73, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x6e, 0x66, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4e, 0x66, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6e,
+	0x66, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x50, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x03, 0x70, 0x69, 0x6e,
+	0x12, 0x32, 0x0a, 0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x75,
+	0x6e, 0x70, 0x69, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x54, 0x79, 0x70, 0x69, 0x6e,
+	0x67, 0x48, 0x00, 0x52, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x08, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0xff, 0x03, 0x0a, 0x08, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6a, 0x6f, 0x69,
+	0x6e, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x65, 0x64, 0x50,
+	0x69, 0x6e, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x73, 0x1a, 0xff, 0x02, 0x0a, 0x06, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d,
+	0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x4a, 0x0a, 0x0d, 0x73, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x65, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a,
+	0x0a, 0x03, 0x6e, 0x66, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x4e, 0x66, 0x74, 0x52, 0x03, 0x6e, 0x66, 0x74, 0x1a, 0xc5, 0x01, 0x0a, 0x0a, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x23, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d,
+	0x0a, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x1a, 0x98, 0x01, 0x0a, 0x0f, 0x4b, 0x65, 0x79, 0x53, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6e,
+	0x65, 0x77, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x4e, 0x65, 0x77, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x1a, 0x73, 0x0a,
+	0x0e, 0x4b, 0x65, 0x79, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x73, 0x1a, 0x66, 0x0a, 0x03, 0x4e, 0x66, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x1a, 0x61, 0x0a, 0x0a, 0x53, 0x6e,
+	0x61, 0x70, 0x70, 0x65, 0x64, 0x50, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2a, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x50, 0x69, 0x6e, 0x52, 0x03, 0x70, 0x69, 0x6e, 0x1a, 0x4a, 0x0a,
+	0x03, 0x50, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x22, 0x0a, 0x05, 0x55, 0x6e, 0x70,
+	0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x1a, 0x55, 0x0a,
+	0x06, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x74, 0x79,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x54, 0x79,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x69, 0x64, 0x1a, 0x27, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x09, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xd8, 0x05, 0x0a, 0x0c, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x88, 0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x1a, 0x5b, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a,
+	0xc3, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f,
+	0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x0b, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a,
+	0x04, 0x08, 0x05, 0x10, 0x06, 0x1a, 0x90, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52,
+	0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0xf6, 0x0a, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x72, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x09, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x65, 0x64,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x04,
+	0x65, 0x64, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x46, 0x0a, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x1a, 0xf0, 0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70,
 	0x73, 0x68, 0x6f, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
 	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49,
 	0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x1a, 0x82, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x4a, 0x04, 0x08, 0x04,
-	0x10, 0x05, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x1a, 0x26, 0x0a, 0x09, 0x52, 0x65, 0x64, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x9f, 0x03, 0x0a, 0x10,
-	0x44, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x41, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x6d, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63,
-	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x05, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x6e, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x1a, 0x82, 0x01, 0x0a, 0x09, 0x49,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x1a,
+	0x56, 0x0a, 0x09, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x1a, 0x5a, 0x0a, 0x0b, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x4b, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
-	0x74, 0x12, 0x3f, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x9b, 0x03, 0x0a, 0x0d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x10, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x1a, 0x43, 0x0a, 0x05,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75,
+	0x6d, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x9d, 0x05, 0x0a,
+	0x10, 0x44, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x41, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x6d, 0x43,
 	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e,
-	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0xbd, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x2e, 0x0a,
-	0x13, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x66, 0x69, 0x72, 0x73,
-	0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a,
-	0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x73, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x99, 0x04,
-	0x0a, 0x11, 0x47, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47,
-	0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e,
-	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x12, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x11, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x1a, 0x98, 0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x40, 0x0a,
-	0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x4a, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xa0, 0x01, 0x0a, 0x09,
-	0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x09,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xec, 0x06, 0x0a, 0x0b, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3c, 0x0a, 0x09, 0x69, 0x6e, 0x63,
-	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x65, 0x64, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x65, 0x64, 0x69, 0x74,
+	0x12, 0x2d, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3a, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0xb3, 0x01, 0x0a, 0x08,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x44, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45,
+	0x64, 0x69, 0x74, 0x52, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x1a, 0xbd, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31,
+	0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x96, 0x06, 0x0a,
+	0x11, 0x47, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x64,
+	0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x11, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x04, 0x65, 0x64, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69,
+	0x74, 0x48, 0x00, 0x52, 0x04, 0x65, 0x64, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x80, 0x02, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x40, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x64, 0x6d,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x11, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xa0, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x12, 0x43, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xec, 0x06, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3c, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72,
 	0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e,
-	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x48,
+	0x00, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x12, 0x5f, 0x0a, 0x16, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x14, 0x75, 0x73,
+	0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x8b, 0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x3a, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0b, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x21, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79,
 	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x68, 0x69, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x5f, 0x0a, 0x16, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x14, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x8b, 0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x43, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x68, 0x69, 0x70, 0x73, 0x1a, 0x5b, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31,
-	0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x1a, 0xc1, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x64, 0x12, 0x23, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
-	0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x68, 0x69, 0x70, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73,
+	0x1a, 0x5b, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0xc1, 0x01,
+	0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x02, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x4f, 0x70, 0x52, 0x02,
+	0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52, 0x0e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x1a, 0xb5, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x4f,
+	0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x2d, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
 	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48,
-	0x01, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72,
-	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x1a, 0xb5, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x13, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x68, 0x69, 0x70, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x2d, 0x0a, 0x10, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xb4, 0x08, 0x0a, 0x10, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x41, 0x0a,
-	0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62,
-	0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2f, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63,
-	0x6b, 0x12, 0x6d, 0x0a, 0x19, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x17, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x1a, 0xec, 0x02, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3f, 0x0a,
-	0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62,
-	0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a,
-	0x0a, 0x0e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0x51, 0x0a, 0x0d, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x75, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x70, 0x0a,
-	0x12, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x44, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x5b, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x9a, 0x02, 0x0a,
-	0x17, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65,
+	0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x22, 0xb4, 0x08, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62,
+	0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x69, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x03,
+	0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x12, 0x6d, 0x0a,
+	0x19, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62,
+	0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x48, 0x00, 0x52, 0x17, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xec, 0x02, 0x0a,
+	0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x69, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x0e, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0x51, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75,
+	0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x1a, 0x70, 0x0a, 0x12, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x44, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62,
+	0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5b, 0x0a, 0x09, 0x49,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65,
 	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x62, 0x0a, 0x0b, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74,
-	0x65, 0x78, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x69, 0x70, 0x68,
-	0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x69,
-	0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x43, 0x69, 0x70,
-	0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x03, 0x41, 0x63, 0x6b,
-	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12,
-	0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x4e, 0x75, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
-	0xa4, 0x08, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a,
-	0x12, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x61, 0x64, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x52,
-	0x65, 0x61, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x1a, 0xbe, 0x03, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x42,
-	0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e,
-	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x12, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x79,
-	0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x10, 0x66, 0x75, 0x6c,
-	0x6c, 0x79, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x58, 0x0a,
-	0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x9a, 0x02, 0x0a, 0x17, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x62, 0x0a, 0x0b, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65,
+	0x78, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x74, 0x65, 0x78, 0x74, 0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74,
+	0x65, 0x78, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
+	0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa4, 0x08, 0x0a, 0x13,
 	0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0xb8, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x4c, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x34, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x43, 0x0a,
-	0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
-	0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e,
-	0x75, 0x6d, 0x1a, 0x5b, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08,
-	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a,
-	0x23, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x1a, 0x73, 0x0a, 0x10, 0x46, 0x75, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x61,
-	0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55,
+	0x6f, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55,
 	0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x60, 0x0a, 0x09, 0x55, 0x73, 0x65,
-	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa7, 0x04, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
-	0x45, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
-	0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63,
-	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x48,
-	0x00, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x1a, 0xac, 0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x12, 0x43, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08,
-	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a,
-	0x54, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b,
-	0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x4b, 0x65, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x22, 0x9b, 0x04, 0x0a, 0x0c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x31, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68,
-	0x75, 0x6e, 0x6b, 0x1a, 0x47, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
-	0x3b, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x86, 0x02, 0x0a,
-	0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x08,
-	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
-	0x1e, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x48, 0x01, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
-	0x48, 0x02, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09,
-	0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x1a, 0x3c, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xc3,
-	0x06, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x07, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
-	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x43, 0x0a, 0x0d, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52,
-	0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x40, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x59, 0x0a, 0x15, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x13, 0x75, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x17,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09,
+	0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x12, 0x66, 0x75, 0x6c,
+	0x6c, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x72, 0x73, 0x48, 0x00, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x61, 0x64, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x00, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0xbe, 0x03,
+	0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x6e,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x59,
+	0x0a, 0x12, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x61, 0x64,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x10, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x58, 0x0a, 0x10, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x1a, 0xb8, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x06, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x43, 0x0a, 0x05, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x1a, 0x5b,
+	0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x23, 0x0a, 0x0d, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0x73, 0x0a, 0x10, 0x46, 0x75, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x42, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x60, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0xa7, 0x04, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x45, 0x0a, 0x09, 0x69,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
 	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x14, 0x75, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x10, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x1a,
+	0xac, 0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x43, 0x0a, 0x09,
+	0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5b, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x4b, 0x65, 0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x11, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x5b,
+	0x0a, 0x09, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x54, 0x0a, 0x10, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4b, 0x65,
+	0x79, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x9b, 0x04, 0x0a,
+	0x0c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3d, 0x0a,
+	0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x1a,
+	0x47, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x69,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69,
+	0x6e, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x86, 0x02, 0x0a, 0x09, 0x49, 0x6e, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01, 0x52,
+	0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x02, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x1a, 0x3c, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42,
+	0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xc3, 0x06, 0x0a, 0x08, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0d, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x65, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x70, 0x61, 0x63, 0x65,
 	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x48, 0x00, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x50, 0x0a, 0x12, 0x64, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61,
+	0x48, 0x00, 0x52, 0x0c, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x49, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0c, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61,
 	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00,
-	0x52, 0x10, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x53, 0x0a, 0x13, 0x67, 0x64, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x48, 0x00, 0x52, 0x11, 0x67, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x6e, 0x62, 0x6f, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6d, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x62, 0x6f, 0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62,
-	0x6f, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x08, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66,
-	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
-	0x4e, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6e,
-	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x69,
-	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0xf1, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x25,
-	0x0a, 0x0c, 0x72, 0x65, 0x66, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
-	0x75, 0x6d, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x14, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e,
-	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65,
-	0x72, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e,
-	0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e,
-	0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
-	0x68, 0x22, 0xc4, 0x01, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x67, 0x65, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c,
-	0x47, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
-	0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69,
-	0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76,
-	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x4d, 0x69, 0x6e, 0x69, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x27, 0x0a, 0x06,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72,
-	0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x79,
-	0x6e, 0x63, 0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6f, 0x6b,
-	0x69, 0x65, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6f, 0x6b,
-	0x69, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d,
-	0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x73,
-	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x22, 0x31, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x45, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x69, 0x70, 0x6f,
-	0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7e, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69,
-	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x69, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d,
-	0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x69, 0x70,
-	0x6f, 0x6f, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xde, 0x01, 0x0a, 0x13,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65,
-	0x6c, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
-	0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x46, 0x0a, 0x14,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x06, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x22, 0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x22, 0x43, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x59, 0x0a,
+	0x15, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x48, 0x00, 0x52, 0x13, 0x75, 0x73, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x17, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48,
+	0x00, 0x52, 0x14, 0x75, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4b, 0x65, 0x79,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x0c,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x12,
+	0x64, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x44, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x10, 0x64, 0x6d,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x53,
+	0x0a, 0x13, 0x67, 0x64, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x47, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00,
+	0x52, 0x11, 0x67, 0x64, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x12, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x62, 0x6f,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f,
+	0x78, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x48, 0x00, 0x52, 0x10, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x62, 0x6f, 0x78, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x59, 0x0a, 0x08, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4e, 0x0a, 0x0e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3c, 0x0a,
+	0x1a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x18, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf1, 0x01, 0x0a, 0x0d,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x72, 0x65,
+	0x66, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0x7c, 0x0a, 0x14, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x82, 0x01,
+	0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x66, 0x0a, 0x12, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x0a, 0x08, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xed, 0x01,
+	0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a,
+	0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x70, 0x65, 0x64,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a,
+	0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x1a, 0x4d,
+	0x0a, 0x08, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d,
+	0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xc4, 0x01,
+	0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x47, 0x65, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c,
+	0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6d, 0x69, 0x6e,
+	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0xc8, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41,
+	0x6e, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x3b, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x63,
+	0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x0e,
+	0x6e, 0x65, 0x78, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x30,
+	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x65, 0x74, 0x22,
+	0xa6, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f,
+	0x6d, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f,
+	0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x74, 0x6f, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x4d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0x33, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x69,
+	0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7e, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e,
+	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x69, 0x70, 0x6f,
+	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e,
+	0x69, 0x70, 0x6f, 0x6f, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xde, 0x01,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x46,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x06,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x7b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
-	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x66,
-	0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73,
-	0x69, 0x76, 0x65, 0x22, 0x65, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a,
-	0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x75, 0x73, 0x22, 0x3a, 0x0a, 0x1b, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
-	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
-	0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69,
-	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x22,
-	0x71, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x22, 0x43, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x4e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0xb0, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x4f, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x1a, 0x8c, 0x01, 0x0a,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x06, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7b, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x22, 0x65, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d,
+	0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x69,
+	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x75, 0x73, 0x22,
+	0x4d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12,
-	0x25, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52,
-	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41,
-	0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x4f, 0x0a, 0x05,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x63, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x22, 0x42, 0x0a,
-	0x12, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x6f, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79,
-	0x6e, 0x63, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f,
-	0x73, 0x22, 0xc2, 0x01, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e,
-	0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63,
-	0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6f, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63,
-	0x4f, 0x70, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6f, 0x6b,
-	0x69, 0x65, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f,
-	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x6f, 0x6e, 0x67, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x79, 0x6e,
-	0x63, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x07,
-	0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x53, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
-	0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x0f, 0x50,
-	0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x12, 0x0a,
-	0x10, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x23, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x22, 0x7f, 0x0a, 0x0c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x74, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x74, 0x69, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x6b, 0x0a, 0x06, 0x53, 0x79, 0x6e, 0x63, 0x4f,
-	0x70, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x59, 0x4e, 0x43, 0x5f,
-	0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x4c,
-	0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x50,
-	0x4f, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x44, 0x4f,
-	0x57, 0x4e, 0x10, 0x05, 0x2a, 0x4c, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
-	0x69, 0x70, 0x4f, 0x70, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x4f, 0x5f, 0x49,
-	0x4e, 0x56, 0x49, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4f, 0x5f, 0x4a, 0x4f,
-	0x49, 0x4e, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x4f, 0x5f, 0x4c, 0x45, 0x41, 0x56, 0x45,
-	0x10, 0x03, 0x2a, 0x4f, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x12,
-	0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
-	0x44, 0x10, 0x04, 0x2a, 0xdd, 0x0a, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x12, 0x13, 0x0a, 0x0f, 0x45,
-	0x52, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x49,
-	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10,
-	0x03, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x58,
-	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f,
-	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41,
-	0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x50,
-	0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44,
-	0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45,
-	0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x41,
-	0x49, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
-	0x4e, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x0a,
-	0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45,
-	0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d, 0x45, 0x4e,
-	0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41,
-	0x4c, 0x10, 0x0d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42,
-	0x4c, 0x45, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4c, 0x4f, 0x53,
-	0x53, 0x10, 0x0f, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54,
-	0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x10, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x42, 0x55,
-	0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x11, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x41, 0x44,
-	0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x49, 0x44, 0x10, 0x12, 0x12, 0x1e, 0x0a, 0x1a,
-	0x42, 0x41, 0x44, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x10, 0x13, 0x12, 0x19, 0x0a, 0x15,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x53,
-	0x57, 0x49, 0x54, 0x43, 0x48, 0x10, 0x14, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x41, 0x44, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x15, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x44,
-	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45,
-	0x10, 0x16, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x41, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x46,
-	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x17, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x41, 0x44, 0x5f, 0x50,
-	0x52, 0x45, 0x56, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x41,
-	0x53, 0x48, 0x10, 0x18, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x4f, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x19, 0x12, 0x0d, 0x0a, 0x09,
-	0x42, 0x41, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x1a, 0x12, 0x12, 0x0a, 0x0e, 0x55,
-	0x53, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x10, 0x1b, 0x12,
-	0x15, 0x0a, 0x11, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x48, 0x41,
-	0x53, 0x48, 0x45, 0x53, 0x10, 0x1c, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
-	0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x1d, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x52, 0x45,
-	0x41, 0x4d, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x1e, 0x12, 0x14,
-	0x0a, 0x10, 0x42, 0x41, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x53,
-	0x49, 0x47, 0x10, 0x1f, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x41, 0x44, 0x5f, 0x50, 0x55, 0x42, 0x4c,
-	0x49, 0x43, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x20, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x44, 0x5f,
-	0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x21, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x41, 0x44,
-	0x5f, 0x48, 0x45, 0x58, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x22, 0x12, 0x12, 0x0a,
-	0x0e, 0x42, 0x41, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10,
-	0x23, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x41, 0x44, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x4f,
-	0x4f, 0x4b, 0x49, 0x45, 0x10, 0x24, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43,
-	0x41, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x25, 0x12, 0x0d, 0x0a, 0x09, 0x42,
-	0x41, 0x44, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x26, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54,
-	0x52, 0x45, 0x41, 0x4d, 0x5f, 0x4e, 0x4f, 0x5f, 0x49, 0x4e, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x27, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x41, 0x44,
-	0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x28, 0x12,
-	0x15, 0x0a, 0x11, 0x42, 0x41, 0x44, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x50, 0x4f, 0x4f, 0x4c, 0x5f,
-	0x53, 0x4c, 0x4f, 0x54, 0x10, 0x29, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x44, 0x5f, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x2a, 0x12,
-	0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54,
-	0x45, 0x10, 0x2b, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x41, 0x44, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f,
-	0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41,
-	0x54, 0x55, 0x52, 0x45, 0x10, 0x2c, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x4f,
-	0x4f, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x44, 0x10, 0x2d, 0x12, 0x10, 0x0a, 0x0c, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x2e, 0x12, 0x18, 0x0a,
-	0x14, 0x44, 0x42, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41,
-	0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x2f, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x49, 0x4e, 0x49, 0x42,
-	0x4c, 0x4f, 0x43, 0x4b, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x41,
-	0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x30, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x44, 0x5f, 0x41,
-	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x31, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x55, 0x46, 0x46,
-	0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x32, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x41, 0x44,
-	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x33, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x41, 0x44,
-	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x34, 0x12, 0x12, 0x0a, 0x0e, 0x43,
-	0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x35, 0x12,
-	0x1d, 0x0a, 0x19, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x4c, 0x49,
-	0x4e, 0x4b, 0x45, 0x44, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x53, 0x10, 0x36, 0x12, 0x1d,
-	0x0a, 0x19, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x45,
-	0x4e, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x37, 0x12, 0x18, 0x0a,
-	0x14, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x43, 0x4f, 0x4e,
-	0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x38, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x50, 0x41, 0x43, 0x45,
-	0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x39, 0x12, 0x14, 0x0a, 0x10, 0x43,
-	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10,
-	0x3a, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41,
-	0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x3b, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x49, 0x4e, 0x49,
-	0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x53, 0x10, 0x3c, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f,
-	0x4c, 0x41, 0x53, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41,
-	0x54, 0x43, 0x48, 0x10, 0x3d, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x4f, 0x57, 0x4e, 0x53, 0x54, 0x52,
-	0x45, 0x41, 0x4d, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x10, 0x3e, 0x32, 0xf6, 0x06, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x12, 0x19, 0x2e,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
-	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
-	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
-	0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e,
-	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69,
-	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x16, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
+	0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x7b,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e,
+	0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x22, 0x81, 0x02, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x4e, 0x75, 0x6d, 0x12, 0x25, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x69,
+	0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x37, 0x0a, 0x17, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x14, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x7d, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
+	0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x22, 0x8f,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x75, 0x73,
+	0x22, 0x7a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x12, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x66, 0x72, 0x6f, 0x6d,
+	0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x22, 0x92, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x75,
+	0x73, 0x22, 0x3a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x69,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x57, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x22, 0x71, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x41, 0x64,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x1a, 0x4f, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x75, 0x6e, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66,
+	0x75, 0x6e, 0x63, 0x73, 0x22, 0xd3, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x41, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
+	0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x6b, 0x0a, 0x16, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0x47, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdb, 0x07, 0x0a, 0x15, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x61,
+	0x6e, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x3a, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41,
+	0x75, 0x74, 0x68, 0x73, 0x12, 0x5d, 0x0a, 0x15, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x13,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x1b, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x17, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x63, 0x61, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x50, 0x0a, 0x0e, 0x64, 0x65, 0x72, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44,
+	0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x72,
+	0x69, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x49, 0x0a, 0x05, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xc9, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x1a, 0x55, 0x0a, 0x0c, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x1a, 0x4b, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x46, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e,
 	0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x46, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12,
-	0x19, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x1d, 0x2e, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53,
-	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53, 0x79,
-	0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a,
-	0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x6f,
-	0x6d, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x22, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0a, 0x53, 0x79,
+	0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x3a, 0x0a, 0x19, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x17, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x10,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x29, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xbd, 0x01, 0x0a, 0x12, 0x53, 0x79, 0x6e,
+	0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x52, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x12, 0x2e, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a,
+	0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0d,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x13, 0x53, 0x79, 0x6e,
+	0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x4f,
+	0x70, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x41, 0x6e, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x6e, 0x67, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x9a, 0x01,
+	0x0a, 0x16, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53, 0x79, 0x6e,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63,
+	0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x12,
+	0x2e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65,
 	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x79,
-	0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40,
+	0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x22, 0x12, 0x0a, 0x10, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63,
+	0x49, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x64, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x61, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x49, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x76, 0x65,
 	0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72,
-	0x6f, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
-	0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3b, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x2e, 0x72, 0x69,
+	0x6f, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2c, 0x0a,
+	0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69,
 	0x76, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x6e, 0x67,
-	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x06, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
+	0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0xb6, 0x01, 0x0a, 0x0b, 0x53,
+	0x79, 0x6e, 0x63, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x79,
+	0x6e, 0x63, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52,
+	0x07, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x23, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x22, 0x7f, 0x0a, 0x0c, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x74, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x74, 0x69, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x6b, 0x0a, 0x06, 0x53, 0x79, 0x6e,
+	0x63, 0x4f, 0x70, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x59, 0x4e,
+	0x43, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x59, 0x4e, 0x43,
+	0x5f, 0x50, 0x4f, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x05, 0x2a, 0x4c, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x4f, 0x70, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x4f,
+	0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x4f, 0x5f,
+	0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x4f, 0x5f, 0x4c, 0x45, 0x41,
+	0x56, 0x45, 0x10, 0x03, 0x2a, 0x4f, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f,
+	0x70, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x5f, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xf4, 0x0a, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x12, 0x13, 0x0a,
+	0x0f, 0x45, 0x52, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
+	0x54, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x5f,
+	0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x06, 0x12, 0x15, 0x0a,
+	0x11, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x10, 0x0a, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52, 0x41, 0x4e,
+	0x47, 0x45, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x4d,
+	0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x10, 0x0d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4c,
+	0x4f, 0x53, 0x53, 0x10, 0x0f, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x45,
+	0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x10, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45,
+	0x42, 0x55, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x11, 0x12, 0x11, 0x0a, 0x0d, 0x42,
+	0x41, 0x44, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x49, 0x44, 0x10, 0x12, 0x12, 0x1e,
+	0x0a, 0x1a, 0x42, 0x41, 0x44, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x10, 0x13, 0x12, 0x19,
+	0x0a, 0x15, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x53, 0x57, 0x49, 0x54, 0x43, 0x48, 0x10, 0x14, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x41, 0x44,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x15, 0x12, 0x17, 0x0a, 0x13, 0x42,
+	0x41, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x10, 0x16, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x41, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x17, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x41, 0x44,
+	0x5f, 0x50, 0x52, 0x45, 0x56, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x10, 0x18, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x4f, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x19, 0x12, 0x0d,
+	0x0a, 0x09, 0x42, 0x41, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x1a, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x10,
+	0x1b, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x42, 0x41, 0x44, 0x5f,
+	0x48, 0x41, 0x53, 0x48, 0x45, 0x53, 0x10, 0x1c, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x52, 0x45,
+	0x41, 0x4d, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x1d, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54,
+	0x52, 0x45, 0x41, 0x4d, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x1e,
+	0x12, 0x14, 0x0a, 0x10, 0x42, 0x41, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45,
+	0x5f, 0x53, 0x49, 0x47, 0x10, 0x1f, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x41, 0x44, 0x5f, 0x50, 0x55,
+	0x42, 0x4c, 0x49, 0x43, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x20, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41,
+	0x44, 0x5f, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x21, 0x12, 0x12, 0x0a, 0x0e, 0x42,
+	0x41, 0x44, 0x5f, 0x48, 0x45, 0x58, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x22, 0x12,
+	0x12, 0x0a, 0x0e, 0x42, 0x41, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x48, 0x41, 0x53,
+	0x48, 0x10, 0x23, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x41, 0x44, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x43, 0x4f, 0x4f, 0x4b, 0x49, 0x45, 0x10, 0x24, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x55, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x25, 0x12, 0x0d, 0x0a,
+	0x09, 0x42, 0x41, 0x44, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x26, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x4e, 0x4f, 0x5f, 0x49, 0x4e, 0x43, 0x45, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x27, 0x12, 0x14, 0x0a, 0x10, 0x42,
+	0x41, 0x44, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10,
+	0x28, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x41, 0x44, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x50, 0x4f, 0x4f,
+	0x4c, 0x5f, 0x53, 0x4c, 0x4f, 0x54, 0x10, 0x29, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x44, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10,
+	0x2a, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x47,
+	0x41, 0x54, 0x45, 0x10, 0x2b, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x41, 0x44, 0x5f, 0x4c, 0x49, 0x4e,
+	0x4b, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x53, 0x49, 0x47,
+	0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x2c, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x41, 0x44, 0x5f,
+	0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x5f, 0x49, 0x44, 0x10, 0x2d, 0x12, 0x10, 0x0a,
+	0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x2e, 0x12,
+	0x18, 0x0a, 0x14, 0x44, 0x42, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x2f, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x49, 0x4e,
+	0x49, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x30, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x44,
+	0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x31, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x55,
+	0x46, 0x46, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x32, 0x12, 0x0e, 0x0a, 0x0a, 0x42,
+	0x41, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x33, 0x12, 0x10, 0x0a, 0x0c, 0x42,
+	0x41, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x34, 0x12, 0x12, 0x0a,
+	0x0e, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10,
+	0x35, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x47, 0x45, 0x54, 0x5f,
+	0x4c, 0x49, 0x4e, 0x4b, 0x45, 0x44, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x53, 0x10, 0x36,
+	0x12, 0x1d, 0x0a, 0x19, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x37, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x38, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x39, 0x12, 0x14, 0x0a,
+	0x10, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45,
+	0x44, 0x10, 0x3a, 0x12, 0x15, 0x0a, 0x11, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x52,
+	0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x3b, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x49,
+	0x4e, 0x49, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x3c, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x4d, 0x49, 0x53,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x3d, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x4f, 0x57, 0x4e, 0x53,
+	0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x3e, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x49, 0x4e, 0x49, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x53, 0x5f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x44, 0x10, 0x3f, 0x32, 0xdd, 0x0a, 0x0a,
+	0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x12, 0x19, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x1e, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x69,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3b, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x09, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1b, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x50, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
+	0x6f, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x1d, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x79,
+	0x6e, 0x63, 0x12, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x22, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x79, 0x6e, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x50, 0x69, 0x6e,
+	0x67, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x12, 0x18, 0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x31, 0x5a, 0x2f,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x76, 0x65, 0x72,
 	0x2d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x72, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72,
 	0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62,
@@ -7629,7 +10664,7 @@ func file_protocol_proto_rawDescGZIP() []byte {
 }
 
 var file_protocol_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
+var file_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 127)
 var file_protocol_proto_goTypes = []interface{}{
 	(SyncOp)(0),                                      // 0: river.SyncOp
 	(MembershipOp)(0),                                // 1: river.MembershipOp
@@ -7654,81 +10689,116 @@ var file_protocol_proto_goTypes = []interface{}{
 	(*StreamSettings)(nil),                           // 20: river.StreamSettings
 	(*EncryptedData)(nil),                            // 21: river.EncryptedData
 	(*WrappedEncryptedData)(nil),                     // 22: river.WrappedEncryptedData
-	(*SyncCookie)(nil),                               // 23: river.SyncCookie
-	(*StreamAndCookie)(nil),                          // 24: river.StreamAndCookie
-	(*GetStreamExRequest)(nil),                       // 25: river.GetStreamExRequest
-	(*Minipool)(nil),                                 // 26: river.Minipool
-	(*GetStreamExResponse)(nil),                      // 27: river.GetStreamExResponse
-	(*CreateStreamRequest)(nil),                      // 28: river.CreateStreamRequest
-	(*CreateStreamResponse)(nil),                     // 29: river.CreateStreamResponse
-	(*GetStreamRequest)(nil),                         // 30: river.GetStreamRequest
-	(*GetStreamResponse)(nil),                        // 31: river.GetStreamResponse
-	(*GetMiniblocksRequest)(nil),                     // 32: river.GetMiniblocksRequest
-	(*GetMiniblocksResponse)(nil),                    // 33: river.GetMiniblocksResponse
-	(*GetLastMiniblockHashRequest)(nil),              // 34: river.GetLastMiniblockHashRequest
-	(*GetLastMiniblockHashResponse)(nil),             // 35: river.GetLastMiniblockHashResponse
-	(*AddEventRequest)(nil),                          // 36: river.AddEventRequest
-	(*AddEventResponse)(nil),                         // 37: river.AddEventResponse
-	(*SyncStreamsRequest)(nil),                       // 38: river.SyncStreamsRequest
-	(*SyncStreamsResponse)(nil),                      // 39: river.SyncStreamsResponse
-	(*AddStreamToSyncRequest)(nil),                   // 40: river.AddStreamToSyncRequest
-	(*AddStreamToSyncResponse)(nil),                  // 41: river.AddStreamToSyncResponse
-	(*RemoveStreamFromSyncRequest)(nil),              // 42: river.RemoveStreamFromSyncRequest
-	(*RemoveStreamFromSyncResponse)(nil),             // 43: river.RemoveStreamFromSyncResponse
-	(*CancelSyncRequest)(nil),                        // 44: river.CancelSyncRequest
-	(*CancelSyncResponse)(nil),                       // 45: river.CancelSyncResponse
-	(*PingSyncRequest)(nil),                          // 46: river.PingSyncRequest
-	(*PingSyncResponse)(nil),                         // 47: river.PingSyncResponse
-	(*InfoRequest)(nil),                              // 48: river.InfoRequest
-	(*InfoResponse)(nil),                             // 49: river.InfoResponse
-	(*MemberPayload_Snapshot)(nil),                   // 50: river.MemberPayload.Snapshot
-	(*MemberPayload_Membership)(nil),                 // 51: river.MemberPayload.Membership
-	(*MemberPayload_KeySolicitation)(nil),            // 52: river.MemberPayload.KeySolicitation
-	(*MemberPayload_KeyFulfillment)(nil),             // 53: river.MemberPayload.KeyFulfillment
-	(*MemberPayload_Nft)(nil),                        // 54: river.MemberPayload.Nft
-	(*MemberPayload_SnappedPin)(nil),                 // 55: river.MemberPayload.SnappedPin
-	(*MemberPayload_Pin)(nil),                        // 56: river.MemberPayload.Pin
-	(*MemberPayload_Unpin)(nil),                      // 57: river.MemberPayload.Unpin
-	(*MemberPayload_Snapshot_Member)(nil),            // 58: river.MemberPayload.Snapshot.Member
-	(*SpacePayload_Snapshot)(nil),                    // 59: river.SpacePayload.Snapshot
-	(*SpacePayload_Inception)(nil),                   // 60: river.SpacePayload.Inception
-	(*SpacePayload_ChannelMetadata)(nil),             // 61: river.SpacePayload.ChannelMetadata
-	(*SpacePayload_ChannelUpdate)(nil),               // 62: river.SpacePayload.ChannelUpdate
-	(*ChannelPayload_Snapshot)(nil),                  // 63: river.ChannelPayload.Snapshot
-	(*ChannelPayload_Inception)(nil),                 // 64: river.ChannelPayload.Inception
-	(*ChannelPayload_Redaction)(nil),                 // 65: river.ChannelPayload.Redaction
-	(*DmChannelPayload_Snapshot)(nil),                // 66: river.DmChannelPayload.Snapshot
-	(*DmChannelPayload_Inception)(nil),               // 67: river.DmChannelPayload.Inception
-	(*GdmChannelPayload_Snapshot)(nil),               // 68: river.GdmChannelPayload.Snapshot
-	(*GdmChannelPayload_Inception)(nil),              // 69: river.GdmChannelPayload.Inception
-	(*UserPayload_Snapshot)(nil),                     // 70: river.UserPayload.Snapshot
-	(*UserPayload_Inception)(nil),                    // 71: river.UserPayload.Inception
-	(*UserPayload_UserMembership)(nil),               // 72: river.UserPayload.UserMembership
-	(*UserPayload_UserMembershipAction)(nil),         // 73: river.UserPayload.UserMembershipAction
-	(*UserInboxPayload_Snapshot)(nil),                // 74: river.UserInboxPayload.Snapshot
-	(*UserInboxPayload_Inception)(nil),               // 75: river.UserInboxPayload.Inception
-	(*UserInboxPayload_GroupEncryptionSessions)(nil), // 76: river.UserInboxPayload.GroupEncryptionSessions
-	(*UserInboxPayload_Ack)(nil),                     // 77: river.UserInboxPayload.Ack
-	(*UserInboxPayload_Snapshot_DeviceSummary)(nil),  // 78: river.UserInboxPayload.Snapshot.DeviceSummary
-	nil,                                   // 79: river.UserInboxPayload.Snapshot.DeviceSummaryEntry
-	nil,                                   // 80: river.UserInboxPayload.GroupEncryptionSessions.CiphertextsEntry
-	(*UserSettingsPayload_Snapshot)(nil),  // 81: river.UserSettingsPayload.Snapshot
-	(*UserSettingsPayload_Inception)(nil), // 82: river.UserSettingsPayload.Inception
-	(*UserSettingsPayload_MarkerContent)(nil),             // 83: river.UserSettingsPayload.MarkerContent
-	(*UserSettingsPayload_FullyReadMarkers)(nil),          // 84: river.UserSettingsPayload.FullyReadMarkers
-	(*UserSettingsPayload_UserBlock)(nil),                 // 85: river.UserSettingsPayload.UserBlock
-	(*UserSettingsPayload_Snapshot_UserBlocks)(nil),       // 86: river.UserSettingsPayload.Snapshot.UserBlocks
-	(*UserSettingsPayload_Snapshot_UserBlocks_Block)(nil), // 87: river.UserSettingsPayload.Snapshot.UserBlocks.Block
-	(*UserDeviceKeyPayload_Snapshot)(nil),                 // 88: river.UserDeviceKeyPayload.Snapshot
-	(*UserDeviceKeyPayload_Inception)(nil),                // 89: river.UserDeviceKeyPayload.Inception
-	(*UserDeviceKeyPayload_EncryptionDevice)(nil),         // 90: river.UserDeviceKeyPayload.EncryptionDevice
-	(*MediaPayload_Snapshot)(nil),                         // 91: river.MediaPayload.Snapshot
-	(*MediaPayload_Inception)(nil),                        // 92: river.MediaPayload.Inception
-	(*MediaPayload_Chunk)(nil),                            // 93: river.MediaPayload.Chunk
-	nil,                                                   // 94: river.CreateStreamRequest.MetadataEntry
-	(*AddEventResponse_Error)(nil),                        // 95: river.AddEventResponse.Error
-	(*timestamppb.Timestamp)(nil),                         // 96: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                 // 97: google.protobuf.Empty
+	(*MessageEdit)(nil),                              // 23: river.MessageEdit
+	(*SnappedMessageEdit)(nil),                       // 24: river.SnappedMessageEdit
+	(*Reaction)(nil),                                 // 25: river.Reaction
+	(*SnappedReactions)(nil),                         // 26: river.SnappedReactions
+	(*SyncCookie)(nil),                               // 27: river.SyncCookie
+	(*StreamAndCookie)(nil),                          // 28: river.StreamAndCookie
+	(*GetStreamExRequest)(nil),                       // 29: river.GetStreamExRequest
+	(*Minipool)(nil),                                 // 30: river.Minipool
+	(*GetStreamExResponse)(nil),                      // 31: river.GetStreamExResponse
+	(*CreateStreamRequest)(nil),                      // 32: river.CreateStreamRequest
+	(*CreateStreamResponse)(nil),                     // 33: river.CreateStreamResponse
+	(*GetStreamRequest)(nil),                         // 34: river.GetStreamRequest
+	(*GetStreamResponse)(nil),                        // 35: river.GetStreamResponse
+	(*GetStreamsRequest)(nil),                        // 36: river.GetStreamsRequest
+	(*GetStreamsResponse)(nil),                       // 37: river.GetStreamsResponse
+	(*GetMiniblocksRequest)(nil),                     // 38: river.GetMiniblocksRequest
+	(*GetMiniblocksResponse)(nil),                    // 39: river.GetMiniblocksResponse
+	(*GetEventRequest)(nil),                          // 40: river.GetEventRequest
+	(*GetEventResponse)(nil),                         // 41: river.GetEventResponse
+	(*GetStreamHistoryRequest)(nil),                  // 42: river.GetStreamHistoryRequest
+	(*StreamHistoryEvent)(nil),                       // 43: river.StreamHistoryEvent
+	(*GetStreamHistoryResponse)(nil),                 // 44: river.GetStreamHistoryResponse
+	(*GetThreadRequest)(nil),                         // 45: river.GetThreadRequest
+	(*GetThreadResponse)(nil),                        // 46: river.GetThreadResponse
+	(*GetLastMiniblockHashRequest)(nil),              // 47: river.GetLastMiniblockHashRequest
+	(*GetLastMiniblockHashResponse)(nil),             // 48: river.GetLastMiniblockHashResponse
+	(*AddEventRequest)(nil),                          // 49: river.AddEventRequest
+	(*AddEventResponse)(nil),                         // 50: river.AddEventResponse
+	(*ValidateEventRequest)(nil),                     // 51: river.ValidateEventRequest
+	(*ValidateEventResponse)(nil),                    // 52: river.ValidateEventResponse
+	(*AddEventsRequest)(nil),                         // 53: river.AddEventsRequest
+	(*AddEventsResponse)(nil),                        // 54: river.AddEventsResponse
+	(*SyncFilter)(nil),                               // 55: river.SyncFilter
+	(*StreamSyncFilter)(nil),                         // 56: river.StreamSyncFilter
+	(*SyncStreamsRequest)(nil),                       // 57: river.SyncStreamsRequest
+	(*SyncStreamsResponse)(nil),                      // 58: river.SyncStreamsResponse
+	(*AddStreamToSyncRequest)(nil),                   // 59: river.AddStreamToSyncRequest
+	(*AddStreamToSyncResponse)(nil),                  // 60: river.AddStreamToSyncResponse
+	(*RemoveStreamFromSyncRequest)(nil),              // 61: river.RemoveStreamFromSyncRequest
+	(*RemoveStreamFromSyncResponse)(nil),             // 62: river.RemoveStreamFromSyncResponse
+	(*CancelSyncRequest)(nil),                        // 63: river.CancelSyncRequest
+	(*CancelSyncResponse)(nil),                       // 64: river.CancelSyncResponse
+	(*PingSyncRequest)(nil),                          // 65: river.PingSyncRequest
+	(*PingSyncResponse)(nil),                         // 66: river.PingSyncResponse
+	(*ResumeSyncRequest)(nil),                        // 67: river.ResumeSyncRequest
+	(*SyncCommand)(nil),                              // 68: river.SyncCommand
+	(*SyncSession)(nil),                              // 69: river.SyncSession
+	(*InfoRequest)(nil),                              // 70: river.InfoRequest
+	(*InfoResponse)(nil),                             // 71: river.InfoResponse
+	(*MemberPayload_Snapshot)(nil),                   // 72: river.MemberPayload.Snapshot
+	(*MemberPayload_Membership)(nil),                 // 73: river.MemberPayload.Membership
+	(*MemberPayload_KeySolicitation)(nil),            // 74: river.MemberPayload.KeySolicitation
+	(*MemberPayload_KeyFulfillment)(nil),             // 75: river.MemberPayload.KeyFulfillment
+	(*MemberPayload_Nft)(nil),                        // 76: river.MemberPayload.Nft
+	(*MemberPayload_SnappedPin)(nil),                 // 77: river.MemberPayload.SnappedPin
+	(*MemberPayload_Pin)(nil),                        // 78: river.MemberPayload.Pin
+	(*MemberPayload_Unpin)(nil),                      // 79: river.MemberPayload.Unpin
+	(*MemberPayload_Typing)(nil),                     // 80: river.MemberPayload.Typing
+	(*MemberPayload_Presence)(nil),                   // 81: river.MemberPayload.Presence
+	(*MemberPayload_Snapshot_Member)(nil),            // 82: river.MemberPayload.Snapshot.Member
+	(*SpacePayload_Snapshot)(nil),                    // 83: river.SpacePayload.Snapshot
+	(*SpacePayload_Inception)(nil),                   // 84: river.SpacePayload.Inception
+	(*SpacePayload_ChannelMetadata)(nil),             // 85: river.SpacePayload.ChannelMetadata
+	(*SpacePayload_ChannelUpdate)(nil),               // 86: river.SpacePayload.ChannelUpdate
+	(*ChannelPayload_Snapshot)(nil),                  // 87: river.ChannelPayload.Snapshot
+	(*ChannelPayload_Inception)(nil),                 // 88: river.ChannelPayload.Inception
+	(*ChannelPayload_Redaction)(nil),                 // 89: river.ChannelPayload.Redaction
+	(*ChannelPayload_ThreadReply)(nil),               // 90: river.ChannelPayload.ThreadReply
+	(*ChannelPayload_ThreadSummary)(nil),             // 91: river.ChannelPayload.ThreadSummary
+	(*ChannelPayload_ThreadSummary_Reply)(nil),       // 92: river.ChannelPayload.ThreadSummary.Reply
+	(*DmChannelPayload_Snapshot)(nil),                // 93: river.DmChannelPayload.Snapshot
+	(*DmChannelPayload_Inception)(nil),               // 94: river.DmChannelPayload.Inception
+	(*GdmChannelPayload_Snapshot)(nil),               // 95: river.GdmChannelPayload.Snapshot
+	(*GdmChannelPayload_Inception)(nil),              // 96: river.GdmChannelPayload.Inception
+	(*UserPayload_Snapshot)(nil),                     // 97: river.UserPayload.Snapshot
+	(*UserPayload_Inception)(nil),                    // 98: river.UserPayload.Inception
+	(*UserPayload_UserMembership)(nil),               // 99: river.UserPayload.UserMembership
+	(*UserPayload_UserMembershipAction)(nil),         // 100: river.UserPayload.UserMembershipAction
+	(*UserInboxPayload_Snapshot)(nil),                // 101: river.UserInboxPayload.Snapshot
+	(*UserInboxPayload_Inception)(nil),               // 102: river.UserInboxPayload.Inception
+	(*UserInboxPayload_GroupEncryptionSessions)(nil), // 103: river.UserInboxPayload.GroupEncryptionSessions
+	(*UserInboxPayload_Ack)(nil),                     // 104: river.UserInboxPayload.Ack
+	(*UserInboxPayload_Snapshot_DeviceSummary)(nil),  // 105: river.UserInboxPayload.Snapshot.DeviceSummary
+	nil,                                   // 106: river.UserInboxPayload.Snapshot.DeviceSummaryEntry
+	nil,                                   // 107: river.UserInboxPayload.GroupEncryptionSessions.CiphertextsEntry
+	(*UserSettingsPayload_Snapshot)(nil),  // 108: river.UserSettingsPayload.Snapshot
+	(*UserSettingsPayload_Inception)(nil), // 109: river.UserSettingsPayload.Inception
+	(*UserSettingsPayload_MarkerContent)(nil),             // 110: river.UserSettingsPayload.MarkerContent
+	(*UserSettingsPayload_FullyReadMarkers)(nil),          // 111: river.UserSettingsPayload.FullyReadMarkers
+	(*UserSettingsPayload_UserBlock)(nil),                 // 112: river.UserSettingsPayload.UserBlock
+	(*UserSettingsPayload_Snapshot_UserBlocks)(nil),       // 113: river.UserSettingsPayload.Snapshot.UserBlocks
+	(*UserSettingsPayload_Snapshot_UserBlocks_Block)(nil), // 114: river.UserSettingsPayload.Snapshot.UserBlocks.Block
+	(*UserDeviceKeyPayload_Snapshot)(nil),                 // 115: river.UserDeviceKeyPayload.Snapshot
+	(*UserDeviceKeyPayload_Inception)(nil),                // 116: river.UserDeviceKeyPayload.Inception
+	(*UserDeviceKeyPayload_EncryptionDevice)(nil),         // 117: river.UserDeviceKeyPayload.EncryptionDevice
+	(*MediaPayload_Snapshot)(nil),                         // 118: river.MediaPayload.Snapshot
+	(*MediaPayload_Inception)(nil),                        // 119: river.MediaPayload.Inception
+	(*MediaPayload_Chunk)(nil),                            // 120: river.MediaPayload.Chunk
+	(*SnappedReactions_Reaction)(nil),                     // 121: river.SnappedReactions.Reaction
+	nil,                                                   // 122: river.CreateStreamRequest.MetadataEntry
+	(*GetStreamsResponse_Error)(nil),                      // 123: river.GetStreamsResponse.Error
+	(*GetStreamsResponse_Result)(nil),                     // 124: river.GetStreamsResponse.Result
+	(*AddEventResponse_Error)(nil),                        // 125: river.AddEventResponse.Error
+	nil,                                                   // 126: river.ValidateEventRequest.CreateStreamMetadataEntry
+	(*ValidateEventResponse_Check)(nil),                   // 127: river.ValidateEventResponse.Check
+	(*ValidateEventResponse_ChainAuth)(nil),               // 128: river.ValidateEventResponse.ChainAuth
+	(*ValidateEventResponse_DerivedEvent)(nil),            // 129: river.ValidateEventResponse.DerivedEvent
+	(*AddEventsRequest_Event)(nil),                        // 130: river.AddEventsRequest.Event
+	(*timestamppb.Timestamp)(nil),                         // 131: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                 // 132: google.protobuf.Empty
 }
 var file_protocol_proto_depIdxs = []int32{
 	5,   // 0: river.Miniblock.events:type_name -> river.Envelope
@@ -7744,144 +10814,215 @@ var file_protocol_proto_depIdxs = []int32{
 	17,  // 10: river.StreamEvent.media_payload:type_name -> river.MediaPayload
 	11,  // 11: river.StreamEvent.dm_channel_payload:type_name -> river.DmChannelPayload
 	12,  // 12: river.StreamEvent.gdm_channel_payload:type_name -> river.GdmChannelPayload
-	96,  // 13: river.MiniblockHeader.timestamp:type_name -> google.protobuf.Timestamp
+	131, // 13: river.MiniblockHeader.timestamp:type_name -> google.protobuf.Timestamp
 	18,  // 14: river.MiniblockHeader.snapshot:type_name -> river.Snapshot
-	97,  // 15: river.MiniblockHeader.none:type_name -> google.protobuf.Empty
-	51,  // 16: river.MemberPayload.membership:type_name -> river.MemberPayload.Membership
-	52,  // 17: river.MemberPayload.key_solicitation:type_name -> river.MemberPayload.KeySolicitation
-	53,  // 18: river.MemberPayload.key_fulfillment:type_name -> river.MemberPayload.KeyFulfillment
+	132, // 15: river.MiniblockHeader.none:type_name -> google.protobuf.Empty
+	73,  // 16: river.MemberPayload.membership:type_name -> river.MemberPayload.Membership
+	74,  // 17: river.MemberPayload.key_solicitation:type_name -> river.MemberPayload.KeySolicitation
+	75,  // 18: river.MemberPayload.key_fulfillment:type_name -> river.MemberPayload.KeyFulfillment
 	21,  // 19: river.MemberPayload.username:type_name -> river.EncryptedData
 	21,  // 20: river.MemberPayload.display_name:type_name -> river.EncryptedData
-	54,  // 21: river.MemberPayload.nft:type_name -> river.MemberPayload.Nft
-	56,  // 22: river.MemberPayload.pin:type_name -> river.MemberPayload.Pin
-	57,  // 23: river.MemberPayload.unpin:type_name -> river.MemberPayload.Unpin
-	60,  // 24: river.SpacePayload.inception:type_name -> river.SpacePayload.Inception
-	62,  // 25: river.SpacePayload.channel:type_name -> river.SpacePayload.ChannelUpdate
-	64,  // 26: river.ChannelPayload.inception:type_name -> river.ChannelPayload.Inception
-	21,  // 27: river.ChannelPayload.message:type_name -> river.EncryptedData
-	65,  // 28: river.ChannelPayload.redaction:type_name -> river.ChannelPayload.Redaction
-	67,  // 29: river.DmChannelPayload.inception:type_name -> river.DmChannelPayload.Inception
-	21,  // 30: river.DmChannelPayload.message:type_name -> river.EncryptedData
-	69,  // 31: river.GdmChannelPayload.inception:type_name -> river.GdmChannelPayload.Inception
-	21,  // 32: river.GdmChannelPayload.message:type_name -> river.EncryptedData
-	21,  // 33: river.GdmChannelPayload.channel_properties:type_name -> river.EncryptedData
-	71,  // 34: river.UserPayload.inception:type_name -> river.UserPayload.Inception
-	72,  // 35: river.UserPayload.user_membership:type_name -> river.UserPayload.UserMembership
-	73,  // 36: river.UserPayload.user_membership_action:type_name -> river.UserPayload.UserMembershipAction
-	75,  // 37: river.UserInboxPayload.inception:type_name -> river.UserInboxPayload.Inception
-	77,  // 38: river.UserInboxPayload.ack:type_name -> river.UserInboxPayload.Ack
-	76,  // 39: river.UserInboxPayload.group_encryption_sessions:type_name -> river.UserInboxPayload.GroupEncryptionSessions
-	82,  // 40: river.UserSettingsPayload.inception:type_name -> river.UserSettingsPayload.Inception
-	84,  // 41: river.UserSettingsPayload.fully_read_markers:type_name -> river.UserSettingsPayload.FullyReadMarkers
-	85,  // 42: river.UserSettingsPayload.user_block:type_name -> river.UserSettingsPayload.UserBlock
-	89,  // 43: river.UserDeviceKeyPayload.inception:type_name -> river.UserDeviceKeyPayload.Inception
-	90,  // 44: river.UserDeviceKeyPayload.encryption_device:type_name -> river.UserDeviceKeyPayload.EncryptionDevice
-	92,  // 45: river.MediaPayload.inception:type_name -> river.MediaPayload.Inception
-	93,  // 46: river.MediaPayload.chunk:type_name -> river.MediaPayload.Chunk
-	50,  // 47: river.Snapshot.members:type_name -> river.MemberPayload.Snapshot
-	59,  // 48: river.Snapshot.space_content:type_name -> river.SpacePayload.Snapshot
-	63,  // 49: river.Snapshot.channel_content:type_name -> river.ChannelPayload.Snapshot
-	70,  // 50: river.Snapshot.user_content:type_name -> river.UserPayload.Snapshot
-	81,  // 51: river.Snapshot.user_settings_content:type_name -> river.UserSettingsPayload.Snapshot
-	88,  // 52: river.Snapshot.user_device_key_content:type_name -> river.UserDeviceKeyPayload.Snapshot
-	91,  // 53: river.Snapshot.media_content:type_name -> river.MediaPayload.Snapshot
-	66,  // 54: river.Snapshot.dm_channel_content:type_name -> river.DmChannelPayload.Snapshot
-	68,  // 55: river.Snapshot.gdm_channel_content:type_name -> river.GdmChannelPayload.Snapshot
-	74,  // 56: river.Snapshot.user_inbox_content:type_name -> river.UserInboxPayload.Snapshot
-	21,  // 57: river.WrappedEncryptedData.data:type_name -> river.EncryptedData
-	5,   // 58: river.StreamAndCookie.events:type_name -> river.Envelope
-	23,  // 59: river.StreamAndCookie.next_sync_cookie:type_name -> river.SyncCookie
-	4,   // 60: river.StreamAndCookie.miniblocks:type_name -> river.Miniblock
-	5,   // 61: river.Minipool.events:type_name -> river.Envelope
-	4,   // 62: river.GetStreamExResponse.miniblock:type_name -> river.Miniblock
-	26,  // 63: river.GetStreamExResponse.minipool:type_name -> river.Minipool
-	5,   // 64: river.CreateStreamRequest.events:type_name -> river.Envelope
-	94,  // 65: river.CreateStreamRequest.metadata:type_name -> river.CreateStreamRequest.MetadataEntry
-	24,  // 66: river.CreateStreamResponse.stream:type_name -> river.StreamAndCookie
-	24,  // 67: river.GetStreamResponse.stream:type_name -> river.StreamAndCookie
-	4,   // 68: river.GetMiniblocksResponse.miniblocks:type_name -> river.Miniblock
-	5,   // 69: river.AddEventRequest.event:type_name -> river.Envelope
-	95,  // 70: river.AddEventResponse.error:type_name -> river.AddEventResponse.Error
-	23,  // 71: river.SyncStreamsRequest.sync_pos:type_name -> river.SyncCookie
-	0,   // 72: river.SyncStreamsResponse.sync_op:type_name -> river.SyncOp
-	24,  // 73: river.SyncStreamsResponse.stream:type_name -> river.StreamAndCookie
-	23,  // 74: river.AddStreamToSyncRequest.sync_pos:type_name -> river.SyncCookie
-	96,  // 75: river.InfoResponse.start_time:type_name -> google.protobuf.Timestamp
-	58,  // 76: river.MemberPayload.Snapshot.joined:type_name -> river.MemberPayload.Snapshot.Member
-	55,  // 77: river.MemberPayload.Snapshot.pins:type_name -> river.MemberPayload.SnappedPin
-	1,   // 78: river.MemberPayload.Membership.op:type_name -> river.MembershipOp
-	56,  // 79: river.MemberPayload.SnappedPin.pin:type_name -> river.MemberPayload.Pin
-	6,   // 80: river.MemberPayload.Pin.event:type_name -> river.StreamEvent
-	52,  // 81: river.MemberPayload.Snapshot.Member.solicitations:type_name -> river.MemberPayload.KeySolicitation
-	22,  // 82: river.MemberPayload.Snapshot.Member.username:type_name -> river.WrappedEncryptedData
-	22,  // 83: river.MemberPayload.Snapshot.Member.display_name:type_name -> river.WrappedEncryptedData
-	54,  // 84: river.MemberPayload.Snapshot.Member.nft:type_name -> river.MemberPayload.Nft
-	60,  // 85: river.SpacePayload.Snapshot.inception:type_name -> river.SpacePayload.Inception
-	61,  // 86: river.SpacePayload.Snapshot.channels:type_name -> river.SpacePayload.ChannelMetadata
-	20,  // 87: river.SpacePayload.Inception.settings:type_name -> river.StreamSettings
-	2,   // 88: river.SpacePayload.ChannelMetadata.op:type_name -> river.ChannelOp
-	19,  // 89: river.SpacePayload.ChannelMetadata.origin_event:type_name -> river.EventRef
-	2,   // 90: river.SpacePayload.ChannelUpdate.op:type_name -> river.ChannelOp
-	19,  // 91: river.SpacePayload.ChannelUpdate.origin_event:type_name -> river.EventRef
-	64,  // 92: river.ChannelPayload.Snapshot.inception:type_name -> river.ChannelPayload.Inception
-	20,  // 93: river.ChannelPayload.Inception.settings:type_name -> river.StreamSettings
-	67,  // 94: river.DmChannelPayload.Snapshot.inception:type_name -> river.DmChannelPayload.Inception
-	20,  // 95: river.DmChannelPayload.Inception.settings:type_name -> river.StreamSettings
-	69,  // 96: river.GdmChannelPayload.Snapshot.inception:type_name -> river.GdmChannelPayload.Inception
-	22,  // 97: river.GdmChannelPayload.Snapshot.channel_properties:type_name -> river.WrappedEncryptedData
-	21,  // 98: river.GdmChannelPayload.Inception.channel_properties:type_name -> river.EncryptedData
-	20,  // 99: river.GdmChannelPayload.Inception.settings:type_name -> river.StreamSettings
-	71,  // 100: river.UserPayload.Snapshot.inception:type_name -> river.UserPayload.Inception
-	72,  // 101: river.UserPayload.Snapshot.memberships:type_name -> river.UserPayload.UserMembership
-	20,  // 102: river.UserPayload.Inception.settings:type_name -> river.StreamSettings
-	1,   // 103: river.UserPayload.UserMembership.op:type_name -> river.MembershipOp
-	1,   // 104: river.UserPayload.UserMembershipAction.op:type_name -> river.MembershipOp
-	75,  // 105: river.UserInboxPayload.Snapshot.inception:type_name -> river.UserInboxPayload.Inception
-	79,  // 106: river.UserInboxPayload.Snapshot.device_summary:type_name -> river.UserInboxPayload.Snapshot.DeviceSummaryEntry
-	20,  // 107: river.UserInboxPayload.Inception.settings:type_name -> river.StreamSettings
-	80,  // 108: river.UserInboxPayload.GroupEncryptionSessions.ciphertexts:type_name -> river.UserInboxPayload.GroupEncryptionSessions.CiphertextsEntry
-	78,  // 109: river.UserInboxPayload.Snapshot.DeviceSummaryEntry.value:type_name -> river.UserInboxPayload.Snapshot.DeviceSummary
-	82,  // 110: river.UserSettingsPayload.Snapshot.inception:type_name -> river.UserSettingsPayload.Inception
-	84,  // 111: river.UserSettingsPayload.Snapshot.fully_read_markers:type_name -> river.UserSettingsPayload.FullyReadMarkers
-	86,  // 112: river.UserSettingsPayload.Snapshot.user_blocks_list:type_name -> river.UserSettingsPayload.Snapshot.UserBlocks
-	20,  // 113: river.UserSettingsPayload.Inception.settings:type_name -> river.StreamSettings
-	83,  // 114: river.UserSettingsPayload.FullyReadMarkers.content:type_name -> river.UserSettingsPayload.MarkerContent
-	87,  // 115: river.UserSettingsPayload.Snapshot.UserBlocks.blocks:type_name -> river.UserSettingsPayload.Snapshot.UserBlocks.Block
-	89,  // 116: river.UserDeviceKeyPayload.Snapshot.inception:type_name -> river.UserDeviceKeyPayload.Inception
-	90,  // 117: river.UserDeviceKeyPayload.Snapshot.encryption_devices:type_name -> river.UserDeviceKeyPayload.EncryptionDevice
-	20,  // 118: river.UserDeviceKeyPayload.Inception.settings:type_name -> river.StreamSettings
-	92,  // 119: river.MediaPayload.Snapshot.inception:type_name -> river.MediaPayload.Inception
-	20,  // 120: river.MediaPayload.Inception.settings:type_name -> river.StreamSettings
-	3,   // 121: river.AddEventResponse.Error.code:type_name -> river.Err
-	28,  // 122: river.StreamService.CreateStream:input_type -> river.CreateStreamRequest
-	30,  // 123: river.StreamService.GetStream:input_type -> river.GetStreamRequest
-	25,  // 124: river.StreamService.GetStreamEx:input_type -> river.GetStreamExRequest
-	32,  // 125: river.StreamService.GetMiniblocks:input_type -> river.GetMiniblocksRequest
-	34,  // 126: river.StreamService.GetLastMiniblockHash:input_type -> river.GetLastMiniblockHashRequest
-	36,  // 127: river.StreamService.AddEvent:input_type -> river.AddEventRequest
-	38,  // 128: river.StreamService.SyncStreams:input_type -> river.SyncStreamsRequest
-	40,  // 129: river.StreamService.AddStreamToSync:input_type -> river.AddStreamToSyncRequest
-	44,  // 130: river.StreamService.CancelSync:input_type -> river.CancelSyncRequest
-	42,  // 131: river.StreamService.RemoveStreamFromSync:input_type -> river.RemoveStreamFromSyncRequest
-	48,  // 132: river.StreamService.Info:input_type -> river.InfoRequest
-	46,  // 133: river.StreamService.PingSync:input_type -> river.PingSyncRequest
-	29,  // 134: river.StreamService.CreateStream:output_type -> river.CreateStreamResponse
-	31,  // 135: river.StreamService.GetStream:output_type -> river.GetStreamResponse
-	27,  // 136: river.StreamService.GetStreamEx:output_type -> river.GetStreamExResponse
-	33,  // 137: river.StreamService.GetMiniblocks:output_type -> river.GetMiniblocksResponse
-	35,  // 138: river.StreamService.GetLastMiniblockHash:output_type -> river.GetLastMiniblockHashResponse
-	37,  // 139: river.StreamService.AddEvent:output_type -> river.AddEventResponse
-	39,  // 140: river.StreamService.SyncStreams:output_type -> river.SyncStreamsResponse
-	41,  // 141: river.StreamService.AddStreamToSync:output_type -> river.AddStreamToSyncResponse
-	45,  // 142: river.StreamService.CancelSync:output_type -> river.CancelSyncResponse
-	43,  // 143: river.StreamService.RemoveStreamFromSync:output_type -> river.RemoveStreamFromSyncResponse
-	49,  // 144: river.StreamService.Info:output_type -> river.InfoResponse
-	47,  // 145: river.StreamService.PingSync:output_type -> river.PingSyncResponse
-	134, // [134:146] is the sub-list for method output_type
-	122, // [122:134] is the sub-list for method input_type
-	122, // [122:122] is the sub-list for extension type_name
-	122, // [122:122] is the sub-list for extension extendee
-	0,   // [0:122] is the sub-list for field type_name
+	76,  // 21: river.MemberPayload.nft:type_name -> river.MemberPayload.Nft
+	78,  // 22: river.MemberPayload.pin:type_name -> river.MemberPayload.Pin
+	79,  // 23: river.MemberPayload.unpin:type_name -> river.MemberPayload.Unpin
+	80,  // 24: river.MemberPayload.typing:type_name -> river.MemberPayload.Typing
+	81,  // 25: river.MemberPayload.presence:type_name -> river.MemberPayload.Presence
+	84,  // 26: river.SpacePayload.inception:type_name -> river.SpacePayload.Inception
+	86,  // 27: river.SpacePayload.channel:type_name -> river.SpacePayload.ChannelUpdate
+	88,  // 28: river.ChannelPayload.inception:type_name -> river.ChannelPayload.Inception
+	21,  // 29: river.ChannelPayload.message:type_name -> river.EncryptedData
+	89,  // 30: river.ChannelPayload.redaction:type_name -> river.ChannelPayload.Redaction
+	23,  // 31: river.ChannelPayload.edit:type_name -> river.MessageEdit
+	25,  // 32: river.ChannelPayload.reaction:type_name -> river.Reaction
+	25,  // 33: river.ChannelPayload.remove_reaction:type_name -> river.Reaction
+	90,  // 34: river.ChannelPayload.thread_reply:type_name -> river.ChannelPayload.ThreadReply
+	94,  // 35: river.DmChannelPayload.inception:type_name -> river.DmChannelPayload.Inception
+	21,  // 36: river.DmChannelPayload.message:type_name -> river.EncryptedData
+	23,  // 37: river.DmChannelPayload.edit:type_name -> river.MessageEdit
+	25,  // 38: river.DmChannelPayload.reaction:type_name -> river.Reaction
+	25,  // 39: river.DmChannelPayload.remove_reaction:type_name -> river.Reaction
+	96,  // 40: river.GdmChannelPayload.inception:type_name -> river.GdmChannelPayload.Inception
+	21,  // 41: river.GdmChannelPayload.message:type_name -> river.EncryptedData
+	21,  // 42: river.GdmChannelPayload.channel_properties:type_name -> river.EncryptedData
+	23,  // 43: river.GdmChannelPayload.edit:type_name -> river.MessageEdit
+	25,  // 44: river.GdmChannelPayload.reaction:type_name -> river.Reaction
+	25,  // 45: river.GdmChannelPayload.remove_reaction:type_name -> river.Reaction
+	98,  // 46: river.UserPayload.inception:type_name -> river.UserPayload.Inception
+	99,  // 47: river.UserPayload.user_membership:type_name -> river.UserPayload.UserMembership
+	100, // 48: river.UserPayload.user_membership_action:type_name -> river.UserPayload.UserMembershipAction
+	102, // 49: river.UserInboxPayload.inception:type_name -> river.UserInboxPayload.Inception
+	104, // 50: river.UserInboxPayload.ack:type_name -> river.UserInboxPayload.Ack
+	103, // 51: river.UserInboxPayload.group_encryption_sessions:type_name -> river.UserInboxPayload.GroupEncryptionSessions
+	109, // 52: river.UserSettingsPayload.inception:type_name -> river.UserSettingsPayload.Inception
+	111, // 53: river.UserSettingsPayload.fully_read_markers:type_name -> river.UserSettingsPayload.FullyReadMarkers
+	112, // 54: river.UserSettingsPayload.user_block:type_name -> river.UserSettingsPayload.UserBlock
+	116, // 55: river.UserDeviceKeyPayload.inception:type_name -> river.UserDeviceKeyPayload.Inception
+	117, // 56: river.UserDeviceKeyPayload.encryption_device:type_name -> river.UserDeviceKeyPayload.EncryptionDevice
+	119, // 57: river.MediaPayload.inception:type_name -> river.MediaPayload.Inception
+	120, // 58: river.MediaPayload.chunk:type_name -> river.MediaPayload.Chunk
+	72,  // 59: river.Snapshot.members:type_name -> river.MemberPayload.Snapshot
+	83,  // 60: river.Snapshot.space_content:type_name -> river.SpacePayload.Snapshot
+	87,  // 61: river.Snapshot.channel_content:type_name -> river.ChannelPayload.Snapshot
+	97,  // 62: river.Snapshot.user_content:type_name -> river.UserPayload.Snapshot
+	108, // 63: river.Snapshot.user_settings_content:type_name -> river.UserSettingsPayload.Snapshot
+	115, // 64: river.Snapshot.user_device_key_content:type_name -> river.UserDeviceKeyPayload.Snapshot
+	118, // 65: river.Snapshot.media_content:type_name -> river.MediaPayload.Snapshot
+	93,  // 66: river.Snapshot.dm_channel_content:type_name -> river.DmChannelPayload.Snapshot
+	95,  // 67: river.Snapshot.gdm_channel_content:type_name -> river.GdmChannelPayload.Snapshot
+	101, // 68: river.Snapshot.user_inbox_content:type_name -> river.UserInboxPayload.Snapshot
+	21,  // 69: river.WrappedEncryptedData.data:type_name -> river.EncryptedData
+	6,   // 70: river.MessageEdit.event:type_name -> river.StreamEvent
+	21,  // 71: river.MessageEdit.message:type_name -> river.EncryptedData
+	22,  // 72: river.SnappedMessageEdit.message:type_name -> river.WrappedEncryptedData
+	121, // 73: river.SnappedReactions.reactions:type_name -> river.SnappedReactions.Reaction
+	5,   // 74: river.StreamAndCookie.events:type_name -> river.Envelope
+	27,  // 75: river.StreamAndCookie.next_sync_cookie:type_name -> river.SyncCookie
+	4,   // 76: river.StreamAndCookie.miniblocks:type_name -> river.Miniblock
+	5,   // 77: river.Minipool.events:type_name -> river.Envelope
+	4,   // 78: river.GetStreamExResponse.miniblock:type_name -> river.Miniblock
+	30,  // 79: river.GetStreamExResponse.minipool:type_name -> river.Minipool
+	5,   // 80: river.CreateStreamRequest.events:type_name -> river.Envelope
+	122, // 81: river.CreateStreamRequest.metadata:type_name -> river.CreateStreamRequest.MetadataEntry
+	28,  // 82: river.CreateStreamResponse.stream:type_name -> river.StreamAndCookie
+	28,  // 83: river.GetStreamResponse.stream:type_name -> river.StreamAndCookie
+	124, // 84: river.GetStreamsResponse.results:type_name -> river.GetStreamsResponse.Result
+	4,   // 85: river.GetMiniblocksResponse.miniblocks:type_name -> river.Miniblock
+	5,   // 86: river.GetEventResponse.event:type_name -> river.Envelope
+	5,   // 87: river.StreamHistoryEvent.event:type_name -> river.Envelope
+	43,  // 88: river.GetStreamHistoryResponse.events:type_name -> river.StreamHistoryEvent
+	43,  // 89: river.GetThreadResponse.replies:type_name -> river.StreamHistoryEvent
+	5,   // 90: river.AddEventRequest.event:type_name -> river.Envelope
+	125, // 91: river.AddEventResponse.error:type_name -> river.AddEventResponse.Error
+	5,   // 92: river.ValidateEventRequest.event:type_name -> river.Envelope
+	5,   // 93: river.ValidateEventRequest.create_stream_events:type_name -> river.Envelope
+	126, // 94: river.ValidateEventRequest.create_stream_metadata:type_name -> river.ValidateEventRequest.CreateStreamMetadataEntry
+	125, // 95: river.ValidateEventResponse.error:type_name -> river.AddEventResponse.Error
+	127, // 96: river.ValidateEventResponse.checks:type_name -> river.ValidateEventResponse.Check
+	128, // 97: river.ValidateEventResponse.chain_auths:type_name -> river.ValidateEventResponse.ChainAuth
+	129, // 98: river.ValidateEventResponse.required_parent_event:type_name -> river.ValidateEventResponse.DerivedEvent
+	129, // 99: river.ValidateEventResponse.on_chain_auth_failure_event:type_name -> river.ValidateEventResponse.DerivedEvent
+	129, // 100: river.ValidateEventResponse.derived_events:type_name -> river.ValidateEventResponse.DerivedEvent
+	130, // 101: river.AddEventsRequest.events:type_name -> river.AddEventsRequest.Event
+	50,  // 102: river.AddEventsResponse.results:type_name -> river.AddEventResponse
+	55,  // 103: river.StreamSyncFilter.filter:type_name -> river.SyncFilter
+	27,  // 104: river.SyncStreamsRequest.sync_pos:type_name -> river.SyncCookie
+	55,  // 105: river.SyncStreamsRequest.filter:type_name -> river.SyncFilter
+	56,  // 106: river.SyncStreamsRequest.stream_filters:type_name -> river.StreamSyncFilter
+	0,   // 107: river.SyncStreamsResponse.sync_op:type_name -> river.SyncOp
+	28,  // 108: river.SyncStreamsResponse.stream:type_name -> river.StreamAndCookie
+	27,  // 109: river.AddStreamToSyncRequest.sync_pos:type_name -> river.SyncCookie
+	55,  // 110: river.AddStreamToSyncRequest.filter:type_name -> river.SyncFilter
+	59,  // 111: river.SyncCommand.add_stream:type_name -> river.AddStreamToSyncRequest
+	61,  // 112: river.SyncCommand.remove_stream:type_name -> river.RemoveStreamFromSyncRequest
+	65,  // 113: river.SyncCommand.ping:type_name -> river.PingSyncRequest
+	63,  // 114: river.SyncCommand.cancel:type_name -> river.CancelSyncRequest
+	27,  // 115: river.SyncSession.sync_pos:type_name -> river.SyncCookie
+	55,  // 116: river.SyncSession.filter:type_name -> river.SyncFilter
+	56,  // 117: river.SyncSession.stream_filters:type_name -> river.StreamSyncFilter
+	131, // 118: river.InfoResponse.start_time:type_name -> google.protobuf.Timestamp
+	82,  // 119: river.MemberPayload.Snapshot.joined:type_name -> river.MemberPayload.Snapshot.Member
+	77,  // 120: river.MemberPayload.Snapshot.pins:type_name -> river.MemberPayload.SnappedPin
+	1,   // 121: river.MemberPayload.Membership.op:type_name -> river.MembershipOp
+	78,  // 122: river.MemberPayload.SnappedPin.pin:type_name -> river.MemberPayload.Pin
+	6,   // 123: river.MemberPayload.Pin.event:type_name -> river.StreamEvent
+	74,  // 124: river.MemberPayload.Snapshot.Member.solicitations:type_name -> river.MemberPayload.KeySolicitation
+	22,  // 125: river.MemberPayload.Snapshot.Member.username:type_name -> river.WrappedEncryptedData
+	22,  // 126: river.MemberPayload.Snapshot.Member.display_name:type_name -> river.WrappedEncryptedData
+	76,  // 127: river.MemberPayload.Snapshot.Member.nft:type_name -> river.MemberPayload.Nft
+	84,  // 128: river.SpacePayload.Snapshot.inception:type_name -> river.SpacePayload.Inception
+	85,  // 129: river.SpacePayload.Snapshot.channels:type_name -> river.SpacePayload.ChannelMetadata
+	20,  // 130: river.SpacePayload.Inception.settings:type_name -> river.StreamSettings
+	2,   // 131: river.SpacePayload.ChannelMetadata.op:type_name -> river.ChannelOp
+	19,  // 132: river.SpacePayload.ChannelMetadata.origin_event:type_name -> river.EventRef
+	2,   // 133: river.SpacePayload.ChannelUpdate.op:type_name -> river.ChannelOp
+	19,  // 134: river.SpacePayload.ChannelUpdate.origin_event:type_name -> river.EventRef
+	88,  // 135: river.ChannelPayload.Snapshot.inception:type_name -> river.ChannelPayload.Inception
+	24,  // 136: river.ChannelPayload.Snapshot.edits:type_name -> river.SnappedMessageEdit
+	26,  // 137: river.ChannelPayload.Snapshot.reactions:type_name -> river.SnappedReactions
+	91,  // 138: river.ChannelPayload.Snapshot.threads:type_name -> river.ChannelPayload.ThreadSummary
+	20,  // 139: river.ChannelPayload.Inception.settings:type_name -> river.StreamSettings
+	21,  // 140: river.ChannelPayload.ThreadReply.message:type_name -> river.EncryptedData
+	92,  // 141: river.ChannelPayload.ThreadSummary.previous_replies:type_name -> river.ChannelPayload.ThreadSummary.Reply
+	94,  // 142: river.DmChannelPayload.Snapshot.inception:type_name -> river.DmChannelPayload.Inception
+	24,  // 143: river.DmChannelPayload.Snapshot.edits:type_name -> river.SnappedMessageEdit
+	26,  // 144: river.DmChannelPayload.Snapshot.reactions:type_name -> river.SnappedReactions
+	20,  // 145: river.DmChannelPayload.Inception.settings:type_name -> river.StreamSettings
+	96,  // 146: river.GdmChannelPayload.Snapshot.inception:type_name -> river.GdmChannelPayload.Inception
+	22,  // 147: river.GdmChannelPayload.Snapshot.channel_properties:type_name -> river.WrappedEncryptedData
+	24,  // 148: river.GdmChannelPayload.Snapshot.edits:type_name -> river.SnappedMessageEdit
+	26,  // 149: river.GdmChannelPayload.Snapshot.reactions:type_name -> river.SnappedReactions
+	21,  // 150: river.GdmChannelPayload.Inception.channel_properties:type_name -> river.EncryptedData
+	20,  // 151: river.GdmChannelPayload.Inception.settings:type_name -> river.StreamSettings
+	98,  // 152: river.UserPayload.Snapshot.inception:type_name -> river.UserPayload.Inception
+	99,  // 153: river.UserPayload.Snapshot.memberships:type_name -> river.UserPayload.UserMembership
+	20,  // 154: river.UserPayload.Inception.settings:type_name -> river.StreamSettings
+	1,   // 155: river.UserPayload.UserMembership.op:type_name -> river.MembershipOp
+	1,   // 156: river.UserPayload.UserMembershipAction.op:type_name -> river.MembershipOp
+	102, // 157: river.UserInboxPayload.Snapshot.inception:type_name -> river.UserInboxPayload.Inception
+	106, // 158: river.UserInboxPayload.Snapshot.device_summary:type_name -> river.UserInboxPayload.Snapshot.DeviceSummaryEntry
+	20,  // 159: river.UserInboxPayload.Inception.settings:type_name -> river.StreamSettings
+	107, // 160: river.UserInboxPayload.GroupEncryptionSessions.ciphertexts:type_name -> river.UserInboxPayload.GroupEncryptionSessions.CiphertextsEntry
+	105, // 161: river.UserInboxPayload.Snapshot.DeviceSummaryEntry.value:type_name -> river.UserInboxPayload.Snapshot.DeviceSummary
+	109, // 162: river.UserSettingsPayload.Snapshot.inception:type_name -> river.UserSettingsPayload.Inception
+	111, // 163: river.UserSettingsPayload.Snapshot.fully_read_markers:type_name -> river.UserSettingsPayload.FullyReadMarkers
+	113, // 164: river.UserSettingsPayload.Snapshot.user_blocks_list:type_name -> river.UserSettingsPayload.Snapshot.UserBlocks
+	20,  // 165: river.UserSettingsPayload.Inception.settings:type_name -> river.StreamSettings
+	110, // 166: river.UserSettingsPayload.FullyReadMarkers.content:type_name -> river.UserSettingsPayload.MarkerContent
+	114, // 167: river.UserSettingsPayload.Snapshot.UserBlocks.blocks:type_name -> river.UserSettingsPayload.Snapshot.UserBlocks.Block
+	116, // 168: river.UserDeviceKeyPayload.Snapshot.inception:type_name -> river.UserDeviceKeyPayload.Inception
+	117, // 169: river.UserDeviceKeyPayload.Snapshot.encryption_devices:type_name -> river.UserDeviceKeyPayload.EncryptionDevice
+	20,  // 170: river.UserDeviceKeyPayload.Inception.settings:type_name -> river.StreamSettings
+	119, // 171: river.MediaPayload.Snapshot.inception:type_name -> river.MediaPayload.Inception
+	20,  // 172: river.MediaPayload.Inception.settings:type_name -> river.StreamSettings
+	3,   // 173: river.GetStreamsResponse.Error.code:type_name -> river.Err
+	28,  // 174: river.GetStreamsResponse.Result.stream:type_name -> river.StreamAndCookie
+	123, // 175: river.GetStreamsResponse.Result.error:type_name -> river.GetStreamsResponse.Error
+	3,   // 176: river.AddEventResponse.Error.code:type_name -> river.Err
+	6,   // 177: river.ValidateEventResponse.DerivedEvent.event:type_name -> river.StreamEvent
+	5,   // 178: river.AddEventsRequest.Event.event:type_name -> river.Envelope
+	32,  // 179: river.StreamService.CreateStream:input_type -> river.CreateStreamRequest
+	34,  // 180: river.StreamService.GetStream:input_type -> river.GetStreamRequest
+	36,  // 181: river.StreamService.GetStreams:input_type -> river.GetStreamsRequest
+	29,  // 182: river.StreamService.GetStreamEx:input_type -> river.GetStreamExRequest
+	38,  // 183: river.StreamService.GetMiniblocks:input_type -> river.GetMiniblocksRequest
+	40,  // 184: river.StreamService.GetEvent:input_type -> river.GetEventRequest
+	42,  // 185: river.StreamService.GetStreamHistory:input_type -> river.GetStreamHistoryRequest
+	45,  // 186: river.StreamService.GetThread:input_type -> river.GetThreadRequest
+	47,  // 187: river.StreamService.GetLastMiniblockHash:input_type -> river.GetLastMiniblockHashRequest
+	49,  // 188: river.StreamService.AddEvent:input_type -> river.AddEventRequest
+	53,  // 189: river.StreamService.AddEvents:input_type -> river.AddEventsRequest
+	51,  // 190: river.StreamService.ValidateEvent:input_type -> river.ValidateEventRequest
+	57,  // 191: river.StreamService.SyncStreams:input_type -> river.SyncStreamsRequest
+	59,  // 192: river.StreamService.AddStreamToSync:input_type -> river.AddStreamToSyncRequest
+	63,  // 193: river.StreamService.CancelSync:input_type -> river.CancelSyncRequest
+	61,  // 194: river.StreamService.RemoveStreamFromSync:input_type -> river.RemoveStreamFromSyncRequest
+	70,  // 195: river.StreamService.Info:input_type -> river.InfoRequest
+	65,  // 196: river.StreamService.PingSync:input_type -> river.PingSyncRequest
+	67,  // 197: river.StreamService.ResumeSync:input_type -> river.ResumeSyncRequest
+	33,  // 198: river.StreamService.CreateStream:output_type -> river.CreateStreamResponse
+	35,  // 199: river.StreamService.GetStream:output_type -> river.GetStreamResponse
+	37,  // 200: river.StreamService.GetStreams:output_type -> river.GetStreamsResponse
+	31,  // 201: river.StreamService.GetStreamEx:output_type -> river.GetStreamExResponse
+	39,  // 202: river.StreamService.GetMiniblocks:output_type -> river.GetMiniblocksResponse
+	41,  // 203: river.StreamService.GetEvent:output_type -> river.GetEventResponse
+	44,  // 204: river.StreamService.GetStreamHistory:output_type -> river.GetStreamHistoryResponse
+	46,  // 205: river.StreamService.GetThread:output_type -> river.GetThreadResponse
+	48,  // 206: river.StreamService.GetLastMiniblockHash:output_type -> river.GetLastMiniblockHashResponse
+	50,  // 207: river.StreamService.AddEvent:output_type -> river.AddEventResponse
+	54,  // 208: river.StreamService.AddEvents:output_type -> river.AddEventsResponse
+	52,  // 209: river.StreamService.ValidateEvent:output_type -> river.ValidateEventResponse
+	58,  // 210: river.StreamService.SyncStreams:output_type -> river.SyncStreamsResponse
+	60,  // 211: river.StreamService.AddStreamToSync:output_type -> river.AddStreamToSyncResponse
+	64,  // 212: river.StreamService.CancelSync:output_type -> river.CancelSyncResponse
+	62,  // 213: river.StreamService.RemoveStreamFromSync:output_type -> river.RemoveStreamFromSyncResponse
+	71,  // 214: river.StreamService.Info:output_type -> river.InfoResponse
+	66,  // 215: river.StreamService.PingSync:output_type -> river.PingSyncResponse
+	58,  // 216: river.StreamService.ResumeSync:output_type -> river.SyncStreamsResponse
+	198, // [198:217] is the sub-list for method output_type
+	179, // [179:198] is the sub-list for method input_type
+	179, // [179:179] is the sub-list for extension type_name
+	179, // [179:179] is the sub-list for extension extendee
+	0,   // [0:179] is the sub-list for field type_name
 }
 
 func init() { file_protocol_proto_init() }
@@ -7902,8 +11043,200 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Envelope); i {
+		file_protocol_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MiniblockHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpacePayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DmChannelPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GdmChannelPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInboxPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSettingsPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDeviceKeyPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncryptedData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7914,8 +11247,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamEvent); i {
+		file_protocol_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WrappedEncryptedData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7926,8 +11259,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MiniblockHeader); i {
+		file_protocol_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageEdit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7938,8 +11271,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload); i {
+		file_protocol_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnappedMessageEdit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7950,8 +11283,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpacePayload); i {
+		file_protocol_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7962,8 +11295,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelPayload); i {
+		file_protocol_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnappedReactions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7974,8 +11307,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DmChannelPayload); i {
+		file_protocol_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncCookie); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7986,8 +11319,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GdmChannelPayload); i {
+		file_protocol_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamAndCookie); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7998,8 +11331,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserPayload); i {
+		file_protocol_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamExRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8010,8 +11343,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInboxPayload); i {
+		file_protocol_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Minipool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8022,8 +11355,224 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSettingsPayload); i {
+		file_protocol_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamExResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMiniblocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMiniblocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamHistoryEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastMiniblockHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastMiniblockHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8034,8 +11583,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserDeviceKeyPayload); i {
+		file_protocol_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8046,8 +11595,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MediaPayload); i {
+		file_protocol_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8058,8 +11607,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Snapshot); i {
+		file_protocol_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8070,8 +11619,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRef); i {
+		file_protocol_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8082,8 +11631,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamSettings); i {
+		file_protocol_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8094,8 +11643,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncryptedData); i {
+		file_protocol_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8106,8 +11655,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WrappedEncryptedData); i {
+		file_protocol_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamSyncFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8118,8 +11667,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncCookie); i {
+		file_protocol_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncStreamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8130,8 +11679,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamAndCookie); i {
+		file_protocol_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncStreamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8142,8 +11691,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamExRequest); i {
+		file_protocol_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStreamToSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8154,8 +11703,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Minipool); i {
+		file_protocol_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStreamToSyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8166,8 +11715,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamExResponse); i {
+		file_protocol_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStreamFromSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8178,8 +11727,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateStreamRequest); i {
+		file_protocol_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStreamFromSyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8190,8 +11739,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateStreamResponse); i {
+		file_protocol_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8202,8 +11751,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamRequest); i {
+		file_protocol_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8214,8 +11763,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamResponse); i {
+		file_protocol_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8226,8 +11775,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMiniblocksRequest); i {
+		file_protocol_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingSyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8238,8 +11787,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMiniblocksResponse); i {
+		file_protocol_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8250,8 +11799,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastMiniblockHashRequest); i {
+		file_protocol_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8262,8 +11811,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastMiniblockHashResponse); i {
+		file_protocol_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8274,8 +11823,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddEventRequest); i {
+		file_protocol_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8286,8 +11835,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddEventResponse); i {
+		file_protocol_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8298,8 +11847,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncStreamsRequest); i {
+		file_protocol_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8310,8 +11859,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncStreamsResponse); i {
+		file_protocol_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_Membership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8322,8 +11871,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddStreamToSyncRequest); i {
+		file_protocol_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_KeySolicitation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8334,8 +11883,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddStreamToSyncResponse); i {
+		file_protocol_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_KeyFulfillment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8346,8 +11895,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveStreamFromSyncRequest); i {
+		file_protocol_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_Nft); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8358,8 +11907,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveStreamFromSyncResponse); i {
+		file_protocol_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_SnappedPin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8370,8 +11919,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelSyncRequest); i {
+		file_protocol_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_Pin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8382,8 +11931,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelSyncResponse); i {
+		file_protocol_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_Unpin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8394,8 +11943,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingSyncRequest); i {
+		file_protocol_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_Typing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8406,8 +11955,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingSyncResponse); i {
+		file_protocol_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_Presence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8418,8 +11967,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InfoRequest); i {
+		file_protocol_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPayload_Snapshot_Member); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8430,8 +11979,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InfoResponse); i {
+		file_protocol_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpacePayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8442,8 +11991,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload_Snapshot); i {
+		file_protocol_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpacePayload_Inception); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8454,8 +12003,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload_Membership); i {
+		file_protocol_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpacePayload_ChannelMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8466,8 +12015,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload_KeySolicitation); i {
+		file_protocol_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpacePayload_ChannelUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8478,8 +12027,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload_KeyFulfillment); i {
+		file_protocol_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8490,8 +12039,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload_Nft); i {
+		file_protocol_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPayload_Inception); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8502,8 +12051,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload_SnappedPin); i {
+		file_protocol_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPayload_Redaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8514,8 +12063,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload_Pin); i {
+		file_protocol_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPayload_ThreadReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8526,8 +12075,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload_Unpin); i {
+		file_protocol_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPayload_ThreadSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8538,8 +12087,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MemberPayload_Snapshot_Member); i {
+		file_protocol_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPayload_ThreadSummary_Reply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8550,8 +12099,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpacePayload_Snapshot); i {
+		file_protocol_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DmChannelPayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8562,8 +12111,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpacePayload_Inception); i {
+		file_protocol_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DmChannelPayload_Inception); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8574,8 +12123,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpacePayload_ChannelMetadata); i {
+		file_protocol_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GdmChannelPayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8586,8 +12135,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpacePayload_ChannelUpdate); i {
+		file_protocol_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GdmChannelPayload_Inception); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8598,8 +12147,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelPayload_Snapshot); i {
+		file_protocol_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8610,8 +12159,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelPayload_Inception); i {
+		file_protocol_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPayload_Inception); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8622,8 +12171,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelPayload_Redaction); i {
+		file_protocol_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPayload_UserMembership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8634,8 +12183,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DmChannelPayload_Snapshot); i {
+		file_protocol_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserPayload_UserMembershipAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8646,8 +12195,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DmChannelPayload_Inception); i {
+		file_protocol_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInboxPayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8658,8 +12207,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GdmChannelPayload_Snapshot); i {
+		file_protocol_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInboxPayload_Inception); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8670,8 +12219,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GdmChannelPayload_Inception); i {
+		file_protocol_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInboxPayload_GroupEncryptionSessions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8682,8 +12231,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserPayload_Snapshot); i {
+		file_protocol_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInboxPayload_Ack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8694,8 +12243,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserPayload_Inception); i {
+		file_protocol_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInboxPayload_Snapshot_DeviceSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8706,8 +12255,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserPayload_UserMembership); i {
+		file_protocol_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSettingsPayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8718,8 +12267,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserPayload_UserMembershipAction); i {
+		file_protocol_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSettingsPayload_Inception); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8730,8 +12279,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInboxPayload_Snapshot); i {
+		file_protocol_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSettingsPayload_MarkerContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8742,8 +12291,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInboxPayload_Inception); i {
+		file_protocol_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSettingsPayload_FullyReadMarkers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8754,8 +12303,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInboxPayload_GroupEncryptionSessions); i {
+		file_protocol_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSettingsPayload_UserBlock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8766,8 +12315,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInboxPayload_Ack); i {
+		file_protocol_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSettingsPayload_Snapshot_UserBlocks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8778,8 +12327,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInboxPayload_Snapshot_DeviceSummary); i {
+		file_protocol_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSettingsPayload_Snapshot_UserBlocks_Block); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8790,8 +12339,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSettingsPayload_Snapshot); i {
+		file_protocol_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDeviceKeyPayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8802,8 +12351,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSettingsPayload_Inception); i {
+		file_protocol_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDeviceKeyPayload_Inception); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8814,8 +12363,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSettingsPayload_MarkerContent); i {
+		file_protocol_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDeviceKeyPayload_EncryptionDevice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8826,8 +12375,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSettingsPayload_FullyReadMarkers); i {
+		file_protocol_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaPayload_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8838,8 +12387,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSettingsPayload_UserBlock); i {
+		file_protocol_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaPayload_Inception); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8850,8 +12399,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSettingsPayload_Snapshot_UserBlocks); i {
+		file_protocol_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MediaPayload_Chunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8862,8 +12411,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserSettingsPayload_Snapshot_UserBlocks_Block); i {
+		file_protocol_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnappedReactions_Reaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8874,8 +12423,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserDeviceKeyPayload_Snapshot); i {
+		file_protocol_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamsResponse_Error); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8886,8 +12435,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserDeviceKeyPayload_Inception); i {
+		file_protocol_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamsResponse_Result); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8898,8 +12447,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserDeviceKeyPayload_EncryptionDevice); i {
+		file_protocol_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEventResponse_Error); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8910,8 +12459,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MediaPayload_Snapshot); i {
+		file_protocol_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateEventResponse_Check); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8922,8 +12471,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MediaPayload_Inception); i {
+		file_protocol_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateEventResponse_ChainAuth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8934,8 +12483,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MediaPayload_Chunk); i {
+		file_protocol_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateEventResponse_DerivedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8946,8 +12495,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddEventResponse_Error); i {
+		file_protocol_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEventsRequest_Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8985,6 +12534,8 @@ func file_protocol_proto_init() {
 		(*MemberPayload_Nft_)(nil),
 		(*MemberPayload_Pin_)(nil),
 		(*MemberPayload_Unpin_)(nil),
+		(*MemberPayload_Typing_)(nil),
+		(*MemberPayload_Presence_)(nil),
 	}
 	file_protocol_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*SpacePayload_Inception_)(nil),
@@ -8994,15 +12545,25 @@ func file_protocol_proto_init() {
 		(*ChannelPayload_Inception_)(nil),
 		(*ChannelPayload_Message)(nil),
 		(*ChannelPayload_Redaction_)(nil),
+		(*ChannelPayload_Edit)(nil),
+		(*ChannelPayload_Reaction)(nil),
+		(*ChannelPayload_RemoveReaction)(nil),
+		(*ChannelPayload_ThreadReply_)(nil),
 	}
 	file_protocol_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*DmChannelPayload_Inception_)(nil),
 		(*DmChannelPayload_Message)(nil),
+		(*DmChannelPayload_Edit)(nil),
+		(*DmChannelPayload_Reaction)(nil),
+		(*DmChannelPayload_RemoveReaction)(nil),
 	}
 	file_protocol_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*GdmChannelPayload_Inception_)(nil),
 		(*GdmChannelPayload_Message)(nil),
 		(*GdmChannelPayload_ChannelProperties)(nil),
+		(*GdmChannelPayload_Edit)(nil),
+		(*GdmChannelPayload_Reaction)(nil),
+		(*GdmChannelPayload_RemoveReaction)(nil),
 	}
 	file_protocol_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*UserPayload_Inception_)(nil),
@@ -9039,21 +12600,37 @@ func file_protocol_proto_init() {
 		(*Snapshot_UserInboxContent)(nil),
 	}
 	file_protocol_proto_msgTypes[17].OneofWrappers = []interface{}{}
-	file_protocol_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_protocol_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*GetStreamExResponse_Miniblock)(nil),
 		(*GetStreamExResponse_Minipool)(nil),
 	}
-	file_protocol_proto_msgTypes[47].OneofWrappers = []interface{}{}
-	file_protocol_proto_msgTypes[68].OneofWrappers = []interface{}{}
+	file_protocol_proto_msgTypes[38].OneofWrappers = []interface{}{
+		(*GetStreamHistoryRequest_EventNum)(nil),
+		(*GetStreamHistoryRequest_MiniblockNum)(nil),
+		(*GetStreamHistoryRequest_CreatedBeforeEpochMs)(nil),
+	}
+	file_protocol_proto_msgTypes[53].OneofWrappers = []interface{}{}
+	file_protocol_proto_msgTypes[55].OneofWrappers = []interface{}{}
+	file_protocol_proto_msgTypes[64].OneofWrappers = []interface{}{
+		(*SyncCommand_AddStream)(nil),
+		(*SyncCommand_RemoveStream)(nil),
+		(*SyncCommand_Ping)(nil),
+		(*SyncCommand_Cancel)(nil),
+	}
+	file_protocol_proto_msgTypes[65].OneofWrappers = []interface{}{}
 	file_protocol_proto_msgTypes[69].OneofWrappers = []interface{}{}
-	file_protocol_proto_msgTypes[88].OneofWrappers = []interface{}{}
+	file_protocol_proto_msgTypes[76].OneofWrappers = []interface{}{}
+	file_protocol_proto_msgTypes[85].OneofWrappers = []interface{}{}
+	file_protocol_proto_msgTypes[95].OneofWrappers = []interface{}{}
+	file_protocol_proto_msgTypes[96].OneofWrappers = []interface{}{}
+	file_protocol_proto_msgTypes[115].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protocol_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   92,
+			NumMessages:   127,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
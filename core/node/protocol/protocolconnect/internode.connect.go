@@ -39,6 +39,9 @@ const (
 	// NodeToNodeNewEventReceivedProcedure is the fully-qualified name of the NodeToNode's
 	// NewEventReceived RPC.
 	NodeToNodeNewEventReceivedProcedure = "/river.NodeToNode/NewEventReceived"
+	// NodeToNodeNewEventsReceivedProcedure is the fully-qualified name of the NodeToNode's
+	// NewEventsReceived RPC.
+	NodeToNodeNewEventsReceivedProcedure = "/river.NodeToNode/NewEventsReceived"
 	// NodeToNodeNewEventInPoolProcedure is the fully-qualified name of the NodeToNode's NewEventInPool
 	// RPC.
 	NodeToNodeNewEventInPoolProcedure = "/river.NodeToNode/NewEventInPool"
@@ -55,6 +58,7 @@ var (
 	nodeToNodeServiceDescriptor                      = protocol.File_internode_proto.Services().ByName("NodeToNode")
 	nodeToNodeAllocateStreamMethodDescriptor         = nodeToNodeServiceDescriptor.Methods().ByName("AllocateStream")
 	nodeToNodeNewEventReceivedMethodDescriptor       = nodeToNodeServiceDescriptor.Methods().ByName("NewEventReceived")
+	nodeToNodeNewEventsReceivedMethodDescriptor      = nodeToNodeServiceDescriptor.Methods().ByName("NewEventsReceived")
 	nodeToNodeNewEventInPoolMethodDescriptor         = nodeToNodeServiceDescriptor.Methods().ByName("NewEventInPool")
 	nodeToNodeProposeMiniblockMethodDescriptor       = nodeToNodeServiceDescriptor.Methods().ByName("ProposeMiniblock")
 	nodeToNodeSaveMiniblockCandidateMethodDescriptor = nodeToNodeServiceDescriptor.Methods().ByName("SaveMiniblockCandidate")
@@ -64,6 +68,7 @@ var (
 type NodeToNodeClient interface {
 	AllocateStream(context.Context, *connect.Request[protocol.AllocateStreamRequest]) (*connect.Response[protocol.AllocateStreamResponse], error)
 	NewEventReceived(context.Context, *connect.Request[protocol.NewEventReceivedRequest]) (*connect.Response[protocol.NewEventReceivedResponse], error)
+	NewEventsReceived(context.Context, *connect.Request[protocol.NewEventsReceivedRequest]) (*connect.Response[protocol.NewEventsReceivedResponse], error)
 	NewEventInPool(context.Context, *connect.Request[protocol.NewEventInPoolRequest]) (*connect.Response[protocol.NewEventInPoolResponse], error)
 	ProposeMiniblock(context.Context, *connect.Request[protocol.ProposeMiniblockRequest]) (*connect.Response[protocol.ProposeMiniblockResponse], error)
 	SaveMiniblockCandidate(context.Context, *connect.Request[protocol.SaveMiniblockCandidateRequest]) (*connect.Response[protocol.SaveMiniblockCandidateResponse], error)
@@ -91,6 +96,12 @@ func NewNodeToNodeClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(nodeToNodeNewEventReceivedMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		newEventsReceived: connect.NewClient[protocol.NewEventsReceivedRequest, protocol.NewEventsReceivedResponse](
+			httpClient,
+			baseURL+NodeToNodeNewEventsReceivedProcedure,
+			connect.WithSchema(nodeToNodeNewEventsReceivedMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		newEventInPool: connect.NewClient[protocol.NewEventInPoolRequest, protocol.NewEventInPoolResponse](
 			httpClient,
 			baseURL+NodeToNodeNewEventInPoolProcedure,
@@ -116,6 +127,7 @@ func NewNodeToNodeClient(httpClient connect.HTTPClient, baseURL string, opts ...
 type nodeToNodeClient struct {
 	allocateStream         *connect.Client[protocol.AllocateStreamRequest, protocol.AllocateStreamResponse]
 	newEventReceived       *connect.Client[protocol.NewEventReceivedRequest, protocol.NewEventReceivedResponse]
+	newEventsReceived      *connect.Client[protocol.NewEventsReceivedRequest, protocol.NewEventsReceivedResponse]
 	newEventInPool         *connect.Client[protocol.NewEventInPoolRequest, protocol.NewEventInPoolResponse]
 	proposeMiniblock       *connect.Client[protocol.ProposeMiniblockRequest, protocol.ProposeMiniblockResponse]
 	saveMiniblockCandidate *connect.Client[protocol.SaveMiniblockCandidateRequest, protocol.SaveMiniblockCandidateResponse]
@@ -131,6 +143,11 @@ func (c *nodeToNodeClient) NewEventReceived(ctx context.Context, req *connect.Re
 	return c.newEventReceived.CallUnary(ctx, req)
 }
 
+// NewEventsReceived calls river.NodeToNode.NewEventsReceived.
+func (c *nodeToNodeClient) NewEventsReceived(ctx context.Context, req *connect.Request[protocol.NewEventsReceivedRequest]) (*connect.Response[protocol.NewEventsReceivedResponse], error) {
+	return c.newEventsReceived.CallUnary(ctx, req)
+}
+
 // NewEventInPool calls river.NodeToNode.NewEventInPool.
 func (c *nodeToNodeClient) NewEventInPool(ctx context.Context, req *connect.Request[protocol.NewEventInPoolRequest]) (*connect.Response[protocol.NewEventInPoolResponse], error) {
 	return c.newEventInPool.CallUnary(ctx, req)
@@ -150,6 +167,7 @@ func (c *nodeToNodeClient) SaveMiniblockCandidate(ctx context.Context, req *conn
 type NodeToNodeHandler interface {
 	AllocateStream(context.Context, *connect.Request[protocol.AllocateStreamRequest]) (*connect.Response[protocol.AllocateStreamResponse], error)
 	NewEventReceived(context.Context, *connect.Request[protocol.NewEventReceivedRequest]) (*connect.Response[protocol.NewEventReceivedResponse], error)
+	NewEventsReceived(context.Context, *connect.Request[protocol.NewEventsReceivedRequest]) (*connect.Response[protocol.NewEventsReceivedResponse], error)
 	NewEventInPool(context.Context, *connect.Request[protocol.NewEventInPoolRequest]) (*connect.Response[protocol.NewEventInPoolResponse], error)
 	ProposeMiniblock(context.Context, *connect.Request[protocol.ProposeMiniblockRequest]) (*connect.Response[protocol.ProposeMiniblockResponse], error)
 	SaveMiniblockCandidate(context.Context, *connect.Request[protocol.SaveMiniblockCandidateRequest]) (*connect.Response[protocol.SaveMiniblockCandidateResponse], error)
@@ -173,6 +191,12 @@ func NewNodeToNodeHandler(svc NodeToNodeHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(nodeToNodeNewEventReceivedMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	nodeToNodeNewEventsReceivedHandler := connect.NewUnaryHandler(
+		NodeToNodeNewEventsReceivedProcedure,
+		svc.NewEventsReceived,
+		connect.WithSchema(nodeToNodeNewEventsReceivedMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	nodeToNodeNewEventInPoolHandler := connect.NewUnaryHandler(
 		NodeToNodeNewEventInPoolProcedure,
 		svc.NewEventInPool,
@@ -197,6 +221,8 @@ func NewNodeToNodeHandler(svc NodeToNodeHandler, opts ...connect.HandlerOption)
 			nodeToNodeAllocateStreamHandler.ServeHTTP(w, r)
 		case NodeToNodeNewEventReceivedProcedure:
 			nodeToNodeNewEventReceivedHandler.ServeHTTP(w, r)
+		case NodeToNodeNewEventsReceivedProcedure:
+			nodeToNodeNewEventsReceivedHandler.ServeHTTP(w, r)
 		case NodeToNodeNewEventInPoolProcedure:
 			nodeToNodeNewEventInPoolHandler.ServeHTTP(w, r)
 		case NodeToNodeProposeMiniblockProcedure:
@@ -220,6 +246,10 @@ func (UnimplementedNodeToNodeHandler) NewEventReceived(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NodeToNode.NewEventReceived is not implemented"))
 }
 
+func (UnimplementedNodeToNodeHandler) NewEventsReceived(context.Context, *connect.Request[protocol.NewEventsReceivedRequest]) (*connect.Response[protocol.NewEventsReceivedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NodeToNode.NewEventsReceived is not implemented"))
+}
+
 func (UnimplementedNodeToNodeHandler) NewEventInPool(context.Context, *connect.Request[protocol.NewEventInPoolRequest]) (*connect.Response[protocol.NewEventInPoolResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NodeToNode.NewEventInPool is not implemented"))
 }
@@ -38,17 +38,32 @@ const (
 	StreamServiceCreateStreamProcedure = "/river.StreamService/CreateStream"
 	// StreamServiceGetStreamProcedure is the fully-qualified name of the StreamService's GetStream RPC.
 	StreamServiceGetStreamProcedure = "/river.StreamService/GetStream"
+	// StreamServiceGetStreamsProcedure is the fully-qualified name of the StreamService's GetStreams
+	// RPC.
+	StreamServiceGetStreamsProcedure = "/river.StreamService/GetStreams"
 	// StreamServiceGetStreamExProcedure is the fully-qualified name of the StreamService's GetStreamEx
 	// RPC.
 	StreamServiceGetStreamExProcedure = "/river.StreamService/GetStreamEx"
 	// StreamServiceGetMiniblocksProcedure is the fully-qualified name of the StreamService's
 	// GetMiniblocks RPC.
 	StreamServiceGetMiniblocksProcedure = "/river.StreamService/GetMiniblocks"
+	// StreamServiceGetEventProcedure is the fully-qualified name of the StreamService's GetEvent RPC.
+	StreamServiceGetEventProcedure = "/river.StreamService/GetEvent"
+	// StreamServiceGetStreamHistoryProcedure is the fully-qualified name of the StreamService's
+	// GetStreamHistory RPC.
+	StreamServiceGetStreamHistoryProcedure = "/river.StreamService/GetStreamHistory"
+	// StreamServiceGetThreadProcedure is the fully-qualified name of the StreamService's GetThread RPC.
+	StreamServiceGetThreadProcedure = "/river.StreamService/GetThread"
 	// StreamServiceGetLastMiniblockHashProcedure is the fully-qualified name of the StreamService's
 	// GetLastMiniblockHash RPC.
 	StreamServiceGetLastMiniblockHashProcedure = "/river.StreamService/GetLastMiniblockHash"
 	// StreamServiceAddEventProcedure is the fully-qualified name of the StreamService's AddEvent RPC.
 	StreamServiceAddEventProcedure = "/river.StreamService/AddEvent"
+	// StreamServiceAddEventsProcedure is the fully-qualified name of the StreamService's AddEvents RPC.
+	StreamServiceAddEventsProcedure = "/river.StreamService/AddEvents"
+	// StreamServiceValidateEventProcedure is the fully-qualified name of the StreamService's
+	// ValidateEvent RPC.
+	StreamServiceValidateEventProcedure = "/river.StreamService/ValidateEvent"
 	// StreamServiceSyncStreamsProcedure is the fully-qualified name of the StreamService's SyncStreams
 	// RPC.
 	StreamServiceSyncStreamsProcedure = "/river.StreamService/SyncStreams"
@@ -65,6 +80,9 @@ const (
 	StreamServiceInfoProcedure = "/river.StreamService/Info"
 	// StreamServicePingSyncProcedure is the fully-qualified name of the StreamService's PingSync RPC.
 	StreamServicePingSyncProcedure = "/river.StreamService/PingSync"
+	// StreamServiceResumeSyncProcedure is the fully-qualified name of the StreamService's ResumeSync
+	// RPC.
+	StreamServiceResumeSyncProcedure = "/river.StreamService/ResumeSync"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -72,32 +90,46 @@ var (
 	streamServiceServiceDescriptor                    = protocol.File_protocol_proto.Services().ByName("StreamService")
 	streamServiceCreateStreamMethodDescriptor         = streamServiceServiceDescriptor.Methods().ByName("CreateStream")
 	streamServiceGetStreamMethodDescriptor            = streamServiceServiceDescriptor.Methods().ByName("GetStream")
+	streamServiceGetStreamsMethodDescriptor           = streamServiceServiceDescriptor.Methods().ByName("GetStreams")
 	streamServiceGetStreamExMethodDescriptor          = streamServiceServiceDescriptor.Methods().ByName("GetStreamEx")
 	streamServiceGetMiniblocksMethodDescriptor        = streamServiceServiceDescriptor.Methods().ByName("GetMiniblocks")
+	streamServiceGetEventMethodDescriptor             = streamServiceServiceDescriptor.Methods().ByName("GetEvent")
+	streamServiceGetStreamHistoryMethodDescriptor     = streamServiceServiceDescriptor.Methods().ByName("GetStreamHistory")
+	streamServiceGetThreadMethodDescriptor            = streamServiceServiceDescriptor.Methods().ByName("GetThread")
 	streamServiceGetLastMiniblockHashMethodDescriptor = streamServiceServiceDescriptor.Methods().ByName("GetLastMiniblockHash")
 	streamServiceAddEventMethodDescriptor             = streamServiceServiceDescriptor.Methods().ByName("AddEvent")
+	streamServiceAddEventsMethodDescriptor            = streamServiceServiceDescriptor.Methods().ByName("AddEvents")
+	streamServiceValidateEventMethodDescriptor        = streamServiceServiceDescriptor.Methods().ByName("ValidateEvent")
 	streamServiceSyncStreamsMethodDescriptor          = streamServiceServiceDescriptor.Methods().ByName("SyncStreams")
 	streamServiceAddStreamToSyncMethodDescriptor      = streamServiceServiceDescriptor.Methods().ByName("AddStreamToSync")
 	streamServiceCancelSyncMethodDescriptor           = streamServiceServiceDescriptor.Methods().ByName("CancelSync")
 	streamServiceRemoveStreamFromSyncMethodDescriptor = streamServiceServiceDescriptor.Methods().ByName("RemoveStreamFromSync")
 	streamServiceInfoMethodDescriptor                 = streamServiceServiceDescriptor.Methods().ByName("Info")
 	streamServicePingSyncMethodDescriptor             = streamServiceServiceDescriptor.Methods().ByName("PingSync")
+	streamServiceResumeSyncMethodDescriptor           = streamServiceServiceDescriptor.Methods().ByName("ResumeSync")
 )
 
 // StreamServiceClient is a client for the river.StreamService service.
 type StreamServiceClient interface {
 	CreateStream(context.Context, *connect.Request[protocol.CreateStreamRequest]) (*connect.Response[protocol.CreateStreamResponse], error)
 	GetStream(context.Context, *connect.Request[protocol.GetStreamRequest]) (*connect.Response[protocol.GetStreamResponse], error)
+	GetStreams(context.Context, *connect.Request[protocol.GetStreamsRequest]) (*connect.Response[protocol.GetStreamsResponse], error)
 	GetStreamEx(context.Context, *connect.Request[protocol.GetStreamExRequest]) (*connect.ServerStreamForClient[protocol.GetStreamExResponse], error)
 	GetMiniblocks(context.Context, *connect.Request[protocol.GetMiniblocksRequest]) (*connect.Response[protocol.GetMiniblocksResponse], error)
+	GetEvent(context.Context, *connect.Request[protocol.GetEventRequest]) (*connect.Response[protocol.GetEventResponse], error)
+	GetStreamHistory(context.Context, *connect.Request[protocol.GetStreamHistoryRequest]) (*connect.Response[protocol.GetStreamHistoryResponse], error)
+	GetThread(context.Context, *connect.Request[protocol.GetThreadRequest]) (*connect.Response[protocol.GetThreadResponse], error)
 	GetLastMiniblockHash(context.Context, *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error)
 	AddEvent(context.Context, *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error)
+	AddEvents(context.Context, *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error)
+	ValidateEvent(context.Context, *connect.Request[protocol.ValidateEventRequest]) (*connect.Response[protocol.ValidateEventResponse], error)
 	SyncStreams(context.Context, *connect.Request[protocol.SyncStreamsRequest]) (*connect.ServerStreamForClient[protocol.SyncStreamsResponse], error)
 	AddStreamToSync(context.Context, *connect.Request[protocol.AddStreamToSyncRequest]) (*connect.Response[protocol.AddStreamToSyncResponse], error)
 	CancelSync(context.Context, *connect.Request[protocol.CancelSyncRequest]) (*connect.Response[protocol.CancelSyncResponse], error)
 	RemoveStreamFromSync(context.Context, *connect.Request[protocol.RemoveStreamFromSyncRequest]) (*connect.Response[protocol.RemoveStreamFromSyncResponse], error)
 	Info(context.Context, *connect.Request[protocol.InfoRequest]) (*connect.Response[protocol.InfoResponse], error)
 	PingSync(context.Context, *connect.Request[protocol.PingSyncRequest]) (*connect.Response[protocol.PingSyncResponse], error)
+	ResumeSync(context.Context, *connect.Request[protocol.ResumeSyncRequest]) (*connect.ServerStreamForClient[protocol.SyncStreamsResponse], error)
 }
 
 // NewStreamServiceClient constructs a client for the river.StreamService service. By default, it
@@ -122,6 +154,12 @@ func NewStreamServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(streamServiceGetStreamMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getStreams: connect.NewClient[protocol.GetStreamsRequest, protocol.GetStreamsResponse](
+			httpClient,
+			baseURL+StreamServiceGetStreamsProcedure,
+			connect.WithSchema(streamServiceGetStreamsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getStreamEx: connect.NewClient[protocol.GetStreamExRequest, protocol.GetStreamExResponse](
 			httpClient,
 			baseURL+StreamServiceGetStreamExProcedure,
@@ -134,6 +172,24 @@ func NewStreamServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(streamServiceGetMiniblocksMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getEvent: connect.NewClient[protocol.GetEventRequest, protocol.GetEventResponse](
+			httpClient,
+			baseURL+StreamServiceGetEventProcedure,
+			connect.WithSchema(streamServiceGetEventMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getStreamHistory: connect.NewClient[protocol.GetStreamHistoryRequest, protocol.GetStreamHistoryResponse](
+			httpClient,
+			baseURL+StreamServiceGetStreamHistoryProcedure,
+			connect.WithSchema(streamServiceGetStreamHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getThread: connect.NewClient[protocol.GetThreadRequest, protocol.GetThreadResponse](
+			httpClient,
+			baseURL+StreamServiceGetThreadProcedure,
+			connect.WithSchema(streamServiceGetThreadMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getLastMiniblockHash: connect.NewClient[protocol.GetLastMiniblockHashRequest, protocol.GetLastMiniblockHashResponse](
 			httpClient,
 			baseURL+StreamServiceGetLastMiniblockHashProcedure,
@@ -146,6 +202,18 @@ func NewStreamServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(streamServiceAddEventMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		addEvents: connect.NewClient[protocol.AddEventsRequest, protocol.AddEventsResponse](
+			httpClient,
+			baseURL+StreamServiceAddEventsProcedure,
+			connect.WithSchema(streamServiceAddEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		validateEvent: connect.NewClient[protocol.ValidateEventRequest, protocol.ValidateEventResponse](
+			httpClient,
+			baseURL+StreamServiceValidateEventProcedure,
+			connect.WithSchema(streamServiceValidateEventMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		syncStreams: connect.NewClient[protocol.SyncStreamsRequest, protocol.SyncStreamsResponse](
 			httpClient,
 			baseURL+StreamServiceSyncStreamsProcedure,
@@ -182,6 +250,12 @@ func NewStreamServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(streamServicePingSyncMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		resumeSync: connect.NewClient[protocol.ResumeSyncRequest, protocol.SyncStreamsResponse](
+			httpClient,
+			baseURL+StreamServiceResumeSyncProcedure,
+			connect.WithSchema(streamServiceResumeSyncMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -189,16 +263,23 @@ func NewStreamServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type streamServiceClient struct {
 	createStream         *connect.Client[protocol.CreateStreamRequest, protocol.CreateStreamResponse]
 	getStream            *connect.Client[protocol.GetStreamRequest, protocol.GetStreamResponse]
+	getStreams           *connect.Client[protocol.GetStreamsRequest, protocol.GetStreamsResponse]
 	getStreamEx          *connect.Client[protocol.GetStreamExRequest, protocol.GetStreamExResponse]
 	getMiniblocks        *connect.Client[protocol.GetMiniblocksRequest, protocol.GetMiniblocksResponse]
+	getEvent             *connect.Client[protocol.GetEventRequest, protocol.GetEventResponse]
+	getStreamHistory     *connect.Client[protocol.GetStreamHistoryRequest, protocol.GetStreamHistoryResponse]
+	getThread            *connect.Client[protocol.GetThreadRequest, protocol.GetThreadResponse]
 	getLastMiniblockHash *connect.Client[protocol.GetLastMiniblockHashRequest, protocol.GetLastMiniblockHashResponse]
 	addEvent             *connect.Client[protocol.AddEventRequest, protocol.AddEventResponse]
+	addEvents            *connect.Client[protocol.AddEventsRequest, protocol.AddEventsResponse]
+	validateEvent        *connect.Client[protocol.ValidateEventRequest, protocol.ValidateEventResponse]
 	syncStreams          *connect.Client[protocol.SyncStreamsRequest, protocol.SyncStreamsResponse]
 	addStreamToSync      *connect.Client[protocol.AddStreamToSyncRequest, protocol.AddStreamToSyncResponse]
 	cancelSync           *connect.Client[protocol.CancelSyncRequest, protocol.CancelSyncResponse]
 	removeStreamFromSync *connect.Client[protocol.RemoveStreamFromSyncRequest, protocol.RemoveStreamFromSyncResponse]
 	info                 *connect.Client[protocol.InfoRequest, protocol.InfoResponse]
 	pingSync             *connect.Client[protocol.PingSyncRequest, protocol.PingSyncResponse]
+	resumeSync           *connect.Client[protocol.ResumeSyncRequest, protocol.SyncStreamsResponse]
 }
 
 // CreateStream calls river.StreamService.CreateStream.
@@ -211,6 +292,11 @@ func (c *streamServiceClient) GetStream(ctx context.Context, req *connect.Reques
 	return c.getStream.CallUnary(ctx, req)
 }
 
+// GetStreams calls river.StreamService.GetStreams.
+func (c *streamServiceClient) GetStreams(ctx context.Context, req *connect.Request[protocol.GetStreamsRequest]) (*connect.Response[protocol.GetStreamsResponse], error) {
+	return c.getStreams.CallUnary(ctx, req)
+}
+
 // GetStreamEx calls river.StreamService.GetStreamEx.
 func (c *streamServiceClient) GetStreamEx(ctx context.Context, req *connect.Request[protocol.GetStreamExRequest]) (*connect.ServerStreamForClient[protocol.GetStreamExResponse], error) {
 	return c.getStreamEx.CallServerStream(ctx, req)
@@ -221,6 +307,21 @@ func (c *streamServiceClient) GetMiniblocks(ctx context.Context, req *connect.Re
 	return c.getMiniblocks.CallUnary(ctx, req)
 }
 
+// GetEvent calls river.StreamService.GetEvent.
+func (c *streamServiceClient) GetEvent(ctx context.Context, req *connect.Request[protocol.GetEventRequest]) (*connect.Response[protocol.GetEventResponse], error) {
+	return c.getEvent.CallUnary(ctx, req)
+}
+
+// GetStreamHistory calls river.StreamService.GetStreamHistory.
+func (c *streamServiceClient) GetStreamHistory(ctx context.Context, req *connect.Request[protocol.GetStreamHistoryRequest]) (*connect.Response[protocol.GetStreamHistoryResponse], error) {
+	return c.getStreamHistory.CallUnary(ctx, req)
+}
+
+// GetThread calls river.StreamService.GetThread.
+func (c *streamServiceClient) GetThread(ctx context.Context, req *connect.Request[protocol.GetThreadRequest]) (*connect.Response[protocol.GetThreadResponse], error) {
+	return c.getThread.CallUnary(ctx, req)
+}
+
 // GetLastMiniblockHash calls river.StreamService.GetLastMiniblockHash.
 func (c *streamServiceClient) GetLastMiniblockHash(ctx context.Context, req *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error) {
 	return c.getLastMiniblockHash.CallUnary(ctx, req)
@@ -231,6 +332,16 @@ func (c *streamServiceClient) AddEvent(ctx context.Context, req *connect.Request
 	return c.addEvent.CallUnary(ctx, req)
 }
 
+// AddEvents calls river.StreamService.AddEvents.
+func (c *streamServiceClient) AddEvents(ctx context.Context, req *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error) {
+	return c.addEvents.CallUnary(ctx, req)
+}
+
+// ValidateEvent calls river.StreamService.ValidateEvent.
+func (c *streamServiceClient) ValidateEvent(ctx context.Context, req *connect.Request[protocol.ValidateEventRequest]) (*connect.Response[protocol.ValidateEventResponse], error) {
+	return c.validateEvent.CallUnary(ctx, req)
+}
+
 // SyncStreams calls river.StreamService.SyncStreams.
 func (c *streamServiceClient) SyncStreams(ctx context.Context, req *connect.Request[protocol.SyncStreamsRequest]) (*connect.ServerStreamForClient[protocol.SyncStreamsResponse], error) {
 	return c.syncStreams.CallServerStream(ctx, req)
@@ -261,20 +372,32 @@ func (c *streamServiceClient) PingSync(ctx context.Context, req *connect.Request
 	return c.pingSync.CallUnary(ctx, req)
 }
 
+// ResumeSync calls river.StreamService.ResumeSync.
+func (c *streamServiceClient) ResumeSync(ctx context.Context, req *connect.Request[protocol.ResumeSyncRequest]) (*connect.ServerStreamForClient[protocol.SyncStreamsResponse], error) {
+	return c.resumeSync.CallServerStream(ctx, req)
+}
+
 // StreamServiceHandler is an implementation of the river.StreamService service.
 type StreamServiceHandler interface {
 	CreateStream(context.Context, *connect.Request[protocol.CreateStreamRequest]) (*connect.Response[protocol.CreateStreamResponse], error)
 	GetStream(context.Context, *connect.Request[protocol.GetStreamRequest]) (*connect.Response[protocol.GetStreamResponse], error)
+	GetStreams(context.Context, *connect.Request[protocol.GetStreamsRequest]) (*connect.Response[protocol.GetStreamsResponse], error)
 	GetStreamEx(context.Context, *connect.Request[protocol.GetStreamExRequest], *connect.ServerStream[protocol.GetStreamExResponse]) error
 	GetMiniblocks(context.Context, *connect.Request[protocol.GetMiniblocksRequest]) (*connect.Response[protocol.GetMiniblocksResponse], error)
+	GetEvent(context.Context, *connect.Request[protocol.GetEventRequest]) (*connect.Response[protocol.GetEventResponse], error)
+	GetStreamHistory(context.Context, *connect.Request[protocol.GetStreamHistoryRequest]) (*connect.Response[protocol.GetStreamHistoryResponse], error)
+	GetThread(context.Context, *connect.Request[protocol.GetThreadRequest]) (*connect.Response[protocol.GetThreadResponse], error)
 	GetLastMiniblockHash(context.Context, *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error)
 	AddEvent(context.Context, *connect.Request[protocol.AddEventRequest]) (*connect.Response[protocol.AddEventResponse], error)
+	AddEvents(context.Context, *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error)
+	ValidateEvent(context.Context, *connect.Request[protocol.ValidateEventRequest]) (*connect.Response[protocol.ValidateEventResponse], error)
 	SyncStreams(context.Context, *connect.Request[protocol.SyncStreamsRequest], *connect.ServerStream[protocol.SyncStreamsResponse]) error
 	AddStreamToSync(context.Context, *connect.Request[protocol.AddStreamToSyncRequest]) (*connect.Response[protocol.AddStreamToSyncResponse], error)
 	CancelSync(context.Context, *connect.Request[protocol.CancelSyncRequest]) (*connect.Response[protocol.CancelSyncResponse], error)
 	RemoveStreamFromSync(context.Context, *connect.Request[protocol.RemoveStreamFromSyncRequest]) (*connect.Response[protocol.RemoveStreamFromSyncResponse], error)
 	Info(context.Context, *connect.Request[protocol.InfoRequest]) (*connect.Response[protocol.InfoResponse], error)
 	PingSync(context.Context, *connect.Request[protocol.PingSyncRequest]) (*connect.Response[protocol.PingSyncResponse], error)
+	ResumeSync(context.Context, *connect.Request[protocol.ResumeSyncRequest], *connect.ServerStream[protocol.SyncStreamsResponse]) error
 }
 
 // NewStreamServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -295,6 +418,12 @@ func NewStreamServiceHandler(svc StreamServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(streamServiceGetStreamMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	streamServiceGetStreamsHandler := connect.NewUnaryHandler(
+		StreamServiceGetStreamsProcedure,
+		svc.GetStreams,
+		connect.WithSchema(streamServiceGetStreamsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	streamServiceGetStreamExHandler := connect.NewServerStreamHandler(
 		StreamServiceGetStreamExProcedure,
 		svc.GetStreamEx,
@@ -307,6 +436,24 @@ func NewStreamServiceHandler(svc StreamServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(streamServiceGetMiniblocksMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	streamServiceGetEventHandler := connect.NewUnaryHandler(
+		StreamServiceGetEventProcedure,
+		svc.GetEvent,
+		connect.WithSchema(streamServiceGetEventMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	streamServiceGetStreamHistoryHandler := connect.NewUnaryHandler(
+		StreamServiceGetStreamHistoryProcedure,
+		svc.GetStreamHistory,
+		connect.WithSchema(streamServiceGetStreamHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	streamServiceGetThreadHandler := connect.NewUnaryHandler(
+		StreamServiceGetThreadProcedure,
+		svc.GetThread,
+		connect.WithSchema(streamServiceGetThreadMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	streamServiceGetLastMiniblockHashHandler := connect.NewUnaryHandler(
 		StreamServiceGetLastMiniblockHashProcedure,
 		svc.GetLastMiniblockHash,
@@ -319,6 +466,18 @@ func NewStreamServiceHandler(svc StreamServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(streamServiceAddEventMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	streamServiceAddEventsHandler := connect.NewUnaryHandler(
+		StreamServiceAddEventsProcedure,
+		svc.AddEvents,
+		connect.WithSchema(streamServiceAddEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	streamServiceValidateEventHandler := connect.NewUnaryHandler(
+		StreamServiceValidateEventProcedure,
+		svc.ValidateEvent,
+		connect.WithSchema(streamServiceValidateEventMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	streamServiceSyncStreamsHandler := connect.NewServerStreamHandler(
 		StreamServiceSyncStreamsProcedure,
 		svc.SyncStreams,
@@ -355,20 +514,38 @@ func NewStreamServiceHandler(svc StreamServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(streamServicePingSyncMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	streamServiceResumeSyncHandler := connect.NewServerStreamHandler(
+		StreamServiceResumeSyncProcedure,
+		svc.ResumeSync,
+		connect.WithSchema(streamServiceResumeSyncMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/river.StreamService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case StreamServiceCreateStreamProcedure:
 			streamServiceCreateStreamHandler.ServeHTTP(w, r)
 		case StreamServiceGetStreamProcedure:
 			streamServiceGetStreamHandler.ServeHTTP(w, r)
+		case StreamServiceGetStreamsProcedure:
+			streamServiceGetStreamsHandler.ServeHTTP(w, r)
 		case StreamServiceGetStreamExProcedure:
 			streamServiceGetStreamExHandler.ServeHTTP(w, r)
 		case StreamServiceGetMiniblocksProcedure:
 			streamServiceGetMiniblocksHandler.ServeHTTP(w, r)
+		case StreamServiceGetEventProcedure:
+			streamServiceGetEventHandler.ServeHTTP(w, r)
+		case StreamServiceGetStreamHistoryProcedure:
+			streamServiceGetStreamHistoryHandler.ServeHTTP(w, r)
+		case StreamServiceGetThreadProcedure:
+			streamServiceGetThreadHandler.ServeHTTP(w, r)
 		case StreamServiceGetLastMiniblockHashProcedure:
 			streamServiceGetLastMiniblockHashHandler.ServeHTTP(w, r)
 		case StreamServiceAddEventProcedure:
 			streamServiceAddEventHandler.ServeHTTP(w, r)
+		case StreamServiceAddEventsProcedure:
+			streamServiceAddEventsHandler.ServeHTTP(w, r)
+		case StreamServiceValidateEventProcedure:
+			streamServiceValidateEventHandler.ServeHTTP(w, r)
 		case StreamServiceSyncStreamsProcedure:
 			streamServiceSyncStreamsHandler.ServeHTTP(w, r)
 		case StreamServiceAddStreamToSyncProcedure:
@@ -381,6 +558,8 @@ func NewStreamServiceHandler(svc StreamServiceHandler, opts ...connect.HandlerOp
 			streamServiceInfoHandler.ServeHTTP(w, r)
 		case StreamServicePingSyncProcedure:
 			streamServicePingSyncHandler.ServeHTTP(w, r)
+		case StreamServiceResumeSyncProcedure:
+			streamServiceResumeSyncHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -398,6 +577,10 @@ func (UnimplementedStreamServiceHandler) GetStream(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetStream is not implemented"))
 }
 
+func (UnimplementedStreamServiceHandler) GetStreams(context.Context, *connect.Request[protocol.GetStreamsRequest]) (*connect.Response[protocol.GetStreamsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetStreams is not implemented"))
+}
+
 func (UnimplementedStreamServiceHandler) GetStreamEx(context.Context, *connect.Request[protocol.GetStreamExRequest], *connect.ServerStream[protocol.GetStreamExResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetStreamEx is not implemented"))
 }
@@ -406,6 +589,18 @@ func (UnimplementedStreamServiceHandler) GetMiniblocks(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetMiniblocks is not implemented"))
 }
 
+func (UnimplementedStreamServiceHandler) GetEvent(context.Context, *connect.Request[protocol.GetEventRequest]) (*connect.Response[protocol.GetEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetEvent is not implemented"))
+}
+
+func (UnimplementedStreamServiceHandler) GetStreamHistory(context.Context, *connect.Request[protocol.GetStreamHistoryRequest]) (*connect.Response[protocol.GetStreamHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetStreamHistory is not implemented"))
+}
+
+func (UnimplementedStreamServiceHandler) GetThread(context.Context, *connect.Request[protocol.GetThreadRequest]) (*connect.Response[protocol.GetThreadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetThread is not implemented"))
+}
+
 func (UnimplementedStreamServiceHandler) GetLastMiniblockHash(context.Context, *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.GetLastMiniblockHash is not implemented"))
 }
@@ -414,6 +609,14 @@ func (UnimplementedStreamServiceHandler) AddEvent(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.AddEvent is not implemented"))
 }
 
+func (UnimplementedStreamServiceHandler) AddEvents(context.Context, *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.AddEvents is not implemented"))
+}
+
+func (UnimplementedStreamServiceHandler) ValidateEvent(context.Context, *connect.Request[protocol.ValidateEventRequest]) (*connect.Response[protocol.ValidateEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.ValidateEvent is not implemented"))
+}
+
 func (UnimplementedStreamServiceHandler) SyncStreams(context.Context, *connect.Request[protocol.SyncStreamsRequest], *connect.ServerStream[protocol.SyncStreamsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.SyncStreams is not implemented"))
 }
@@ -437,3 +640,7 @@ func (UnimplementedStreamServiceHandler) Info(context.Context, *connect.Request[
 func (UnimplementedStreamServiceHandler) PingSync(context.Context, *connect.Request[protocol.PingSyncRequest]) (*connect.Response[protocol.PingSyncResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.PingSync is not implemented"))
 }
+
+func (UnimplementedStreamServiceHandler) ResumeSync(context.Context, *connect.Request[protocol.ResumeSyncRequest], *connect.ServerStream[protocol.SyncStreamsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("river.StreamService.ResumeSync is not implemented"))
+}
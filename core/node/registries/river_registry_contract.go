@@ -476,6 +476,44 @@ func (c *RiverRegistryContract) SetStreamLastMiniblock(
 	return RiverError(Err_ERR_UNSPECIFIED, "SetStreamLastMiniblock transaction result unknown")
 }
 
+// UpdateStreamPlacement submits PlaceStreamOnNode transaction if isAdded is true and RemoveStreamFromNode otherwise.
+// It doesn't wait for the transaction to be included in the chain.
+func (c *RiverRegistryContract) UpdateStreamPlacement(
+	ctx context.Context,
+	streamId StreamId,
+	nodeAddress common.Address,
+	isAdded bool,
+) (crypto.TransactionPoolPendingTransaction, error) {
+	name := "RemoveStreamFromNode"
+	if isAdded {
+		name = "PlaceStreamOnNode"
+	}
+
+	pendingTx, err := c.Blockchain.TxPool.Submit(
+		ctx,
+		name,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			if isAdded {
+				return c.StreamRegistry.PlaceStreamOnNode(opts, streamId, nodeAddress)
+			}
+			return c.StreamRegistry.RemoveStreamFromNode(opts, streamId, nodeAddress)
+		},
+	)
+	if err != nil {
+		ce, se, err := c.errDecoder.DecodeEVMError(err)
+		switch {
+		case ce != nil:
+			err = ce
+		case se != nil:
+			err = se
+		}
+		return nil, AsRiverError(err, Err_CANNOT_CALL_CONTRACT).
+			Func("UpdateStreamPlacement").
+			Tags("name", name, "streamId", streamId, "nodeAddress", nodeAddress)
+	}
+	return pendingTx, nil
+}
+
 type NodeRecord = river.Node
 
 func (c *RiverRegistryContract) GetAllNodes(ctx context.Context, blockNum crypto.BlockNumber) ([]NodeRecord, error) {
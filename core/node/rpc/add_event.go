@@ -2,9 +2,11 @@ package rpc
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
 
 	. "github.com/river-build/river/core/node/base"
 	"github.com/river-build/river/core/node/dlog"
@@ -38,14 +40,7 @@ func (s *Service) localAddEvent(
 	if err != nil && req.Msg.Optional {
 		// aellis 5/2024 - we only want to wrap errors from canAddEvent,
 		// currently this is catching all errors, which is not ideal
-		riverError := AsRiverError(err).Func("localAddEvent")
-		return connect.NewResponse(&AddEventResponse{
-			Error: &AddEventResponse_Error{
-				Code:  riverError.Code,
-				Msg:   riverError.Error(),
-				Funcs: riverError.Funcs,
-			},
-		}), nil
+		return connect.NewResponse(newAddEventErrorResponse(AsRiverError(err).Func("localAddEvent"))), nil
 	} else if err != nil {
 		return nil, AsRiverError(err).Func("localAddEvent")
 	} else {
@@ -59,11 +54,39 @@ func (s *Service) addParsedEvent(
 	parsedEvent *ParsedEvent,
 	nodes StreamNodes,
 ) error {
-	localStream, streamView, err := s.cache.GetStream(ctx, streamId)
+	localStream, canAddEvent, err := s.checkParsedEvent(ctx, streamId, parsedEvent)
+	if !canAddEvent || err != nil {
+		return err
+	}
+
+	stream := &replicatedStream{
+		streamId:    streamId.String(),
+		localStream: localStream,
+		nodes:       nodes,
+		service:     s,
+	}
+
+	err = stream.AddEvent(ctx, parsedEvent)
 	if err != nil {
 		return err
 	}
 
+	return nil
+}
+
+// checkParsedEvent checks if the event can be added to the local stream and
+// executes side effects required by the stream rules.
+// Returns false without error if the event is valid, but should not be added.
+func (s *Service) checkParsedEvent(
+	ctx context.Context,
+	streamId StreamId,
+	parsedEvent *ParsedEvent,
+) (SyncStream, bool, error) {
+	localStream, streamView, err := s.cache.GetStream(ctx, streamId)
+	if err != nil {
+		return nil, false, err
+	}
+
 	canAddEvent, chainAuthArgsList, sideEffects, err := rules.CanAddEvent(
 		ctx,
 		s.chainConfig,
@@ -71,10 +94,21 @@ func (s *Service) addParsedEvent(
 		time.Now(),
 		parsedEvent,
 		streamView,
+		s.storage,
 	)
 
 	if !canAddEvent || err != nil {
-		return err
+		return nil, false, err
+	}
+
+	// Tokens are taken only for events that passed the stream rules, so rejected and no-op events
+	// don't use up the limits, but before entitlement checks and side effects.
+	// Events created by nodes, e.g. side effect events, are not rate limited.
+	creator := common.BytesToAddress(parsedEvent.Event.CreatorAddress)
+	if s.rateLimiter != nil && !slices.Contains(s.nodeRegistry.GetValidNodeAddresses(), creator) {
+		if err := s.rateLimiter.Allow(streamId, creator); err != nil {
+			return nil, false, err
+		}
 	}
 
 	if len(chainAuthArgsList) > 0 {
@@ -84,7 +118,7 @@ func (s *Service) addParsedEvent(
 		for _, chainAuthArgs := range chainAuthArgsList {
 			isEntitled, err = s.chainAuth.IsEntitled(ctx, s.config, chainAuthArgs)
 			if err != nil {
-				return err
+				return nil, false, err
 			}
 			if isEntitled {
 				break
@@ -99,10 +133,10 @@ func (s *Service) addParsedEvent(
 					sideEffects.OnChainAuthFailure.Payload,
 				)
 				if err != nil {
-					return err
+					return nil, false, err
 				}
 			}
-			return RiverError(
+			return nil, false, RiverError(
 				Err_PERMISSION_DENIED,
 				"IsEntitled failed",
 				"chainAuthArgsList",
@@ -114,23 +148,11 @@ func (s *Service) addParsedEvent(
 	if sideEffects.RequiredParentEvent != nil {
 		err := s.addEventPayload(ctx, sideEffects.RequiredParentEvent.StreamId, sideEffects.RequiredParentEvent.Payload)
 		if err != nil {
-			return err
+			return nil, false, err
 		}
 	}
 
-	stream := &replicatedStream{
-		streamId:    streamId.String(),
-		localStream: localStream,
-		nodes:       nodes,
-		service:     s,
-	}
-
-	err = stream.AddEvent(ctx, parsedEvent)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return localStream, true, nil
 }
 
 func (s *Service) addEventPayload(ctx context.Context, streamId StreamId, payload IsStreamEvent_Payload) error {
@@ -0,0 +1,318 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/nodes"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// maxAddEventsBatch is the maximum number of events in a single AddEvents request.
+const maxAddEventsBatch = 5000
+
+// addEventsItem is an event from AddEvents request that is added to a stream hosted on this node.
+type addEventsItem struct {
+	index    int // position in the request
+	streamId StreamId
+	nodes    StreamNodes
+	event    *ParsedEvent
+
+	// quorum adds the event to the local stream and to the remote nodes like replicatedStream.
+	quorum *QuorumPool
+}
+
+// addEventsForward is an event from AddEvents request that is forwarded to a stream hosted elsewhere.
+type addEventsForward struct {
+	index int // position in the request
+	nodes StreamNodes
+	// attempts is the number of nodes the event was forwarded to.
+	attempts int
+}
+
+func newAddEventErrorResponse(err *RiverErrorImpl) *AddEventResponse {
+	return &AddEventResponse{
+		Error: &AddEventResponse_Error{
+			Code:  err.Code,
+			Msg:   err.Error(),
+			Funcs: err.Funcs,
+		},
+	}
+}
+
+func (s *Service) addEventsImpl(
+	ctx context.Context,
+	req *connect.Request[AddEventsRequest],
+) (*connect.Response[AddEventsResponse], error) {
+	if len(req.Msg.Events) > maxAddEventsBatch {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Too many events in request").
+			Tags("numEvents", len(req.Msg.Events), "maxEvents", maxAddEventsBatch)
+	}
+
+	results := make([]*AddEventResponse, len(req.Msg.Events))
+	setError := func(index int, err error) {
+		results[index] = newAddEventErrorResponse(AsRiverError(err).Func("addEvents"))
+	}
+
+	type streamInfo struct {
+		nodes StreamNodes
+		err   error
+	}
+	streams := make(map[StreamId]streamInfo)
+	var local []*addEventsItem
+	var forwards []*addEventsForward
+	for i, e := range req.Msg.Events {
+		streamId, err := StreamIdFromBytes(e.StreamId)
+		if err != nil {
+			setError(i, err)
+			continue
+		}
+		info, ok := streams[streamId]
+		if !ok {
+			info.nodes, info.err = s.streamRegistry.GetStreamInfo(ctx, streamId)
+			streams[streamId] = info
+		}
+		if info.err != nil {
+			setError(i, info.err)
+			continue
+		}
+
+		if !info.nodes.IsLocal() {
+			forwards = append(forwards, &addEventsForward{index: i, nodes: info.nodes})
+			continue
+		}
+
+		parsedEvent, err := ParseEvent(e.Event)
+		if err != nil {
+			setError(i, err)
+			continue
+		}
+		local = append(local, &addEventsItem{
+			index:    i,
+			streamId: streamId,
+			nodes:    info.nodes,
+			event:    parsedEvent,
+		})
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.forwardAddEventsWithRetries(ctx, req.Msg.Events, forwards, results)
+	}()
+
+	s.localAddEvents(ctx, local, results)
+	wg.Wait()
+
+	for i, r := range results {
+		if r == nil {
+			results[i] = &AddEventResponse{}
+		}
+	}
+	return connect.NewResponse(&AddEventsResponse{Results: results}), nil
+}
+
+// forwardAddEventsWithRetries forwards events of the streams hosted elsewhere in a single request per node.
+// Like peerNodeRequestWithRetries, events are forwarded to another replica of their stream
+// if the node is unavailable, but no more than once to each replica.
+func (s *Service) forwardAddEventsWithRetries(
+	ctx context.Context,
+	events []*AddEventsRequest_Event,
+	forwards []*addEventsForward,
+	results []*AddEventResponse,
+) {
+	numRetries := max(s.config.Network.NumRetries, 1)
+
+	var mu sync.Mutex
+	for len(forwards) > 0 {
+		peers := make(map[common.Address][]*addEventsForward)
+		for _, f := range forwards {
+			peer := f.nodes.GetStickyPeer()
+			f.attempts++
+			peers[peer] = append(peers[peer], f)
+		}
+
+		var retries []*addEventsForward
+		var wg sync.WaitGroup
+		for peer, peerForwards := range peers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := s.forwardAddEvents(ctx, peer, events, peerForwards, results)
+				if err == nil {
+					return
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				for _, f := range peerForwards {
+					if IsConnectNetworkError(err) && f.attempts < min(numRetries, f.nodes.NumRemotes()) {
+						// Mark peer as unavailable.
+						f.nodes.AdvanceStickyPeer(peer)
+						retries = append(retries, f)
+						continue
+					}
+					results[f.index] = newAddEventErrorResponse(
+						AsRiverError(err).Func("forwardAddEventsWithRetries").Tag("numAttempts", f.attempts),
+					)
+				}
+			}()
+		}
+		wg.Wait()
+
+		forwards = retries
+	}
+}
+
+func (s *Service) forwardAddEvents(
+	ctx context.Context,
+	peer common.Address,
+	events []*AddEventsRequest_Event,
+	forwards []*addEventsForward,
+	results []*AddEventResponse,
+) error {
+	stub, err := s.nodeRegistry.GetStreamServiceClientForAddress(peer)
+	if err != nil {
+		return err
+	}
+
+	req := &AddEventsRequest{Events: make([]*AddEventsRequest_Event, len(forwards))}
+	for j, f := range forwards {
+		req.Events[j] = events[f.index]
+	}
+	resp, err := stub.AddEvents(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if len(resp.Msg.Results) != len(forwards) {
+		return RiverError(Err_INTERNAL, "Unexpected number of results in AddEvents response").
+			Tags("nodeAddress", peer, "numEvents", len(forwards), "numResults", len(resp.Msg.Results))
+	}
+
+	for j, f := range forwards {
+		results[f.index] = resp.Msg.Results[j]
+	}
+	return nil
+}
+
+// localAddEvents adds events to the local streams in rounds, each round adds the next event of each stream,
+// so events of the same stream are validated and added in the request order.
+func (s *Service) localAddEvents(ctx context.Context, items []*addEventsItem, results []*AddEventResponse) {
+	var rounds [][]*addEventsItem
+	streamRounds := make(map[StreamId]int)
+	for _, item := range items {
+		round := streamRounds[item.streamId]
+		streamRounds[item.streamId]++
+		if round == len(rounds) {
+			rounds = append(rounds, nil)
+		}
+		rounds[round] = append(rounds[round], item)
+	}
+
+	for _, round := range rounds {
+		s.localAddEventsRound(ctx, round, results)
+	}
+}
+
+// localAddEventsRound validates events of different streams and adds them in the same way as replicatedStream:
+// each event is added to the local stream and to the remote nodes of its stream in parallel, and it's added
+// if it is added to the local stream and to the majority quorum of the remote nodes.
+// Remote nodes receive the events of the round in a single request per node.
+func (s *Service) localAddEventsRound(ctx context.Context, items []*addEventsItem, results []*AddEventResponse) {
+	setError := func(item *addEventsItem, err error) {
+		results[item.index] = newAddEventErrorResponse(AsRiverError(err).Func("localAddEvents"))
+	}
+
+	var added []*addEventsItem
+	remotes := make(map[common.Address][]*addEventsItem)
+	for _, item := range items {
+		localStream, canAddEvent, err := s.checkParsedEvent(ctx, item.streamId, item.event)
+		if err != nil {
+			setError(item, err)
+			continue
+		}
+		if !canAddEvent {
+			continue
+		}
+
+		item.quorum = NewQuorumPool(item.nodes.NumRemotes())
+		item.quorum.GoLocal(func() error {
+			return localStream.AddEvent(ctx, item.event)
+		})
+		added = append(added, item)
+		for _, node := range item.nodes.GetRemotes() {
+			remotes[node] = append(remotes[node], item)
+		}
+	}
+
+	for node, nodeItems := range remotes {
+		var errs []error
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			errs = s.replicateEvents(ctx, node, nodeItems)
+		}()
+
+		for j, item := range nodeItems {
+			item.quorum.GoRemote(node, func(common.Address) error {
+				<-done
+				return errs[j]
+			})
+		}
+	}
+
+	for _, item := range added {
+		if err := item.quorum.Wait(); err != nil {
+			setError(item, err)
+		}
+	}
+}
+
+// replicateEvents sends events to the remote node and returns an error for each event that was not added.
+func (s *Service) replicateEvents(ctx context.Context, node common.Address, items []*addEventsItem) []error {
+	errs := make([]error, len(items))
+
+	resp, err := func() (*NewEventsReceivedResponse, error) {
+		stub, err := s.nodeRegistry.GetNodeToNodeClientForAddress(node)
+		if err != nil {
+			return nil, err
+		}
+
+		req := &NewEventsReceivedRequest{Events: make([]*NewEventReceivedRequest, len(items))}
+		for j, item := range items {
+			req.Events[j] = &NewEventReceivedRequest{
+				StreamId: item.streamId[:],
+				Event:    item.event.Envelope,
+			}
+		}
+		resp, err := stub.NewEventsReceived(ctx, connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Msg.Results) != len(items) {
+			return nil, RiverError(Err_INTERNAL, "Unexpected number of results in NewEventsReceived response").
+				Tags("numEvents", len(items), "numResults", len(resp.Msg.Results))
+		}
+		return resp.Msg, nil
+	}()
+	if err != nil {
+		for j := range errs {
+			errs[j] = AsRiverError(err).Tag("nodeAddress", node)
+		}
+		return errs
+	}
+
+	for j, r := range resp.Results {
+		if r.Error != nil {
+			errs[j] = RiverError(r.Error.Code, r.Error.Msg, "nodeAddress", node)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+func TestAddEvents(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 5, replicationFactor: 3, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	var streamIds []StreamId
+	var wallets []*crypto.Wallet
+	for range 3 {
+		wallet, err := crypto.NewWallet(ctx)
+		require.NoError(err)
+		streamId, _, _, err := createUserSettingsStream(ctx, wallet, client, nil)
+		require.NoError(err)
+		streamIds = append(streamIds, streamId)
+		wallets = append(wallets, wallet)
+	}
+
+	makeEvent := func(i int, prevMiniblockHash []byte) *protocol.AddEventsRequest_Event {
+		if prevMiniblockHash == nil {
+			resp, err := client.GetLastMiniblockHash(ctx, connect.NewRequest(&protocol.GetLastMiniblockHashRequest{
+				StreamId: streamIds[i][:],
+			}))
+			require.NoError(err)
+			prevMiniblockHash = resp.Msg.Hash
+		}
+		addr := crypto.GetTestAddress()
+		envelope, err := events.MakeEnvelopeWithPayload(
+			wallets[i],
+			events.Make_UserSettingsPayload_UserBlock(
+				&protocol.UserSettingsPayload_UserBlock{
+					UserId:    addr[:],
+					IsBlocked: true,
+					EventNum:  1,
+				},
+			),
+			prevMiniblockHash,
+		)
+		require.NoError(err)
+		return &protocol.AddEventsRequest_Event{StreamId: streamIds[i][:], Event: envelope}
+	}
+
+	req := &protocol.AddEventsRequest{
+		Events: []*protocol.AddEventsRequest_Event{
+			makeEvent(0, nil),
+			makeEvent(1, nil),
+			makeEvent(0, nil),
+			makeEvent(2, make([]byte, 32)),
+			makeEvent(2, nil),
+			{StreamId: []byte{1, 2, 3}, Event: makeEvent(2, nil).Event},
+			makeEvent(1, nil),
+		},
+	}
+	resp, err := client.AddEvents(ctx, connect.NewRequest(req))
+	require.NoError(err)
+	require.Len(resp.Msg.Results, len(req.Events))
+	for i, r := range resp.Msg.Results {
+		if i == 3 || i == 5 {
+			require.NotNil(r.Error, "event %d", i)
+		} else {
+			require.Nil(r.Error, "event %d", i)
+		}
+	}
+
+	for i, expected := range []int{2, 2, 1} {
+		getResp, err := client.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{
+			StreamId: streamIds[i][:],
+		}))
+		require.NoError(err)
+		numFillers := 0
+		for _, e := range getResp.Msg.Stream.Events {
+			parsed, err := events.ParseEvent(e)
+			require.NoError(err)
+			if parsed.Event.GetUserSettingsPayload().GetUserBlock() != nil {
+				numFillers++
+			}
+		}
+		for _, mb := range getResp.Msg.Stream.Miniblocks {
+			for _, e := range mb.Events {
+				parsed, err := events.ParseEvent(e)
+				require.NoError(err)
+				if parsed.Event.GetUserSettingsPayload().GetUserBlock() != nil {
+					numFillers++
+				}
+			}
+		}
+		require.Equal(expected, numFillers, "stream %d", i)
+	}
+
+	tooMany := &protocol.AddEventsRequest{}
+	for range maxAddEventsBatch + 1 {
+		tooMany.Events = append(tooMany.Events, req.Events[0])
+	}
+	_, err = client.AddEvents(ctx, connect.NewRequest(tooMany))
+	require.Equal(connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -18,14 +18,12 @@ import (
 	. "github.com/river-build/river/core/node/base"
 	"github.com/river-build/river/core/node/crypto"
 	"github.com/river-build/river/core/node/events"
-	"github.com/river-build/river/core/node/infra"
 	"github.com/river-build/river/core/node/nodes"
 	. "github.com/river-build/river/core/node/protocol"
 	"github.com/river-build/river/core/node/protocol/protocolconnect"
 	"github.com/river-build/river/core/node/registries"
 	. "github.com/river-build/river/core/node/shared"
 	"github.com/river-build/river/core/node/storage"
-	"github.com/river-build/river/core/node/testutils/dbtestutils"
 )
 
 func fillUserSettingsStreamWithData(
@@ -244,21 +242,7 @@ func TestArchiveOneStream(t *testing.T) {
 	)
 	require.NoError(err)
 
-	dbCfg, schema, schemaDeleter, err := dbtestutils.StartDB(ctx)
-	require.NoError(err)
-	defer schemaDeleter()
-
-	pool, err := storage.CreateAndValidatePgxPool(ctx, dbCfg, schema, nil)
-	require.NoError(err)
-
-	streamStorage, err := storage.NewPostgresEventStore(
-		ctx,
-		pool,
-		GenShortNanoid(),
-		make(chan error, 1),
-		infra.NewMetricsFactory(nil, "", ""),
-	)
-	require.NoError(err)
+	streamStorage := storage.NewMemEventStore()
 
 	arch := NewArchiver(&archiveCfg.Archive, registryContract, nodeRegistry, streamStorage)
 
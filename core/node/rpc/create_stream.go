@@ -65,48 +65,12 @@ func (s *Service) createStream(ctx context.Context, req *CreateStreamRequest) (*
 		return nil, err
 	}
 
-	// check that the creator satisfies the required memberships reqirements
-	if csRules.RequiredMemberships != nil {
-		// load the creator's user stream
-		_, creatorStreamView, err := s.loadStream(ctx, csRules.CreatorStreamId)
-		if err != nil {
-			return nil, RiverError(Err_PERMISSION_DENIED, "failed to load creator stream", "err", err)
-		}
-		for _, streamIdBytes := range csRules.RequiredMemberships {
-			streamId, err := StreamIdFromBytes(streamIdBytes)
-			if err != nil {
-				return nil, RiverError(Err_BAD_STREAM_CREATION_PARAMS, "invalid stream id", "err", err)
-			}
-			if !creatorStreamView.(UserStreamView).IsMemberOf(streamId) {
-				return nil, RiverError(Err_PERMISSION_DENIED, "not a member of", "requiredStreamId", streamId)
-			}
-		}
-	}
-
-	// DEPRECATED check that all required users exist in the system
-	for _, userId := range csRules.RequiredUsers {
-		addr, err := AddressStrToEthAddress(userId)
-		if err != nil {
-			return nil, RiverError(Err_PERMISSION_DENIED, "invalid user id", "requiredUserId", userId)
-		}
-		userStreamId := UserStreamIdFromAddr(addr)
-		_, err = s.streamRegistry.GetStreamInfo(ctx, userStreamId)
-		if err != nil {
-			return nil, RiverError(Err_PERMISSION_DENIED, "user does not exist", "requiredUserId", userId)
-		}
+	if err = s.checkRequiredMemberships(ctx, csRules); err != nil {
+		return nil, err
 	}
 
-	// check that all required users exist in the system
-	for _, userAddress := range csRules.RequiredUserAddrs {
-		addr, err := BytesToAddress(userAddress)
-		if err != nil {
-			return nil, RiverError(Err_PERMISSION_DENIED, "invalid user id", "requiredUser", userAddress)
-		}
-		userStreamId := UserStreamIdFromAddr(addr)
-		_, err = s.streamRegistry.GetStreamInfo(ctx, userStreamId)
-		if err != nil {
-			return nil, RiverError(Err_PERMISSION_DENIED, "user does not exist", "requiredUser", userAddress)
-		}
+	if err = s.checkRequiredUsers(ctx, csRules); err != nil {
+		return nil, err
 	}
 
 	// check entitlements
@@ -145,6 +109,57 @@ func (s *Service) createStream(ctx context.Context, req *CreateStreamRequest) (*
 	return resp, nil
 }
 
+// checkRequiredMemberships checks that the creator satisfies the required memberships reqirements
+func (s *Service) checkRequiredMemberships(ctx context.Context, csRules *rules.CreateStreamRules) error {
+	if csRules.RequiredMemberships == nil {
+		return nil
+	}
+	// load the creator's user stream
+	_, creatorStreamView, err := s.loadStream(ctx, csRules.CreatorStreamId)
+	if err != nil {
+		return RiverError(Err_PERMISSION_DENIED, "failed to load creator stream", "err", err)
+	}
+	for _, streamIdBytes := range csRules.RequiredMemberships {
+		streamId, err := StreamIdFromBytes(streamIdBytes)
+		if err != nil {
+			return RiverError(Err_BAD_STREAM_CREATION_PARAMS, "invalid stream id", "err", err)
+		}
+		if !creatorStreamView.(UserStreamView).IsMemberOf(streamId) {
+			return RiverError(Err_PERMISSION_DENIED, "not a member of", "requiredStreamId", streamId)
+		}
+	}
+	return nil
+}
+
+// checkRequiredUsers checks that all required users exist in the system
+func (s *Service) checkRequiredUsers(ctx context.Context, csRules *rules.CreateStreamRules) error {
+	// DEPRECATED
+	for _, userId := range csRules.RequiredUsers {
+		addr, err := AddressStrToEthAddress(userId)
+		if err != nil {
+			return RiverError(Err_PERMISSION_DENIED, "invalid user id", "requiredUserId", userId)
+		}
+		userStreamId := UserStreamIdFromAddr(addr)
+		_, err = s.streamRegistry.GetStreamInfo(ctx, userStreamId)
+		if err != nil {
+			return RiverError(Err_PERMISSION_DENIED, "user does not exist", "requiredUserId", userId)
+		}
+	}
+
+	for _, userAddress := range csRules.RequiredUserAddrs {
+		addr, err := BytesToAddress(userAddress)
+		if err != nil {
+			return RiverError(Err_PERMISSION_DENIED, "invalid user id", "requiredUser", userAddress)
+		}
+		userStreamId := UserStreamIdFromAddr(addr)
+		_, err = s.streamRegistry.GetStreamInfo(ctx, userStreamId)
+		if err != nil {
+			return RiverError(Err_PERMISSION_DENIED, "user does not exist", "requiredUser", userAddress)
+		}
+	}
+	return nil
+}
+
 func (s *Service) createReplicatedStream(
 	ctx context.Context,
 	streamId StreamId,
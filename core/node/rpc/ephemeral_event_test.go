@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+)
+
+func TestEphemeralEvent(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 3, replicationFactor: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, cookie, _, err := createUserSettingsStream(ctx, wallet, client, nil)
+	require.NoError(err)
+
+	makeEphemeral := func(payload protocol.IsStreamEvent_Payload) *protocol.Envelope {
+		event, err := events.MakeStreamEvent(wallet, payload, cookie.PrevMiniblockHash)
+		require.NoError(err)
+		event.Ephemeral = true
+		envelope, err := events.MakeEnvelopeWithEvent(wallet, event)
+		require.NoError(err)
+		return envelope
+	}
+
+	syncCtx, syncCancel := context.WithCancel(ctx)
+	defer syncCancel()
+	syncRes, err := tt.testClient(1).SyncStreams(syncCtx, connect.NewRequest(&protocol.SyncStreamsRequest{
+		SyncPos: []*protocol.SyncCookie{cookie},
+	}))
+	require.NoError(err)
+	require.True(syncRes.Receive())
+	require.NotEmpty(syncRes.Msg().SyncId)
+
+	ephemeral := makeEphemeral(events.Make_MemberPayload_Typing(true, nil))
+	_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+		StreamId: streamId[:],
+		Event:    ephemeral,
+	}))
+	require.NoError(err)
+
+	// Ephemeral event is delivered to the subscribers.
+	received := false
+	for !received && syncRes.Receive() {
+		for _, e := range syncRes.Msg().GetStream().GetEvents() {
+			if bytes.Equal(e.Hash, ephemeral.Hash) {
+				received = true
+			}
+		}
+	}
+	require.True(received)
+	syncCancel()
+
+	// Ephemeral event is not stored in the stream.
+	resp, err := client.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{
+		StreamId: streamId[:],
+	}))
+	require.NoError(err)
+	require.Empty(resp.Msg.Stream.Events)
+
+	// Only typing and presence events can be ephemeral.
+	addr := crypto.GetTestAddress()
+	_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+		StreamId: streamId[:],
+		Event: makeEphemeral(events.Make_UserSettingsPayload_UserBlock(
+			&protocol.UserSettingsPayload_UserBlock{
+				UserId:    addr[:],
+				IsBlocked: true,
+				EventNum:  1,
+			},
+		)),
+	}))
+	require.Error(err)
+	require.Equal(protocol.Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+
+	// Typing and presence events can't be stored.
+	presence, err := events.MakeEnvelopeWithPayload(wallet, events.Make_MemberPayload_Presence(true), cookie.PrevMiniblockHash)
+	require.NoError(err)
+	_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+		StreamId: streamId[:],
+		Event:    presence,
+	}))
+	require.Error(err)
+	require.Equal(protocol.Err_PERMISSION_DENIED, AsRiverError(err).Code)
+}
@@ -210,6 +210,25 @@ func (s *Service) GetStreamEx(
 	return nil
 }
 
+func (s *Service) GetStreams(
+	ctx context.Context,
+	req *connect.Request[GetStreamsRequest],
+) (*connect.Response[GetStreamsResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("GetStreams ENTER", "numStreams", len(req.Msg.StreamIds))
+	r, e := s.getStreamsImpl(ctx, req)
+	if e != nil {
+		return nil, AsRiverError(
+			e,
+		).Func("GetStreams").
+			Tag("numStreams", len(req.Msg.StreamIds)).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("GetStreams LEAVE", "numStreams", len(req.Msg.StreamIds))
+	return r, nil
+}
+
 func (s *Service) getStreamImpl(
 	ctx context.Context,
 	req *connect.Request[GetStreamRequest],
@@ -365,6 +384,164 @@ func (s *Service) getMiniblocksImpl(
 	)
 }
 
+func (s *Service) GetEvent(
+	ctx context.Context,
+	req *connect.Request[GetEventRequest],
+) (*connect.Response[GetEventResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("GetEvent ENTER", "req", req.Msg)
+	r, e := s.getEventImpl(ctx, req)
+	if e != nil {
+		return nil, AsRiverError(
+			e,
+		).Func("GetEvent").
+			Tag("req.Msg.StreamId", req.Msg.StreamId).
+			Tag("req.Msg.EventHash", req.Msg.EventHash).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("GetEvent LEAVE", "response", r.Msg)
+	return r, nil
+}
+
+func (s *Service) getEventImpl(
+	ctx context.Context,
+	req *connect.Request[GetEventRequest],
+) (*connect.Response[GetEventResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.streamRegistry.GetStreamInfo(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodes.IsLocal() {
+		return s.localGetEvent(ctx, req)
+	}
+
+	return peerNodeRequestWithRetries(
+		ctx,
+		nodes,
+		s,
+		func(ctx context.Context, stub StreamServiceClient) (*connect.Response[GetEventResponse], error) {
+			ret, err := stub.GetEvent(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(ret.Msg), nil
+		},
+		-1,
+	)
+}
+
+func (s *Service) GetStreamHistory(
+	ctx context.Context,
+	req *connect.Request[GetStreamHistoryRequest],
+) (*connect.Response[GetStreamHistoryResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("GetStreamHistory ENTER", "req", req.Msg)
+	r, e := s.getStreamHistoryImpl(ctx, req)
+	if e != nil {
+		return nil, AsRiverError(
+			e,
+		).Func("GetStreamHistory").
+			Tag("req.Msg.StreamId", req.Msg.StreamId).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("GetStreamHistory LEAVE", "response", r.Msg)
+	return r, nil
+}
+
+func (s *Service) getStreamHistoryImpl(
+	ctx context.Context,
+	req *connect.Request[GetStreamHistoryRequest],
+) (*connect.Response[GetStreamHistoryResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.streamRegistry.GetStreamInfo(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodes.IsLocal() {
+		return s.localGetStreamHistory(ctx, req)
+	}
+
+	return peerNodeRequestWithRetries(
+		ctx,
+		nodes,
+		s,
+		func(ctx context.Context, stub StreamServiceClient) (*connect.Response[GetStreamHistoryResponse], error) {
+			ret, err := stub.GetStreamHistory(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(ret.Msg), nil
+		},
+		-1,
+	)
+}
+
+func (s *Service) GetThread(
+	ctx context.Context,
+	req *connect.Request[GetThreadRequest],
+) (*connect.Response[GetThreadResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("GetThread ENTER", "req", req.Msg)
+	r, e := s.getThreadImpl(ctx, req)
+	if e != nil {
+		return nil, AsRiverError(
+			e,
+		).Func("GetThread").
+			Tag("req.Msg.StreamId", req.Msg.StreamId).
+			Tag("req.Msg.ThreadId", req.Msg.ThreadId).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("GetThread LEAVE", "response", r.Msg)
+	return r, nil
+}
+
+func (s *Service) getThreadImpl(
+	ctx context.Context,
+	req *connect.Request[GetThreadRequest],
+) (*connect.Response[GetThreadResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.streamRegistry.GetStreamInfo(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodes.IsLocal() {
+		return s.localGetThread(ctx, req)
+	}
+
+	return peerNodeRequestWithRetries(
+		ctx,
+		nodes,
+		s,
+		func(ctx context.Context, stub StreamServiceClient) (*connect.Response[GetThreadResponse], error) {
+			ret, err := stub.GetThread(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(ret.Msg), nil
+		},
+		-1,
+	)
+}
+
 func (s *Service) GetLastMiniblockHash(
 	ctx context.Context,
 	req *connect.Request[GetLastMiniblockHashRequest],
@@ -436,6 +613,82 @@ func (s *Service) AddEvent(
 	return r, nil
 }
 
+func (s *Service) AddEvents(
+	ctx context.Context,
+	req *connect.Request[AddEventsRequest],
+) (*connect.Response[AddEventsResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("AddEvents ENTER", "numEvents", len(req.Msg.Events))
+	r, e := s.addEventsImpl(ctx, req)
+	if e != nil {
+		return nil, AsRiverError(
+			e,
+		).Func("AddEvents").
+			Tag("numEvents", len(req.Msg.Events)).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("AddEvents LEAVE", "numEvents", len(req.Msg.Events))
+	return r, nil
+}
+
+func (s *Service) ValidateEvent(
+	ctx context.Context,
+	req *connect.Request[ValidateEventRequest],
+) (*connect.Response[ValidateEventResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("ValidateEvent ENTER", "req", req.Msg)
+	r, e := s.validateEventImpl(ctx, req)
+	if e != nil {
+		return nil, AsRiverError(
+			e,
+		).Func("ValidateEvent").
+			Tag("req.Msg.StreamId", req.Msg.StreamId).
+			LogWarn(log).
+			AsConnectError()
+	}
+	log.Debug("ValidateEvent LEAVE", "req.Msg.StreamId", req.Msg.StreamId)
+	return r, nil
+}
+
+func (s *Service) validateEventImpl(
+	ctx context.Context,
+	req *connect.Request[ValidateEventRequest],
+) (*connect.Response[ValidateEventResponse], error) {
+	// Same as CreateStream stream creation is evaluated on the node that received the request.
+	if len(req.Msg.CreateStreamEvents) > 0 {
+		return s.localValidateCreateStream(ctx, req)
+	}
+
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := s.streamRegistry.GetStreamInfo(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodes.IsLocal() {
+		return s.localValidateEvent(ctx, req)
+	}
+
+	return peerNodeRequestWithRetries(
+		ctx,
+		nodes,
+		s,
+		func(ctx context.Context, stub StreamServiceClient) (*connect.Response[ValidateEventResponse], error) {
+			ret, err := stub.ValidateEvent(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(ret.Msg), nil
+		},
+		-1,
+	)
+}
+
 func (s *Service) addEventImpl(
 	ctx context.Context,
 	req *connect.Request[AddEventRequest],
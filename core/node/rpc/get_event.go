@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/shared"
+)
+
+func (s *Service) localGetEvent(
+	ctx context.Context,
+	req *connect.Request[GetEventRequest],
+) (*connect.Response[GetEventResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+	eventHash := common.BytesToHash(req.Msg.EventHash)
+
+	location, err := s.storage.GetEventLocation(ctx, eventHash)
+	if err != nil {
+		return nil, err
+	}
+	if location.StreamId != streamId {
+		return nil, RiverError(Err_NOT_FOUND, "Event not found in stream", "eventHash", eventHash)
+	}
+
+	stream, err := s.cache.GetSyncStream(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblocks, _, err := stream.GetMiniblocks(ctx, location.MiniblockNum, location.MiniblockNum+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(miniblocks) != 1 || location.EventIndex >= len(miniblocks[0].Events) {
+		return nil, RiverError(Err_INTERNAL, "Event index points to missing event").
+			Tags("eventHash", eventHash, "miniblockNum", location.MiniblockNum, "eventIndex", location.EventIndex)
+	}
+	event := miniblocks[0].Events[location.EventIndex]
+	if !bytes.Equal(event.Hash, eventHash[:]) {
+		return nil, RiverError(Err_INTERNAL, "Event index points to another event").
+			Tags("eventHash", eventHash, "miniblockNum", location.MiniblockNum, "eventIndex", location.EventIndex)
+	}
+
+	header, err := events.ParseEvent(miniblocks[0].Header)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &GetEventResponse{
+		Event:        event,
+		MiniblockNum: location.MiniblockNum,
+		EventNum:     header.Event.GetMiniblockHeader().GetEventNumOffset() + int64(location.EventIndex),
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/protocol"
+)
+
+func TestGetEvent(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 5, replicationFactor: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, _, inceptionHash, err := createUserSettingsStream(ctx, wallet, tt.testClient(0), nil)
+	require.NoError(err)
+
+	// Stream is placed on a single node, so the rest of the nodes forward the request.
+	for i := range tt.nodes {
+		resp, err := tt.testClient(i).GetEvent(ctx, connect.NewRequest(&protocol.GetEventRequest{
+			StreamId:  streamId[:],
+			EventHash: inceptionHash,
+		}))
+		require.NoError(err, "node %d", i)
+		require.Equal(inceptionHash, resp.Msg.Event.Hash)
+		require.EqualValues(0, resp.Msg.MiniblockNum)
+		require.EqualValues(0, resp.Msg.EventNum)
+	}
+
+	_, err = tt.testClient(0).GetEvent(ctx, connect.NewRequest(&protocol.GetEventRequest{
+		StreamId:  streamId[:],
+		EventHash: make([]byte, 32),
+	}))
+	require.Error(err)
+	require.Equal(connect.CodeNotFound, connect.CodeOf(err))
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/river-build/river/core/node/shared"
 )
 
+// getStreamExReadBatch is the number of miniblocks read from storage at once.
+const getStreamExReadBatch = 100
+
 func (s *Service) localGetStreamEx(
 	ctx context.Context,
 	req *connect.Request[GetStreamExRequest],
@@ -21,19 +24,102 @@ func (s *Service) localGetStreamEx(
 		return err
 	}
 
-	var miniblockNumber int64 = 0
-	for {
-		miniblocks, err := s.storage.ReadMiniblocks(ctx, streamId, miniblockNumber, miniblockNumber+1)
+	from := req.Msg.FromInclusive
+	to := req.Msg.ToExclusive
+	if from < 0 || (to != 0 && to < from) {
+		return RiverError(Err_INVALID_ARGUMENT, "Invalid miniblock range").
+			Tags("fromInclusive", from, "toExclusive", to)
+	}
+
+	for to == 0 || from < to {
+		batchEnd := from + getStreamExReadBatch
+		if to != 0 {
+			batchEnd = min(batchEnd, to)
+		}
+		miniblocks, err := s.storage.ReadMiniblocks(ctx, streamId, from, batchEnd)
 		if err != nil {
 			return err
 		}
-		if len(miniblocks) == 0 {
+		if err := sendGetStreamExMiniblocks(resp, miniblocks); err != nil {
+			return err
+		}
+		from += int64(len(miniblocks))
+		if from < batchEnd {
 			break
 		}
+	}
 
-		var miniblock Miniblock
-		err = proto.Unmarshal(miniblocks[0], &miniblock)
+	if to == 0 && req.Msg.IncludeMinipool {
+		if err := s.sendGetStreamExMinipool(ctx, streamId, from, resp); err != nil {
+			return err
+		}
+	}
+
+	// Send back an empty response to signal the end of the stream.
+	if err := resp.Send(&GetStreamExResponse{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// sendGetStreamExMinipool sends the minipool of the stream after miniblocks up to, but not including, from
+// were sent. Miniblocks produced since then are sent before the minipool.
+func (s *Service) sendGetStreamExMinipool(
+	ctx context.Context,
+	streamId shared.StreamId,
+	from int64,
+	resp *connect.ServerStream[GetStreamExResponse],
+) error {
+	for {
+		result, err := s.storage.ReadStreamFromLastSnapshot(ctx, streamId, 0)
 		if err != nil {
+			return err
+		}
+
+		if result.StartMiniblockNumber > from {
+			// New snapshot was produced since the last read, read miniblocks in between from storage.
+			miniblocks, err := s.storage.ReadMiniblocks(ctx, streamId, from, result.StartMiniblockNumber)
+			if err != nil {
+				return err
+			}
+			if len(miniblocks) == 0 {
+				return RiverError(Err_INTERNAL, "Miniblocks before last snapshot not found").
+					Tags("fromInclusive", from, "snapshotMiniblockNum", result.StartMiniblockNumber)
+			}
+			if err := sendGetStreamExMiniblocks(resp, miniblocks); err != nil {
+				return err
+			}
+			from += int64(len(miniblocks))
+			continue
+		}
+
+		skip := from - result.StartMiniblockNumber
+		if skip < int64(len(result.Miniblocks)) {
+			if err := sendGetStreamExMiniblocks(resp, result.Miniblocks[skip:]); err != nil {
+				return err
+			}
+		}
+
+		minipool := &Minipool{Events: make([]*Envelope, len(result.MinipoolEnvelopes))}
+		for i, data := range result.MinipoolEnvelopes {
+			minipool.Events[i] = &Envelope{}
+			if err := proto.Unmarshal(data, minipool.Events[i]); err != nil {
+				return WrapRiverError(Err_BAD_EVENT, err).Message("Unable to unmarshal minipool envelope")
+			}
+		}
+		return resp.Send(&GetStreamExResponse{
+			Data: &GetStreamExResponse_Minipool{
+				Minipool: minipool,
+			},
+		})
+	}
+}
+
+func sendGetStreamExMiniblocks(resp *connect.ServerStream[GetStreamExResponse], miniblocks [][]byte) error {
+	for _, data := range miniblocks {
+		var miniblock Miniblock
+		if err := proto.Unmarshal(data, &miniblock); err != nil {
 			return WrapRiverError(Err_BAD_BLOCK, err).Message("Unable to unmarshal miniblock")
 		}
 
@@ -44,14 +130,6 @@ func (s *Service) localGetStreamEx(
 		}); err != nil {
 			return err
 		}
-
-		miniblockNumber++
 	}
-
-	// Send back an empty response to signal the end of the stream.
-	if err := resp.Send(&GetStreamExResponse{}); err != nil {
-		return err
-	}
-
 	return nil
 }
@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+)
+
+func TestGetStreamExRange(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, _, _, err := createUserSettingsStream(ctx, wallet, client, nil)
+	require.NoError(err)
+
+	for i := int64(1); i <= 4; i++ {
+		require.NoError(addUserBlockedFillerEvent(ctx, wallet, client, streamId, nil))
+		_, num, err := makeMiniblock(ctx, client, streamId, i == 2, i-1)
+		require.NoError(err)
+		require.Equal(i, num)
+	}
+	require.NoError(addUserBlockedFillerEvent(ctx, wallet, client, streamId, nil))
+
+	getStreamEx := func(req *protocol.GetStreamExRequest) ([]int64, *protocol.Minipool) {
+		req.StreamId = streamId[:]
+		resp, err := client.GetStreamEx(ctx, connect.NewRequest(req))
+		require.NoError(err)
+		defer resp.Close()
+
+		nums := []int64{}
+		var minipool *protocol.Minipool
+		sawLastPacket := false
+		for resp.Receive() {
+			require.False(sawLastPacket)
+			switch data := resp.Msg().GetData().(type) {
+			case *protocol.GetStreamExResponse_Miniblock:
+				require.Nil(minipool)
+				header, err := events.ParseEvent(data.Miniblock.Header)
+				require.NoError(err)
+				nums = append(nums, header.Event.GetMiniblockHeader().GetMiniblockNum())
+			case *protocol.GetStreamExResponse_Minipool:
+				minipool = data.Minipool
+			default:
+				sawLastPacket = true
+			}
+		}
+		require.NoError(resp.Err())
+		require.True(sawLastPacket)
+		return nums, minipool
+	}
+
+	nums, minipool := getStreamEx(&protocol.GetStreamExRequest{})
+	require.Equal([]int64{0, 1, 2, 3, 4}, nums)
+	require.Nil(minipool)
+
+	nums, minipool = getStreamEx(&protocol.GetStreamExRequest{FromInclusive: 1, ToExclusive: 3})
+	require.Equal([]int64{1, 2}, nums)
+	require.Nil(minipool)
+
+	nums, minipool = getStreamEx(&protocol.GetStreamExRequest{FromInclusive: 3, IncludeMinipool: true})
+	require.Equal([]int64{3, 4}, nums)
+	require.NotNil(minipool)
+	require.Len(minipool.Events, 1)
+
+	nums, minipool = getStreamEx(&protocol.GetStreamExRequest{FromInclusive: 5, IncludeMinipool: true})
+	require.Empty(nums)
+	require.Len(minipool.GetEvents(), 1)
+
+	resp, err := client.GetStreamEx(ctx, connect.NewRequest(&protocol.GetStreamExRequest{
+		StreamId:      streamId[:],
+		FromInclusive: 3,
+		ToExclusive:   2,
+	}))
+	require.NoError(err)
+	defer resp.Close()
+	require.False(resp.Receive())
+	require.Equal(connect.CodeInvalidArgument, connect.CodeOf(resp.Err()))
+}
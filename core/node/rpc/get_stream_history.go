@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/shared"
+)
+
+func (s *Service) localGetStreamHistory(
+	ctx context.Context,
+	req *connect.Request[GetStreamHistoryRequest],
+) (*connect.Response[GetStreamHistoryResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, streamView, err := s.cache.GetStream(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := events.ReadStreamHistory(ctx, s.storage, streamId, streamView.LastBlock().Num, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,145 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+func newGetStreamsError(err *RiverErrorImpl) *GetStreamsResponse_Error {
+	return &GetStreamsResponse_Error{
+		Code:  err.Code,
+		Msg:   err.Error(),
+		Funcs: err.Funcs,
+	}
+}
+
+func (s *Service) getStreamsImpl(
+	ctx context.Context,
+	req *connect.Request[GetStreamsRequest],
+) (*connect.Response[GetStreamsResponse], error) {
+	maxIds := s.config.Network.GetMaxGetStreamsIds()
+	if len(req.Msg.StreamIds) > maxIds {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Too many stream ids in request").
+			Tags("numStreams", len(req.Msg.StreamIds), "maxStreams", maxIds)
+	}
+
+	results := make([]*GetStreamsResponse_Result, len(req.Msg.StreamIds))
+	for i, id := range req.Msg.StreamIds {
+		results[i] = &GetStreamsResponse_Result{StreamId: id}
+	}
+	setError := func(index int, err error) {
+		results[index].Error = newGetStreamsError(AsRiverError(err).Func("getStreams"))
+	}
+
+	var local []int
+	forwards := make(map[common.Address][]int)
+	for i, id := range req.Msg.StreamIds {
+		streamId, err := StreamIdFromBytes(id)
+		if err != nil {
+			setError(i, err)
+			continue
+		}
+		nodes, err := s.streamRegistry.GetStreamInfo(ctx, streamId)
+		if err != nil {
+			if req.Msg.Optional && AsRiverError(err).Code == Err_NOT_FOUND {
+				continue
+			}
+			setError(i, err)
+			continue
+		}
+		if nodes.IsLocal() {
+			local = append(local, i)
+		} else {
+			peer := nodes.GetStickyPeer()
+			forwards[peer] = append(forwards[peer], i)
+		}
+	}
+
+	// Streams hosted elsewhere are requested with a single request per node.
+	var wg sync.WaitGroup
+	for peer, indices := range forwards {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := s.forwardGetStreams(ctx, peer, req.Msg, indices, results)
+			if err == nil {
+				return
+			}
+
+			// Fall back to requesting streams one by one, so other replicas are tried.
+			dlog.FromCtx(ctx).Warn("GetStreams: forwarding failed", "nodeAddress", peer, "error", err)
+			for _, i := range indices {
+				s.getStreamsResult(ctx, req.Msg, i, results, s.getStreamImpl)
+			}
+		}()
+	}
+
+	for _, i := range local {
+		s.getStreamsResult(ctx, req.Msg, i, results, s.localGetStream)
+	}
+	wg.Wait()
+
+	return connect.NewResponse(&GetStreamsResponse{Results: results}), nil
+}
+
+// getStreamsResult loads a single stream of the GetStreams request with the given GetStream implementation.
+func (s *Service) getStreamsResult(
+	ctx context.Context,
+	req *GetStreamsRequest,
+	index int,
+	results []*GetStreamsResponse_Result,
+	getStream func(context.Context, *connect.Request[GetStreamRequest]) (*connect.Response[GetStreamResponse], error),
+) {
+	resp, err := getStream(ctx, connect.NewRequest(&GetStreamRequest{
+		StreamId: req.StreamIds[index],
+		Optional: req.Optional,
+	}))
+	if err != nil {
+		results[index].Error = newGetStreamsError(AsRiverError(err).Func("getStreams"))
+		return
+	}
+	results[index].Stream = resp.Msg.Stream
+}
+
+func (s *Service) forwardGetStreams(
+	ctx context.Context,
+	peer common.Address,
+	req *GetStreamsRequest,
+	indices []int,
+	results []*GetStreamsResponse_Result,
+) error {
+	stub, err := s.nodeRegistry.GetStreamServiceClientForAddress(peer)
+	if err != nil {
+		return err
+	}
+
+	fwdReq := &GetStreamsRequest{
+		StreamIds: make([][]byte, len(indices)),
+		Optional:  req.Optional,
+	}
+	for j, i := range indices {
+		fwdReq.StreamIds[j] = req.StreamIds[i]
+	}
+	resp, err := stub.GetStreams(ctx, connect.NewRequest(fwdReq))
+	if err != nil {
+		return err
+	}
+	if len(resp.Msg.Results) != len(indices) {
+		return RiverError(Err_INTERNAL, "Unexpected number of results in GetStreams response").
+			Tags("nodeAddress", peer, "numStreams", len(indices), "numResults", len(resp.Msg.Results))
+	}
+
+	for j, i := range indices {
+		results[i].Stream = resp.Msg.Results[j].Stream
+		results[i].Error = resp.Msg.Results[j].Error
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestGetStreams(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 5, replicationFactor: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	var streamIds [][]byte
+	for range 6 {
+		wallet, err := crypto.NewWallet(ctx)
+		require.NoError(err)
+		streamId, _, _, err := createUserSettingsStream(ctx, wallet, client, nil)
+		require.NoError(err)
+		streamIds = append(streamIds, streamId[:])
+	}
+	unknownId := testutils.FakeStreamId(shared.STREAM_USER_SETTINGS_BIN)
+	streamIds = append(streamIds, unknownId[:])
+
+	// Streams are placed on different nodes, so the request is fanned out.
+	resp, err := client.GetStreams(ctx, connect.NewRequest(&protocol.GetStreamsRequest{StreamIds: streamIds}))
+	require.NoError(err)
+	require.Len(resp.Msg.Results, len(streamIds))
+	for i, r := range resp.Msg.Results[:len(streamIds)-1] {
+		require.Equal(streamIds[i], r.StreamId)
+		require.Nil(r.Error)
+		require.Equal(streamIds[i], r.Stream.NextSyncCookie.StreamId)
+		require.NotEmpty(r.Stream.Miniblocks)
+	}
+	last := resp.Msg.Results[len(streamIds)-1]
+	require.Nil(last.Stream)
+	require.NotNil(last.Error)
+	require.Equal(protocol.Err_NOT_FOUND, last.Error.Code)
+
+	resp, err = client.GetStreams(ctx, connect.NewRequest(&protocol.GetStreamsRequest{
+		StreamIds: streamIds,
+		Optional:  true,
+	}))
+	require.NoError(err)
+	last = resp.Msg.Results[len(streamIds)-1]
+	require.Nil(last.Stream)
+	require.Nil(last.Error)
+
+	tooMany := &protocol.GetStreamsRequest{}
+	for range tt.nodes[0].service.config.Network.GetMaxGetStreamsIds() + 1 {
+		tooMany.StreamIds = append(tooMany.StreamIds, streamIds[0])
+	}
+	_, err = client.GetStreams(ctx, connect.NewRequest(tooMany))
+	require.Equal(connect.CodeInvalidArgument, connect.CodeOf(err))
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/shared"
+)
+
+func (s *Service) localGetThread(
+	ctx context.Context,
+	req *connect.Request[GetThreadRequest],
+) (*connect.Response[GetThreadResponse], error) {
+	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Msg.ThreadId) != 32 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "invalid thread id")
+	}
+	if req.Msg.FromMiniblockNum < 0 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "invalid from_miniblock_num", "from", req.Msg.FromMiniblockNum)
+	}
+
+	_, streamView, err := s.cache.GetStream(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replies can't be added before the thread root event, so reading starts with the root miniblock.
+	// Roots in miniblocks written before the event index was created are found once
+	// the index backfill reaches them, see PostgresEventStore.StartEventIndexBackfill.
+	from := req.Msg.FromMiniblockNum
+	location, err := s.storage.GetEventLocation(ctx, common.BytesToHash(req.Msg.ThreadId))
+	if err == nil {
+		if location.StreamId != streamId {
+			return nil, RiverError(Err_NOT_FOUND, "Thread root event not found in stream")
+		}
+		from = max(from, location.MiniblockNum)
+	} else if AsRiverError(err).Code == Err_NOT_FOUND {
+		inMinipool := slices.ContainsFunc(streamView.MinipoolEnvelopes(), func(e *Envelope) bool {
+			return bytes.Equal(e.Hash, req.Msg.ThreadId)
+		})
+		if !inMinipool {
+			return nil, RiverError(Err_NOT_FOUND, "Thread root event not found in stream")
+		}
+		from = max(from, streamView.LastBlock().Num+1)
+	} else {
+		return nil, err
+	}
+
+	resp, err := events.ReadThread(ctx, s.storage, streamId, streamView, req.Msg.ThreadId, from)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestGetThread(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 3, replicationFactor: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	_, _, err = createUser(ctx, wallet, client, nil)
+	require.NoError(err)
+	_, _, err = createUserDeviceKeyStream(ctx, wallet, client, nil)
+	require.NoError(err)
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	_, _, err = createSpace(ctx, wallet, client, spaceId, nil)
+	require.NoError(err)
+	channelId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	_, channelHash, err := createChannel(
+		ctx,
+		wallet,
+		client,
+		spaceId,
+		channelId,
+		&protocol.StreamSettings{DisableMiniblockCreation: true},
+	)
+	require.NoError(err)
+
+	sendEvent := func(payload protocol.IsStreamEvent_Payload) (*protocol.Envelope, error) {
+		envelope, err := events.MakeEnvelopeWithPayload(wallet, payload, channelHash)
+		require.NoError(err)
+		_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+			StreamId: channelId[:],
+			Event:    envelope,
+		}))
+		return envelope, err
+	}
+	addEvent := func(payload protocol.IsStreamEvent_Payload) *protocol.Envelope {
+		envelope, err := sendEvent(payload)
+		require.NoError(err)
+		return envelope
+	}
+
+	root := addEvent(events.Make_ChannelPayload_Message("root"))
+	reply1 := addEvent(events.Make_ChannelPayload_ThreadReply(root.Hash, "reply 1"))
+	addEvent(events.Make_ChannelPayload_Message("other"))
+	_, _, err = makeMiniblock(ctx, client, channelId, false, 0)
+	require.NoError(err)
+	reply2 := addEvent(events.Make_ChannelPayload_ThreadReply(root.Hash, "reply 2"))
+
+	// Replies are returned from miniblocks and the minipool by any node.
+	for i := range tt.nodes {
+		resp, err := tt.testClient(i).GetThread(ctx, connect.NewRequest(&protocol.GetThreadRequest{
+			StreamId: channelId[:],
+			ThreadId: root.Hash,
+		}))
+		require.NoError(err)
+		require.True(resp.Msg.Terminus)
+		require.Len(resp.Msg.Replies, 2)
+		require.Equal(reply1.Hash, resp.Msg.Replies[0].Event.Hash)
+		require.Equal(reply2.Hash, resp.Msg.Replies[1].Event.Hash)
+		require.EqualValues(-1, resp.Msg.Replies[1].MiniblockNum)
+	}
+
+	_, err = client.GetThread(ctx, connect.NewRequest(&protocol.GetThreadRequest{
+		StreamId: channelId[:],
+		ThreadId: make([]byte, 32),
+	}))
+	require.Equal(connect.CodeNotFound, connect.CodeOf(err))
+
+	// Thread root must be a message in the stream.
+	_, err = sendEvent(events.Make_ChannelPayload_ThreadReply(make([]byte, 32), "reply"))
+	require.ErrorContains(err, "event is not in the stream")
+	_, err = sendEvent(events.Make_ChannelPayload_ThreadReply(reply1.Hash, "reply"))
+	require.ErrorContains(err, "thread root is not a message")
+
+	// Redacting a reply requires the thread id and updates the thread summary.
+	_, err = sendEvent(events.Make_ChannelPayload_Redaction(reply2.Hash, nil))
+	require.ErrorContains(err, "thread id doesn't match the redacted thread reply")
+	addEvent(events.Make_ChannelPayload_Redaction(reply2.Hash, root.Hash))
+
+	_, _, err = makeMiniblock(ctx, client, channelId, true, 0)
+	require.NoError(err)
+	resp, err := client.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{
+		StreamId: channelId[:],
+	}))
+	require.NoError(err)
+	header, err := events.ParseEvent(resp.Msg.Stream.Miniblocks[0].Header)
+	require.NoError(err)
+	threads := header.Event.GetMiniblockHeader().GetSnapshot().GetChannelContent().GetThreads()
+	require.Len(threads, 1)
+	require.EqualValues(1, threads[0].ReplyCount)
+	require.Equal(reply1.Hash, threads[0].LastReplyEventHash)
+	require.Empty(threads[0].PreviousReplies)
+}
@@ -0,0 +1,128 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestMessageEdit(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet1, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	wallet2, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	_, _, err = createUser(ctx, wallet1, client, nil)
+	require.NoError(err)
+	_, _, err = createUserDeviceKeyStream(ctx, wallet1, client, nil)
+	require.NoError(err)
+	user2, _, err := createUser(ctx, wallet2, client, nil)
+	require.NoError(err)
+	_, _, err = createUserDeviceKeyStream(ctx, wallet2, client, nil)
+	require.NoError(err)
+
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	_, _, err = createSpace(ctx, wallet1, client, spaceId, nil)
+	require.NoError(err)
+	channelId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	_, channelHash, err := createChannel(
+		ctx,
+		wallet1,
+		client,
+		spaceId,
+		channelId,
+		&protocol.StreamSettings{DisableMiniblockCreation: true},
+	)
+	require.NoError(err)
+
+	userJoin, err := events.MakeEnvelopeWithPayload(
+		wallet2,
+		events.Make_UserPayload_Membership(protocol.MembershipOp_SO_JOIN, channelId, nil, spaceId[:]),
+		user2.PrevMiniblockHash,
+	)
+	require.NoError(err)
+	_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+		StreamId: user2.StreamId,
+		Event:    userJoin,
+	}))
+	require.NoError(err)
+
+	addEvent := func(wallet *crypto.Wallet, payload protocol.IsStreamEvent_Payload) error {
+		envelope, err := events.MakeEnvelopeWithPayload(wallet, payload, channelHash)
+		require.NoError(err)
+		_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+			StreamId: channelId[:],
+			Event:    envelope,
+		}))
+		return err
+	}
+
+	message, err := events.MakeStreamEvent(wallet1, events.Make_ChannelPayload_Message("hello"), channelHash)
+	require.NoError(err)
+	envelope, err := events.MakeEnvelopeWithEvent(wallet1, message)
+	require.NoError(err)
+	_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+		StreamId: channelId[:],
+		Event:    envelope,
+	}))
+	require.NoError(err)
+
+	// Creator can edit the message.
+	require.NoError(addEvent(wallet1, events.Make_ChannelPayload_Edit(envelope.Hash, message, "hello, world")))
+
+	// Edited event must match the event id.
+	err = addEvent(wallet1, events.Make_ChannelPayload_Edit(make([]byte, 32), message, "hi"))
+	require.Equal(protocol.Err_PERMISSION_DENIED, AsRiverError(err).Code)
+	require.ErrorContains(err, "invalid message hash")
+
+	// Edited message must be in the stream.
+	otherMessage, err := events.MakeStreamEvent(wallet1, events.Make_ChannelPayload_Message("other"), channelHash)
+	require.NoError(err)
+	otherEnvelope, err := events.MakeEnvelopeWithEvent(wallet1, otherMessage)
+	require.NoError(err)
+	err = addEvent(wallet1, events.Make_ChannelPayload_Edit(otherEnvelope.Hash, otherMessage, "hi"))
+	require.Equal(protocol.Err_PERMISSION_DENIED, AsRiverError(err).Code)
+	require.ErrorContains(err, "event is not in the stream")
+
+	// Only the creator can edit the message.
+	err = addEvent(wallet2, events.Make_ChannelPayload_Edit(envelope.Hash, message, "bye"))
+	require.Equal(protocol.Err_PERMISSION_DENIED, AsRiverError(err).Code)
+
+	// Message can't be edited after the edit window.
+	oldMessage, err := events.MakeStreamEvent(wallet1, events.Make_ChannelPayload_Message("old"), channelHash)
+	require.NoError(err)
+	oldMessage.CreatedAtEpochMs = time.Now().Add(-48 * time.Hour).UnixMilli()
+	oldEnvelope, err := events.MakeEnvelopeWithEvent(wallet1, oldMessage)
+	require.NoError(err)
+	err = addEvent(wallet1, events.Make_ChannelPayload_Edit(oldEnvelope.Hash, oldMessage, "new"))
+	require.Equal(protocol.Err_PERMISSION_DENIED, AsRiverError(err).Code)
+	require.ErrorContains(err, "message edit window has passed")
+
+	// Latest edit is in the snapshot.
+	_, _, err = makeMiniblock(ctx, client, channelId, true, 0)
+	require.NoError(err)
+	resp, err := client.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{
+		StreamId: channelId[:],
+	}))
+	require.NoError(err)
+	header, err := events.ParseEvent(resp.Msg.Stream.Miniblocks[0].Header)
+	require.NoError(err)
+	snapshot := header.Event.GetMiniblockHeader().GetSnapshot()
+	require.NotNil(snapshot)
+	edits := snapshot.GetChannelContent().GetEdits()
+	require.Len(edits, 1)
+	require.Equal(envelope.Hash, edits[0].EventId)
+	require.Equal("hello, world", edits[0].Message.Data.Ciphertext)
+}
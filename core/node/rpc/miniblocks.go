@@ -59,3 +59,55 @@ func (s *Service) SaveMbCandidate(
 
 	return err
 }
+
+func (s *Service) GetMbs(
+	ctx context.Context,
+	node common.Address,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([]*Miniblock, error) {
+	stub, err := s.nodeRegistry.GetStreamServiceClientForAddress(node)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := stub.GetMiniblocks(
+		ctx,
+		connect.NewRequest(&GetMiniblocksRequest{
+			StreamId:      streamId[:],
+			FromInclusive: fromInclusive,
+			ToExclusive:   toExclusive,
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Msg.Miniblocks, nil
+}
+
+func (s *Service) GetLastSnapshotMbs(
+	ctx context.Context,
+	node common.Address,
+	streamId StreamId,
+) ([]*Miniblock, int64, error) {
+	stub, err := s.nodeRegistry.GetStreamServiceClientForAddress(node)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := stub.GetStream(
+		ctx,
+		connect.NewRequest(&GetStreamRequest{
+			StreamId: streamId[:],
+		}),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	stream := resp.Msg.GetStream()
+	miniblocks := stream.GetMiniblocks()
+	return miniblocks, stream.GetNextSyncCookie().GetMinipoolGen() - int64(len(miniblocks)), nil
+}
@@ -95,6 +95,30 @@ func (s *Service) newEventReceived(
 	return &NewEventReceivedResponse{}, nil
 }
 
+func (s *Service) NewEventsReceived(
+	ctx context.Context,
+	req *connect.Request[NewEventsReceivedRequest],
+) (*connect.Response[NewEventsReceivedResponse], error) {
+	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	log.Debug("NewEventsReceived ENTER", "numEvents", len(req.Msg.Events))
+	r := s.newEventsReceived(ctx, req.Msg)
+	log.Debug("NewEventsReceived LEAVE", "numEvents", len(req.Msg.Events))
+	return connect.NewResponse(r), nil
+}
+
+// newEventsReceived adds events in order and returns a result for each event.
+func (s *Service) newEventsReceived(ctx context.Context, req *NewEventsReceivedRequest) *NewEventsReceivedResponse {
+	resp := &NewEventsReceivedResponse{Results: make([]*AddEventResponse, len(req.Events))}
+	for i, e := range req.Events {
+		if _, err := s.newEventReceived(ctx, e); err != nil {
+			resp.Results[i] = newAddEventErrorResponse(AsRiverError(err).Func("newEventsReceived"))
+		} else {
+			resp.Results[i] = &AddEventResponse{}
+		}
+	}
+	return resp
+}
+
 func (s *Service) NewEventInPool(
 	context.Context,
 	*connect.Request[NewEventInPoolRequest],
@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestReactions(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	_, _, err = createUser(ctx, wallet, client, nil)
+	require.NoError(err)
+	_, _, err = createUserDeviceKeyStream(ctx, wallet, client, nil)
+	require.NoError(err)
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	_, _, err = createSpace(ctx, wallet, client, spaceId, nil)
+	require.NoError(err)
+	channelId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	_, channelHash, err := createChannel(
+		ctx,
+		wallet,
+		client,
+		spaceId,
+		channelId,
+		&protocol.StreamSettings{DisableMiniblockCreation: true},
+	)
+	require.NoError(err)
+
+	addEvent := func(payload protocol.IsStreamEvent_Payload) (*protocol.Envelope, error) {
+		envelope, err := events.MakeEnvelopeWithPayload(wallet, payload, channelHash)
+		require.NoError(err)
+		_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+			StreamId: channelId[:],
+			Event:    envelope,
+		}))
+		return envelope, err
+	}
+
+	message, err := addEvent(events.Make_ChannelPayload_Message("hello"))
+	require.NoError(err)
+	_, err = addEvent(events.Make_ChannelPayload_Reaction(message.Hash, "👍"))
+	require.NoError(err)
+	_, err = addEvent(events.Make_ChannelPayload_Reaction(message.Hash, "🎉"))
+	require.NoError(err)
+	_, err = addEvent(events.Make_ChannelPayload_RemoveReaction(message.Hash, "🎉"))
+	require.NoError(err)
+
+	_, err = addEvent(events.Make_ChannelPayload_Reaction(message.Hash, ""))
+	require.Equal(protocol.Err_PERMISSION_DENIED, AsRiverError(err).Code)
+	require.ErrorContains(err, "invalid reaction length")
+	_, err = addEvent(events.Make_ChannelPayload_Reaction(message.Hash[:8], "👍"))
+	require.Equal(protocol.Err_PERMISSION_DENIED, AsRiverError(err).Code)
+	require.ErrorContains(err, "invalid message hash")
+
+	// Reacted message must be in the stream.
+	unknown := make([]byte, 32)
+	_, err = addEvent(events.Make_ChannelPayload_Reaction(unknown, "👍"))
+	require.Equal(protocol.Err_PERMISSION_DENIED, AsRiverError(err).Code)
+	require.ErrorContains(err, "event is not in the stream")
+
+	// Reaction counts are aggregated in the snapshot.
+	_, _, err = makeMiniblock(ctx, client, channelId, true, 0)
+	require.NoError(err)
+	resp, err := client.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{
+		StreamId: channelId[:],
+	}))
+	require.NoError(err)
+	header, err := events.ParseEvent(resp.Msg.Stream.Miniblocks[0].Header)
+	require.NoError(err)
+	reactions := header.Event.GetMiniblockHeader().GetSnapshot().GetChannelContent().GetReactions()
+	require.Len(reactions, 1)
+	require.Equal(message.Hash, reactions[0].EventId)
+	require.Len(reactions[0].Reactions, 1)
+	require.Equal("👍", reactions[0].Reactions[0].Reaction)
+	require.Equal([][]byte{wallet.Address.Bytes()}, reactions[0].Reactions[0].UserAddresses)
+}
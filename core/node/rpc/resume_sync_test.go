@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+)
+
+func TestResumeSync(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, cookie, _, err := createUserSettingsStream(ctx, wallet, client, nil)
+	require.NoError(err)
+
+	addEvent := func(eventNum int64) *protocol.Envelope {
+		addr := crypto.GetTestAddress()
+		envelope, err := events.MakeEnvelopeWithPayload(
+			wallet,
+			events.Make_UserSettingsPayload_UserBlock(&protocol.UserSettingsPayload_UserBlock{
+				UserId:    addr[:],
+				IsBlocked: true,
+				EventNum:  eventNum,
+			}),
+			cookie.PrevMiniblockHash,
+		)
+		require.NoError(err)
+		_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+			StreamId: streamId[:],
+			Event:    envelope,
+		}))
+		require.NoError(err)
+		return envelope
+	}
+
+	receive := func(syncRes *connect.ServerStreamForClient[protocol.SyncStreamsResponse], hash []byte) {
+		for syncRes.Receive() {
+			for _, e := range syncRes.Msg().GetStream().GetEvents() {
+				if bytes.Equal(e.Hash, hash) {
+					return
+				}
+			}
+		}
+		require.Fail("event not received", "err: %v", syncRes.Err())
+	}
+
+	syncCtx, syncCancel := context.WithCancel(ctx)
+	syncRes, err := client.SyncStreams(syncCtx, connect.NewRequest(&protocol.SyncStreamsRequest{
+		SyncPos: []*protocol.SyncCookie{cookie},
+	}))
+	require.NoError(err)
+	require.True(syncRes.Receive())
+	syncID := syncRes.Msg().GetSyncId()
+	require.NotEmpty(syncID)
+
+	receive(syncRes, addEvent(1).Hash)
+
+	// client disconnects
+	syncCancel()
+
+	// resumed sync continues with events added while the client was disconnected
+	second := addEvent(2)
+	resumeCtx, resumeCancel := context.WithCancel(ctx)
+	defer resumeCancel()
+	resumeRes, err := client.ResumeSync(resumeCtx, connect.NewRequest(&protocol.ResumeSyncRequest{SyncId: syncID}))
+	require.NoError(err)
+	require.True(resumeRes.Receive())
+	require.Equal(protocol.SyncOp_SYNC_NEW, resumeRes.Msg().GetSyncOp())
+	require.Equal(syncID, resumeRes.Msg().GetSyncId())
+	receive(resumeRes, second.Hash)
+
+	// resumed sync supports the regular sync requests
+	_, err = client.PingSync(ctx, connect.NewRequest(&protocol.PingSyncRequest{SyncId: syncID, Nonce: "ping"}))
+	require.NoError(err)
+
+	// cancelled sync can't be resumed
+	_, err = client.CancelSync(ctx, connect.NewRequest(&protocol.CancelSyncRequest{SyncId: syncID}))
+	require.NoError(err)
+	resumeRes, err = client.ResumeSync(ctx, connect.NewRequest(&protocol.ResumeSyncRequest{SyncId: syncID}))
+	require.NoError(err)
+	require.False(resumeRes.Receive())
+	require.Equal(protocol.Err_NOT_FOUND, AsRiverError(resumeRes.Err()).Code)
+
+	resumeRes, err = client.ResumeSync(ctx, connect.NewRequest(&protocol.ResumeSyncRequest{SyncId: "unknown"}))
+	require.NoError(err)
+	require.False(resumeRes.Receive())
+	require.Equal(protocol.Err_NOT_FOUND, AsRiverError(resumeRes.Err()).Code)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/river-build/river/core/node/protocol/protocolconnect"
 	"github.com/river-build/river/core/node/registries"
 	"github.com/river-build/river/core/node/rpc/sync"
+	"github.com/river-build/river/core/node/rpc/sync/client"
 	"github.com/river-build/river/core/node/storage"
 	"github.com/river-build/river/core/xchain/entitlement"
 )
@@ -40,6 +41,7 @@ const (
 	ServerModeFull    = "full"
 	ServerModeInfo    = "info"
 	ServerModeArchive = "archive"
+	ServerModeFsck    = "fsck"
 )
 
 func (s *Service) httpServerClose() {
@@ -164,6 +166,8 @@ func (s *Service) start() error {
 		return AsRiverError(err).Message("Failed to init cache and sync").LogError(s.defaultLogger)
 	}
 
+	s.initMiniblockPruner()
+
 	s.riverChain.StartChainMonitor(s.serverCtx)
 
 	s.initHandlers()
@@ -324,6 +328,8 @@ func (s *Service) initRiverChain() error {
 		s.nodeRegistry,
 		s.registryContract,
 		s.chainConfig,
+		s.riverChain.InitialBlockNum,
+		s.riverChain.ChainMonitor,
 	)
 
 	return nil
@@ -334,7 +340,7 @@ func (s *Service) prepareStore() error {
 	case storage.StreamStorageTypePostgres:
 		var schema string
 		switch s.mode {
-		case ServerModeFull:
+		case ServerModeFull, ServerModeFsck:
 			schema = storage.DbSchemaNameFromAddress(s.wallet.Address.Hex())
 		case ServerModeArchive:
 			schema = storage.DbSchemaNameForArchive(s.config.Archive.ArchiveId)
@@ -353,6 +359,8 @@ func (s *Service) prepareStore() error {
 		}
 		s.storagePoolInfo = pool
 
+		return nil
+	case storage.StreamStorageTypeEmbedded, storage.StreamStorageTypeMemory:
 		return nil
 	default:
 		return RiverError(
@@ -415,6 +423,7 @@ func (s *Service) runHttpServer() error {
 			"User-Agent",
 			"Connect-Protocol-Version",
 			"x-river-request-id",
+			"Last-Event-ID",
 		},
 	})
 
@@ -511,6 +520,40 @@ func (s *Service) initStore() error {
 		s.storage = store
 		s.onClose(store.Close)
 
+		if s.config.ColdStorage.Type != "" {
+			cold, err := storage.NewColdStorage(&s.config.ColdStorage)
+			if err != nil {
+				return err
+			}
+			store.SetColdStorage(cold)
+			// Storage is not modified in the background while it's checked.
+			if s.mode != ServerModeFsck {
+				store.StartColdStorageMover(
+					ctx,
+					s.config.ColdStorage.GetMoveInterval(),
+					s.config.ColdStorage.GetMoveBatchSize(),
+				)
+			}
+		}
+
+		if s.config.StorageCompression.Enabled {
+			if err := store.EnableCompression(s.config.StorageCompression.GetLevel()); err != nil {
+				return err
+			}
+			if s.config.StorageCompression.RewriteExisting && s.mode != ServerModeFsck {
+				store.StartCompressionRewriter(
+					ctx,
+					s.config.StorageCompression.GetRewriteInterval(),
+					s.config.StorageCompression.GetRewriteBatchSize(),
+				)
+			}
+		}
+
+		// Storage is not modified in the background while it's checked.
+		if s.mode != ServerModeFsck {
+			store.StartEventIndexBackfill(ctx)
+		}
+
 		streamsCount, err := store.GetStreamsNumber(ctx)
 		if err != nil {
 			return err
@@ -526,6 +569,70 @@ func (s *Service) initStore() error {
 			)
 		}
 		return nil
+	case storage.StreamStorageTypeEmbedded:
+		if s.config.ColdStorage.Type != "" {
+			return RiverError(
+				Err_BAD_CONFIG,
+				"Cold storage is only supported by postgres storage",
+				"storageType",
+				s.config.StorageType,
+			).Func("createStore")
+		}
+		if s.config.StorageCompression.Enabled {
+			return RiverError(
+				Err_BAD_CONFIG,
+				"Storage compression is only supported by postgres storage",
+				"storageType",
+				s.config.StorageType,
+			).Func("createStore")
+		}
+		store, err := storage.NewEmbeddedEventStore(ctx, &s.config.EmbeddedStorage, s.metrics)
+		if err != nil {
+			return err
+		}
+		s.storage = store
+		s.onClose(store.Close)
+
+		streamsCount, err := store.GetStreamsNumber(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !s.config.Log.Simplify {
+			log.Info(
+				"Created embedded event store",
+				"path",
+				s.config.EmbeddedStorage.Path,
+				"totalStreamsCount",
+				streamsCount,
+			)
+		}
+		return nil
+	case storage.StreamStorageTypeMemory:
+		if s.config.ColdStorage.Type != "" {
+			return RiverError(
+				Err_BAD_CONFIG,
+				"Cold storage is only supported by postgres storage",
+				"storageType",
+				s.config.StorageType,
+			).Func("createStore")
+		}
+		if s.config.StorageCompression.Enabled {
+			return RiverError(
+				Err_BAD_CONFIG,
+				"Storage compression is only supported by postgres storage",
+				"storageType",
+				s.config.StorageType,
+			).Func("createStore")
+		}
+		store := storage.NewMemEventStore()
+		s.storage = store
+		s.onClose(store.Close)
+
+		if !s.config.Log.Simplify {
+			log.Info("Created in-memory event store")
+		}
+		return nil
 	default:
 		return RiverError(
 			Err_BAD_CONFIG,
@@ -536,6 +643,21 @@ func (s *Service) initStore() error {
 	}
 }
 
+// initMiniblockPruner starts pruning of miniblocks outside of the on-chain retention window.
+// Archive nodes don't call it since they keep full stream history.
+func (s *Service) initMiniblockPruner() {
+	store, ok := s.storage.(storage.PrunableStreamStorage)
+	if !ok {
+		return
+	}
+	storage.NewMiniblockPruner(
+		store,
+		s.chainConfig,
+		s.config.GetMiniblockPruneInterval(),
+		s.metrics,
+	).Start(s.serverCtx)
+}
+
 func (s *Service) initCacheAndSync() error {
 	var err error
 	s.cache, err = events.NewStreamCache(
@@ -550,6 +672,7 @@ func (s *Service) initCacheAndSync() error {
 			ChainMonitor:            s.riverChain.ChainMonitor,
 			Metrics:                 s.metrics,
 			RemoteMiniblockProvider: s,
+			PurgeRemovedStreams:     s.config.PurgeRemovedStreams,
 		},
 	)
 	if err != nil {
@@ -558,11 +681,31 @@ func (s *Service) initCacheAndSync() error {
 
 	s.mbProducer = events.NewMiniblockProducer(s.serverCtx, s.cache, nil)
 
-	s.syncHandler = sync.NewHandler(
+	s.rateLimiter = events.NewEventRateLimiter(s.chainConfig, s.metrics)
+
+	switch policy := s.config.SyncBuffer.GetOverflowPolicy(); policy {
+	case config.SyncBufferPolicyReset, config.SyncBufferPolicyCancel:
+	default:
+		return RiverError(
+			Err_BAD_CONFIG,
+			"Unknown sync buffer overflow policy",
+			"overflowPolicy",
+			policy,
+		).Func("initCacheAndSync")
+	}
+
+	syncHandler := sync.NewHandler(
 		s.wallet.Address,
 		s.cache,
 		s.nodeRegistry,
+		s.streamRegistry,
+		s.storage,
+		s.config.GetSyncSessionTTL(),
+		s.config.SyncBuffer,
+		client.NewSyncBufferMetrics(s.metrics),
 	)
+	syncHandler.StartSessionCleanup(s.serverCtx)
+	s.syncHandler = syncHandler
 
 	return nil
 }
@@ -582,6 +725,14 @@ func (s *Service) initHandlers() {
 	nodeServicePattern, nodeServiceHandler := protocolconnect.NewNodeToNodeHandler(s, interceptors)
 	s.mux.Handle(nodeServicePattern, newHttpHandler(nodeServiceHandler, s.defaultLogger))
 
+	if transportHandler, ok := s.syncHandler.(sync.TransportHandler); ok {
+		s.mux.Handle(
+			syncWebSocketPattern,
+			newHttpHandler(http.HandlerFunc(transportHandler.ServeWebSocket), s.defaultLogger),
+		)
+		s.mux.Handle(syncSSEPattern, newHttpHandler(http.HandlerFunc(transportHandler.ServeSSE), s.defaultLogger))
+	}
+
 	s.registerDebugHandlers(s.config.EnableDebugEndpoints)
 }
 
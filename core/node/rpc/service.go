@@ -47,6 +47,7 @@ type Service struct {
 	cache       events.StreamCache
 	mbProducer  events.MiniblockProducer
 	syncHandler river_sync.Handler
+	rateLimiter *events.EventRateLimiter
 
 	// River chain
 	riverChain       *crypto.Blockchain
@@ -6,6 +6,13 @@ import (
 	. "github.com/river-build/river/core/node/protocol"
 )
 
+const (
+	// syncWebSocketPattern serves streams sync operations over WebSocket.
+	syncWebSocketPattern = "/sync/websocket"
+	// syncSSEPattern serves streams sync operations as server-sent events.
+	syncSSEPattern = "/sync/sse"
+)
+
 // TODO: wire metrics.
 // var (
 // 	syncStreamsRequests   = infra.NewSuccessMetrics("sync_streams_requests", serviceRequests)
@@ -53,3 +60,11 @@ func (s *Service) PingSync(
 ) (*connect.Response[PingSyncResponse], error) {
 	return s.syncHandler.PingSync(ctx, req)
 }
+
+func (s *Service) ResumeSync(
+	ctx context.Context,
+	req *connect.Request[ResumeSyncRequest],
+	res *connect.ServerStream[SyncStreamsResponse],
+) error {
+	return s.syncHandler.ResumeSync(ctx, req, res)
+}
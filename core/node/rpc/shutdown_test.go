@@ -6,10 +6,14 @@ import (
 
 	. "github.com/river-build/river/core/node/base"
 	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/storage"
 )
 
 func TestShutdown(t *testing.T) {
-	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	tester := newServiceTester(
+		t,
+		serviceTesterOpts{numNodes: 1, start: true, storageType: storage.StreamStorageTypePostgres},
+	)
 	require := tester.require
 
 	first := tester.nodes[0].service
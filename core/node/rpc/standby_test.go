@@ -18,6 +18,7 @@ import (
 	"github.com/river-build/river/core/node/nodes"
 	. "github.com/river-build/river/core/node/protocol"
 	"github.com/river-build/river/core/node/rpc/statusinfo"
+	"github.com/river-build/river/core/node/storage"
 )
 
 func stanbyStartOpts() startOpts {
@@ -61,7 +62,7 @@ func requireStatus(t *testing.T, url string, expected string) {
 }
 
 func TestStandbySingle(t *testing.T) {
-	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1})
+	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1, storageType: storage.StreamStorageTypePostgres})
 	require := tester.require
 
 	tester.initNodeRecords(0, 1, river.NodeStatus_Operational)
@@ -73,7 +74,7 @@ func TestStandbySingle(t *testing.T) {
 }
 
 func TestStandbyEvictionByNlbSwitch(t *testing.T) {
-	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1})
+	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1, storageType: storage.StreamStorageTypePostgres})
 	require := tester.require
 
 	redirector := tester.nodes[0].listener
@@ -143,7 +144,7 @@ func TestStandbyEvictionByNlbSwitch(t *testing.T) {
 }
 
 func TestStandbyEvictionByUrlUpdate(t *testing.T) {
-	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1})
+	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1, storageType: storage.StreamStorageTypePostgres})
 	require := tester.require
 
 	firstListener := tester.nodes[0].listener
@@ -0,0 +1,158 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	"github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+func (s *Service) startFsckMode() error {
+	s.startTime = time.Now()
+
+	s.initInstance(ServerModeFsck)
+
+	err := s.initWallet()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init wallet").LogError(s.defaultLogger)
+	}
+
+	err = s.initRiverChain()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init river chain").LogError(s.defaultLogger)
+	}
+
+	err = s.prepareStore()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to prepare store").LogError(s.defaultLogger)
+	}
+
+	err = s.initStore()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init store").LogError(s.defaultLogger)
+	}
+
+	return nil
+}
+
+// RunStorageFsck checks consistency of all streams in the local storage of the node
+// and returns found problems. If repair is set, broken streams are rebuilt from miniblocks
+// fetched from the other stream replicas and orphaned tables are dropped.
+// Node must not be running while the check is in progress.
+func RunStorageFsck(ctx context.Context, cfg *config.Config, repair bool) ([]*events.StorageProblem, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	service := &Service{
+		serverCtx:  ctx,
+		config:     cfg,
+		exitSignal: make(chan error, 1),
+	}
+	defer service.Close()
+
+	err := service.startFsckMode()
+	if err != nil {
+		return nil, err
+	}
+
+	return service.storageFsck(service.serverCtx, repair)
+}
+
+func (s *Service) storageFsck(ctx context.Context, repair bool) ([]*events.StorageProblem, error) {
+	log := dlog.FromCtx(ctx)
+
+	store, ok := s.storage.(storage.PrunableStreamStorage)
+	if !ok {
+		return nil, RiverError(Err_BAD_CONFIG, "Storage doesn't support listing streams").Func("storageFsck")
+	}
+	streamIds, err := store.GetStreams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Checking streams", "streams", len(streamIds), "repair", repair)
+
+	var problems []*events.StorageProblem
+	for _, streamId := range streamIds {
+		if ctx.Err() != nil {
+			return problems, ctx.Err()
+		}
+
+		data, streamProblems := s.fsckStream(ctx, streamId)
+		if len(streamProblems) > 0 && repair {
+			err := s.repairStream(ctx, streamId, data)
+			if err != nil {
+				log.Error("Failed to repair stream", "streamId", streamId, "error", err)
+			} else {
+				for _, p := range streamProblems {
+					p.Repaired = true
+				}
+			}
+		}
+		problems = append(problems, streamProblems...)
+	}
+
+	if pgStore, ok := s.storage.(*storage.PostgresEventStore); ok {
+		var tables []string
+		if repair {
+			tables, err = pgStore.DropOrphanedStreamTables(ctx)
+		} else {
+			tables, err = pgStore.GetOrphanedStreamTables(ctx)
+		}
+		if err != nil {
+			return problems, err
+		}
+		for _, table := range tables {
+			problems = append(problems, &events.StorageProblem{
+				Type:         events.StorageProblemOrphanedTable,
+				MiniblockNum: -1,
+				Message:      table,
+				Repaired:     repair,
+			})
+		}
+	}
+
+	return problems, nil
+}
+
+// fsckStream reads and checks local data of the stream. Returned data is nil if the stream is unreadable.
+func (s *Service) fsckStream(
+	ctx context.Context,
+	streamId StreamId,
+) (*storage.DebugReadStreamDataResult, []*events.StorageProblem) {
+	data, err := s.storage.DebugReadStreamData(ctx, streamId)
+	if err != nil {
+		return nil, []*events.StorageProblem{{
+			Type:         events.StorageProblemUnreadable,
+			StreamId:     streamId,
+			MiniblockNum: -1,
+			Message:      err.Error(),
+		}}
+	}
+	return data, events.CheckStreamStorage(data)
+}
+
+// repairStream replaces the local copy of the stream with miniblocks fetched from the other replicas
+// up to the last miniblock registered in the stream registry.
+func (s *Service) repairStream(ctx context.Context, streamId StreamId, data *storage.DebugReadStreamDataResult) error {
+	record, err := s.registryContract.GetStream(ctx, streamId)
+	if err != nil {
+		return err
+	}
+	nodes, err := s.streamRegistry.GetStreamInfo(ctx, streamId)
+	if err != nil {
+		return err
+	}
+
+	written, err := events.RepairStreamStorage(ctx, s.storage, s, nodes, record, data)
+	if err != nil {
+		return err
+	}
+	dlog.FromCtx(ctx).Info("Stream repaired", "streamId", streamId, "miniblocks", written)
+	return nil
+}
@@ -0,0 +1,268 @@
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/river-build/river/core/config"
+	"github.com/river-build/river/core/node/dlog"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// SyncBufferMetrics are shared by the buffers of all sync operations.
+type SyncBufferMetrics struct {
+	bufferedEvents prometheus.Gauge
+	coalesced      prometheus.Counter
+	reset          prometheus.Counter
+	cancelled      prometheus.Counter
+}
+
+func NewSyncBufferMetrics(metrics infra.MetricsFactory) *SyncBufferMetrics {
+	updates := metrics.NewCounterVecEx(
+		"sync_buffer_overflow_updates",
+		"Number of stream updates for sync clients that were coalesced, replaced with a sync reset or cancelled the sync",
+		"result",
+	)
+	return &SyncBufferMetrics{
+		bufferedEvents: metrics.NewGaugeEx(
+			"sync_buffer_events",
+			"Number of events buffered for clients of sync operations",
+		),
+		coalesced: updates.WithLabelValues("coalesced"),
+		reset:     updates.WithLabelValues("reset"),
+		cancelled: updates.WithLabelValues("cancelled"),
+	}
+}
+
+// SyncStreamReset is a request to re-subscribe on the stream, so the client receives a sync reset with the current
+// stream state instead of the updates that were dropped from the buffer.
+type SyncStreamReset struct {
+	StreamID StreamId
+	// Cookie is the last cookie received for the stream.
+	Cookie *SyncCookie
+}
+
+// SyncBuffer is the bounded buffer of messages for the client of a sync operation.
+// Syncers add messages to the buffer and the sync operation sends them to the client.
+//
+// While an update of a stream waits in the buffer, following updates of the stream are coalesced into it.
+// When the number of buffered events exceeds the limit either the buffered updates of the largest stream are
+// replaced with a sync reset, or the sync operation is cancelled, depending on the overflow policy.
+type SyncBuffer struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	syncID    string
+	maxEvents int
+	policy    string
+	metrics   *SyncBufferMetrics
+
+	// ready is signalled when messages are added or the buffer is closed
+	ready chan struct{}
+
+	// mu guards the fields below
+	mu sync.Mutex
+	// messages are the buffered messages in the order they were added, dropped updates are nil
+	messages []*SyncStreamsResponse
+	// updates maps from stream id to the index of the buffered update of the stream in messages
+	updates map[StreamId]int
+	// events is the number of buffered events
+	events int
+	// resetting keeps streams for which a sync reset is requested,
+	// updates of these streams are dropped until the reset update is received
+	resetting map[StreamId]struct{}
+	// resets are the sync reset requests that are not yet handled by the sync operation
+	resets []*SyncStreamReset
+	closed bool
+}
+
+func NewSyncBuffer(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	syncID string,
+	cfg config.SyncBufferConfig,
+	metrics *SyncBufferMetrics,
+) *SyncBuffer {
+	return &SyncBuffer{
+		ctx:       ctx,
+		cancel:    cancel,
+		syncID:    syncID,
+		maxEvents: cfg.GetMaxEvents(),
+		policy:    cfg.GetOverflowPolicy(),
+		metrics:   metrics,
+		ready:     make(chan struct{}, 1),
+		updates:   make(map[StreamId]int),
+		resetting: make(map[StreamId]struct{}),
+	}
+}
+
+// Ready returns a channel that receives a value when messages can be taken from the buffer with Take.
+func (b *SyncBuffer) Ready() <-chan struct{} {
+	return b.ready
+}
+
+func (b *SyncBuffer) signal() {
+	select {
+	case b.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Add adds the message to the buffer. Updates are coalesced with the buffered update of the same stream.
+func (b *SyncBuffer) Add(msg *SyncStreamsResponse) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	if msg.GetSyncOp() == SyncOp_SYNC_UPDATE {
+		if !b.addUpdate(msg) {
+			return
+		}
+	} else {
+		if streamID, err := StreamIdFromBytes(msg.GetStreamId()); err == nil {
+			// following updates of the stream must be sent after this message
+			delete(b.updates, streamID)
+			delete(b.resetting, streamID)
+		}
+		b.messages = append(b.messages, msg)
+	}
+
+	if b.events > b.maxEvents {
+		b.overflow()
+	}
+
+	b.signal()
+}
+
+// addUpdate adds the stream update to the buffer and returns true if the buffer changed.
+func (b *SyncBuffer) addUpdate(msg *SyncStreamsResponse) bool {
+	update := msg.GetStream()
+	streamID, err := StreamIdFromBytes(update.GetNextSyncCookie().GetStreamId())
+	if err != nil {
+		return false
+	}
+
+	if _, resetting := b.resetting[streamID]; resetting {
+		if !update.GetSyncReset() {
+			return false
+		}
+		delete(b.resetting, streamID)
+	}
+
+	b.addEvents(len(update.GetEvents()))
+
+	i, found := b.updates[streamID]
+	if !found {
+		b.updates[streamID] = len(b.messages)
+		b.messages = append(b.messages, msg)
+		return true
+	}
+
+	buffered := b.messages[i].GetStream()
+	if update.GetSyncReset() {
+		// sync reset replaces the stream state on the client, previous updates are not needed
+		b.addEvents(-len(buffered.GetEvents()))
+		b.messages[i] = msg
+	} else {
+		// updates can be shared with other sync operations, so a new update is created
+		events := make([]*Envelope, 0, len(buffered.GetEvents())+len(update.GetEvents()))
+		events = append(events, buffered.GetEvents()...)
+		b.messages[i] = &SyncStreamsResponse{
+			SyncOp: SyncOp_SYNC_UPDATE,
+			Stream: &StreamAndCookie{
+				Events:         append(events, update.GetEvents()...),
+				NextSyncCookie: update.GetNextSyncCookie(),
+				Miniblocks:     append(slices.Clone(buffered.GetMiniblocks()), update.GetMiniblocks()...),
+				SyncReset:      buffered.GetSyncReset(),
+			},
+		}
+	}
+	if b.metrics != nil {
+		b.metrics.coalesced.Inc()
+	}
+	return true
+}
+
+func (b *SyncBuffer) addEvents(n int) {
+	b.events += n
+	if b.metrics != nil {
+		b.metrics.bufferedEvents.Add(float64(n))
+	}
+}
+
+// overflow applies the overflow policy when the client falls behind and too many events are buffered.
+func (b *SyncBuffer) overflow() {
+	if b.policy == config.SyncBufferPolicyCancel {
+		dlog.FromCtx(b.ctx).Error("Cancel client sync operation - client buffer full", "syncId", b.syncID)
+		if b.metrics != nil {
+			b.metrics.cancelled.Inc()
+		}
+		b.cancel()
+		return
+	}
+
+	for b.events > b.maxEvents {
+		var (
+			largest     StreamId
+			largestSize = -1
+		)
+		for streamID, i := range b.updates {
+			if size := len(b.messages[i].GetStream().GetEvents()); size > largestSize {
+				largest, largestSize = streamID, size
+			}
+		}
+		if largestSize <= 0 {
+			return
+		}
+
+		i := b.updates[largest]
+		b.resets = append(b.resets, &SyncStreamReset{
+			StreamID: largest,
+			Cookie:   b.messages[i].GetStream().GetNextSyncCookie(),
+		})
+		b.addEvents(-largestSize)
+		b.messages[i] = nil
+		delete(b.updates, largest)
+		b.resetting[largest] = struct{}{}
+		if b.metrics != nil {
+			b.metrics.reset.Inc()
+		}
+	}
+}
+
+// Take returns the buffered messages and sync reset requests and empties the buffer.
+// closed is true if the buffer is closed and no more messages are added.
+func (b *SyncBuffer) Take() (messages []*SyncStreamsResponse, resets []*SyncStreamReset, closed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	messages = make([]*SyncStreamsResponse, 0, len(b.messages))
+	for _, msg := range b.messages {
+		if msg != nil {
+			messages = append(messages, msg)
+		}
+	}
+	resets = b.resets
+
+	b.addEvents(-b.events)
+	b.messages = nil
+	b.resets = nil
+	clear(b.updates)
+
+	return messages, resets, b.closed
+}
+
+// Close marks the end of messages, it's called when all syncers are stopped.
+func (b *SyncBuffer) Close() {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+	b.signal()
+}
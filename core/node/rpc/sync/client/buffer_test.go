@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func syncUpdate(streamID StreamId, events int, gen int64, reset bool) *SyncStreamsResponse {
+	envelopes := make([]*Envelope, events)
+	for i := range envelopes {
+		envelopes[i] = &Envelope{Hash: []byte{byte(i)}}
+	}
+	return &SyncStreamsResponse{
+		SyncOp: SyncOp_SYNC_UPDATE,
+		Stream: &StreamAndCookie{
+			Events:         envelopes,
+			NextSyncCookie: &SyncCookie{StreamId: streamID[:], MinipoolGen: gen},
+			SyncReset:      reset,
+		},
+	}
+}
+
+func TestSyncBufferCoalesce(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream1 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	stream2 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	buffer := NewSyncBuffer(ctx, cancel, "sync", config.SyncBufferConfig{MaxEvents: 100}, nil)
+	buffer.Add(syncUpdate(stream1, 2, 1, false))
+	buffer.Add(syncUpdate(stream2, 1, 1, false))
+	buffer.Add(syncUpdate(stream1, 3, 2, false))
+
+	<-buffer.Ready()
+	msgs, resets, closed := buffer.Take()
+	require.False(closed)
+	require.Empty(resets)
+	require.Len(msgs, 2)
+	require.Len(msgs[0].GetStream().GetEvents(), 5)
+	require.EqualValues(2, msgs[0].GetStream().GetNextSyncCookie().GetMinipoolGen())
+	require.Len(msgs[1].GetStream().GetEvents(), 1)
+
+	// updates after a stream down message are not coalesced with updates before it
+	buffer.Add(syncUpdate(stream1, 1, 3, false))
+	buffer.Add(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: stream1[:]})
+	buffer.Add(syncUpdate(stream1, 1, 4, true))
+
+	msgs, _, _ = buffer.Take()
+	require.Len(msgs, 3)
+	require.Equal(SyncOp_SYNC_DOWN, msgs[1].GetSyncOp())
+	require.True(msgs[2].GetStream().GetSyncReset())
+
+	buffer.Close()
+	<-buffer.Ready()
+	msgs, _, closed = buffer.Take()
+	require.Empty(msgs)
+	require.True(closed)
+}
+
+func TestSyncBufferOverflowReset(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream1 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	stream2 := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	buffer := NewSyncBuffer(ctx, cancel, "sync", config.SyncBufferConfig{MaxEvents: 10}, nil)
+	buffer.Add(syncUpdate(stream1, 3, 1, false))
+	buffer.Add(syncUpdate(stream2, 2, 1, false))
+	buffer.Add(syncUpdate(stream1, 6, 2, false))
+	// updates of stream1 are dropped until the sync reset is received
+	buffer.Add(syncUpdate(stream1, 1, 3, false))
+
+	msgs, resets, _ := buffer.Take()
+	require.NoError(ctx.Err())
+	require.Len(msgs, 1)
+	require.Equal(stream2[:], msgs[0].GetStream().GetNextSyncCookie().GetStreamId())
+	require.Len(resets, 1)
+	require.Equal(stream1, resets[0].StreamID)
+	require.EqualValues(2, resets[0].Cookie.GetMinipoolGen())
+
+	buffer.Add(syncUpdate(stream1, 1, 4, false))
+	buffer.Add(syncUpdate(stream1, 4, 5, true))
+	buffer.Add(syncUpdate(stream1, 1, 5, false))
+
+	msgs, resets, _ = buffer.Take()
+	require.Empty(resets)
+	require.Len(msgs, 1)
+	require.True(msgs[0].GetStream().GetSyncReset())
+	require.Len(msgs[0].GetStream().GetEvents(), 5)
+}
+
+func TestSyncBufferOverflowCancel(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	buffer := NewSyncBuffer(
+		ctx,
+		cancel,
+		"sync",
+		config.SyncBufferConfig{MaxEvents: 10, OverflowPolicy: config.SyncBufferPolicyCancel},
+		nil,
+	)
+	buffer.Add(syncUpdate(stream, 10, 1, false))
+	require.NoError(ctx.Err())
+	buffer.Add(syncUpdate(stream, 1, 2, false))
+	require.Error(ctx.Err())
+}
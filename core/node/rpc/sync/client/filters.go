@@ -0,0 +1,81 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// SyncFilters keeps the event filters of a sync operation.
+// Syncers apply them to stream updates before the updates are written to the client messages buffer.
+type SyncFilters struct {
+	mu sync.RWMutex
+	// filter applies to streams without a stream filter
+	filter *events.SyncEventFilter
+	// streams keeps the filters of individual streams
+	streams map[StreamId]*events.SyncEventFilter
+}
+
+// NewSyncFilters creates the sync operation filters from the sync request.
+func NewSyncFilters(filter *SyncFilter, streamFilters []*StreamSyncFilter) (*SyncFilters, error) {
+	defaultFilter, err := events.NewSyncEventFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	f := &SyncFilters{
+		filter:  defaultFilter,
+		streams: make(map[StreamId]*events.SyncEventFilter, len(streamFilters)),
+	}
+	for _, streamFilter := range streamFilters {
+		streamID, err := StreamIdFromBytes(streamFilter.GetStreamId())
+		if err != nil {
+			return nil, err
+		}
+		if err := f.SetStreamFilter(streamID, streamFilter.GetFilter()); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// SetStreamFilter replaces the sync operation filter for the given stream.
+func (f *SyncFilters) SetStreamFilter(streamID StreamId, filter *SyncFilter) error {
+	streamFilter, err := events.NewSyncEventFilter(filter)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	f.streams[streamID] = streamFilter
+	f.mu.Unlock()
+	return nil
+}
+
+// RemoveStream drops the filter of the stream that was removed from the sync operation.
+func (f *SyncFilters) RemoveStream(streamID StreamId) {
+	f.mu.Lock()
+	delete(f.streams, streamID)
+	f.mu.Unlock()
+}
+
+// Apply returns the stream update without the events that are filtered out for the stream.
+// parsedEvents are the parsed update events if they are available, nil otherwise.
+func (f *SyncFilters) Apply(update *StreamAndCookie, parsedEvents []*events.ParsedEvent) *StreamAndCookie {
+	if f == nil {
+		return update
+	}
+	streamID, err := StreamIdFromBytes(update.GetNextSyncCookie().GetStreamId())
+	if err != nil {
+		return update
+	}
+
+	f.mu.RLock()
+	filter, found := f.streams[streamID]
+	if !found {
+		filter = f.filter
+	}
+	f.mu.RUnlock()
+
+	return filter.Apply(update, parsedEvents)
+}
@@ -2,7 +2,6 @@ package client
 
 import (
 	"context"
-	"github.com/river-build/river/core/node/dlog"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -13,15 +12,13 @@ import (
 )
 
 type localSyncer struct {
-	globalSyncOpID string
-
-	syncStreamCtx      context.Context
-	cancelGlobalSyncOp context.CancelFunc
+	syncStreamCtx context.Context
 
 	streamCache events.StreamCache
 	cookies     []*SyncCookie
-	messages    chan<- *SyncStreamsResponse
+	messages    *SyncBuffer
 	localAddr   common.Address
+	filters     *SyncFilters
 
 	activeStreamsMu sync.Mutex
 	activeStreams   map[StreamId]events.SyncStream
@@ -29,22 +26,20 @@ type localSyncer struct {
 
 func newLocalSyncer(
 	ctx context.Context,
-	globalSyncOpID string,
-	cancelGlobalSyncOp context.CancelFunc,
 	localAddr common.Address,
 	streamCache events.StreamCache,
 	cookies []*SyncCookie,
-	messages chan<- *SyncStreamsResponse,
+	messages *SyncBuffer,
+	filters *SyncFilters,
 ) (*localSyncer, error) {
 	return &localSyncer{
-		globalSyncOpID:     globalSyncOpID,
-		syncStreamCtx:      ctx,
-		cancelGlobalSyncOp: cancelGlobalSyncOp,
-		streamCache:        streamCache,
-		localAddr:          localAddr,
-		cookies:            cookies,
-		messages:           messages,
-		activeStreams:      make(map[StreamId]events.SyncStream),
+		syncStreamCtx: ctx,
+		streamCache:   streamCache,
+		localAddr:     localAddr,
+		cookies:       cookies,
+		messages:      messages,
+		filters:       filters,
+		activeStreams: make(map[StreamId]events.SyncStream),
 	}, nil
 }
 
@@ -77,6 +72,9 @@ func (s *localSyncer) AddStream(ctx context.Context, cookie *SyncCookie) error {
 	return s.addStream(ctx, streamID, cookie)
 }
 
+// Discard is a no-op, the local syncer subscribes to streams when it's started.
+func (s *localSyncer) Discard() {}
+
 func (s *localSyncer) RemoveStream(_ context.Context, streamID StreamId) (bool, error) {
 	s.activeStreamsMu.Lock()
 	defer s.activeStreamsMu.Unlock()
@@ -91,17 +89,8 @@ func (s *localSyncer) RemoveStream(_ context.Context, streamID StreamId) (bool,
 }
 
 // OnUpdate is called each time a new cookie is available for a stream
-func (s *localSyncer) OnUpdate(r *StreamAndCookie) {
-	select {
-	case s.messages <- &SyncStreamsResponse{SyncOp: SyncOp_SYNC_UPDATE, Stream: r}:
-		return
-	case <-s.syncStreamCtx.Done():
-		return
-	default:
-		log := dlog.FromCtx(s.syncStreamCtx)
-		log.Error("Cancel client sync operation - client buffer full", "syncId", s.globalSyncOpID)
-		s.cancelGlobalSyncOp()
-	}
+func (s *localSyncer) OnUpdate(r *StreamAndCookie, parsedEvents []*events.ParsedEvent) {
+	s.messages.Add(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_UPDATE, Stream: s.filters.Apply(r, parsedEvents)})
 }
 
 // OnSyncError is called when a sync subscription failed unrecoverable
@@ -118,16 +107,7 @@ func (s *localSyncer) OnSyncError(error) {
 
 // OnStreamSyncDown is called when updates for a stream could not be given.
 func (s *localSyncer) OnStreamSyncDown(streamID StreamId) {
-	select {
-	case s.messages <- &SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]}:
-		return
-	case <-s.syncStreamCtx.Done():
-		return
-	default:
-		log := dlog.FromCtx(s.syncStreamCtx)
-		log.Error("Cancel client sync operation - client buffer full", "syncId", s.globalSyncOpID)
-		s.cancelGlobalSyncOp()
-	}
+	s.messages.Add(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]})
 }
 
 func (s *localSyncer) addStream(ctx context.Context, streamID StreamId, cookie *SyncCookie) error {
@@ -18,6 +18,7 @@ import (
 )
 
 type remoteSyncer struct {
+	ctx                context.Context
 	cancelGlobalSyncOp context.CancelFunc
 	syncStreamCtx      context.Context
 	syncStreamCancel   context.CancelFunc
@@ -26,9 +27,14 @@ type remoteSyncer struct {
 	remoteAddr         common.Address
 	client             protocolconnect.StreamServiceClient
 	cookies            []*SyncCookie
-	messages           chan<- *SyncStreamsResponse
-	streams            sync.Map
-	responseStream     *connect.ServerStreamForClient[SyncStreamsResponse]
+	messages           *SyncBuffer
+	filters            *SyncFilters
+	// streams maps from stream id to the last sync cookie that was delivered to the client
+	streams        sync.Map
+	responseStream *connect.ServerStreamForClient[SyncStreamsResponse]
+	// unavailable is called with the last delivered cookies of the streams that were still synced
+	// when the connection to the remote was lost
+	unavailable func(remote common.Address, cookies []*SyncCookie)
 }
 
 func newRemoteSyncer(
@@ -38,17 +44,13 @@ func newRemoteSyncer(
 	remoteAddr common.Address,
 	client protocolconnect.StreamServiceClient,
 	cookies []*SyncCookie,
-	messages chan<- *SyncStreamsResponse,
+	messages *SyncBuffer,
+	filters *SyncFilters,
+	unavailable func(remote common.Address, cookies []*SyncCookie),
 ) (*remoteSyncer, error) {
 	syncStreamCtx, syncStreamCancel := context.WithCancel(ctx)
 	responseStream, err := client.SyncStreams(syncStreamCtx, connect.NewRequest(&SyncStreamsRequest{SyncPos: cookies}))
 	if err != nil {
-		for _, cookie := range cookies {
-			messages <- &SyncStreamsResponse{
-				SyncOp:   SyncOp_SYNC_DOWN,
-				StreamId: cookie.GetStreamId(),
-			}
-		}
 		syncStreamCancel()
 		return nil, err
 	}
@@ -69,6 +71,7 @@ func newRemoteSyncer(
 	}
 
 	s := &remoteSyncer{
+		ctx:                ctx,
 		forwarderSyncID:    forwarderSyncID,
 		cancelGlobalSyncOp: cancelGlobalSyncOp,
 		syncStreamCtx:      syncStreamCtx,
@@ -76,15 +79,17 @@ func newRemoteSyncer(
 		client:             client,
 		cookies:            cookies,
 		messages:           messages,
+		filters:            filters,
 		responseStream:     responseStream,
 		remoteAddr:         remoteAddr,
+		unavailable:        unavailable,
 	}
 
 	s.syncID = responseStream.Msg().GetSyncId()
 
 	for _, cookie := range s.cookies {
 		streamID, _ := StreamIdFromBytes(cookie.GetStreamId())
-		s.streams.Store(streamID, struct{}{})
+		s.streams.Store(streamID, cookie)
 	}
 
 	return s, nil
@@ -111,6 +116,7 @@ func (s *remoteSyncer) Run() {
 		res := s.responseStream.Msg()
 
 		if res.GetSyncOp() == SyncOp_SYNC_UPDATE {
+			res.Stream = s.filters.Apply(res.GetStream(), nil)
 			if err := s.sendSyncStreamResponseToClient(res); err != nil {
 				if !errors.Is(err, context.Canceled) {
 					log.Error("Cancel remote sync with client", "remote", s.remoteAddr, "err", err)
@@ -118,6 +124,11 @@ func (s *remoteSyncer) Run() {
 				}
 				return
 			}
+			if streamID, err := StreamIdFromBytes(res.GetStream().GetNextSyncCookie().GetStreamId()); err == nil {
+				if _, found := s.streams.Load(streamID); found {
+					s.streams.Store(streamID, res.GetStream().GetNextSyncCookie())
+				}
+			}
 		} else if res.GetSyncOp() == SyncOp_SYNC_DOWN {
 			if streamID, err := StreamIdFromBytes(res.GetStreamId()); err == nil {
 				if err := s.sendSyncStreamResponseToClient(res); err != nil {
@@ -133,40 +144,31 @@ func (s *remoteSyncer) Run() {
 		}
 	}
 
-	// stream interrupted while client didn't cancel sync -> remote is unavailable
-	if s.syncStreamCtx.Err() == nil {
+	// stream interrupted while client didn't cancel sync -> remote is unavailable,
+	// let the syncer set move the remaining streams to other replicas.
+	if s.ctx.Err() == nil {
 		log.Info("remote node disconnected", "remote", s.remoteAddr)
 
+		var cookies []*SyncCookie
 		s.streams.Range(func(key, value any) bool {
-			streamID := key.(StreamId)
-			log.Debug("stream down", "syncId", s.forwarderSyncID, "remote", s.remoteAddr, "stream", streamID)
-
-			msg := &SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]}
-
-			if err := s.sendSyncStreamResponseToClient(msg); err != nil {
-				log.Error("Cancel remote sync with client", "remote", s.remoteAddr, "err", err)
-				s.cancelGlobalSyncOp()
-				return false
-			}
-
+			cookies = append(cookies, value.(*SyncCookie))
 			return true
 		})
+
+		if len(cookies) > 0 {
+			s.unavailable(s.remoteAddr, cookies)
+		}
 	}
 }
 
-// sendSyncStreamResponseToClient tries to write msg to the client send message channel.
-// If the channel is full or the sync operation is cancelled, the function returns an error.
+// sendSyncStreamResponseToClient adds msg to the client message buffer.
+// If the sync operation is cancelled, the function returns an error.
 func (s *remoteSyncer) sendSyncStreamResponseToClient(msg *SyncStreamsResponse) error {
-	select {
-	case s.messages <- msg:
-		return nil
-	case <-s.syncStreamCtx.Done():
-		return s.syncStreamCtx.Err()
-	default:
-		return RiverError(Err_BUFFER_FULL, "Client sync subscription message channel is full").
-			Tag("syncOpId", s.forwarderSyncID).
-			Func("sendSyncStreamResponseToClient")
+	if err := s.syncStreamCtx.Err(); err != nil {
+		return err
 	}
+	s.messages.Add(msg)
+	return nil
 }
 
 // connectionAlive periodically pings remote to check if the connection is still alive.
@@ -209,7 +211,6 @@ func (s *remoteSyncer) connectionAlive(latestMsgReceived *atomic.Value) {
 				s.syncStreamCancel()
 				return
 			}
-			return
 
 		case <-s.syncStreamCtx.Done():
 			return
@@ -233,12 +234,17 @@ func (s *remoteSyncer) AddStream(ctx context.Context, cookie *SyncCookie) error
 	}))
 
 	if err == nil {
-		s.streams.Store(streamID, struct{}{})
+		s.streams.Store(streamID, cookie)
 	}
 
 	return err
 }
 
+func (s *remoteSyncer) Discard() {
+	s.syncStreamCancel()
+	_ = s.responseStream.Close()
+}
+
 func (s *remoteSyncer) RemoveStream(ctx context.Context, streamID StreamId) (bool, error) {
 	_, err := s.client.RemoveStreamFromSync(ctx, connect.NewRequest(&RemoveStreamFromSyncRequest{
 		SyncId:   s.syncID,
@@ -2,14 +2,18 @@ package client
 
 import (
 	"context"
+	"math"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
 	"github.com/river-build/river/core/node/events"
 	"github.com/river-build/river/core/node/nodes"
 	. "github.com/river-build/river/core/node/protocol"
 	. "github.com/river-build/river/core/node/shared"
+	"google.golang.org/protobuf/proto"
 )
 
 type (
@@ -18,6 +22,8 @@ type (
 		Address() common.Address
 		AddStream(ctx context.Context, cookie *SyncCookie) error
 		RemoveStream(ctx context.Context, streamID StreamId) (bool, error)
+		// Discard releases the syncer that was created, but is not started.
+		Discard()
 	}
 
 	DebugStreamsSyncer interface {
@@ -34,12 +40,16 @@ type (
 		syncID string
 		// localNodeAddress is the node address for this stream node instance
 		localNodeAddress common.Address
-		// messages is the channel to which StreamsSyncers write updates that must be sent to the client
-		messages chan *SyncStreamsResponse
+		// messages is the buffer to which StreamsSyncers write updates that must be sent to the client
+		messages *SyncBuffer
 		// streamCache is used to subscribe to streams managed by this node instance
 		streamCache events.StreamCache
 		// nodeRegistry keeps a mapping from node address to node meta-data
 		nodeRegistry nodes.NodeRegistry
+		// streamRegistry is used to find the replicas of a stream when its syncer becomes unavailable
+		streamRegistry nodes.StreamRegistry
+		// filters are applied by syncers to stream updates before they are sent to the client
+		filters *SyncFilters
 		// syncerTasks is a wait group for running background StreamsSyncers that is used to ensure all syncers stopped
 		syncerTasks sync.WaitGroup
 		// muSyncers guards syncers and streamID2Syncer
@@ -50,6 +60,9 @@ type (
 		syncers map[common.Address]StreamsSyncer
 		// streamID2Syncer maps from a stream to its syncer
 		streamID2Syncer map[StreamId]StreamsSyncer
+		// unavailable holds the streams of remotes that couldn't be reached when the sync operation started,
+		// these streams are moved to other replicas when the syncer set runs
+		unavailable StreamCookieSetGroupedByNodeAddress
 	}
 
 	// SyncCookieSet maps from a stream id to a sync cookie
@@ -58,6 +71,10 @@ type (
 	StreamCookieSetGroupedByNodeAddress map[common.Address]SyncCookieSet
 )
 
+// rehomeTimeout bounds the registry lookups and node calls that are made to move the streams of an unavailable node
+// to other replicas.
+const rehomeTimeout = 30 * time.Second
+
 var (
 	_ StreamsSyncer      = (*localSyncer)(nil)
 	_ DebugStreamsSyncer = (*localSyncer)(nil)
@@ -75,64 +92,60 @@ func (cs SyncCookieSet) AsSlice() []*SyncCookie {
 }
 
 // NewSyncers creates the required syncer set that subscribe on all given cookies.
-// A syncer can either be local or remote and writes received events to an internal messages channel from which events
-// are streamed to the client.
+// A syncer can either be local or remote and writes received events to the given messages buffer from which events
+// are streamed to the client. Streams of remotes that can't be reached are moved to other replicas when the set runs.
 func NewSyncers(
 	ctx context.Context,
 	globalSyncOpCtxCancel context.CancelFunc,
 	syncID string,
 	streamCache events.StreamCache,
 	nodeRegistry nodes.NodeRegistry,
+	streamRegistry nodes.StreamRegistry,
 	localNodeAddress common.Address,
 	cookies StreamCookieSetGroupedByNodeAddress,
-) (*SyncerSet, <-chan *SyncStreamsResponse, error) {
+	filters *SyncFilters,
+	messages *SyncBuffer,
+) (*SyncerSet, error) {
 	var (
 		syncers         = make(map[common.Address]StreamsSyncer)
 		streamID2Syncer = make(map[StreamId]StreamsSyncer)
-		messages        = make(chan *SyncStreamsResponse, 128)
+		ss              = &SyncerSet{
+			ctx:                   ctx,
+			globalSyncOpCtxCancel: globalSyncOpCtxCancel,
+			syncID:                syncID,
+			streamCache:           streamCache,
+			nodeRegistry:          nodeRegistry,
+			streamRegistry:        streamRegistry,
+			filters:               filters,
+			localNodeAddress:      localNodeAddress,
+			syncers:               syncers,
+			streamID2Syncer:       streamID2Syncer,
+			unavailable:           make(StreamCookieSetGroupedByNodeAddress),
+			messages:              messages,
+		}
 	)
 
 	// instantiate background syncers for sync operation
 	for nodeAddress, cookieSet := range cookies {
-		if nodeAddress == localNodeAddress { // stream managed by this node
-			syncer, err := newLocalSyncer(
-				ctx, syncID, globalSyncOpCtxCancel, localNodeAddress, streamCache, cookieSet.AsSlice(), messages)
-			if err != nil {
-				return nil, nil, err
-			}
-			syncers[nodeAddress] = syncer
-		} else {
-			client, err := nodeRegistry.GetStreamServiceClientForAddress(nodeAddress)
-			if err != nil {
-				return nil, nil, err
-			}
-
-			syncer, err := newRemoteSyncer(
-				ctx, globalSyncOpCtxCancel, syncID, nodeAddress, client, cookieSet.AsSlice(), messages)
-			if err != nil {
-				return nil, nil, err
+		syncer, err := ss.newSyncer(nodeAddress, cookieSet.AsSlice())
+		if err != nil {
+			if nodeAddress == localNodeAddress {
+				return nil, err
 			}
-
-			syncers[nodeAddress] = syncer
+			dlog.FromCtx(ctx).Info("Unable to sync with remote, move streams to other replicas",
+				"syncId", syncID, "remote", nodeAddress, "err", err)
+			ss.unavailable[nodeAddress] = cookieSet
+			continue
 		}
+		syncers[nodeAddress] = syncer
 
 		// associate syncer with streamId to remove stream from sync operation
-		syncer := syncers[nodeAddress]
 		for streamID := range cookieSet {
 			streamID2Syncer[streamID] = syncer
 		}
 	}
 
-	return &SyncerSet{
-		ctx:              ctx,
-		syncID:           syncID,
-		streamCache:      streamCache,
-		nodeRegistry:     nodeRegistry,
-		localNodeAddress: localNodeAddress,
-		syncers:          syncers,
-		streamID2Syncer:  streamID2Syncer,
-		messages:         messages,
-	}, messages, nil
+	return ss, nil
 }
 
 func (ss *SyncerSet) Run() {
@@ -140,6 +153,13 @@ func (ss *SyncerSet) Run() {
 	for _, syncer := range ss.syncers {
 		ss.startSyncer(syncer)
 	}
+	for nodeAddress, cookieSet := range ss.unavailable {
+		ss.syncerTasks.Add(1)
+		go func() {
+			ss.moveStreams(nodeAddress, cookieSet.AsSlice())
+			ss.syncerTasks.Done()
+		}()
+	}
 	ss.muSyncers.Unlock()
 
 	<-ss.ctx.Done() // sync cancelled by client, client conn dropped or client send buffer full
@@ -148,8 +168,8 @@ func (ss *SyncerSet) Run() {
 	ss.stopped = true
 	ss.muSyncers.Unlock()
 
-	ss.syncerTasks.Wait() // background syncers finished -> safe to close messages channel
-	close(ss.messages)    // close will cause the sync operation to send the SYNC_CLOSE message to the client
+	ss.syncerTasks.Wait() // background syncers finished -> safe to close messages buffer
+	ss.messages.Close()   // close will cause the sync operation to send the SYNC_CLOSE message to the client
 }
 
 func (ss *SyncerSet) AddStream(ctx context.Context, nodeAddress common.Address, streamID StreamId, cookie *SyncCookie) error {
@@ -164,6 +184,61 @@ func (ss *SyncerSet) AddStream(ctx context.Context, nodeAddress common.Address,
 		return nil // stream is already part of sync operation
 	}
 
+	err := ss.addStreamToNodeLocked(ctx, nodeAddress, streamID, cookie)
+	if err != nil && nodeAddress != ss.localNodeAddress {
+		ss.messages.Add(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]})
+	}
+	return err
+}
+
+// ResetStream re-subscribes on the stream with a cookie that can't be continued from.
+// This causes the syncer to send a sync reset with the current stream state to the client,
+// which replaces the updates that were dropped from the messages buffer.
+// A SYNC_DOWN message is sent to the client if the stream could not be re-subscribed.
+func (ss *SyncerSet) ResetStream(ctx context.Context, streamID StreamId, cookie *SyncCookie) error {
+	ss.muSyncers.Lock()
+	defer ss.muSyncers.Unlock()
+
+	if ss.stopped {
+		return RiverError(Err_CANCELED, "Sync operation stopped", "syncId", ss.syncID)
+	}
+
+	syncer, found := ss.streamID2Syncer[streamID]
+	if !found {
+		return nil // stream removed from sync operation by client
+	}
+
+	syncerStopped, err := syncer.RemoveStream(ctx, streamID)
+	if err != nil {
+		return err
+	}
+	delete(ss.streamID2Syncer, streamID)
+	if syncerStopped && ss.syncers[syncer.Address()] == syncer {
+		delete(ss.syncers, syncer.Address())
+	}
+
+	resetCookie := proto.Clone(cookie).(*SyncCookie)
+	resetCookie.NodeAddress = syncer.Address().Bytes()
+	resetCookie.MinipoolGen = math.MaxInt64
+	resetCookie.MinipoolSlot = 0
+
+	if err := ss.addStreamToNodeLocked(ctx, syncer.Address(), streamID, resetCookie); err != nil {
+		ss.messages.Add(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]})
+		return err
+	}
+
+	return nil
+}
+
+// addStreamToNodeLocked adds the stream to the syncer for the given node, a new syncer is started
+// if this is the first stream that is synced with the node.
+// caller must have ss.muSyncers claimed
+func (ss *SyncerSet) addStreamToNodeLocked(
+	ctx context.Context,
+	nodeAddress common.Address,
+	streamID StreamId,
+	cookie *SyncCookie,
+) error {
 	// check if there is already a syncer that can sync the given stream -> add stream to the syncer
 	if syncer, found := ss.syncers[nodeAddress]; found {
 		if err := syncer.AddStream(ctx, cookie); err != nil {
@@ -174,24 +249,9 @@ func (ss *SyncerSet) AddStream(ctx context.Context, nodeAddress common.Address,
 	}
 
 	// first stream to sync with remote -> create a new syncer instance
-	var (
-		syncer StreamsSyncer
-		err    error
-	)
-	if nodeAddress == ss.localNodeAddress {
-		if syncer, err = newLocalSyncer(
-			ss.ctx, ss.syncID, ss.globalSyncOpCtxCancel, ss.localNodeAddress,
-			ss.streamCache, []*SyncCookie{cookie}, ss.messages); err != nil {
-			return err
-		}
-	} else {
-		client, err := ss.nodeRegistry.GetStreamServiceClientForAddress(nodeAddress)
-		if err != nil {
-			return err
-		}
-		if syncer, err = newRemoteSyncer(ss.ctx, ss.globalSyncOpCtxCancel, ss.syncID, nodeAddress, client, []*SyncCookie{cookie}, ss.messages); err != nil {
-			return err
-		}
+	syncer, err := ss.newSyncer(nodeAddress, []*SyncCookie{cookie})
+	if err != nil {
+		return err
 	}
 
 	ss.syncers[nodeAddress] = syncer
@@ -201,18 +261,234 @@ func (ss *SyncerSet) AddStream(ctx context.Context, nodeAddress common.Address,
 	return nil
 }
 
+// newSyncer creates a syncer for the given node that syncs the given streams, the syncer is not started.
+func (ss *SyncerSet) newSyncer(nodeAddress common.Address, cookies []*SyncCookie) (StreamsSyncer, error) {
+	if nodeAddress == ss.localNodeAddress {
+		return newLocalSyncer(ss.ctx, ss.localNodeAddress, ss.streamCache, cookies, ss.messages, ss.filters)
+	}
+
+	client, err := ss.nodeRegistry.GetStreamServiceClientForAddress(nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRemoteSyncer(
+		ss.ctx, ss.globalSyncOpCtxCancel, ss.syncID, nodeAddress, client, cookies, ss.messages, ss.filters,
+		ss.rehomeStreams)
+}
+
 // caller must have ss.muSyncers claimed
 func (ss *SyncerSet) startSyncer(syncer StreamsSyncer) {
 	ss.syncerTasks.Add(1)
 	go func() {
 		syncer.Run()
-		ss.syncerTasks.Done()
 		ss.muSyncers.Lock()
-		delete(ss.syncers, syncer.Address())
+		if ss.syncers[syncer.Address()] == syncer {
+			delete(ss.syncers, syncer.Address())
+		}
 		ss.muSyncers.Unlock()
+		ss.syncerTasks.Done()
 	}()
 }
 
+// rehomeStreams is called by a remote syncer when the connection with its remote node is lost.
+// It moves the given streams that are still synced through the failed syncer to other replicas and resumes syncing
+// from the last delivered cookie.
+func (ss *SyncerSet) rehomeStreams(failed common.Address, cookies []*SyncCookie) {
+	ss.muSyncers.Lock()
+	if ss.stopped {
+		ss.muSyncers.Unlock()
+		return
+	}
+
+	moving := make([]*SyncCookie, 0, len(cookies))
+	for _, cookie := range cookies {
+		streamID, err := StreamIdFromBytes(cookie.GetStreamId())
+		if err != nil {
+			continue
+		}
+		syncer, found := ss.streamID2Syncer[streamID]
+		if !found || syncer.Address() != failed {
+			continue // stream removed from sync operation by client
+		}
+		delete(ss.streamID2Syncer, streamID)
+		if ss.syncers[failed] == syncer {
+			delete(ss.syncers, failed)
+		}
+		moving = append(moving, cookie)
+	}
+	ss.muSyncers.Unlock()
+
+	ss.moveStreams(failed, moving)
+}
+
+// moveStreams adds the given streams to the syncers of other replicas that hold the streams.
+// Streams are grouped per replica so each replica is called once for all streams that are moved to it.
+// Registry lookups and node calls are made without holding ss.muSyncers.
+// A SYNC_DOWN message is sent to the client for streams for which none of the replicas is reachable.
+func (ss *SyncerSet) moveStreams(failed common.Address, cookies []*SyncCookie) {
+	ctx, cancel := context.WithTimeout(ss.ctx, rehomeTimeout)
+	defer cancel()
+
+	// replicas holds for each stream the replicas that are not tried yet, in the order in which they are tried
+	var (
+		replicas = make(map[StreamId][]common.Address)
+		streams  = make(SyncCookieSet)
+	)
+	for _, cookie := range cookies {
+		streamID, err := StreamIdFromBytes(cookie.GetStreamId())
+		if err != nil {
+			continue
+		}
+
+		streamNodes, err := ss.streamRegistry.GetStreamInfo(ctx, streamID)
+		if err != nil {
+			ss.streamDown(failed, streamID)
+			continue
+		}
+
+		var nodeAddresses []common.Address
+		if streamNodes.IsLocal() && failed != ss.localNodeAddress {
+			nodeAddresses = append(nodeAddresses, ss.localNodeAddress)
+		}
+		peer := streamNodes.GetStickyPeer()
+		for range streamNodes.NumRemotes() {
+			if peer != failed {
+				nodeAddresses = append(nodeAddresses, peer)
+			}
+			peer = streamNodes.AdvanceStickyPeer(peer)
+		}
+
+		replicas[streamID] = nodeAddresses
+		streams[streamID] = cookie
+	}
+
+	for len(streams) > 0 && ctx.Err() == nil {
+		// group the streams by the next replica to try
+		targets := make(StreamCookieSetGroupedByNodeAddress)
+		for streamID, cookie := range streams {
+			if len(replicas[streamID]) == 0 {
+				delete(streams, streamID)
+				ss.streamDown(failed, streamID)
+				continue
+			}
+
+			nodeAddress := replicas[streamID][0]
+			replicas[streamID] = replicas[streamID][1:]
+
+			cookie := proto.Clone(cookie).(*SyncCookie)
+			cookie.NodeAddress = nodeAddress.Bytes()
+			if targets[nodeAddress] == nil {
+				targets[nodeAddress] = make(SyncCookieSet)
+			}
+			targets[nodeAddress][streamID] = cookie
+		}
+
+		for nodeAddress, cookieSet := range targets {
+			for _, streamID := range ss.addStreamsToNode(ctx, nodeAddress, cookieSet) {
+				delete(streams, streamID)
+			}
+		}
+	}
+
+	for streamID := range streams {
+		ss.streamDown(failed, streamID)
+	}
+}
+
+// addStreamsToNode adds the streams to the syncer for the given node, a new syncer is created and started if
+// there is no syncer for the node yet. Node calls are made without holding ss.muSyncers.
+// It returns the ids of the streams that are synced through the node.
+func (ss *SyncerSet) addStreamsToNode(
+	ctx context.Context,
+	nodeAddress common.Address,
+	cookies SyncCookieSet,
+) []StreamId {
+	ss.muSyncers.Lock()
+	if ss.stopped {
+		ss.muSyncers.Unlock()
+		return nil
+	}
+	syncer, found := ss.syncers[nodeAddress]
+	// streams that were added to the sync operation in the meantime, e.g. by the client, are already synced
+	var synced []StreamId
+	pending := make(SyncCookieSet, len(cookies))
+	for streamID, cookie := range cookies {
+		if _, exists := ss.streamID2Syncer[streamID]; exists {
+			synced = append(synced, streamID)
+		} else {
+			pending[streamID] = cookie
+		}
+	}
+	ss.muSyncers.Unlock()
+
+	if len(pending) == 0 {
+		return synced
+	}
+
+	if !found {
+		newSyncer, err := ss.newSyncer(nodeAddress, pending.AsSlice())
+		if err != nil {
+			return synced
+		}
+
+		ss.muSyncers.Lock()
+		if ss.stopped {
+			ss.muSyncers.Unlock()
+			newSyncer.Discard()
+			return nil
+		}
+		// another syncer for the node could be started while the new syncer was created,
+		// in that case the streams are added to it and the new syncer is discarded.
+		if syncer, found = ss.syncers[nodeAddress]; !found {
+			syncer = newSyncer
+			ss.syncers[nodeAddress] = syncer
+			ss.startSyncer(syncer)
+			for streamID := range pending {
+				if _, exists := ss.streamID2Syncer[streamID]; !exists {
+					ss.streamID2Syncer[streamID] = syncer
+				}
+				synced = append(synced, streamID)
+			}
+		}
+		ss.muSyncers.Unlock()
+
+		if !found {
+			return synced
+		}
+		newSyncer.Discard()
+	}
+
+	var added []StreamId
+	for streamID, cookie := range pending {
+		if err := syncer.AddStream(ctx, cookie); err == nil {
+			added = append(added, streamID)
+		}
+	}
+
+	ss.muSyncers.Lock()
+	defer ss.muSyncers.Unlock()
+
+	for _, streamID := range added {
+		if _, exists := ss.streamID2Syncer[streamID]; !exists {
+			ss.streamID2Syncer[streamID] = syncer
+		}
+	}
+
+	return append(synced, added...)
+}
+
+// streamDown sends a SYNC_DOWN message to the client for a stream that couldn't be moved to another replica.
+func (ss *SyncerSet) streamDown(failed common.Address, streamID StreamId) {
+	if ss.ctx.Err() != nil {
+		return
+	}
+
+	dlog.FromCtx(ss.ctx).Debug("stream down", "syncId", ss.syncID, "remote", failed, "stream", streamID)
+
+	ss.messages.Add(&SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]})
+}
+
 func (ss *SyncerSet) RemoveStream(ctx context.Context, streamID StreamId) error {
 	ss.muSyncers.Lock()
 	defer ss.muSyncers.Unlock()
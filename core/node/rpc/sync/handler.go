@@ -3,15 +3,21 @@ package sync
 import (
 	"context"
 	"github.com/river-build/river/core/node/utils"
+	"net/http"
 	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/river-build/river/core/config"
 	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
 	"github.com/river-build/river/core/node/events"
 	"github.com/river-build/river/core/node/nodes"
 	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/rpc/sync/client"
 	"github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
 )
 
 type (
@@ -42,6 +48,12 @@ type (
 			ctx context.Context,
 			req *connect.Request[PingSyncRequest],
 		) (*connect.Response[PingSyncResponse], error)
+
+		ResumeSync(
+			ctx context.Context,
+			req *connect.Request[ResumeSyncRequest],
+			res *connect.ServerStream[SyncStreamsResponse],
+		) error
 	}
 
 	// DebugHandler defines the external grpc interface that clients can call for debugging purposes.
@@ -54,6 +66,23 @@ type (
 		) error
 	}
 
+	// TransportHandler serves streams sync operations over WebSocket and server-sent events for clients that
+	// can't use the connect server stream. Both transports send the same SyncStreamsResponse messages.
+	TransportHandler interface {
+		// ServeWebSocket runs a sync operation over a WebSocket connection.
+		// Clients change the sync operation by sending SyncCommand messages over the connection.
+		ServeWebSocket(w http.ResponseWriter, r *http.Request)
+		// ServeSSE runs a sync operation as a stream of server-sent events.
+		// Clients change the sync operation with the AddStreamToSync, RemoveStreamFromSync, PingSync and CancelSync
+		// requests for the sync id that is received in the first event.
+		ServeSSE(w http.ResponseWriter, r *http.Request)
+	}
+
+	// syncResponseSender sends the messages of a sync operation to the client.
+	syncResponseSender interface {
+		Send(*SyncStreamsResponse) error
+	}
+
 	handlerImpl struct {
 		// nodeAddr is used to determine if a stream is local or remote
 		nodeAddr common.Address
@@ -61,14 +90,25 @@ type (
 		streamCache events.StreamCache
 		// nodeRegistry is used to find a node endpoint to subscribe on remote streams
 		nodeRegistry nodes.NodeRegistry
+		// streamRegistry is used to find the replicas of a stream when its remote node becomes unavailable
+		streamRegistry nodes.StreamRegistry
+		// store keeps sync sessions so clients can resume sync operations, nil disables sessions
+		store storage.StreamStorage
+		// sessionTTL is how long a sync session can be resumed after the client disconnected
+		sessionTTL time.Duration
+		// bufferConfig limits the messages that are buffered for a client that falls behind
+		bufferConfig config.SyncBufferConfig
+		// bufferMetrics are shared by the client message buffers of all sync operations
+		bufferMetrics *client.SyncBufferMetrics
 		// activeSyncOperations keeps a mapping from SyncID -> *StreamSyncOperation
 		activeSyncOperations sync.Map
 	}
 )
 
 var (
-	_ Handler      = (*handlerImpl)(nil)
-	_ DebugHandler = (*handlerImpl)(nil)
+	_ Handler          = (*handlerImpl)(nil)
+	_ DebugHandler     = (*handlerImpl)(nil)
+	_ TransportHandler = (*handlerImpl)(nil)
 )
 
 // NewHandler returns a structure that implements the Handler interface.
@@ -78,11 +118,21 @@ func NewHandler(
 	nodeAddr common.Address,
 	cache events.StreamCache,
 	nodeRegistry nodes.NodeRegistry,
+	streamRegistry nodes.StreamRegistry,
+	store storage.StreamStorage,
+	sessionTTL time.Duration,
+	bufferConfig config.SyncBufferConfig,
+	bufferMetrics *client.SyncBufferMetrics,
 ) *handlerImpl {
 	return &handlerImpl{
-		nodeAddr:     nodeAddr,
-		streamCache:  cache,
-		nodeRegistry: nodeRegistry,
+		nodeAddr:       nodeAddr,
+		streamCache:    cache,
+		nodeRegistry:   nodeRegistry,
+		streamRegistry: streamRegistry,
+		store:          store,
+		sessionTTL:     sessionTTL,
+		bufferConfig:   bufferConfig,
+		bufferMetrics:  bufferMetrics,
 	}
 }
 
@@ -91,23 +141,92 @@ func (h *handlerImpl) SyncStreams(
 	req *connect.Request[SyncStreamsRequest],
 	res *connect.ServerStream[SyncStreamsResponse],
 ) error {
-	ctx, log := utils.CtxAndLogForRequest(ctx, req)
+	ctx, _ = utils.CtxAndLogForRequest(ctx, req)
 
-	op, err := NewStreamsSyncOperation(ctx, h.nodeAddr, h.streamCache, h.nodeRegistry)
+	op, err := h.newSyncOperation(ctx, GenNanoid())
 	if err != nil {
-		log.Error("Unable to create streams sync subscription", "error", err)
 		return err
 	}
 
+	return h.runSyncOperation(op, req.Msg, res, "SyncStreams")
+}
+
+// ResumeSync continues the sync operation with the given sync id from the stream positions that were last
+// delivered to the client. If the previous connection for the sync operation is still active it is closed.
+func (h *handlerImpl) ResumeSync(
+	ctx context.Context,
+	req *connect.Request[ResumeSyncRequest],
+	res *connect.ServerStream[SyncStreamsResponse],
+) error {
+	ctx, _ = utils.CtxAndLogForRequest(ctx, req)
+
+	op, syncReq, err := h.resumeSyncOperation(ctx, req.Msg.GetSyncId())
+	if err != nil {
+		return AsRiverError(err).Func("ResumeSync").AsConnectError()
+	}
+
+	return h.runSyncOperation(op, syncReq, res, "ResumeSync")
+}
+
+// newSyncOperation creates a sync operation with the given sync id.
+func (h *handlerImpl) newSyncOperation(ctx context.Context, syncID string) (*StreamSyncOperation, error) {
+	op, err := NewStreamsSyncOperation(
+		ctx, syncID, h.nodeAddr, h.streamCache, h.nodeRegistry, h.streamRegistry, h.store, h.sessionTTL,
+		h.bufferConfig, h.bufferMetrics)
+	if err != nil {
+		dlog.FromCtx(ctx).Error("Unable to create streams sync subscription", "error", err)
+		return nil, err
+	}
+	return op, nil
+}
+
+// resumeSyncOperation creates the sync operation that continues the stored sync session with the given sync id
+// and returns it together with the sync request to run it with.
+// If the previous connection for the sync operation is still active it is closed.
+func (h *handlerImpl) resumeSyncOperation(
+	ctx context.Context,
+	syncID string,
+) (*StreamSyncOperation, *SyncStreamsRequest, error) {
+	if op, ok := h.activeSyncOperations.Load(syncID); ok {
+		op := op.(*StreamSyncOperation)
+		op.cancel()
+		select {
+		case <-op.done:
+		case <-ctx.Done():
+			return nil, nil, AsRiverError(ctx.Err())
+		}
+	}
+
+	syncReq, err := loadSyncSession(ctx, h.store, syncID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	op, err := h.newSyncOperation(ctx, syncID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return op, syncReq, nil
+}
+
+// runSyncOperation sends the sync id to the client and runs the sync operation until it's cancelled.
+func (h *handlerImpl) runSyncOperation(
+	op *StreamSyncOperation,
+	req *SyncStreamsRequest,
+	res syncResponseSender,
+	funcName string,
+) error {
 	h.activeSyncOperations.Store(op.SyncID, op)
-	defer h.activeSyncOperations.Delete(op.SyncID)
+	defer h.activeSyncOperations.CompareAndDelete(op.SyncID, op)
+	defer close(op.done)
 
 	// send SyncID to client
 	if err := res.Send(&SyncStreamsResponse{
 		SyncId: op.SyncID,
 		SyncOp: SyncOp_SYNC_NEW,
 	}); err != nil {
-		err := AsRiverError(err).Func("SyncStreams")
+		err := AsRiverError(err).Func(funcName)
 		return err
 	}
 
@@ -7,12 +7,14 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/river-build/river/core/config"
 	. "github.com/river-build/river/core/node/base"
 	"github.com/river-build/river/core/node/events"
 	"github.com/river-build/river/core/node/nodes"
 	. "github.com/river-build/river/core/node/protocol"
 	"github.com/river-build/river/core/node/rpc/sync/client"
 	"github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
 )
 
 type (
@@ -33,6 +35,18 @@ type (
 		streamCache events.StreamCache
 		// nodeRegistry is used to get the remote remoteNode endpoint from a thisNodeAddress address
 		nodeRegistry nodes.NodeRegistry
+		// streamRegistry is used to find other replicas for streams when a remote node becomes unavailable
+		streamRegistry nodes.StreamRegistry
+		// store keeps the sync session so the client can resume the sync operation, nil disables sessions
+		store storage.StreamStorage
+		// sessionTTL is how long the sync session is kept after it was last saved
+		sessionTTL time.Duration
+		// done is closed when the sync operation finished and its session is saved
+		done chan struct{}
+		// bufferConfig limits the messages that are buffered for the client when it falls behind
+		bufferConfig config.SyncBufferConfig
+		// bufferMetrics are updated by the client messages buffer
+		bufferMetrics *client.SyncBufferMetrics
 	}
 
 	// subCommand represents a request to add or remove a stream and ping sync operation
@@ -60,9 +74,15 @@ func (cmd *subCommand) Reply(err error) {
 // Use the Run method to start syncing.
 func NewStreamsSyncOperation(
 	ctx context.Context,
+	syncID string,
 	node common.Address,
 	streamCache events.StreamCache,
 	nodeRegistry nodes.NodeRegistry,
+	streamRegistry nodes.StreamRegistry,
+	store storage.StreamStorage,
+	sessionTTL time.Duration,
+	bufferConfig config.SyncBufferConfig,
+	bufferMetrics *client.SyncBufferMetrics,
 ) (*StreamSyncOperation, error) {
 	// make the sync operation cancellable for CancelSync
 	ctx, cancel := context.WithCancel(ctx)
@@ -70,31 +90,46 @@ func NewStreamsSyncOperation(
 	return &StreamSyncOperation{
 		ctx:             ctx,
 		cancel:          cancel,
-		SyncID:          GenNanoid(),
+		SyncID:          syncID,
 		thisNodeAddress: node,
 		commands:        make(chan *subCommand),
 		streamCache:     streamCache,
 		nodeRegistry:    nodeRegistry,
+		streamRegistry:  streamRegistry,
+		store:           store,
+		sessionTTL:      sessionTTL,
+		done:            make(chan struct{}),
+		bufferConfig:    bufferConfig,
+		bufferMetrics:   bufferMetrics,
 	}, nil
 }
 
-// Run the stream sync until either sub.Cancel is called or until sub.ctx expired
+// Run the stream sync until either sub.Cancel is called or until sub.ctx expired.
+// The positions delivered to the client are kept in the sync session, so the sync can be resumed with the same
+// sync id until the session expires.
 func (syncOp *StreamSyncOperation) Run(
-	req *connect.Request[SyncStreamsRequest],
-	res *connect.ServerStream[SyncStreamsResponse],
+	req *SyncStreamsRequest,
+	res syncResponseSender,
 ) error {
 	defer syncOp.cancel()
 
 	log := dlog.FromCtx(syncOp.ctx).With("syncId", syncOp.SyncID)
 
-	cookies, err := client.ValidateAndGroupSyncCookies(req.Msg.GetSyncPos())
+	cookies, err := client.ValidateAndGroupSyncCookies(req.GetSyncPos())
 	if err != nil {
 		return err
 	}
 
-	syncers, messages, err := client.NewSyncers(
+	filters, err := client.NewSyncFilters(req.GetFilter(), req.GetStreamFilters())
+	if err != nil {
+		return err
+	}
+
+	messages := client.NewSyncBuffer(syncOp.ctx, syncOp.cancel, syncOp.SyncID, syncOp.bufferConfig, syncOp.bufferMetrics)
+
+	syncers, err := client.NewSyncers(
 		syncOp.ctx, syncOp.cancel, syncOp.SyncID, syncOp.streamCache,
-		syncOp.nodeRegistry, syncOp.thisNodeAddress, cookies)
+		syncOp.nodeRegistry, syncOp.streamRegistry, syncOp.thisNodeAddress, cookies, filters, messages)
 
 	if err != nil {
 		return err
@@ -102,10 +137,50 @@ func (syncOp *StreamSyncOperation) Run(
 
 	go syncers.Run()
 
+	session := newSyncSession(syncOp.SyncID, syncOp.store, syncOp.sessionTTL, req)
+	if err := session.save(syncOp.ctx); err != nil {
+		log.Warn("Unable to save sync session", "err", err)
+	}
+	defer func() {
+		// keep the latest positions when the client disconnects or the node shuts down
+		if err := session.save(context.WithoutCancel(syncOp.ctx)); err != nil {
+			log.Warn("Unable to save sync session", "err", err)
+		}
+	}()
+
+	saveTicker := time.NewTicker(syncSessionSaveInterval)
+	defer saveTicker.Stop()
+
 	for {
 		select {
-		case msg, ok := <-messages:
-			if !ok {
+		case <-saveTicker.C:
+			if err := session.save(syncOp.ctx); err != nil {
+				log.Warn("Unable to save sync session", "err", err)
+			}
+
+		case <-messages.Ready():
+			msgs, resets, closed := messages.Take()
+
+			for _, msg := range msgs {
+				msg.SyncId = syncOp.SyncID
+				if err = res.Send(msg); err != nil {
+					log.Error("Unable to send sync stream update to client", "err", err)
+					return err
+				}
+
+				switch msg.GetSyncOp() {
+				case SyncOp_SYNC_UPDATE:
+					if cookie := msg.GetStream().GetNextSyncCookie(); cookie != nil {
+						session.update(cookie)
+					}
+				case SyncOp_SYNC_DOWN:
+					if streamID, err := shared.StreamIdFromBytes(msg.GetStreamId()); err == nil {
+						session.remove(streamID)
+					}
+				}
+			}
+
+			if closed {
 				_ = res.Send(&SyncStreamsResponse{
 					SyncId: syncOp.SyncID,
 					SyncOp: SyncOp_SYNC_CLOSE,
@@ -113,10 +188,11 @@ func (syncOp *StreamSyncOperation) Run(
 				return nil
 			}
 
-			msg.SyncId = syncOp.SyncID
-			if err = res.Send(msg); err != nil {
-				log.Error("Unable to send sync stream update to client", "err", err)
-				return err
+			// updates dropped from the buffer are replaced by a sync reset with the current stream state
+			for _, reset := range resets {
+				if err := syncers.ResetStream(syncOp.ctx, reset.StreamID, reset.Cookie); err != nil {
+					log.Warn("Unable to reset stream", "streamId", reset.StreamID, "err", err)
+				}
 			}
 
 		case <-syncOp.ctx.Done():
@@ -130,14 +206,30 @@ func (syncOp *StreamSyncOperation) Run(
 					cmd.Reply(err)
 					continue
 				}
-				cmd.Reply(syncers.AddStream(cmd.Ctx, nodeAddress, streamID, cmd.AddStreamReq.Msg.GetSyncPos()))
+				if filter := cmd.AddStreamReq.Msg.GetFilter(); filter != nil {
+					if err := filters.SetStreamFilter(streamID, filter); err != nil {
+						cmd.Reply(err)
+						continue
+					}
+					session.setStreamFilter(streamID, filter)
+				}
+				err = syncers.AddStream(cmd.Ctx, nodeAddress, streamID, cmd.AddStreamReq.Msg.GetSyncPos())
+				if err == nil {
+					session.update(cmd.AddStreamReq.Msg.GetSyncPos())
+				}
+				cmd.Reply(err)
 			} else if cmd.RmStreamReq != nil {
 				streamID, err := shared.StreamIdFromBytes(cmd.RmStreamReq.Msg.GetStreamId())
 				if err != nil {
 					cmd.Reply(err)
 					continue
 				}
-				cmd.Reply(syncers.RemoveStream(cmd.Ctx, streamID))
+				err = syncers.RemoveStream(cmd.Ctx, streamID)
+				if err == nil {
+					filters.RemoveStream(streamID)
+					session.remove(streamID)
+				}
+				cmd.Reply(err)
 			} else if cmd.PingReq != nil {
 				err = res.Send(&SyncStreamsResponse{
 					SyncId:    syncOp.SyncID,
@@ -146,10 +238,19 @@ func (syncOp *StreamSyncOperation) Run(
 				})
 				cmd.Reply(err)
 			} else if cmd.DebugDropStream != (shared.StreamId{}) {
-				cmd.Reply(syncers.DebugDropStream(cmd.Ctx, cmd.DebugDropStream))
+				err = syncers.DebugDropStream(cmd.Ctx, cmd.DebugDropStream)
+				if err == nil {
+					session.remove(cmd.DebugDropStream)
+				}
+				cmd.Reply(err)
 			} else if cmd.CancelReq != nil {
 				syncOp.cancel()
 
+				// cancelled sync operations can't be resumed
+				if err := session.delete(context.WithoutCancel(syncOp.ctx)); err != nil {
+					log.Warn("Unable to delete sync session", "err", err)
+				}
+
 				_ = res.Send(&SyncStreamsResponse{
 					SyncId: syncOp.SyncID,
 					SyncOp: SyncOp_SYNC_CLOSE,
@@ -169,6 +270,9 @@ func (syncOp *StreamSyncOperation) AddStreamToSync(
 	if err := events.SyncCookieValidate(req.Msg.GetSyncPos()); err != nil {
 		return nil, err
 	}
+	if _, err := events.NewSyncEventFilter(req.Msg.GetFilter()); err != nil {
+		return nil, err
+	}
 
 	cmd := &subCommand{
 		Ctx:          ctx,
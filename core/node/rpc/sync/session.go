@@ -0,0 +1,171 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
+)
+
+// syncSessionSaveInterval is how often changed sync session state is written to storage.
+const syncSessionSaveInterval = 10 * time.Second
+
+// syncSession keeps the positions of the streams in a sync operation that were last delivered to the client
+// and the sync operation filters. They are stored so the client can continue the sync operation with ResumeSync
+// after it reconnected or the node restarted. It's only accessed from the sync operation Run loop.
+type syncSession struct {
+	syncID  string
+	store   storage.StreamStorage
+	ttl     time.Duration
+	cookies map[shared.StreamId]*SyncCookie
+	filter  *SyncFilter
+	// streamFilters keeps the filters of individual streams
+	streamFilters map[shared.StreamId]*SyncFilter
+	dirty         bool
+}
+
+func newSyncSession(
+	syncID string,
+	store storage.StreamStorage,
+	ttl time.Duration,
+	req *SyncStreamsRequest,
+) *syncSession {
+	s := &syncSession{
+		syncID:        syncID,
+		store:         store,
+		ttl:           ttl,
+		cookies:       make(map[shared.StreamId]*SyncCookie, len(req.GetSyncPos())),
+		filter:        req.GetFilter(),
+		streamFilters: make(map[shared.StreamId]*SyncFilter, len(req.GetStreamFilters())),
+		dirty:         true,
+	}
+	for _, cookie := range req.GetSyncPos() {
+		s.update(cookie)
+	}
+	for _, streamFilter := range req.GetStreamFilters() {
+		if streamID, err := shared.StreamIdFromBytes(streamFilter.GetStreamId()); err == nil {
+			s.setStreamFilter(streamID, streamFilter.GetFilter())
+		}
+	}
+	return s
+}
+
+// loadSyncSession returns the sync request that continues the stored sync session.
+func loadSyncSession(ctx context.Context, store storage.StreamStorage, syncID string) (*SyncStreamsRequest, error) {
+	if store == nil {
+		return nil, RiverError(Err_NOT_FOUND, "Sync session not found", "syncId", syncID)
+	}
+	data, err := store.ReadSyncSession(ctx, syncID)
+	if err != nil {
+		return nil, err
+	}
+	var session SyncSession
+	if err := proto.Unmarshal(data, &session); err != nil {
+		return nil, AsRiverError(err, Err_INTERNAL).Message("Unable to decode sync session").Tag("syncId", syncID)
+	}
+	return &SyncStreamsRequest{
+		SyncPos:       session.GetSyncPos(),
+		Filter:        session.GetFilter(),
+		StreamFilters: session.GetStreamFilters(),
+	}, nil
+}
+
+// update records the cookie as the last position delivered to the client for the stream.
+func (s *syncSession) update(cookie *SyncCookie) {
+	streamID, err := shared.StreamIdFromBytes(cookie.GetStreamId())
+	if err != nil {
+		return
+	}
+	s.cookies[streamID] = cookie
+	s.dirty = true
+}
+
+func (s *syncSession) setStreamFilter(streamID shared.StreamId, filter *SyncFilter) {
+	s.streamFilters[streamID] = filter
+	s.dirty = true
+}
+
+func (s *syncSession) remove(streamID shared.StreamId) {
+	if _, found := s.cookies[streamID]; found {
+		delete(s.cookies, streamID)
+		s.dirty = true
+	}
+	if _, found := s.streamFilters[streamID]; found {
+		delete(s.streamFilters, streamID)
+		s.dirty = true
+	}
+}
+
+// save writes the session to storage if it changed since it was last saved.
+func (s *syncSession) save(ctx context.Context) error {
+	if s.store == nil || !s.dirty {
+		return nil
+	}
+	session := &SyncSession{
+		SyncPos:       make([]*SyncCookie, 0, len(s.cookies)),
+		Filter:        s.filter,
+		StreamFilters: make([]*StreamSyncFilter, 0, len(s.streamFilters)),
+	}
+	for _, cookie := range s.cookies {
+		session.SyncPos = append(session.SyncPos, cookie)
+	}
+	for streamID, filter := range s.streamFilters {
+		session.StreamFilters = append(session.StreamFilters, &StreamSyncFilter{StreamId: streamID[:], Filter: filter})
+	}
+	data, err := proto.Marshal(session)
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL)
+	}
+	if err := s.store.WriteSyncSession(ctx, s.syncID, data, s.ttl); err != nil {
+		return err
+	}
+	s.dirty = false
+	return nil
+}
+
+// delete removes the session from storage, it can't be resumed afterwards.
+func (s *syncSession) delete(ctx context.Context) error {
+	if s.store == nil {
+		return nil
+	}
+	s.dirty = false
+	return s.store.DeleteSyncSession(ctx, s.syncID)
+}
+
+// StartSessionCleanup periodically deletes expired sync sessions from storage until ctx is cancelled.
+// Expired sessions can't be resumed, they are deleted once per session TTL.
+func (h *handlerImpl) StartSessionCleanup(ctx context.Context) {
+	if h.store == nil {
+		return
+	}
+	go h.runSessionCleanup(ctx)
+}
+
+func (h *handlerImpl) runSessionCleanup(ctx context.Context) {
+	log := dlog.FromCtx(ctx)
+
+	for {
+		select {
+		case <-time.After(h.sessionTTL):
+			deleted, err := h.store.DeleteExpiredSyncSessions(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Error("SyncSessionCleanup: failed to delete expired sync sessions", "error", err)
+				}
+				continue
+			}
+			if deleted > 0 {
+				log.Info("SyncSessionCleanup: deleted expired sync sessions", "sessions", deleted)
+			}
+		case <-ctx.Done():
+			log.Debug("SyncSessionCleanup: shutdown")
+			return
+		}
+	}
+}
@@ -0,0 +1,360 @@
+package sync
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+const (
+	// transportKeepAliveInterval is how often keep-alive messages are sent to WebSocket and server-sent events
+	// clients, so proxies don't close sync connections without updates.
+	transportKeepAliveInterval = 15 * time.Second
+	// webSocketWriteTimeout is the deadline for writing keep-alive and close messages to WebSocket clients.
+	webSocketWriteTimeout = 10 * time.Second
+	// maxSyncRequestSize limits the size of sync requests and commands received from WebSocket and SSE clients.
+	maxSyncRequestSize = 4 * 1024 * 1024
+)
+
+// webSocketUpgrader accepts connections from all origins, the same as the CORS policy of the node.
+var webSocketUpgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// syncCodec encodes messages of the WebSocket and server-sent events transports as either JSON or binary protobuf.
+// It's selected with the encoding query parameter, JSON is the default.
+type syncCodec struct {
+	binary bool
+}
+
+func newSyncCodec(r *http.Request) (syncCodec, error) {
+	switch encoding := r.URL.Query().Get("encoding"); encoding {
+	case "", "json":
+		return syncCodec{binary: false}, nil
+	case "proto":
+		return syncCodec{binary: true}, nil
+	default:
+		return syncCodec{}, RiverError(Err_INVALID_ARGUMENT, "Unsupported sync encoding", "encoding", encoding)
+	}
+}
+
+func (c syncCodec) marshal(msg proto.Message) ([]byte, error) {
+	if c.binary {
+		return proto.Marshal(msg)
+	}
+	return protojson.Marshal(msg)
+}
+
+func (c syncCodec) unmarshal(data []byte, msg proto.Message) error {
+	var err error
+	if c.binary {
+		err = proto.Unmarshal(data, msg)
+	} else {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, msg)
+	}
+	if err != nil {
+		return AsRiverError(err, Err_INVALID_ARGUMENT).Message("Unable to decode sync message")
+	}
+	return nil
+}
+
+// transportHttpStatus returns the HTTP status for errors that occur before the sync operation started.
+func transportHttpStatus(err error) int {
+	switch AsRiverError(err).Code {
+	case Err_NOT_FOUND:
+		return http.StatusNotFound
+	case Err_INVALID_ARGUMENT, Err_BAD_SYNC_COOKIE:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// webSocketSender writes sync messages to the WebSocket connection.
+// Text messages are used for JSON encoding and binary messages for protobuf encoding.
+type webSocketSender struct {
+	conn  *websocket.Conn
+	codec syncCodec
+	// mu serializes writes from the sync operation and the in-band command handler
+	mu sync.Mutex
+}
+
+func (s *webSocketSender) Send(msg *SyncStreamsResponse) error {
+	data, err := s.codec.marshal(msg)
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL)
+	}
+	messageType := websocket.TextMessage
+	if s.codec.binary {
+		messageType = websocket.BinaryMessage
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.WriteMessage(messageType, data)
+}
+
+// ServeWebSocket runs a sync operation over a WebSocket connection.
+//
+// The client starts a new sync operation by sending a SyncStreamsRequest as the first message, or resumes a stored
+// sync session by connecting with the syncId query parameter. After that it can send SyncCommand messages to add
+// and remove streams, ping or cancel the sync operation. The sync operation is stopped when the connection closes.
+func (h *handlerImpl) ServeWebSocket(w http.ResponseWriter, r *http.Request) {
+	log := dlog.FromCtx(r.Context())
+
+	codec, err := newSyncCodec(r)
+	if err != nil {
+		http.Error(w, err.Error(), transportHttpStatus(err))
+		return
+	}
+
+	conn, err := webSocketUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// upgrader already replied with an error
+		log.Debug("Unable to upgrade sync WebSocket connection", "err", err)
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(maxSyncRequestSize)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	closeWith := func(err error) {
+		msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		if err != nil {
+			msg = websocket.FormatCloseMessage(websocket.CloseInternalServerErr, AsRiverError(err).Code.String())
+		}
+		_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(webSocketWriteTimeout))
+	}
+
+	var (
+		op  *StreamSyncOperation
+		req *SyncStreamsRequest
+	)
+	if syncID := r.URL.Query().Get("syncId"); syncID != "" {
+		op, req, err = h.resumeSyncOperation(ctx, syncID)
+	} else {
+		req = &SyncStreamsRequest{}
+		var data []byte
+		if _, data, err = conn.ReadMessage(); err == nil {
+			if err = codec.unmarshal(data, req); err == nil {
+				op, err = h.newSyncOperation(ctx, GenNanoid())
+			}
+		}
+	}
+	if err != nil {
+		log.Info("Unable to start WebSocket sync", "err", err)
+		closeWith(err)
+		return
+	}
+
+	res := &webSocketSender{conn: conn, codec: codec}
+
+	// read in-band commands until the client closes the connection, which stops the sync operation
+	go func() {
+		defer cancel()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var cmd SyncCommand
+			if err := codec.unmarshal(data, &cmd); err != nil {
+				log.Info("Invalid sync command", "syncId", op.SyncID, "err", err)
+				continue
+			}
+			h.handleSyncCommand(ctx, op, &cmd)
+		}
+	}()
+
+	go keepAlive(ctx, func() error {
+		return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(webSocketWriteTimeout))
+	})
+
+	err = h.runSyncOperation(op, req, res, "ServeWebSocket")
+	if err != nil {
+		log.Info("WebSocket sync stopped", "syncId", op.SyncID, "err", err)
+	}
+	closeWith(err)
+}
+
+// handleSyncCommand applies an in-band command to the sync operation. There is no reply for commands, errors are
+// logged. Remote streams that could not be added are reported to the client with a SYNC_DOWN message by the syncer set,
+// which keeps the message in order with the updates of the sync operation.
+func (h *handlerImpl) handleSyncCommand(
+	ctx context.Context,
+	op *StreamSyncOperation,
+	cmd *SyncCommand,
+) {
+	var err error
+	switch command := cmd.GetCommand().(type) {
+	case *SyncCommand_AddStream:
+		command.AddStream.SyncId = op.SyncID
+		_, err = op.AddStreamToSync(ctx, connect.NewRequest(command.AddStream))
+	case *SyncCommand_RemoveStream:
+		command.RemoveStream.SyncId = op.SyncID
+		_, err = op.RemoveStreamFromSync(ctx, connect.NewRequest(command.RemoveStream))
+	case *SyncCommand_Ping:
+		command.Ping.SyncId = op.SyncID
+		_, err = op.PingSync(ctx, connect.NewRequest(command.Ping))
+	case *SyncCommand_Cancel:
+		command.Cancel.SyncId = op.SyncID
+		_, err = op.CancelSync(ctx, connect.NewRequest(command.Cancel))
+	default:
+		err = RiverError(Err_INVALID_ARGUMENT, "Unknown sync command")
+	}
+	if err != nil {
+		dlog.FromCtx(ctx).Info("Unable to process sync command", "syncId", op.SyncID, "err", err)
+	}
+}
+
+// sseSender writes sync messages as server-sent events. The event id is the sync id, so clients that reconnect
+// with the Last-Event-ID header resume the sync session. Binary protobuf messages are base64 encoded.
+type sseSender struct {
+	w     http.ResponseWriter
+	rc    *http.ResponseController
+	codec syncCodec
+	// mu serializes writes from the sync operation and keep-alive messages
+	mu sync.Mutex
+}
+
+func (s *sseSender) Send(msg *SyncStreamsResponse) error {
+	data, err := s.codec.marshal(msg)
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL)
+	}
+	if s.codec.binary {
+		data = []byte(base64.StdEncoding.EncodeToString(data))
+	}
+	return s.write(fmt.Sprintf("id: %s\ndata: %s\n\n", msg.GetSyncId(), data))
+}
+
+// keepAlive writes an SSE comment that is ignored by clients.
+func (s *sseSender) keepAlive() error {
+	return s.write(": keep-alive\n\n")
+}
+
+func (s *sseSender) write(event string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := io.WriteString(s.w, event); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// ServeSSE runs a sync operation as a stream of server-sent events.
+//
+// A new sync operation is started with the SyncStreamsRequest from the request query parameter for GET requests,
+// which allows browsers to use EventSource, or from the body for POST requests. A stored sync session is resumed
+// with the syncId query parameter or the Last-Event-ID header.
+func (h *handlerImpl) ServeSSE(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := dlog.FromCtx(ctx)
+
+	op, req, codec, err := h.startSSESyncOperation(ctx, r)
+	if err != nil {
+		log.Info("Unable to start SSE sync", "err", err)
+		http.Error(w, err.Error(), transportHttpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	res := &sseSender{w: w, rc: http.NewResponseController(w), codec: codec}
+
+	keepAliveCtx, cancel := context.WithCancel(ctx)
+	keepAliveDone := make(chan struct{})
+	go func() {
+		defer close(keepAliveDone)
+		keepAlive(keepAliveCtx, res.keepAlive)
+	}()
+
+	if err := h.runSyncOperation(op, req, res, "ServeSSE"); err != nil {
+		log.Info("SSE sync stopped", "syncId", op.SyncID, "err", err)
+	}
+
+	// the response can't be written after the handler returned
+	cancel()
+	<-keepAliveDone
+}
+
+// startSSESyncOperation creates or resumes the sync operation for the server-sent events request.
+func (h *handlerImpl) startSSESyncOperation(
+	ctx context.Context,
+	r *http.Request,
+) (*StreamSyncOperation, *SyncStreamsRequest, syncCodec, error) {
+	codec, err := newSyncCodec(r)
+	if err != nil {
+		return nil, nil, codec, err
+	}
+
+	syncID := r.URL.Query().Get("syncId")
+	if syncID == "" {
+		syncID = r.Header.Get("Last-Event-ID")
+	}
+	if syncID != "" {
+		op, req, err := h.resumeSyncOperation(ctx, syncID)
+		return op, req, codec, err
+	}
+
+	var data []byte
+	switch r.Method {
+	case http.MethodGet:
+		data = []byte(r.URL.Query().Get("request"))
+		if codec.binary {
+			if data, err = base64.URLEncoding.DecodeString(string(data)); err != nil {
+				return nil, nil, codec, AsRiverError(err, Err_INVALID_ARGUMENT).Message("Invalid sync request")
+			}
+		}
+	case http.MethodPost:
+		if data, err = io.ReadAll(io.LimitReader(r.Body, maxSyncRequestSize)); err != nil {
+			return nil, nil, codec, AsRiverError(err, Err_INVALID_ARGUMENT).Message("Unable to read sync request")
+		}
+	default:
+		return nil, nil, codec, RiverError(Err_INVALID_ARGUMENT, "Unsupported method", "method", r.Method)
+	}
+
+	req := &SyncStreamsRequest{}
+	if len(data) > 0 {
+		if err := codec.unmarshal(data, req); err != nil {
+			return nil, nil, codec, err
+		}
+	}
+
+	op, err := h.newSyncOperation(ctx, GenNanoid())
+	return op, req, codec, err
+}
+
+// keepAlive calls send every transportKeepAliveInterval until ctx expires or send fails.
+func keepAlive(ctx context.Context, send func() error) {
+	ticker := time.NewTicker(transportKeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := send(); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+// TestSyncFailover ensures that streams are moved to another replica when the node that
+// serves the sync becomes unavailable and that the client doesn't receive a SyncOp_Down message.
+func TestSyncFailover(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 4, replicationFactor: 3, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client0 := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	_, _, err = createUser(ctx, wallet, client0, nil)
+	require.NoError(err)
+	_, _, err = createUserDeviceKeyStream(ctx, wallet, client0, nil)
+	require.NoError(err)
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	_, _, err = createSpace(ctx, wallet, client0, spaceId, nil)
+	require.NoError(err)
+	channelId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	cookie, _, err := createChannel(ctx, wallet, client0, spaceId, channelId, nil)
+	require.NoError(err)
+
+	// sync through a node that forwards the sync to the node from the cookie
+	syncing, posting := -1, -1
+	for i, n := range tt.nodes {
+		if n.address == common.BytesToAddress(cookie.NodeAddress) {
+			continue
+		}
+		if syncing < 0 {
+			syncing = i
+		} else if posting < 0 {
+			posting = i
+		}
+	}
+	require.GreaterOrEqual(posting, 0)
+
+	syncCtx, syncCancel := context.WithCancel(ctx)
+	defer syncCancel()
+	syncRes, err := tt.testClient(syncing).SyncStreams(syncCtx, connect.NewRequest(&protocol.SyncStreamsRequest{
+		SyncPos: []*protocol.SyncCookie{cookie},
+	}))
+	require.NoError(err)
+	require.True(syncRes.Receive())
+	require.NotEmpty(syncRes.Msg().SyncId)
+
+	received := make(chan string, 16)
+	down := make(chan struct{}, 1)
+	go func() {
+		for syncRes.Receive() {
+			msg := syncRes.Msg()
+			switch msg.GetSyncOp() {
+			case protocol.SyncOp_SYNC_UPDATE:
+				for _, e := range msg.GetStream().GetEvents() {
+					var event protocol.StreamEvent
+					if err := proto.Unmarshal(e.Event, &event); err == nil {
+						if m := event.GetChannelPayload().GetMessage(); m != nil {
+							received <- m.GetCiphertext()
+						}
+					}
+				}
+			case protocol.SyncOp_SYNC_DOWN:
+				if bytes.Equal(msg.GetStreamId(), channelId[:]) {
+					down <- struct{}{}
+				}
+			}
+		}
+	}()
+
+	// bring the node that serves the sync down
+	for i, n := range tt.nodes {
+		if n.address == common.BytesToAddress(cookie.NodeAddress) {
+			tt.CloseNode(i)
+		}
+	}
+
+	client := tt.testClient(posting)
+	require.Eventually(func() bool {
+		resp, err := client.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{StreamId: channelId[:]}))
+		if err != nil {
+			return false
+		}
+		message, err := events.MakeEnvelopeWithPayload(
+			wallet,
+			events.Make_ChannelPayload_Message("after failover"),
+			resp.Msg.GetStream().GetNextSyncCookie().GetPrevMiniblockHash(),
+		)
+		require.NoError(err)
+		if _, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+			StreamId: channelId[:],
+			Event:    message,
+		})); err != nil {
+			return false
+		}
+
+		select {
+		case msg := <-received:
+			return msg == "after failover"
+		case <-time.After(5 * time.Second):
+		}
+		return false
+	}, 60*time.Second, 100*time.Millisecond)
+	require.Empty(down, "received stream down message")
+}
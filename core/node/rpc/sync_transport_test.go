@@ -0,0 +1,162 @@
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+)
+
+func TestSyncTransports(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, cookie, _, err := createUserSettingsStream(ctx, wallet, client, nil)
+	require.NoError(err)
+
+	eventNum := int64(0)
+	addEvent := func() *protocol.Envelope {
+		eventNum++
+		addr := crypto.GetTestAddress()
+		envelope, err := events.MakeEnvelopeWithPayload(
+			wallet,
+			events.Make_UserSettingsPayload_UserBlock(&protocol.UserSettingsPayload_UserBlock{
+				UserId:    addr[:],
+				IsBlocked: true,
+				EventNum:  eventNum,
+			}),
+			cookie.PrevMiniblockHash,
+		)
+		require.NoError(err)
+		_, err = client.AddEvent(ctx, connect.NewRequest(&protocol.AddEventRequest{
+			StreamId: streamId[:],
+			Event:    envelope,
+		}))
+		require.NoError(err)
+		return envelope
+	}
+
+	hasEvent := func(res *protocol.SyncStreamsResponse, hash []byte) bool {
+		for _, e := range res.GetStream().GetEvents() {
+			if bytes.Equal(e.Hash, hash) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// WebSocket sync with in-band commands
+	{
+		wsURL := "ws" + strings.TrimPrefix(tt.nodes[0].url, "http") + syncWebSocketPattern
+		conn, _, err := websocket.DefaultDialer.DialContext(ctx, wsURL, nil)
+		require.NoError(err)
+		defer conn.Close()
+
+		send := func(cmd *protocol.SyncCommand) {
+			data, err := protojson.Marshal(cmd)
+			require.NoError(err)
+			require.NoError(conn.WriteMessage(websocket.TextMessage, data))
+		}
+		receiveUntil := func(match func(*protocol.SyncStreamsResponse) bool) *protocol.SyncStreamsResponse {
+			for {
+				_, data, err := conn.ReadMessage()
+				require.NoError(err)
+				var res protocol.SyncStreamsResponse
+				require.NoError(protojson.Unmarshal(data, &res))
+				if match(&res) {
+					return &res
+				}
+			}
+		}
+
+		data, err := protojson.Marshal(&protocol.SyncStreamsRequest{})
+		require.NoError(err)
+		require.NoError(conn.WriteMessage(websocket.TextMessage, data))
+
+		res := receiveUntil(func(*protocol.SyncStreamsResponse) bool { return true })
+		require.Equal(protocol.SyncOp_SYNC_NEW, res.GetSyncOp())
+		syncID := res.GetSyncId()
+		require.NotEmpty(syncID)
+
+		// streams are added in-band
+		send(&protocol.SyncCommand{Command: &protocol.SyncCommand_AddStream{
+			AddStream: &protocol.AddStreamToSyncRequest{SyncPos: cookie},
+		}})
+		event := addEvent()
+		res = receiveUntil(func(res *protocol.SyncStreamsResponse) bool { return hasEvent(res, event.Hash) })
+		require.Equal(syncID, res.GetSyncId())
+
+		send(&protocol.SyncCommand{Command: &protocol.SyncCommand_Ping{
+			Ping: &protocol.PingSyncRequest{Nonce: "ping"},
+		}})
+		receiveUntil(func(res *protocol.SyncStreamsResponse) bool {
+			return res.GetSyncOp() == protocol.SyncOp_SYNC_PONG && res.GetPongNonce() == "ping"
+		})
+
+		send(&protocol.SyncCommand{Command: &protocol.SyncCommand_Cancel{Cancel: &protocol.CancelSyncRequest{}}})
+		receiveUntil(func(res *protocol.SyncStreamsResponse) bool {
+			return res.GetSyncOp() == protocol.SyncOp_SYNC_CLOSE
+		})
+	}
+
+	// server-sent events sync
+	{
+		syncReq, err := protojson.Marshal(&protocol.SyncStreamsRequest{SyncPos: []*protocol.SyncCookie{cookie}})
+		require.NoError(err)
+		sseURL := tt.nodes[0].url + syncSSEPattern + "?request=" + url.QueryEscape(string(syncReq))
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, sseURL, nil)
+		require.NoError(err)
+		httpRes, err := http.DefaultClient.Do(req)
+		require.NoError(err)
+		defer httpRes.Body.Close()
+		require.Equal(http.StatusOK, httpRes.StatusCode)
+		require.Equal("text/event-stream", httpRes.Header.Get("Content-Type"))
+
+		scanner := bufio.NewScanner(httpRes.Body)
+		receiveUntil := func(match func(*protocol.SyncStreamsResponse) bool) *protocol.SyncStreamsResponse {
+			for scanner.Scan() {
+				data, found := strings.CutPrefix(scanner.Text(), "data: ")
+				if !found {
+					continue
+				}
+				var res protocol.SyncStreamsResponse
+				require.NoError(protojson.Unmarshal([]byte(data), &res))
+				if match(&res) {
+					return &res
+				}
+			}
+			require.FailNow("sse stream closed", "err: %v", scanner.Err())
+			return nil
+		}
+
+		res := receiveUntil(func(*protocol.SyncStreamsResponse) bool { return true })
+		require.Equal(protocol.SyncOp_SYNC_NEW, res.GetSyncOp())
+		syncID := res.GetSyncId()
+		require.NotEmpty(syncID)
+
+		event := addEvent()
+		receiveUntil(func(res *protocol.SyncStreamsResponse) bool { return hasEvent(res, event.Hash) })
+
+		// SSE sync operations are changed with the regular sync requests
+		_, err = client.CancelSync(ctx, connect.NewRequest(&protocol.CancelSyncRequest{SyncId: syncID}))
+		require.NoError(err)
+		receiveUntil(func(res *protocol.SyncStreamsResponse) bool {
+			return res.GetSyncOp() == protocol.SyncOp_SYNC_CLOSE
+		})
+	}
+}
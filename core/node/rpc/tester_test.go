@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"net"
 	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,6 +30,37 @@ import (
 	"github.com/river-build/river/core/node/testutils/dbtestutils"
 )
 
+// connClosingListener closes the accepted connections when the listener is closed.
+// The h2c server hijacks its connections, they are not closed when the http server is closed
+// and a closed node would keep serving its open streams.
+type connClosingListener struct {
+	net.Listener
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func (l *connClosingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	l.conns = append(l.conns, conn)
+	l.mu.Unlock()
+	return conn, nil
+}
+
+func (l *connClosingListener) Close() error {
+	err := l.Listener.Close()
+	l.mu.Lock()
+	for _, conn := range l.conns {
+		_ = conn.Close()
+	}
+	l.conns = nil
+	l.mu.Unlock()
+	return err
+}
+
 type testNodeRecord struct {
 	listener net.Listener
 	url      string
@@ -36,12 +68,14 @@ type testNodeRecord struct {
 	address  common.Address
 }
 
+// Close stops the node and deletes its test database schema if the node uses postgres storage,
+// i.e. if dbUrl is set.
 func (n *testNodeRecord) Close(ctx context.Context, dbUrl string) {
 	if n.service != nil {
 		n.service.Close()
 		n.service = nil
 	}
-	if n.address != (common.Address{}) {
+	if dbUrl != "" && n.address != (common.Address{}) {
 		_ = dbtestutils.DeleteTestSchema(
 			ctx,
 			dbUrl,
@@ -64,6 +98,9 @@ type serviceTesterOpts struct {
 	numNodes          int
 	replicationFactor int
 	start             bool
+	// storageType is the storage type of the nodes, defaults to in-memory storage.
+	// Postgres storage is required to run multiple instances of the same node.
+	storageType string
 }
 
 func newServiceTester(t *testing.T, opts serviceTesterOpts) *serviceTester {
@@ -77,6 +114,10 @@ func newServiceTester(t *testing.T, opts serviceTesterOpts) *serviceTester {
 		opts.replicationFactor = 1
 	}
 
+	if opts.storageType == "" {
+		opts.storageType = storage.StreamStorageTypeMemory
+	}
+
 	ctx, ctxCancel := test.NewTestContext()
 	t.Cleanup(ctxCancel)
 
@@ -86,10 +127,12 @@ func newServiceTester(t *testing.T, opts serviceTesterOpts) *serviceTester {
 		ctx:     ctx,
 		t:       t,
 		require: require,
-		dbUrl:   dbtestutils.GetTestDbUrl(),
 		nodes:   make([]*testNodeRecord, opts.numNodes),
 		opts:    opts,
 	}
+	if opts.storageType == storage.StreamStorageTypePostgres {
+		st.dbUrl = dbtestutils.GetTestDbUrl()
+	}
 
 	btc, err := crypto.NewBlockchainTestContext(
 		st.ctx,
@@ -107,7 +150,7 @@ func newServiceTester(t *testing.T, opts serviceTesterOpts) *serviceTester {
 		// the server
 		listener, err := net.Listen("tcp", "localhost:0")
 		require.NoError(err)
-		st.nodes[i].listener = listener
+		st.nodes[i].listener = &connClosingListener{Listener: listener}
 
 		port := listener.Addr().(*net.TCPAddr).Port
 
@@ -222,7 +265,7 @@ func (st *serviceTester) getConfig(opts ...startOpts) *config.Config {
 			Url:          st.dbUrl,
 			StartupDelay: 2 * time.Millisecond,
 		},
-		StorageType: "postgres",
+		StorageType: st.opts.storageType,
 		Network: config.NetworkConfig{
 			NumRetries: 3,
 		},
@@ -0,0 +1,225 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/river-build/river/core/node/auth"
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/events"
+	. "github.com/river-build/river/core/node/protocol"
+	"github.com/river-build/river/core/node/rules"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// localValidateEvent evaluates the stream rules and entitlements for the event in the same way as addParsedEvent,
+// but doesn't add the event or its side effect events.
+func (s *Service) localValidateEvent(
+	ctx context.Context,
+	req *connect.Request[ValidateEventRequest],
+) (*connect.Response[ValidateEventResponse], error) {
+	streamId, err := StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedEvent, err := ParseEvent(req.Msg.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	_, streamView, err := s.cache.GetStream(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := rules.ExplainAddEvent(
+		ctx,
+		s.chainConfig,
+		s.nodeRegistry.GetValidNodeAddresses(),
+		time.Now(),
+		parsedEvent,
+		streamView,
+		s.storage,
+	)
+
+	resp := &ValidateEventResponse{
+		CanAddEvent: explanation.CanAddEvent,
+		Entitled:    len(explanation.ChainAuthArgs) == 0,
+	}
+	resp.Checks = toValidateEventChecks(explanation.Checks)
+
+	if explanation.Err != nil {
+		resp.Error = newAddEventErrorResponse(AsRiverError(explanation.Err).Func("localValidateEvent")).Error
+		return connect.NewResponse(resp), nil
+	}
+	if !explanation.CanAddEvent {
+		return connect.NewResponse(resp), nil
+	}
+
+	// Unlike addParsedEvent all chain auths are evaluated to report the outcome of each.
+	var entitlementErr error
+	for _, args := range explanation.ChainAuthArgs {
+		chainAuth := toValidateEventChainAuth(args)
+		chainAuth.Entitled, err = s.chainAuth.IsEntitled(ctx, s.config, args)
+		if err != nil {
+			chainAuth.Error = err.Error()
+			if entitlementErr == nil {
+				entitlementErr = err
+			}
+		}
+		resp.Entitled = resp.Entitled || chainAuth.Entitled
+		resp.ChainAuths = append(resp.ChainAuths, chainAuth)
+	}
+
+	if explanation.SideEffects != nil {
+		resp.RequiredParentEvent = toValidateEventDerivedEvent(explanation.SideEffects.RequiredParentEvent)
+		if !resp.Entitled {
+			resp.OnChainAuthFailureEvent = toValidateEventDerivedEvent(explanation.SideEffects.OnChainAuthFailure)
+		}
+	}
+
+	if !resp.Entitled {
+		if entitlementErr == nil {
+			entitlementErr = RiverError(
+				Err_PERMISSION_DENIED,
+				"IsEntitled failed",
+				"chainAuthArgsList",
+				explanation.ChainAuthArgs,
+			)
+		}
+		resp.Error = newAddEventErrorResponse(AsRiverError(entitlementErr).Func("localValidateEvent")).Error
+		return connect.NewResponse(resp), nil
+	}
+
+	resp.Valid = true
+	return connect.NewResponse(resp), nil
+}
+
+// localValidateCreateStream evaluates the stream creation rules, requirements and entitlements in the same way
+// as createStream, but doesn't create the stream or add its derived events.
+func (s *Service) localValidateCreateStream(
+	ctx context.Context,
+	req *connect.Request[ValidateEventRequest],
+) (*connect.Response[ValidateEventResponse], error) {
+	streamId, err := StreamIdFromBytes(req.Msg.StreamId)
+	if err != nil {
+		return nil, RiverError(Err_BAD_STREAM_CREATION_PARAMS, "invalid stream id", "err", err)
+	}
+
+	parsedEvents, err := ParseEvents(req.Msg.CreateStreamEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := rules.ExplainCreateStream(
+		ctx,
+		s.config,
+		s.chainConfig,
+		time.Now(),
+		streamId,
+		parsedEvents,
+		req.Msg.CreateStreamMetadata,
+	)
+
+	resp := &ValidateEventResponse{
+		CanCreateStream: explanation.Err == nil,
+		Entitled:        explanation.Rules == nil || explanation.Rules.ChainAuth == nil,
+	}
+	resp.Checks = toValidateEventChecks(explanation.Checks)
+
+	if explanation.Err != nil {
+		resp.Error = newAddEventErrorResponse(AsRiverError(explanation.Err).Func("localValidateCreateStream")).Error
+		return connect.NewResponse(resp), nil
+	}
+	csRules := explanation.Rules
+
+	err = s.checkRequiredMemberships(ctx, csRules)
+	resp.Checks = append(resp.Checks, toValidateEventCheck("requiredMemberships", err))
+	if err == nil {
+		err = s.checkRequiredUsers(ctx, csRules)
+		resp.Checks = append(resp.Checks, toValidateEventCheck("requiredUsers", err))
+	}
+	if err != nil {
+		resp.Error = newAddEventErrorResponse(AsRiverError(err).Func("localValidateCreateStream")).Error
+		return connect.NewResponse(resp), nil
+	}
+
+	for _, de := range csRules.DerivedEvents {
+		resp.DerivedEvents = append(resp.DerivedEvents, toValidateEventDerivedEvent(de))
+	}
+
+	if csRules.ChainAuth != nil {
+		chainAuth := toValidateEventChainAuth(csRules.ChainAuth)
+		chainAuth.Entitled, err = s.chainAuth.IsEntitled(ctx, s.config, csRules.ChainAuth)
+		if err != nil {
+			chainAuth.Error = err.Error()
+		} else if !chainAuth.Entitled {
+			err = RiverError(Err_PERMISSION_DENIED, "IsEntitled failed", "chainAuthArgs", csRules.ChainAuth.String())
+		}
+		resp.Entitled = chainAuth.Entitled
+		resp.ChainAuths = append(resp.ChainAuths, chainAuth)
+		if err != nil {
+			resp.Error = newAddEventErrorResponse(AsRiverError(err).Func("localValidateCreateStream")).Error
+			return connect.NewResponse(resp), nil
+		}
+	}
+
+	resp.Valid = true
+	return connect.NewResponse(resp), nil
+}
+
+func toValidateEventChecks(checks []*rules.RuleCheck) []*ValidateEventResponse_Check {
+	var ret []*ValidateEventResponse_Check
+	for _, check := range checks {
+		c := &ValidateEventResponse_Check{
+			Name:   check.Name,
+			Passed: check.Passed,
+		}
+		if check.Err != nil {
+			c.Error = check.Err.Error()
+		}
+		ret = append(ret, c)
+	}
+	return ret
+}
+
+func toValidateEventCheck(name string, err error) *ValidateEventResponse_Check {
+	c := &ValidateEventResponse_Check{
+		Name:   name,
+		Passed: err == nil,
+	}
+	if err != nil {
+		c.Error = err.Error()
+	}
+	return c
+}
+
+func toValidateEventChainAuth(args *auth.ChainAuthArgs) *ValidateEventResponse_ChainAuth {
+	return &ValidateEventResponse_ChainAuth{
+		Kind:       args.Kind(),
+		SpaceId:    streamIdBytesOrNil(args.SpaceId()),
+		ChannelId:  streamIdBytesOrNil(args.ChannelId()),
+		Principal:  args.Principal().Bytes(),
+		Permission: args.Permission().String(),
+	}
+}
+
+func toValidateEventDerivedEvent(event *rules.DerivedEvent) *ValidateEventResponse_DerivedEvent {
+	if event == nil {
+		return nil
+	}
+	return &ValidateEventResponse_DerivedEvent{
+		StreamId: streamIdBytesOrNil(event.StreamId),
+		Event:    &StreamEvent{Payload: event.Payload},
+	}
+}
+
+func streamIdBytesOrNil(streamId StreamId) []byte {
+	if streamId == (StreamId{}) {
+		return nil
+	}
+	return streamId[:]
+}
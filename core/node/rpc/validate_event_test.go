@@ -0,0 +1,177 @@
+package rpc
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/events"
+	"github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+func TestValidateEvent(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 3, replicationFactor: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, _, _, err := createUserSettingsStream(ctx, wallet, client, nil)
+	require.NoError(err)
+
+	hashResp, err := client.GetLastMiniblockHash(ctx, connect.NewRequest(&protocol.GetLastMiniblockHashRequest{
+		StreamId: streamId[:],
+	}))
+	require.NoError(err)
+
+	makeEvent := func(signer *crypto.Wallet, prevMiniblockHash []byte) *protocol.Envelope {
+		addr := crypto.GetTestAddress()
+		envelope, err := events.MakeEnvelopeWithPayload(
+			signer,
+			events.Make_UserSettingsPayload_UserBlock(
+				&protocol.UserSettingsPayload_UserBlock{
+					UserId:    addr[:],
+					IsBlocked: true,
+					EventNum:  1,
+				},
+			),
+			prevMiniblockHash,
+		)
+		require.NoError(err)
+		return envelope
+	}
+	checkNames := func(resp *protocol.ValidateEventResponse) []string {
+		var names []string
+		for _, c := range resp.Checks {
+			names = append(names, c.Name)
+		}
+		return names
+	}
+
+	// Valid event is not added to the stream.
+	for i := range tt.nodes {
+		resp, err := tt.testClient(i).ValidateEvent(ctx, connect.NewRequest(&protocol.ValidateEventRequest{
+			StreamId: streamId[:],
+			Event:    makeEvent(wallet, hashResp.Msg.Hash),
+		}))
+		require.NoError(err)
+		require.True(resp.Msg.Valid)
+		require.True(resp.Msg.CanAddEvent)
+		require.True(resp.Msg.Entitled)
+		require.Nil(resp.Msg.Error)
+		require.Empty(resp.Msg.ChainAuths)
+		require.Equal([]string{"validNextEvent", "payloadTypeMatchesStreamType", "creatorIsMember"}, checkNames(resp.Msg))
+		for _, c := range resp.Msg.Checks {
+			require.True(c.Passed, c.Name)
+		}
+	}
+	getResp, err := client.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{StreamId: streamId[:]}))
+	require.NoError(err)
+	require.Empty(getResp.Msg.Stream.Events)
+
+	// Event from another user fails membership check.
+	otherWallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	resp, err := client.ValidateEvent(ctx, connect.NewRequest(&protocol.ValidateEventRequest{
+		StreamId: streamId[:],
+		Event:    makeEvent(otherWallet, hashResp.Msg.Hash),
+	}))
+	require.NoError(err)
+	require.False(resp.Msg.Valid)
+	require.NotNil(resp.Msg.Error)
+	require.Equal(protocol.Err_PERMISSION_DENIED, resp.Msg.Error.Code)
+	last := resp.Msg.Checks[len(resp.Msg.Checks)-1]
+	require.Equal("creatorIsMember", last.Name)
+	require.False(last.Passed)
+
+	// Event with unknown previous miniblock fails before stream rules are evaluated.
+	resp, err = client.ValidateEvent(ctx, connect.NewRequest(&protocol.ValidateEventRequest{
+		StreamId: streamId[:],
+		Event:    makeEvent(wallet, make([]byte, 32)),
+	}))
+	require.NoError(err)
+	require.False(resp.Msg.Valid)
+	require.NotNil(resp.Msg.Error)
+	require.Equal([]string{"validNextEvent"}, checkNames(resp.Msg))
+	require.False(resp.Msg.Checks[0].Passed)
+	require.NotEmpty(resp.Msg.Checks[0].Error)
+}
+
+func TestValidateCreateStream(t *testing.T) {
+	tt := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tt.ctx
+	require := tt.require
+	client := tt.testClient(0)
+
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId := UserSettingStreamIdFromAddr(wallet.Address)
+
+	makeInception := func(signer *crypto.Wallet) *protocol.Envelope {
+		envelope, err := events.MakeEnvelopeWithPayload(
+			signer,
+			events.Make_UserSettingsPayload_Inception(streamId, nil),
+			nil,
+		)
+		require.NoError(err)
+		return envelope
+	}
+	checkNames := func(resp *protocol.ValidateEventResponse) []string {
+		var names []string
+		for _, c := range resp.Checks {
+			names = append(names, c.Name)
+		}
+		return names
+	}
+
+	// Valid stream is not created.
+	resp, err := client.ValidateEvent(ctx, connect.NewRequest(&protocol.ValidateEventRequest{
+		StreamId:           streamId[:],
+		CreateStreamEvents: []*protocol.Envelope{makeInception(wallet)},
+	}))
+	require.NoError(err)
+	require.True(resp.Msg.Valid)
+	require.True(resp.Msg.CanCreateStream)
+	require.True(resp.Msg.Entitled)
+	require.Nil(resp.Msg.Error)
+	require.Equal(
+		[]string{"streamIdType", "eventCount", "isUserStreamId", "requiredMemberships", "requiredUsers"},
+		checkNames(resp.Msg),
+	)
+	for _, c := range resp.Msg.Checks {
+		require.True(c.Passed, c.Name)
+	}
+	_, err = client.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{StreamId: streamId[:]}))
+	require.Equal(protocol.Err_NOT_FOUND, AsRiverError(err).Code)
+
+	// Stream of another user fails the stream id check.
+	otherWallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	resp, err = client.ValidateEvent(ctx, connect.NewRequest(&protocol.ValidateEventRequest{
+		StreamId:           streamId[:],
+		CreateStreamEvents: []*protocol.Envelope{makeInception(otherWallet)},
+	}))
+	require.NoError(err)
+	require.False(resp.Msg.Valid)
+	require.False(resp.Msg.CanCreateStream)
+	require.NotNil(resp.Msg.Error)
+	require.Equal(protocol.Err_PERMISSION_DENIED, resp.Msg.Error.Code)
+	last := resp.Msg.Checks[len(resp.Msg.Checks)-1]
+	require.Equal("isUserStreamId", last.Name)
+	require.False(last.Passed)
+	require.NotEmpty(last.Error)
+
+	// Request stream id must match the inception.
+	otherStreamId := UserSettingStreamIdFromAddr(otherWallet.Address)
+	resp, err = client.ValidateEvent(ctx, connect.NewRequest(&protocol.ValidateEventRequest{
+		StreamId:           otherStreamId[:],
+		CreateStreamEvents: []*protocol.Envelope{makeInception(wallet)},
+	}))
+	require.NoError(err)
+	require.False(resp.Msg.Valid)
+	require.Equal([]string{"streamIdMatchesInception"}, checkNames(resp.Msg))
+}
@@ -19,16 +19,26 @@ import (
 	"github.com/river-build/river/core/node/events"
 	. "github.com/river-build/river/core/node/protocol"
 	"github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/storage"
 )
 
+// EventLocator finds stored events by hash, it's used to check events referenced by the added event,
+// e.g. the edited message, when they are no longer in the stream view.
+type EventLocator interface {
+	GetEventLocation(ctx context.Context, eventHash common.Hash) (*storage.EventLocation, error)
+	ReadMiniblocks(ctx context.Context, streamId shared.StreamId, fromInclusive int64, toExclusive int64) ([][]byte, error)
+}
+
 type aeParams struct {
 	ctx                   context.Context
 	cfg                   crypto.OnChainConfiguration
 	mediaMaxChunkSize     int
 	streamMembershipLimit int
+	messageEditWindow     time.Duration
 	validNodeAddresses    []common.Address
 	currentTime           time.Time
 	streamView            events.StreamView
+	eventLocator          EventLocator
 	parsedEvent           *events.ParsedEvent
 }
 
@@ -62,6 +72,29 @@ type aeUnpinRules struct {
 	unpin  *MemberPayload_Unpin
 }
 
+type aeRedactionRules struct {
+	params    *aeParams
+	redaction *ChannelPayload_Redaction
+}
+
+type aeMessageEditRules struct {
+	params *aeParams
+	edit   *MessageEdit
+}
+
+type aeThreadReplyRules struct {
+	params *aeParams
+	reply  *ChannelPayload_ThreadReply
+}
+
+type aeReactionRules struct {
+	params   *aeParams
+	reaction *Reaction
+}
+
+// maxReactionLength is the maximum size of a reaction in bytes.
+const maxReactionLength = 64
+
 type aeMediaPayloadChunkRules struct {
 	params *aeParams
 	chunk  *MediaPayload_Chunk
@@ -94,6 +127,8 @@ type aeKeyFulfillmentRules struct {
 * for adding an event to a stream.
 *
 
+  - @param eventLocator EventLocator // optional, finds referenced events that are not in the stream view
+
   - @return canAddEvent bool // true if the event can be added to the stream, will be false in case of duplictate state
 
   - @return chainAuthArgsList *auth.ChainAuthArgs[] // a list of on chain requirements, such that, if defined, at least one must be satisfied in order to add the event to the stream
@@ -118,10 +153,49 @@ func CanAddEvent(
 	currentTime time.Time,
 	parsedEvent *events.ParsedEvent,
 	streamView events.StreamView,
+	eventLocator EventLocator,
+) (bool, []*auth.ChainAuthArgs, *AddEventSideEffects, error) {
+	return canAddEvent(ctx, chainConfig, validNodeAddresses, currentTime, parsedEvent, streamView, eventLocator, nil)
+}
+
+// ExplainAddEvent evaluates the same rules as CanAddEvent and returns their results
+// together with the list of evaluated checks. Entitlements are not checked.
+func ExplainAddEvent(
+	ctx context.Context,
+	chainConfig crypto.OnChainConfiguration,
+	validNodeAddresses []common.Address,
+	currentTime time.Time,
+	parsedEvent *events.ParsedEvent,
+	streamView events.StreamView,
+	eventLocator EventLocator,
+) *AddEventExplanation {
+	e := &AddEventExplanation{}
+	e.CanAddEvent, e.ChainAuthArgs, e.SideEffects, e.Err = canAddEvent(
+		ctx,
+		chainConfig,
+		validNodeAddresses,
+		currentTime,
+		parsedEvent,
+		streamView,
+		eventLocator,
+		e,
+	)
+	return e
+}
+
+func canAddEvent(
+	ctx context.Context,
+	chainConfig crypto.OnChainConfiguration,
+	validNodeAddresses []common.Address,
+	currentTime time.Time,
+	parsedEvent *events.ParsedEvent,
+	streamView events.StreamView,
+	eventLocator EventLocator,
+	explanation *AddEventExplanation,
 ) (bool, []*auth.ChainAuthArgs, *AddEventSideEffects, error) {
 	if parsedEvent.Event.DelegateExpiryEpochMs > 0 &&
 		isPastExpiry(currentTime, parsedEvent.Event.DelegateExpiryEpochMs) {
-		return false, nil, nil, RiverError(
+		err := RiverError(
 			Err_PERMISSION_DENIED,
 			"event delegate has expired",
 			"currentTime",
@@ -129,23 +203,37 @@ func CanAddEvent(
 			"expiryTime",
 			parsedEvent.Event.DelegateExpiryEpochMs,
 		)
+		explanation.record("delegateNotExpired", false, err)
+		return false, nil, nil, err
 	}
 
 	// validate that event has required properties
 	if parsedEvent.Event.PrevMiniblockHash == nil {
-		return false, nil, nil, RiverError(Err_INVALID_ARGUMENT, "event has no prevMiniblockHash")
+		err := RiverError(Err_INVALID_ARGUMENT, "event has no prevMiniblockHash")
+		explanation.record("hasPrevMiniblockHash", false, err)
+		return false, nil, nil, err
 	}
 	// check preceding miniblock hash
 	err := streamView.ValidateNextEvent(ctx, chainConfig, parsedEvent, currentTime)
+	explanation.record("validNextEvent", err == nil, err)
 	if err != nil {
 		return false, nil, nil, err
 	}
 	// make sure the stream event is of the same type as the inception event
 	err = parsedEvent.Event.VerifyPayloadTypeMatchesStreamType(streamView.InceptionPayload())
+	explanation.record("payloadTypeMatchesStreamType", err == nil, err)
 	if err != nil {
 		return false, nil, nil, err
 	}
 
+	if parsedEvent.Event.Ephemeral {
+		err = validEphemeralEvent(parsedEvent.Event)
+		explanation.record("validEphemeralEvent", err == nil, err)
+		if err != nil {
+			return false, nil, nil, err
+		}
+	}
+
 	settings := chainConfig.Get()
 
 	ru := &aeParams{
@@ -153,14 +241,35 @@ func CanAddEvent(
 		cfg:                   chainConfig,
 		mediaMaxChunkSize:     int(settings.MediaMaxChunkSize),
 		streamMembershipLimit: int(settings.MembershipLimits.ForType(streamView.StreamId().Type())),
+		messageEditWindow:     settings.MessageEditWindow,
 		validNodeAddresses:    validNodeAddresses,
 		currentTime:           currentTime,
 		parsedEvent:           parsedEvent,
 		streamView:            streamView,
+		eventLocator:          eventLocator,
 	}
 	builder := ru.canAddEvent()
 	ru.log().Debug("CanAddEvent", "builder", builder)
-	return builder.run()
+	canAdd, chainAuthArgs, sideEffects, err := builder.run(explanation)
+	if err == nil && parsedEvent.Event.Ephemeral && sideEffects != nil && sideEffects.RequiredParentEvent != nil {
+		// Required parent event would be stored while the ephemeral event itself is not.
+		err = RiverError(Err_INVALID_ARGUMENT, "ephemeral event can't require parent event")
+		explanation.record("ephemeralEventHasNoParentEvent", false, err)
+		return false, nil, nil, err
+	}
+	return canAdd, chainAuthArgs, sideEffects, err
+}
+
+// validEphemeralEvent checks that the ephemeral event has one of the payloads dedicated to ephemeral events,
+// since ephemeral events are never included into miniblocks.
+func validEphemeralEvent(event *StreamEvent) error {
+	switch event.GetMemberPayload().GetContent().(type) {
+	case *MemberPayload_Typing_, *MemberPayload_Presence_:
+		return nil
+	default:
+		payload, content := events.PayloadTypeName(event)
+		return RiverError(Err_INVALID_ARGUMENT, "event can't be ephemeral", "payload", payload, "content", content)
+	}
 }
 
 func (params *aeParams) canAddEvent() ruleBuilderAE {
@@ -199,12 +308,53 @@ func (params *aeParams) canAddChannelPayload(payload *StreamEvent_ChannelPayload
 			fail(invalidContentType(content))
 	case *ChannelPayload_Message:
 		return aeBuilder().
-			check(params.creatorIsMember).
+			check("creatorIsMember", params.creatorIsMember).
 			requireOneOfChainAuths(params.channelMessageWriteEntitlements, params.channelMessageReactEntitlements)
 	case *ChannelPayload_Redaction_:
+		ru := &aeRedactionRules{
+			params:    params,
+			redaction: content.Redaction,
+		}
 		return aeBuilder().
-			check(params.creatorIsMember).
+			check("creatorIsMember", params.creatorIsMember).
+			check("validRedaction", ru.validRedaction).
 			requireChainAuth(params.redactChannelMessageEntitlements)
+	case *ChannelPayload_Edit:
+		ru := &aeMessageEditRules{
+			params: params,
+			edit:   content.Edit,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validMessageEdit", ru.validMessageEdit).
+			requireChainAuth(params.channelMessageWriteEntitlements)
+	case *ChannelPayload_Reaction:
+		ru := &aeReactionRules{
+			params:   params,
+			reaction: content.Reaction,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validReaction", ru.validReaction).
+			requireChainAuth(params.channelMessageReactEntitlements)
+	case *ChannelPayload_RemoveReaction:
+		ru := &aeReactionRules{
+			params:   params,
+			reaction: content.RemoveReaction,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validReaction", ru.validReaction).
+			requireChainAuth(params.channelMessageReactEntitlements)
+	case *ChannelPayload_ThreadReply_:
+		ru := &aeThreadReplyRules{
+			params: params,
+			reply:  content.ThreadReply,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validThreadReply", ru.validThreadReply).
+			requireChainAuth(params.channelMessageWriteEntitlements)
 	default:
 		return aeBuilder().
 			fail(unknownContentType(content))
@@ -218,7 +368,31 @@ func (params *aeParams) canAddDmChannelPayload(payload *StreamEvent_DmChannelPay
 			fail(invalidContentType(content))
 	case *DmChannelPayload_Message:
 		return aeBuilder().
-			check(params.creatorIsMember)
+			check("creatorIsMember", params.creatorIsMember)
+	case *DmChannelPayload_Edit:
+		ru := &aeMessageEditRules{
+			params: params,
+			edit:   content.Edit,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validMessageEdit", ru.validMessageEdit)
+	case *DmChannelPayload_Reaction:
+		ru := &aeReactionRules{
+			params:   params,
+			reaction: content.Reaction,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validReaction", ru.validReaction)
+	case *DmChannelPayload_RemoveReaction:
+		ru := &aeReactionRules{
+			params:   params,
+			reaction: content.RemoveReaction,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validReaction", ru.validReaction)
 	default:
 		return aeBuilder().
 			fail(unknownContentType(content))
@@ -232,10 +406,34 @@ func (params *aeParams) canAddGdmChannelPayload(payload *StreamEvent_GdmChannelP
 			fail(invalidContentType(content))
 	case *GdmChannelPayload_Message:
 		return aeBuilder().
-			check(params.creatorIsMember)
+			check("creatorIsMember", params.creatorIsMember)
 	case *GdmChannelPayload_ChannelProperties:
 		return aeBuilder().
-			check(params.creatorIsMember)
+			check("creatorIsMember", params.creatorIsMember)
+	case *GdmChannelPayload_Edit:
+		ru := &aeMessageEditRules{
+			params: params,
+			edit:   content.Edit,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validMessageEdit", ru.validMessageEdit)
+	case *GdmChannelPayload_Reaction:
+		ru := &aeReactionRules{
+			params:   params,
+			reaction: content.Reaction,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validReaction", ru.validReaction)
+	case *GdmChannelPayload_RemoveReaction:
+		ru := &aeReactionRules{
+			params:   params,
+			reaction: content.RemoveReaction,
+		}
+		return aeBuilder().
+			check("creatorIsMember", params.creatorIsMember).
+			check("validReaction", ru.validReaction)
 	default:
 		return aeBuilder().
 			fail(unknownContentType(content))
@@ -254,12 +452,12 @@ func (params *aeParams) canAddSpacePayload(payload *StreamEvent_SpacePayload) ru
 		}
 		if content.Channel.Op == ChannelOp_CO_UPDATED {
 			return aeBuilder().
-				check(params.creatorIsMember).
-				check(ru.validSpaceChannelOp)
+				check("creatorIsMember", params.creatorIsMember).
+				check("validSpaceChannelOp", ru.validSpaceChannelOp)
 		} else {
 			return aeBuilder().
-				check(params.creatorIsValidNode).
-				check(ru.validSpaceChannelOp)
+				check("creatorIsValidNode", params.creatorIsValidNode).
+				check("validSpaceChannelOp", ru.validSpaceChannelOp)
 		}
 	default:
 		return aeBuilder().
@@ -279,8 +477,8 @@ func (params *aeParams) canAddUserPayload(payload *StreamEvent_UserPayload) rule
 			userMembership: content.UserMembership,
 		}
 		return aeBuilder().
-			checkOneOf(params.creatorIsMember, params.creatorIsValidNode).
-			check(ru.validUserMembershipTransition).
+			checkOneOf(namedCheck{"creatorIsMember", params.creatorIsMember}, namedCheck{"creatorIsValidNode", params.creatorIsValidNode}).
+			check("validUserMembershipTransition", ru.validUserMembershipTransition).
 			requireParentEvent(ru.parentEventForUserMembership)
 	case *UserPayload_UserMembershipAction_:
 		ru := &aeUserMembershipActionRules{
@@ -288,7 +486,7 @@ func (params *aeParams) canAddUserPayload(payload *StreamEvent_UserPayload) rule
 			action: content.UserMembershipAction,
 		}
 		return aeBuilder().
-			check(params.creatorIsMember).
+			check("creatorIsMember", params.creatorIsMember).
 			requireParentEvent(ru.parentEventForUserMembershipAction)
 	default:
 		return aeBuilder().
@@ -303,7 +501,7 @@ func (params *aeParams) canAddUserDeviceKeyPayload(payload *StreamEvent_UserDevi
 			fail(invalidContentType(content))
 	case *UserDeviceKeyPayload_EncryptionDevice_:
 		return aeBuilder().
-			check(params.creatorIsMember)
+			check("creatorIsMember", params.creatorIsMember)
 	default:
 		return aeBuilder().
 			fail(unknownContentType(content))
@@ -317,10 +515,10 @@ func (params *aeParams) canAddUserSettingsPayload(payload *StreamEvent_UserSetti
 			fail(invalidContentType(content))
 	case *UserSettingsPayload_FullyReadMarkers_:
 		return aeBuilder().
-			check(params.creatorIsMember)
+			check("creatorIsMember", params.creatorIsMember)
 	case *UserSettingsPayload_UserBlock_:
 		return aeBuilder().
-			check(params.creatorIsMember)
+			check("creatorIsMember", params.creatorIsMember)
 	default:
 		return aeBuilder().
 			fail(unknownContentType(content))
@@ -334,10 +532,10 @@ func (params *aeParams) canAddUserInboxPayload(payload *StreamEvent_UserInboxPay
 			fail(invalidContentType(content))
 	case *UserInboxPayload_GroupEncryptionSessions_:
 		return aeBuilder().
-			check(params.pass)
+			check("pass", params.pass)
 	case *UserInboxPayload_Ack_:
 		return aeBuilder().
-			check(params.creatorIsMember)
+			check("creatorIsMember", params.creatorIsMember)
 	default:
 		return aeBuilder().
 			fail(unknownContentType(content))
@@ -355,7 +553,7 @@ func (params *aeParams) canAddMediaPayload(payload *StreamEvent_MediaPayload) ru
 			chunk:  content.Chunk,
 		}
 		return aeBuilder().
-			check(ru.canAddMediaChunk)
+			check("canAddMediaChunk", ru.canAddMediaChunk)
 	default:
 		return aeBuilder().
 			fail(unknownContentType(content))
@@ -371,27 +569,27 @@ func (params *aeParams) canAddMemberPayload(payload *StreamEvent_MemberPayload)
 		}
 		if shared.ValidSpaceStreamId(ru.params.streamView.StreamId()) {
 			return aeBuilder().
-				check(ru.validMembershipPayload).
-				check(ru.validMembershipTransitionForSpace).
-				check(ru.validMembershipLimit).
+				check("validMembershipPayload", ru.validMembershipPayload).
+				check("validMembershipTransitionForSpace", ru.validMembershipTransitionForSpace).
+				check("validMembershipLimit", ru.validMembershipLimit).
 				requireChainAuth(ru.spaceMembershipEntitlements)
 		} else if shared.ValidChannelStreamId(ru.params.streamView.StreamId()) {
 			return aeBuilder().
-				check(ru.validMembershipPayload).
-				check(ru.validMembershipTransitionForChannel).
-				check(ru.validMembershipLimit).
+				check("validMembershipPayload", ru.validMembershipPayload).
+				check("validMembershipTransitionForChannel", ru.validMembershipTransitionForChannel).
+				check("validMembershipLimit", ru.validMembershipLimit).
 				requireChainAuth(ru.channelMembershipEntitlements).
 				requireParentEvent(ru.requireStreamParentMembership)
 		} else if shared.ValidDMChannelStreamId(ru.params.streamView.StreamId()) {
 			return aeBuilder().
-				check(ru.validMembershipPayload).
-				check(ru.validMembershipTransitionForDM).
-				check(ru.validMembershipLimit)
+				check("validMembershipPayload", ru.validMembershipPayload).
+				check("validMembershipTransitionForDM", ru.validMembershipTransitionForDM).
+				check("validMembershipLimit", ru.validMembershipLimit)
 		} else if shared.ValidGDMChannelStreamId(ru.params.streamView.StreamId()) {
 			return aeBuilder().
-				check(ru.validMembershipPayload).
-				check(ru.validMembershipTransitionForGDM).
-				check(ru.validMembershipLimit)
+				check("validMembershipPayload", ru.validMembershipPayload).
+				check("validMembershipTransitionForGDM", ru.validMembershipTransitionForGDM).
+				check("validMembershipLimit", ru.validMembershipLimit)
 		} else {
 			return aeBuilder().
 				fail(RiverError(Err_INVALID_ARGUMENT, "invalid stream id for membership payload", "streamId", ru.params.streamView.StreamId()))
@@ -404,14 +602,14 @@ func (params *aeParams) canAddMemberPayload(payload *StreamEvent_MemberPayload)
 
 		if shared.ValidChannelStreamId(params.streamView.StreamId()) {
 			return aeBuilder().
-				checkOneOf(params.creatorIsMember).
-				check(ru.validKeySolicitation).
+				checkOneOf(namedCheck{"creatorIsMember", params.creatorIsMember}).
+				check("validKeySolicitation", ru.validKeySolicitation).
 				requireChainAuth(params.channelMessageReadEntitlements).
 				onChainAuthFailure(params.onEntitlementFailureForUserEvent)
 		} else {
 			return aeBuilder().
-				checkOneOf(params.creatorIsMember).
-				check(ru.validKeySolicitation)
+				checkOneOf(namedCheck{"creatorIsMember", params.creatorIsMember}).
+				check("validKeySolicitation", ru.validKeySolicitation)
 		}
 	case *MemberPayload_KeyFulfillment_:
 		ru := &aeKeyFulfillmentRules{
@@ -419,30 +617,30 @@ func (params *aeParams) canAddMemberPayload(payload *StreamEvent_MemberPayload)
 			fulfillment: content.KeyFulfillment,
 		}
 		return aeBuilder().
-			checkOneOf(params.creatorIsMember).
-			check(ru.validKeyFulfillment)
+			checkOneOf(namedCheck{"creatorIsMember", params.creatorIsMember}).
+			check("validKeyFulfillment", ru.validKeyFulfillment)
 	case *MemberPayload_DisplayName:
 		return aeBuilder().
-			check(params.creatorIsMember)
+			check("creatorIsMember", params.creatorIsMember)
 	case *MemberPayload_Username:
 		return aeBuilder().
-			check(params.creatorIsMember)
+			check("creatorIsMember", params.creatorIsMember)
 	case *MemberPayload_EnsAddress:
 		ru := &aeEnsAddressRules{
 			params:  params,
 			address: content,
 		}
 		return aeBuilder().
-			check(params.creatorIsMember).
-			check(ru.validEnsAddress)
+			check("creatorIsMember", params.creatorIsMember).
+			check("validEnsAddress", ru.validEnsAddress)
 	case *MemberPayload_Nft_:
 		ru := &aeNftRules{
 			params: params,
 			nft:    content.Nft,
 		}
 		return aeBuilder().
-			check(params.creatorIsMember).
-			check(ru.validNft)
+			check("creatorIsMember", params.creatorIsMember).
+			check("validNft", ru.validNft)
 	case *MemberPayload_Pin_:
 		pinRuls := &aePinRules{
 			params: params,
@@ -450,18 +648,18 @@ func (params *aeParams) canAddMemberPayload(payload *StreamEvent_MemberPayload)
 		}
 		if shared.ValidSpaceStreamId(params.streamView.StreamId()) {
 			return aeBuilder().
-				check(params.creatorIsMember).
-				check(pinRuls.validPin).
+				check("creatorIsMember", params.creatorIsMember).
+				check("validPin", pinRuls.validPin).
 				requireChainAuth(params.spaceWriteEntitlements)
 		} else if shared.ValidChannelStreamId(params.streamView.StreamId()) {
 			return aeBuilder().
-				check(params.creatorIsMember).
-				check(pinRuls.validPin).
+				check("creatorIsMember", params.creatorIsMember).
+				check("validPin", pinRuls.validPin).
 				requireChainAuth(params.channelMessageWriteEntitlements)
 		} else {
 			return aeBuilder().
-				check(params.creatorIsMember).
-				check(pinRuls.validPin)
+				check("creatorIsMember", params.creatorIsMember).
+				check("validPin", pinRuls.validPin)
 		}
 	case *MemberPayload_Unpin_:
 		unpinRuls := &aeUnpinRules{
@@ -470,19 +668,34 @@ func (params *aeParams) canAddMemberPayload(payload *StreamEvent_MemberPayload)
 		}
 		if shared.ValidSpaceStreamId(params.streamView.StreamId()) {
 			return aeBuilder().
-				check(params.creatorIsMember).
-				check(unpinRuls.validUnpin).
+				check("creatorIsMember", params.creatorIsMember).
+				check("validUnpin", unpinRuls.validUnpin).
 				requireChainAuth(params.spaceWriteEntitlements)
 		} else if shared.ValidChannelStreamId(params.streamView.StreamId()) {
 			return aeBuilder().
-				check(params.creatorIsMember).
-				check(unpinRuls.validUnpin).
+				check("creatorIsMember", params.creatorIsMember).
+				check("validUnpin", unpinRuls.validUnpin).
 				requireChainAuth(params.channelMessageWriteEntitlements)
 		} else {
 			return aeBuilder().
-				check(params.creatorIsMember).
-				check(unpinRuls.validUnpin)
+				check("creatorIsMember", params.creatorIsMember).
+				check("validUnpin", unpinRuls.validUnpin)
 		}
+	case *MemberPayload_Typing_:
+		if shared.ValidChannelStreamId(params.streamView.StreamId()) {
+			return aeBuilder().
+				check("eventIsEphemeral", params.eventIsEphemeral).
+				check("creatorIsMember", params.creatorIsMember).
+				requireChainAuth(params.channelMessageWriteEntitlements)
+		} else {
+			return aeBuilder().
+				check("eventIsEphemeral", params.eventIsEphemeral).
+				check("creatorIsMember", params.creatorIsMember)
+		}
+	case *MemberPayload_Presence_:
+		return aeBuilder().
+			check("eventIsEphemeral", params.eventIsEphemeral).
+			check("creatorIsMember", params.creatorIsMember)
 	default:
 		return aeBuilder().
 			fail(unknownContentType(content))
@@ -494,6 +707,71 @@ func (params *aeParams) pass() (bool, error) {
 	return true, nil
 }
 
+// eventInStream checks that the event with the given hash was added to the stream.
+func (params *aeParams) eventInStream(eventId []byte) error {
+	_, err := params.streamEvent(eventId)
+	return err
+}
+
+// streamEvent returns the event with the given hash that was added to the stream. The event is looked up
+// in the stream view first, and then in the event index of the stored miniblocks.
+func (params *aeParams) streamEvent(eventId []byte) (*events.ParsedEvent, error) {
+	eventHash := common.BytesToHash(eventId)
+	if event := params.streamView.GetEvent(eventHash); event != nil {
+		return event, nil
+	}
+	streamId := *params.streamView.StreamId()
+	if params.eventLocator != nil {
+		location, err := params.eventLocator.GetEventLocation(params.ctx, eventHash)
+		if err == nil && location.StreamId == streamId {
+			return params.readStoredEvent(location, eventHash)
+		}
+		if err != nil && AsRiverError(err).Code != Err_NOT_FOUND {
+			return nil, err
+		}
+	}
+	return nil, RiverError(
+		Err_NOT_FOUND,
+		"event is not in the stream",
+		"eventId", eventHash,
+		"streamId", streamId,
+	)
+}
+
+func (params *aeParams) readStoredEvent(
+	location *storage.EventLocation,
+	eventHash common.Hash,
+) (*events.ParsedEvent, error) {
+	data, err := params.eventLocator.ReadMiniblocks(
+		params.ctx,
+		location.StreamId,
+		location.MiniblockNum,
+		location.MiniblockNum+1,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var mb Miniblock
+	if len(data) == 1 {
+		if err := proto.Unmarshal(data[0], &mb); err != nil {
+			return nil, err
+		}
+	}
+	if location.EventIndex >= len(mb.Events) || !bytes.Equal(mb.Events[location.EventIndex].Hash, eventHash[:]) {
+		return nil, RiverError(Err_INTERNAL, "Event index points to missing event").
+			Tags("eventHash", eventHash, "miniblockNum", location.MiniblockNum, "eventIndex", location.EventIndex)
+	}
+	return events.ParseEvent(mb.Events[location.EventIndex])
+}
+
+// eventIsEphemeral checks that the event with a payload dedicated to ephemeral events is not stored.
+func (params *aeParams) eventIsEphemeral() (bool, error) {
+	if !params.parsedEvent.Event.Ephemeral {
+		return false, RiverError(Err_INVALID_ARGUMENT, "event must be ephemeral")
+	}
+	return true, nil
+}
+
 func (params *aeParams) creatorIsMember() (bool, error) {
 	creatorAddress := params.parsedEvent.Event.CreatorAddress
 	isMember, err := params.streamView.IsMember(creatorAddress)
@@ -1256,6 +1534,143 @@ func (ru *aeUnpinRules) validUnpin() (bool, error) {
 	return false, RiverError(Err_INVALID_ARGUMENT, "message is not pinned")
 }
 
+// validMessageEdit checks that the edited event is a message of the same stream type
+// created by the creator of the edit within the message edit window.
+func (ru *aeMessageEditRules) validMessageEdit() (bool, error) {
+	if ru.edit == nil || ru.edit.Event == nil {
+		return false, RiverError(Err_INVALID_ARGUMENT, "event is not a message edit event")
+	}
+	if len(ru.edit.EventId) != 32 {
+		return false, RiverError(Err_INVALID_ARGUMENT, "invalid message hash")
+	}
+	if ru.edit.Message == nil {
+		return false, RiverError(Err_INVALID_ARGUMENT, "message edit has no message")
+	}
+
+	// hash the edited event and check against the hash
+	eventBytes, err := proto.Marshal(ru.edit.Event)
+	if err != nil {
+		return false, err
+	}
+	computedHash := crypto.RiverHash(eventBytes)
+	if !bytes.Equal(ru.edit.EventId, computedHash[:]) {
+		return false, RiverError(Err_INVALID_ARGUMENT, "invalid message hash")
+	}
+
+	editPayload, _ := events.PayloadTypeName(ru.params.parsedEvent.Event)
+	payload, content := events.PayloadTypeName(ru.edit.Event)
+	if payload != editPayload || content != "message" {
+		return false, RiverError(
+			Err_INVALID_ARGUMENT,
+			"edited event is not a message",
+			"payload", payload,
+			"content", content,
+		)
+	}
+
+	if !bytes.Equal(ru.edit.Event.CreatorAddress, ru.params.parsedEvent.Event.CreatorAddress) {
+		return false, RiverError(Err_PERMISSION_DENIED, "only message creator can edit message")
+	}
+
+	if ru.params.messageEditWindow > 0 &&
+		isPastExpiry(
+			ru.params.currentTime,
+			ru.edit.Event.CreatedAtEpochMs+ru.params.messageEditWindow.Milliseconds(),
+		) {
+		return false, RiverError(
+			Err_PERMISSION_DENIED,
+			"message edit window has passed",
+			"createdAt", ru.edit.Event.CreatedAtEpochMs,
+			"window", ru.params.messageEditWindow,
+		)
+	}
+
+	if err := ru.params.eventInStream(ru.edit.EventId); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (ru *aeThreadReplyRules) validThreadReply() (bool, error) {
+	if ru.reply == nil {
+		return false, RiverError(Err_INVALID_ARGUMENT, "event is not a thread reply event")
+	}
+	if len(ru.reply.ThreadId) != 32 {
+		return false, RiverError(Err_INVALID_ARGUMENT, "invalid thread id")
+	}
+	if ru.reply.Message == nil {
+		return false, RiverError(Err_INVALID_ARGUMENT, "thread reply has no message")
+	}
+
+	// thread root must be a message of this stream, replies can't start threads
+	root, err := ru.params.streamEvent(ru.reply.ThreadId)
+	if err != nil {
+		return false, err
+	}
+	if root.Event.GetChannelPayload().GetMessage() == nil {
+		payload, content := events.PayloadTypeName(root.Event)
+		return false, RiverError(
+			Err_INVALID_ARGUMENT,
+			"thread root is not a message",
+			"payload", payload,
+			"content", content,
+		)
+	}
+	return true, nil
+}
+
+// validRedaction checks that the thread id is set if and only if the redacted event is a reply to the thread,
+// so the thread summary can be updated. Redacted events that are not found can't be thread replies.
+func (ru *aeRedactionRules) validRedaction() (bool, error) {
+	if ru.redaction == nil {
+		return false, RiverError(Err_INVALID_ARGUMENT, "event is not a redaction event")
+	}
+	if ru.redaction.ThreadId != nil && len(ru.redaction.ThreadId) != 32 {
+		return false, RiverError(Err_INVALID_ARGUMENT, "invalid thread id")
+	}
+
+	redacted, err := ru.params.streamEvent(ru.redaction.EventId)
+	if err != nil {
+		if ru.redaction.ThreadId == nil && AsRiverError(err).Code == Err_NOT_FOUND {
+			return true, nil
+		}
+		return false, err
+	}
+	reply := redacted.Event.GetChannelPayload().GetThreadReply()
+	if reply == nil && ru.redaction.ThreadId != nil {
+		return false, RiverError(Err_INVALID_ARGUMENT, "redacted event is not a thread reply")
+	}
+	if reply != nil && !bytes.Equal(reply.ThreadId, ru.redaction.ThreadId) {
+		return false, RiverError(
+			Err_INVALID_ARGUMENT,
+			"thread id doesn't match the redacted thread reply",
+			"threadId", reply.ThreadId,
+		)
+	}
+	return true, nil
+}
+
+func (ru *aeReactionRules) validReaction() (bool, error) {
+	if ru.reaction == nil {
+		return false, RiverError(Err_INVALID_ARGUMENT, "event is not a reaction event")
+	}
+	if len(ru.reaction.EventId) != 32 {
+		return false, RiverError(Err_INVALID_ARGUMENT, "invalid message hash")
+	}
+	if len(ru.reaction.Reaction) == 0 || len(ru.reaction.Reaction) > maxReactionLength {
+		return false, RiverError(
+			Err_INVALID_ARGUMENT,
+			"invalid reaction length",
+			"length", len(ru.reaction.Reaction),
+			"max", maxReactionLength,
+		)
+	}
+	if err := ru.params.eventInStream(ru.reaction.EventId); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (ru *aeSpaceChannelRules) validSpaceChannelOp() (bool, error) {
 	if ru.channelUpdate == nil {
 		return false, RiverError(Err_INVALID_ARGUMENT, "event is not a channel event")
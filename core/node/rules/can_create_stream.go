@@ -112,14 +112,54 @@ func CanCreateStream(
 	streamId shared.StreamId,
 	parsedEvents []*events.ParsedEvent,
 	requestMetadata map[string][]byte,
+) (*CreateStreamRules, error) {
+	return canCreateStream(ctx, cfg, chainConfig, currentTime, streamId, parsedEvents, requestMetadata, nil)
+}
+
+// ExplainCreateStream evaluates the same rules as CanCreateStream and returns their results
+// together with the list of evaluated checks. Required memberships, users and entitlements are not checked.
+func ExplainCreateStream(
+	ctx context.Context,
+	cfg *config.Config,
+	chainConfig crypto.OnChainConfiguration,
+	currentTime time.Time,
+	streamId shared.StreamId,
+	parsedEvents []*events.ParsedEvent,
+	requestMetadata map[string][]byte,
+) *CreateStreamExplanation {
+	e := &CreateStreamExplanation{}
+	e.Rules, e.Err = canCreateStream(
+		ctx,
+		cfg,
+		chainConfig,
+		currentTime,
+		streamId,
+		parsedEvents,
+		requestMetadata,
+		e,
+	)
+	return e
+}
+
+func canCreateStream(
+	ctx context.Context,
+	cfg *config.Config,
+	chainConfig crypto.OnChainConfiguration,
+	currentTime time.Time,
+	streamId shared.StreamId,
+	parsedEvents []*events.ParsedEvent,
+	requestMetadata map[string][]byte,
+	explanation *CreateStreamExplanation,
 ) (*CreateStreamRules, error) {
 	if len(parsedEvents) == 0 {
-		return nil, RiverError(Err_BAD_STREAM_CREATION_PARAMS, "no events")
+		err := RiverError(Err_BAD_STREAM_CREATION_PARAMS, "no events")
+		explanation.record("hasEvents", err)
+		return nil, err
 	}
 
 	if parsedEvents[0].Event.DelegateExpiryEpochMs > 0 &&
 		isPastExpiry(currentTime, parsedEvents[0].Event.DelegateExpiryEpochMs) {
-		return nil, RiverError(
+		err := RiverError(
 			Err_PERMISSION_DENIED,
 			"event delegate has expired",
 			"currentTime",
@@ -127,35 +167,46 @@ func CanCreateStream(
 			"expiry",
 			parsedEvents[0].Event.DelegateExpiryEpochMs,
 		)
+		explanation.record("delegateNotExpired", err)
+		return nil, err
 	}
 
 	creatorAddress := parsedEvents[0].Event.GetCreatorAddress()
 	creatorUserId, err := shared.AddressHex(creatorAddress)
 	if err != nil {
+		explanation.record("validCreator", err)
 		return nil, err
 	}
 	creatorUserStreamId, err := shared.UserStreamIdFromBytes(creatorAddress)
 	if err != nil {
-		return nil, RiverError(Err_BAD_STREAM_CREATION_PARAMS, "invalid creator user stream id", "err", err)
+		err = RiverError(Err_BAD_STREAM_CREATION_PARAMS, "invalid creator user stream id", "err", err)
+		explanation.record("validCreator", err)
+		return nil, err
 	}
 
 	for _, event := range parsedEvents {
 		if event.Event.PrevMiniblockHash != nil {
-			return nil, RiverError(Err_BAD_STREAM_CREATION_PARAMS, "PrevMiniblockHash should be nil")
+			err = RiverError(Err_BAD_STREAM_CREATION_PARAMS, "PrevMiniblockHash should be nil")
+			explanation.record("noPrevMiniblockHash", err)
+			return nil, err
 		}
 		if !bytes.Equal(event.Event.CreatorAddress, creatorAddress) {
-			return nil, RiverError(Err_BAD_STREAM_CREATION_PARAMS, "all events should have the same creator address")
+			err = RiverError(Err_BAD_STREAM_CREATION_PARAMS, "all events should have the same creator address")
+			explanation.record("sameCreator", err)
+			return nil, err
 		}
 	}
 
 	inceptionEvent := parsedEvents[0]
 	inceptionPayload := inceptionEvent.Event.GetInceptionPayload()
 	if inceptionPayload == nil {
-		return nil, RiverError(Err_BAD_STREAM_CREATION_PARAMS, "first event is not an inception event")
+		err = RiverError(Err_BAD_STREAM_CREATION_PARAMS, "first event is not an inception event")
+		explanation.record("hasInception", err)
+		return nil, err
 	}
 
 	if !streamId.EqualsBytes(inceptionPayload.GetStreamId()) {
-		return nil, RiverError(
+		err = RiverError(
 			Err_BAD_STREAM_CREATION_PARAMS,
 			"stream id in request does not match stream id in inception event",
 			"inceptionStreamId",
@@ -163,6 +214,8 @@ func CanCreateStream(
 			"streamId",
 			streamId,
 		)
+		explanation.record("streamIdMatchesInception", err)
+		return nil, err
 	}
 
 	settings := chainConfig.Get()
@@ -183,7 +236,7 @@ func CanCreateStream(
 
 	builder := r.canCreateStream()
 	r.log().Debug("CanCreateStream", "builder", builder)
-	return builder.run()
+	return builder.run(explanation)
 }
 
 func (ru *csParams) log() *slog.Logger {
@@ -201,11 +254,9 @@ func (ru *csParams) canCreateStream() ruleBuilderCS {
 			inception: inception,
 		}
 		return builder.
-			check(
-				ru.params.streamIdTypeIsCorrect(shared.STREAM_SPACE_BIN),
-				ru.params.eventCountMatches(2),
-				ru.validateSpaceJoinEvent,
-			).
+			check("streamIdType", ru.params.streamIdTypeIsCorrect(shared.STREAM_SPACE_BIN)).
+			check("eventCount", ru.params.eventCountMatches(2)).
+			check("validSpaceJoinEvent", ru.validateSpaceJoinEvent).
 			requireChainAuth(ru.getCreateSpaceChainAuth).
 			requireDerivedEvent(ru.params.derivedMembershipEvent)
 
@@ -215,11 +266,9 @@ func (ru *csParams) canCreateStream() ruleBuilderCS {
 			inception: inception,
 		}
 		return builder.
-			check(
-				ru.params.streamIdTypeIsCorrect(shared.STREAM_CHANNEL_BIN),
-				ru.params.eventCountMatches(2),
-				ru.validateChannelJoinEvent,
-			).
+			check("streamIdType", ru.params.streamIdTypeIsCorrect(shared.STREAM_CHANNEL_BIN)).
+			check("eventCount", ru.params.eventCountMatches(2)).
+			check("validChannelJoinEvent", ru.validateChannelJoinEvent).
 			requireMembership(
 				inception.SpaceId,
 			).
@@ -235,11 +284,9 @@ func (ru *csParams) canCreateStream() ruleBuilderCS {
 			inception: inception,
 		}
 		return builder.
-			check(
-				ru.params.streamIdTypeIsCorrect(shared.STREAM_MEDIA_BIN),
-				ru.params.eventCountMatches(1),
-				ru.checkMediaInceptionPayload,
-			).
+			check("streamIdType", ru.params.streamIdTypeIsCorrect(shared.STREAM_MEDIA_BIN)).
+			check("eventCount", ru.params.eventCountMatches(1)).
+			check("validMediaInception", ru.checkMediaInceptionPayload).
 			requireMembership(
 				inception.ChannelId,
 				inception.SpaceId,
@@ -252,11 +299,9 @@ func (ru *csParams) canCreateStream() ruleBuilderCS {
 			inception: inception,
 		}
 		return builder.
-			check(
-				ru.params.streamIdTypeIsCorrect(shared.STREAM_DM_CHANNEL_BIN),
-				ru.params.eventCountMatches(3),
-				ru.checkDMInceptionPayload,
-			).
+			check("streamIdType", ru.params.streamIdTypeIsCorrect(shared.STREAM_DM_CHANNEL_BIN)).
+			check("eventCount", ru.params.eventCountMatches(3)).
+			check("validDmInception", ru.checkDMInceptionPayload).
 			requireUserAddr(ru.inception.SecondPartyAddress).
 			requireDerivedEvents(ru.derivedDMMembershipEvents)
 
@@ -266,11 +311,9 @@ func (ru *csParams) canCreateStream() ruleBuilderCS {
 			inception: inception,
 		}
 		return builder.
-			check(
-				ru.params.streamIdTypeIsCorrect(shared.STREAM_GDM_CHANNEL_BIN),
-				ru.params.eventCountGreaterThanOrEqualTo(4),
-				ru.checkGDMPayloads,
-			).
+			check("streamIdType", ru.params.streamIdTypeIsCorrect(shared.STREAM_GDM_CHANNEL_BIN)).
+			check("eventCount", ru.params.eventCountGreaterThanOrEqualTo(4)).
+			check("validGdmPayloads", ru.checkGDMPayloads).
 			requireUser(ru.getGDMUserIds()[1:]...).
 			requireDerivedEvents(ru.derivedGDMMembershipEvents)
 
@@ -280,11 +323,9 @@ func (ru *csParams) canCreateStream() ruleBuilderCS {
 			inception: inception,
 		}
 		return builder.
-			check(
-				ru.params.streamIdTypeIsCorrect(shared.STREAM_USER_BIN),
-				ru.params.eventCountMatches(1),
-				ru.params.isUserStreamId,
-			).
+			check("streamIdType", ru.params.streamIdTypeIsCorrect(shared.STREAM_USER_BIN)).
+			check("eventCount", ru.params.eventCountMatches(1)).
+			check("isUserStreamId", ru.params.isUserStreamId).
 			requireChainAuth(ru.params.getNewUserStreamChainAuth)
 
 	case *UserDeviceKeyPayload_Inception:
@@ -293,11 +334,9 @@ func (ru *csParams) canCreateStream() ruleBuilderCS {
 			inception: inception,
 		}
 		return builder.
-			check(
-				ru.params.streamIdTypeIsCorrect(shared.STREAM_USER_DEVICE_KEY_BIN),
-				ru.params.eventCountMatches(1),
-				ru.params.isUserStreamId,
-			).
+			check("streamIdType", ru.params.streamIdTypeIsCorrect(shared.STREAM_USER_DEVICE_KEY_BIN)).
+			check("eventCount", ru.params.eventCountMatches(1)).
+			check("isUserStreamId", ru.params.isUserStreamId).
 			requireChainAuth(ru.params.getNewUserStreamChainAuth)
 
 	case *UserSettingsPayload_Inception:
@@ -306,11 +345,9 @@ func (ru *csParams) canCreateStream() ruleBuilderCS {
 			inception: inception,
 		}
 		return builder.
-			check(
-				ru.params.streamIdTypeIsCorrect(shared.STREAM_USER_SETTINGS_BIN),
-				ru.params.eventCountMatches(1),
-				ru.params.isUserStreamId,
-			).
+			check("streamIdType", ru.params.streamIdTypeIsCorrect(shared.STREAM_USER_SETTINGS_BIN)).
+			check("eventCount", ru.params.eventCountMatches(1)).
+			check("isUserStreamId", ru.params.isUserStreamId).
 			requireChainAuth(ru.params.getNewUserStreamChainAuth)
 
 	case *UserInboxPayload_Inception:
@@ -319,11 +356,9 @@ func (ru *csParams) canCreateStream() ruleBuilderCS {
 			inception: inception,
 		}
 		return builder.
-			check(
-				ru.params.streamIdTypeIsCorrect(shared.STREAM_USER_INBOX_BIN),
-				ru.params.eventCountMatches(1),
-				ru.params.isUserStreamId,
-			).
+			check("streamIdType", ru.params.streamIdTypeIsCorrect(shared.STREAM_USER_INBOX_BIN)).
+			check("eventCount", ru.params.eventCountMatches(1)).
+			check("isUserStreamId", ru.params.isUserStreamId).
 			requireChainAuth(ru.params.getNewUserStreamChainAuth)
 
 	default:
@@ -15,24 +15,53 @@ type AddEventSideEffects struct {
 	OnChainAuthFailure *DerivedEvent
 }
 
+// RuleCheck is the result of a single check evaluated by the rules.
+type RuleCheck struct {
+	Name   string
+	Passed bool
+	Err    error
+}
+
+// AddEventExplanation contains results of CanAddEvent and the checks that were evaluated to get them
+// in the evaluation order. Checks of checkOneOf after the first passed check are not evaluated.
+type AddEventExplanation struct {
+	Checks        []*RuleCheck
+	CanAddEvent   bool
+	ChainAuthArgs []*auth.ChainAuthArgs
+	SideEffects   *AddEventSideEffects
+	Err           error
+}
+
+func (e *AddEventExplanation) record(name string, passed bool, err error) {
+	if e == nil {
+		return
+	}
+	e.Checks = append(e.Checks, &RuleCheck{Name: name, Passed: passed, Err: err})
+}
+
 type (
 	chainAuthFunc func() (*auth.ChainAuthArgs, error)
+	// namedCheck is a check with the name it's reported with in AddEventExplanation, e.g. "creatorIsMember".
+	namedCheck struct {
+		name string
+		f    func() (bool, error)
+	}
 	ruleBuilderAE interface {
-		check(f func() (bool, error)) ruleBuilderAE
-		checkOneOf(f ...func() (bool, error)) ruleBuilderAE
+		check(name string, f func() (bool, error)) ruleBuilderAE
+		checkOneOf(f ...namedCheck) ruleBuilderAE
 		requireChainAuth(f chainAuthFunc) ruleBuilderAE
 		requireOneOfChainAuths(f ...chainAuthFunc) ruleBuilderAE
 		requireParentEvent(f func() (*DerivedEvent, error)) ruleBuilderAE
 		onChainAuthFailure(f func() (*DerivedEvent, error)) ruleBuilderAE
 		fail(err error) ruleBuilderAE
-		run() (bool, []*auth.ChainAuthArgs, *AddEventSideEffects, error)
+		run(explanation *AddEventExplanation) (bool, []*auth.ChainAuthArgs, *AddEventSideEffects, error)
 	}
 )
 
 type (
 	ruleBuilderAEImpl struct {
 		failErr          error
-		checks           [][]func() (bool, error)
+		checks           [][]namedCheck
 		chainAuths       []chainAuthFunc
 		parentEvent      func() (*DerivedEvent, error)
 		chainAuthFailure func() (*DerivedEvent, error)
@@ -53,11 +82,11 @@ func aeBuilder() ruleBuilderAE {
 	}
 }
 
-func (re *ruleBuilderAEImpl) check(f func() (bool, error)) ruleBuilderAE {
-	return re.checkOneOf(f)
+func (re *ruleBuilderAEImpl) check(name string, f func() (bool, error)) ruleBuilderAE {
+	return re.checkOneOf(namedCheck{name, f})
 }
 
-func (re *ruleBuilderAEImpl) checkOneOf(f ...func() (bool, error)) ruleBuilderAE {
+func (re *ruleBuilderAEImpl) checkOneOf(f ...namedCheck) ruleBuilderAE {
 	re.checks = append(re.checks, f)
 	return re
 }
@@ -86,14 +115,17 @@ func (re *ruleBuilderAEImpl) fail(err error) ruleBuilderAE {
 	return re
 }
 
-func runChecksAE(checksList [][]func() (bool, error)) (bool, error) {
+func runChecksAE(checksList [][]namedCheck, explanation *AddEventExplanation) (bool, error) {
 	// outer loop is an and
 	for _, checks := range checksList {
 		// inner loop is an or
 		foundCanAdd := false
 		var errorMsgs []string
 		for _, check := range checks {
-			canAdd, err := check()
+			canAdd, err := check.f()
+			if explanation != nil {
+				explanation.record(check.name, canAdd && err == nil, err)
+			}
 			if err != nil {
 				errorMsgs = append(errorMsgs, err.Error())
 			} else if canAdd {
@@ -114,12 +146,15 @@ func runChecksAE(checksList [][]func() (bool, error)) (bool, error) {
 	return true, nil
 }
 
-func (re *ruleBuilderAEImpl) run() (bool, []*auth.ChainAuthArgs, *AddEventSideEffects, error) {
+func (re *ruleBuilderAEImpl) run(
+	explanation *AddEventExplanation,
+) (bool, []*auth.ChainAuthArgs, *AddEventSideEffects, error) {
 	if re.failErr != nil {
+		explanation.record("fail", false, re.failErr)
 		return false, nil, nil, re.failErr
 	}
 
-	canAdd, err := runChecksAE(re.checks)
+	canAdd, err := runChecksAE(re.checks, explanation)
 	if err != nil || !canAdd {
 		return false, nil, nil, err
 	}
@@ -16,9 +16,30 @@ type CreateStreamRules struct {
 	DerivedEvents       []*DerivedEvent
 }
 
+// CreateStreamExplanation contains results of CanCreateStream and the checks that were evaluated to get them
+// in the evaluation order.
+type CreateStreamExplanation struct {
+	Checks []*RuleCheck
+	Rules  *CreateStreamRules
+	Err    error
+}
+
+func (e *CreateStreamExplanation) record(name string, err error) {
+	if e == nil {
+		return
+	}
+	e.Checks = append(e.Checks, &RuleCheck{Name: name, Passed: err == nil, Err: err})
+}
+
+// namedCSCheck is a check with the name it's reported with in CreateStreamExplanation, e.g. "eventCount".
+type namedCSCheck struct {
+	name string
+	f    func() error
+}
+
 type ruleBuilderCS interface {
-	check(fn ...func() error) ruleBuilderCS
-	checkOneOf(fns ...func() error) ruleBuilderCS
+	check(name string, f func() error) ruleBuilderCS
+	checkOneOf(f ...namedCSCheck) ruleBuilderCS
 	requireUser(userIds ...string) ruleBuilderCS
 	requireUserAddr(userAddresses ...[]byte) ruleBuilderCS
 	requireMembership(streamIds ...[]byte) ruleBuilderCS
@@ -26,7 +47,7 @@ type ruleBuilderCS interface {
 	requireDerivedEvent(f ...func() (*DerivedEvent, error)) ruleBuilderCS
 	requireDerivedEvents(f func() ([]*DerivedEvent, error)) ruleBuilderCS
 	fail(err error) ruleBuilderCS
-	run() (*CreateStreamRules, error)
+	run(explanation *CreateStreamExplanation) (*CreateStreamRules, error)
 }
 
 type ruleBuilderCSImpl struct {
@@ -35,7 +56,7 @@ type ruleBuilderCSImpl struct {
 	requiredUsers       []string
 	requiredUserAddrs   [][]byte
 	requiredMemberships [][]byte
-	checks              [][]func() error
+	checks              [][]namedCSCheck
 	chainAuth           func() (*auth.ChainAuthArgs, error)
 	derivedEvents       []func() (*DerivedEvent, error)
 	derivedEventSlices  []func() ([]*DerivedEvent, error)
@@ -56,15 +77,12 @@ func csBuilder(creatorStreamId shared.StreamId) ruleBuilderCS {
 	}
 }
 
-func (re *ruleBuilderCSImpl) check(fns ...func() error) ruleBuilderCS {
-	for _, fn := range fns {
-		re.checkOneOf(fn)
-	}
-	return re
+func (re *ruleBuilderCSImpl) check(name string, f func() error) ruleBuilderCS {
+	return re.checkOneOf(namedCSCheck{name, f})
 }
 
-func (re *ruleBuilderCSImpl) checkOneOf(fns ...func() error) ruleBuilderCS {
-	re.checks = append(re.checks, fns)
+func (re *ruleBuilderCSImpl) checkOneOf(f ...namedCSCheck) ruleBuilderCS {
+	re.checks = append(re.checks, f)
 	return re
 }
 
@@ -107,13 +125,14 @@ func (re *ruleBuilderCSImpl) fail(err error) ruleBuilderCS {
 	return re
 }
 
-func runChecksCS(checksList [][]func() error) error {
+func runChecksCS(checksList [][]namedCSCheck, explanation *CreateStreamExplanation) error {
 	// outer loop is an and
-	for _, errFns := range checksList {
+	for _, checks := range checksList {
 		// inner loop is an or
 		var errorMsgs []string
-		for _, fn := range errFns {
-			err := fn()
+		for _, check := range checks {
+			err := check.f()
+			explanation.record(check.name, err)
 			if err != nil {
 				errorMsgs = append(errorMsgs, err.Error())
 			}
@@ -150,12 +169,13 @@ func runDerivedEvents(
 	return derivedEvents, nil
 }
 
-func (re *ruleBuilderCSImpl) run() (*CreateStreamRules, error) {
+func (re *ruleBuilderCSImpl) run(explanation *CreateStreamExplanation) (*CreateStreamRules, error) {
 	if re.failErr != nil {
+		explanation.record("fail", re.failErr)
 		return nil, re.failErr
 	}
 
-	err := runChecksCS(re.checks)
+	err := runChecksCS(re.checks, explanation)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+const (
+	ColdStorageTypeFilesystem = "filesystem"
+	ColdStorageTypeS3         = "s3"
+)
+
+// ColdStorage is a content-addressed blob store for historical miniblocks that are rarely read.
+// Objects are immutable: key is the hex encoded sha256 hash of the data.
+type ColdStorage interface {
+	// Put stores data under the given key. Storing the same key again is a no-op.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get returns data stored under the given key or NOT_FOUND error.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Delete removes data stored under the given key. Deleting a missing key is a no-op.
+	Delete(ctx context.Context, key string) error
+}
+
+// ColdStorageKey returns content address of the data.
+func ColdStorageKey(data []byte) string {
+	return sha256Hex(data)
+}
+
+// coldStorageObjectPath splits objects into subdirectories by the first byte of the key
+// to keep directories and bucket listings small.
+func coldStorageObjectPath(key string) string {
+	return key[:2] + "/" + key
+}
+
+func NewColdStorage(cfg *config.ColdStorageConfig) (ColdStorage, error) {
+	switch cfg.Type {
+	case ColdStorageTypeFilesystem:
+		return NewFilesystemColdStorage(cfg.Path)
+	case ColdStorageTypeS3:
+		return NewS3ColdStorage(&cfg.S3)
+	default:
+		return nil, RiverError(Err_BAD_CONFIG, "Unknown cold storage type", "type", cfg.Type).
+			Func("NewColdStorage")
+	}
+}
+
+// readColdMiniblock fetches miniblock from the cold storage and checks that it matches the key.
+func readColdMiniblock(ctx context.Context, cold ColdStorage, key string) ([]byte, error) {
+	if cold == nil {
+		return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblock is in cold storage, but cold storage is not configured").
+			Tag("key", key)
+	}
+	data, err := cold.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if ColdStorageKey(data) != key {
+		return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Cold storage object doesn't match its key").
+			Tag("key", key)
+	}
+	return data, nil
+}
+
+// FilesystemColdStorage keeps objects as files in a local directory.
+type FilesystemColdStorage struct {
+	path string
+}
+
+var _ ColdStorage = (*FilesystemColdStorage)(nil)
+
+func NewFilesystemColdStorage(path string) (*FilesystemColdStorage, error) {
+	if path == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "Cold storage path is not set").Func("NewFilesystemColdStorage")
+	}
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return nil, AsRiverError(err, Err_BAD_CONFIG).
+			Message("Failed to create cold storage directory").
+			Tag("path", path).
+			Func("NewFilesystemColdStorage")
+	}
+	return &FilesystemColdStorage{path: path}, nil
+}
+
+func (s *FilesystemColdStorage) Put(ctx context.Context, key string, data []byte) error {
+	path := filepath.Join(s.path, filepath.FromSlash(coldStorageObjectPath(key)))
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return AsRiverError(err, Err_MINIBLOCKS_STORAGE_FAILURE).Func("FilesystemColdStorage.Put")
+	}
+
+	// Write to the temporary file first, so partially written objects are never visible.
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".tmp*")
+	if err != nil {
+		return AsRiverError(err, Err_MINIBLOCKS_STORAGE_FAILURE).Func("FilesystemColdStorage.Put")
+	}
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return AsRiverError(err, Err_MINIBLOCKS_STORAGE_FAILURE).Func("FilesystemColdStorage.Put").Tag("key", key)
+	}
+	return nil
+}
+
+func (s *FilesystemColdStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(s.path, filepath.FromSlash(coldStorageObjectPath(key))))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, RiverError(Err_NOT_FOUND, "Cold storage object not found", "key", key).
+				Func("FilesystemColdStorage.Get")
+		}
+		return nil, AsRiverError(err, Err_MINIBLOCKS_STORAGE_FAILURE).Func("FilesystemColdStorage.Get").Tag("key", key)
+	}
+	return data, nil
+}
+
+func (s *FilesystemColdStorage) Delete(ctx context.Context, key string) error {
+	err := os.Remove(filepath.Join(s.path, filepath.FromSlash(coldStorageObjectPath(key))))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return AsRiverError(err, Err_MINIBLOCKS_STORAGE_FAILURE).Func("FilesystemColdStorage.Delete").Tag("key", key)
+	}
+	return nil
+}
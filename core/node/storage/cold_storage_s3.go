@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+// S3ColdStorage keeps objects in a bucket of S3-compatible object storage.
+// Requests are signed with AWS Signature Version 4 and use path-style addressing,
+// so it works with MinIO and other self-hosted implementations.
+type S3ColdStorage struct {
+	cfg      *config.S3Config
+	endpoint *url.URL
+	client   *http.Client
+}
+
+var _ ColdStorage = (*S3ColdStorage)(nil)
+
+func NewS3ColdStorage(cfg *config.S3Config) (*S3ColdStorage, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "Invalid S3 endpoint", "endpoint", cfg.Endpoint).
+			Func("NewS3ColdStorage")
+	}
+	if cfg.Bucket == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "S3 bucket is not set").Func("NewS3ColdStorage")
+	}
+	return &S3ColdStorage{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: time.Minute},
+	}, nil
+}
+
+func (s *S3ColdStorage) objectUrl(key string) *url.URL {
+	path := strings.TrimSuffix(s.endpoint.Path, "/") + "/" + s.cfg.Bucket + "/"
+	if s.cfg.Prefix != "" {
+		path += strings.Trim(s.cfg.Prefix, "/") + "/"
+	}
+	u := *s.endpoint
+	u.Path = path + coldStorageObjectPath(key)
+	return &u
+}
+
+func (s *S3ColdStorage) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s.responseError(resp, "S3ColdStorage.Put", key)
+	}
+	return nil
+}
+
+func (s *S3ColdStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, RiverError(Err_NOT_FOUND, "Cold storage object not found", "key", key).Func("S3ColdStorage.Get")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, s.responseError(resp, "S3ColdStorage.Get", key)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, AsRiverError(err, Err_DOWNSTREAM_NETWORK_ERROR).Func("S3ColdStorage.Get").Tag("key", key)
+	}
+	return data, nil
+}
+
+func (s *S3ColdStorage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// S3 returns 204 for missing keys as well, 404 is accepted for other S3-compatible stores.
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusNotFound {
+		return s.responseError(resp, "S3ColdStorage.Delete", key)
+	}
+	return nil
+}
+
+func (s *S3ColdStorage) responseError(resp *http.Response, funcName string, key string) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return RiverError(Err_DOWNSTREAM_NETWORK_ERROR, "S3 request failed").
+		Func(funcName).
+		Tag("key", key).
+		Tag("status", resp.StatusCode).
+		Tag("body", string(body))
+}
+
+func (s *S3ColdStorage) do(ctx context.Context, method string, key string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectUrl(key).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, AsRiverError(err, Err_INTERNAL).Func("S3ColdStorage.do")
+	}
+	req.ContentLength = int64(len(body))
+	s.sign(req, body, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, AsRiverError(err, Err_DOWNSTREAM_NETWORK_ERROR).Func("S3ColdStorage.do").Tag("key", key)
+	}
+	return resp, nil
+}
+
+// sign adds AWS Signature Version 4 headers to the request.
+func (s *S3ColdStorage) sign(req *http.Request, body []byte, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	req.Header.Set("x-amz-date", amzDate)
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		fmt.Sprintf("host:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n", req.URL.Host, payloadHash, amzDate),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.cfg.GetRegion() + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSha256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	signingKey = hmacSha256(signingKey, s.cfg.GetRegion())
+	signingKey = hmacSha256(signingKey, "s3")
+	signingKey = hmacSha256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSha256(signingKey, stringToSign))
+
+	req.Header.Set(
+		"Authorization",
+		fmt.Sprintf(
+			"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+			s.cfg.AccessKeyId,
+			scope,
+			signedHeaders,
+			signature,
+		),
+	)
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSha256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/base/test"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+// fakeS3 is a minimal in-memory S3 bucket that checks request signatures are present.
+type fakeS3 struct {
+	t       *testing.T
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") ||
+		r.Header.Get("x-amz-date") == "" ||
+		r.Header.Get("x-amz-content-sha256") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		require.NoError(f.t, err)
+		require.Equal(f.t, sha256Hex(data), r.Header.Get("x-amz-content-sha256"))
+		f.objects[r.URL.Path] = data
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func testColdStorage(t *testing.T, ctx context.Context, cold ColdStorage) {
+	require := require.New(t)
+
+	data := []byte("miniblock")
+	key := ColdStorageKey(data)
+
+	_, err := cold.Get(ctx, key)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	require.NoError(cold.Put(ctx, key, data))
+	require.NoError(cold.Put(ctx, key, data))
+
+	stored, err := readColdMiniblock(ctx, cold, key)
+	require.NoError(err)
+	require.Equal(data, stored)
+
+	otherKey := ColdStorageKey([]byte("other"))
+	require.NoError(cold.Put(ctx, otherKey, data))
+	_, err = readColdMiniblock(ctx, cold, otherKey)
+	require.Equal(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+
+	require.NoError(cold.Delete(ctx, otherKey))
+	require.NoError(cold.Delete(ctx, otherKey))
+	_, err = cold.Get(ctx, otherKey)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+	_, err = cold.Get(ctx, key)
+	require.NoError(err)
+}
+
+func TestFilesystemColdStorage(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	cold, err := NewColdStorage(&config.ColdStorageConfig{Type: ColdStorageTypeFilesystem, Path: t.TempDir()})
+	require.NoError(t, err)
+	testColdStorage(t, ctx, cold)
+}
+
+func TestS3ColdStorage(t *testing.T) {
+	ctx, cancel := test.NewTestContext()
+	defer cancel()
+
+	bucket := &fakeS3{t: t, objects: make(map[string][]byte)}
+	srv := httptest.NewServer(bucket)
+	defer srv.Close()
+
+	cold, err := NewColdStorage(&config.ColdStorageConfig{
+		Type: ColdStorageTypeS3,
+		S3: config.S3Config{
+			Endpoint:        srv.URL,
+			Bucket:          "bucket",
+			Prefix:          "node1",
+			AccessKeyId:     "access",
+			SecretAccessKey: "secret",
+		},
+	})
+	require.NoError(t, err)
+	testColdStorage(t, ctx, cold)
+
+	key := ColdStorageKey([]byte("miniblock"))
+	require.Contains(t, bucket.objects, "/bucket/node1/"+key[:2]+"/"+key)
+	require.Len(t, bucket.objects, 1)
+
+	_, err = NewColdStorage(&config.ColdStorageConfig{Type: ColdStorageTypeS3, S3: config.S3Config{Endpoint: srv.URL}})
+	require.Equal(t, Err_BAD_CONFIG, AsRiverError(err).Code)
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"github.com/klauspost/compress/zstd"
+	"github.com/prometheus/client_golang/prometheus"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+const (
+	// compressedDataMarker is the first byte of compressed data.
+	// Serialized protobuf messages never start with zero byte since field number 0 is invalid,
+	// so data without the marker is stored uncompressed.
+	compressedDataMarker byte = 0
+	// compressionFormatZstd follows the marker if data is compressed with zstd.
+	compressionFormatZstd byte = 1
+)
+
+// dataCodec compresses miniblocks and envelopes before they are written to the database
+// and decompresses them on read. Stored data is self-describing, so compressed and uncompressed rows
+// can be mixed and are always readable regardless of whether compression is enabled.
+type dataCodec struct {
+	// encoder is nil if compression is disabled.
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+
+	uncompressedBytes prometheus.Counter
+	compressedBytes   prometheus.Counter
+}
+
+func newDataCodec(metrics infra.MetricsFactory) *dataCodec {
+	// Decoder without concurrency limit is only used with DecodeAll, which is safe for concurrent use.
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	if err != nil {
+		panic(err)
+	}
+	return &dataCodec{
+		decoder: decoder,
+		uncompressedBytes: metrics.NewCounterEx(
+			"compression_uncompressed_bytes",
+			"Size of compressed data before compression, compression ratio is compressed_bytes / uncompressed_bytes",
+		),
+		compressedBytes: metrics.NewCounterEx(
+			"compression_compressed_bytes",
+			"Size of compressed data after compression, compression ratio is compressed_bytes / uncompressed_bytes",
+		),
+	}
+}
+
+// enable turns on compression of written data with the given zstd level (1 fastest - 4 best).
+func (c *dataCodec) enable(level int) error {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	if err != nil {
+		return AsRiverError(err, Err_BAD_CONFIG).Message("Failed to create zstd encoder").Func("dataCodec.enable")
+	}
+	c.encoder = encoder
+	return nil
+}
+
+func (c *dataCodec) enabled() bool {
+	return c.encoder != nil
+}
+
+// encode compresses data if compression is enabled, otherwise returns data unchanged.
+func (c *dataCodec) encode(data []byte) []byte {
+	if c.encoder == nil || data == nil {
+		return data
+	}
+	out := make([]byte, 2, len(data)/2+16)
+	out[0] = compressedDataMarker
+	out[1] = compressionFormatZstd
+	out = c.encoder.EncodeAll(data, out)
+	c.uncompressedBytes.Add(float64(len(data)))
+	c.compressedBytes.Add(float64(len(out)))
+	return out
+}
+
+// decode returns uncompressed data.
+func (c *dataCodec) decode(data []byte) ([]byte, error) {
+	if !isCompressedData(data) {
+		return data, nil
+	}
+	if data[1] != compressionFormatZstd {
+		return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Unknown compression format").
+			Tag("format", data[1]).
+			Func("dataCodec.decode")
+	}
+	out, err := c.decoder.DecodeAll(data[2:], nil)
+	if err != nil {
+		return nil, AsRiverError(err, Err_MINIBLOCKS_STORAGE_FAILURE).
+			Message("Failed to decompress data").
+			Func("dataCodec.decode")
+	}
+	return out, nil
+}
+
+func isCompressedData(data []byte) bool {
+	return len(data) >= 2 && data[0] == compressedDataMarker
+}
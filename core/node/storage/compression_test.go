@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+func TestDataCodec(t *testing.T) {
+	require := require.New(t)
+
+	codec := newDataCodec(infra.NewMetricsFactory(nil, "", ""))
+	data := bytes.Repeat([]byte("miniblock"), 100)
+
+	// Data is passed through unchanged if compression is disabled.
+	require.Equal(data, codec.encode(data))
+	decoded, err := codec.decode(data)
+	require.NoError(err)
+	require.Equal(data, decoded)
+
+	require.NoError(codec.enable(3))
+	compressed := codec.encode(data)
+	require.True(isCompressedData(compressed))
+	require.Less(len(compressed), len(data))
+	require.Nil(codec.encode(nil))
+
+	decoded, err = codec.decode(compressed)
+	require.NoError(err)
+	require.Equal(data, decoded)
+
+	// Uncompressed data written before compression was enabled is still readable.
+	decoded, err = codec.decode(data)
+	require.NoError(err)
+	require.Equal(data, decoded)
+
+	unknownFormat := append([]byte{compressedDataMarker, 0xff}, compressed[2:]...)
+	_, err = codec.decode(unknownFormat)
+	require.Equal(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+
+	corrupted := append([]byte{}, compressed[:len(compressed)/2]...)
+	_, err = codec.decode(corrupted)
+	require.Equal(Err_MINIBLOCKS_STORAGE_FAILURE, AsRiverError(err).Code)
+}
@@ -0,0 +1,1397 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/river-build/river/core/config"
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// Key prefixes of the embedded store.
+// Each stream key starts with prefix followed by the stream id, except for the event index and sync session keys.
+const (
+	// embeddedStreamPrefix key stores latest snapshot miniblock number of the stream.
+	embeddedStreamPrefix byte = 'e'
+	// embeddedMiniblockPrefix key is followed by the miniblock number.
+	embeddedMiniblockPrefix byte = 'm'
+	// embeddedMinipoolPrefix key is followed by slot number + 1, so service record with slot -1 comes first.
+	// Value is generation followed by the envelope.
+	embeddedMinipoolPrefix byte = 'p'
+	// embeddedCandidatePrefix key is followed by the miniblock number and the miniblock hash.
+	embeddedCandidatePrefix byte = 'c'
+	// embeddedEventIndexPrefix key is followed by the event hash.
+	// Value is the stream id followed by the miniblock number and the index of the event in the miniblock.
+	embeddedEventIndexPrefix byte = 'h'
+	// embeddedSyncSessionPrefix key is followed by the sync id.
+	// Value is the expiration time in unix milliseconds followed by the session.
+	embeddedSyncSessionPrefix byte = 's'
+)
+
+// EmbeddedEventStore is a StreamStorage implementation backed by an embedded key-value store
+// in a local directory. It doesn't require external database and is intended for development,
+// single node setups and archive nodes.
+//
+// Write transactions are serialized, read transactions run on consistent snapshots.
+type EmbeddedEventStore struct {
+	db   *pebble.DB
+	path string
+
+	// writeLock serializes write transactions.
+	writeLock sync.Mutex
+
+	txCounter  *infra.StatusCounterVec
+	txDuration *prometheus.HistogramVec
+}
+
+var _ StreamStorage = (*EmbeddedEventStore)(nil)
+
+// embeddedTx is a transaction of the embedded store.
+// For read-only transactions batch is nil and reads are served from the snapshot.
+type embeddedTx struct {
+	reader pebble.Reader
+	batch  *pebble.Batch
+}
+
+// NewEmbeddedEventStore opens or creates the embedded store in the configured directory.
+// Only a single process can open the store at a time.
+func NewEmbeddedEventStore(
+	ctx context.Context,
+	cfg *config.EmbeddedStorageConfig,
+	metrics infra.MetricsFactory,
+) (*EmbeddedEventStore, error) {
+	if cfg.Path == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "Embedded storage path is not set").Func("NewEmbeddedEventStore")
+	}
+
+	db, err := pebble.Open(cfg.Path, &pebble.Options{Logger: &embeddedLogger{log: dlog.FromCtx(ctx)}})
+	if err != nil {
+		return nil, AsRiverError(err, Err_DB_OPERATION_FAILURE).
+			Func("NewEmbeddedEventStore").
+			Message("Failed to open embedded storage").
+			Tag("path", cfg.Path)
+	}
+
+	dlog.FromCtx(ctx).Info("Opened embedded storage", "path", cfg.Path)
+
+	return &EmbeddedEventStore{
+		db:        db,
+		path:      cfg.Path,
+		txCounter: metrics.NewStatusCounterVecEx("embedded_dbtx_status", "Embedded storage transaction status", "name"),
+		txDuration: metrics.NewHistogramVecEx(
+			"embedded_dbtx_duration_seconds",
+			"Embedded storage transaction duration",
+			infra.DefaultDurationBucketsSeconds,
+			"name",
+		),
+	}, nil
+}
+
+// embeddedLogger forwards storage engine messages to the node log.
+type embeddedLogger struct {
+	log *slog.Logger
+}
+
+func (l *embeddedLogger) Infof(format string, args ...interface{}) {
+	l.log.Debug("embedded storage: " + fmt.Sprintf(format, args...))
+}
+
+func (l *embeddedLogger) Fatalf(format string, args ...interface{}) {
+	l.log.Error("embedded storage: " + fmt.Sprintf(format, args...))
+	os.Exit(1)
+}
+
+// Close flushes and closes the embedded store.
+func (s *EmbeddedEventStore) Close(ctx context.Context) {
+	if err := s.db.Close(); err != nil {
+		dlog.FromCtx(ctx).Error("Failed to close embedded storage", "path", s.path, "err", err)
+	}
+}
+
+func (s *EmbeddedEventStore) txRunner(
+	ctx context.Context,
+	name string,
+	readWrite bool,
+	txFn func(context.Context, *embeddedTx) error,
+	opts *txRunnerOpts,
+	tags ...any,
+) error {
+	defer prometheus.NewTimer(s.txDuration.WithLabelValues(name)).ObserveDuration()
+
+	var err error
+	if readWrite {
+		err = s.runWriteTx(ctx, txFn)
+	} else {
+		snapshot := s.db.NewSnapshot()
+		err = txFn(ctx, &embeddedTx{reader: snapshot})
+		_ = snapshot.Close()
+	}
+
+	if err != nil {
+		pass := false
+		level := slog.LevelWarn
+		if opts != nil && opts.skipLoggingNotFound && AsRiverError(err).Code == Err_NOT_FOUND {
+			// Count "not found" as succeess if error is potentially expected
+			pass = true
+			level = slog.LevelDebug
+		}
+		dlog.FromCtx(ctx).Log(
+			ctx, level, "embedded.txRunner: transaction failed", append(tags, "name", name, "err", err)...)
+
+		if pass {
+			s.txCounter.IncPass(name)
+		} else {
+			s.txCounter.IncFail(name)
+		}
+
+		return WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Func("embedded.txRunner").
+			Message("transaction failed").
+			Tag("name", name).
+			Tags(tags...)
+	}
+
+	s.txCounter.IncPass(name)
+	return nil
+}
+
+func (s *EmbeddedEventStore) runWriteTx(ctx context.Context, txFn func(context.Context, *embeddedTx) error) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	batch := s.db.NewIndexedBatch()
+	defer batch.Close()
+
+	if err := txFn(ctx, &embeddedTx{reader: batch, batch: batch}); err != nil {
+		return err
+	}
+	return batch.Commit(pebble.Sync)
+}
+
+func embeddedStreamKey(prefix byte, streamId StreamId, suffixLen int) []byte {
+	key := make([]byte, 0, 1+STREAM_ID_BYTES_LENGTH+suffixLen)
+	key = append(key, prefix)
+	return append(key, streamId[:]...)
+}
+
+func embeddedNumKey(prefix byte, streamId StreamId, num int64) []byte {
+	return binary.BigEndian.AppendUint64(embeddedStreamKey(prefix, streamId, 8), uint64(num))
+}
+
+func embeddedMinipoolKey(streamId StreamId, slot int64) []byte {
+	return embeddedNumKey(embeddedMinipoolPrefix, streamId, slot+1)
+}
+
+func embeddedCandidateKey(streamId StreamId, num int64, hash common.Hash) []byte {
+	return append(embeddedNumKey(embeddedCandidatePrefix, streamId, num), hash[:]...)
+}
+
+func embeddedEventIndexKey(eventHash common.Hash) []byte {
+	return append([]byte{embeddedEventIndexPrefix}, eventHash[:]...)
+}
+
+func embeddedSyncSessionKey(syncId string) []byte {
+	return append([]byte{embeddedSyncSessionPrefix}, syncId...)
+}
+
+// embeddedPrefixEnd returns the smallest key that is greater than all keys with the given prefix.
+func embeddedPrefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+func embeddedDecodeNum(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[len(key)-8:]))
+}
+
+func (tx *embeddedTx) get(key []byte) ([]byte, bool, error) {
+	value, closer, err := tx.reader.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	defer closer.Close()
+	return bytes.Clone(value), true, nil
+}
+
+func (tx *embeddedTx) set(key []byte, value []byte) error {
+	return tx.batch.Set(key, value, nil)
+}
+
+func (tx *embeddedTx) delete(key []byte) error {
+	return tx.batch.Delete(key, nil)
+}
+
+func (tx *embeddedTx) deleteRange(start []byte, end []byte) error {
+	return tx.batch.DeleteRange(start, end, nil)
+}
+
+// iterate calls f for each key in [start, end) in the key order. Key and value are only valid during the call.
+func (tx *embeddedTx) iterate(start []byte, end []byte, f func(key []byte, value []byte) error) error {
+	iter, err := tx.reader.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	if err != nil {
+		return err
+	}
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := f(iter.Key(), iter.Value()); err != nil {
+			_ = iter.Close()
+			return err
+		}
+	}
+	return iter.Close()
+}
+
+// iterateStream calls f for each key of the stream with the given prefix.
+func (tx *embeddedTx) iterateStream(
+	prefix byte,
+	streamId StreamId,
+	f func(key []byte, value []byte) error,
+) error {
+	start := embeddedStreamKey(prefix, streamId, 0)
+	return tx.iterate(start, embeddedPrefixEnd(start), f)
+}
+
+func (tx *embeddedTx) deleteStreamPrefix(prefix byte, streamId StreamId) error {
+	start := embeddedStreamKey(prefix, streamId, 0)
+	return tx.deleteRange(start, embeddedPrefixEnd(start))
+}
+
+func encodeEmbeddedInt64(v int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(v))
+}
+
+func decodeEmbeddedInt64(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func encodeEmbeddedMinipoolRecord(generation int64, envelope []byte) []byte {
+	return append(encodeEmbeddedInt64(generation), envelope...)
+}
+
+func decodeEmbeddedMinipoolRecord(value []byte) (int64, []byte) {
+	return decodeEmbeddedInt64(value[:8]), bytes.Clone(value[8:])
+}
+
+// getLatestSnapshot returns latest snapshot miniblock number of the stream and false if the stream doesn't exist.
+// setMiniblock stores the miniblock and adds its events to the event index.
+func (tx *embeddedTx) setMiniblock(streamId StreamId, num int64, miniblock []byte) error {
+	if err := tx.set(embeddedNumKey(embeddedMiniblockPrefix, streamId, num), miniblock); err != nil {
+		return err
+	}
+	for i, hash := range miniblockEventHashes(miniblock) {
+		value := make([]byte, 0, STREAM_ID_BYTES_LENGTH+12)
+		value = append(value, streamId[:]...)
+		value = binary.BigEndian.AppendUint64(value, uint64(num))
+		value = binary.BigEndian.AppendUint32(value, uint32(i))
+		if err := tx.set(embeddedEventIndexKey(hash), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unindexMiniblocks removes events of the miniblocks with keys in [start, end) from the event index.
+func (tx *embeddedTx) unindexMiniblocks(start []byte, end []byte) error {
+	return tx.iterate(start, end, func(_ []byte, value []byte) error {
+		for _, hash := range miniblockEventHashes(value) {
+			if err := tx.delete(embeddedEventIndexKey(hash)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (tx *embeddedTx) getLatestSnapshot(streamId StreamId) (int64, bool, error) {
+	value, ok, err := tx.get(embeddedStreamKey(embeddedStreamPrefix, streamId, 0))
+	if err != nil || !ok {
+		return -1, ok, err
+	}
+	return decodeEmbeddedInt64(value), true, nil
+}
+
+func (tx *embeddedTx) setLatestSnapshot(streamId StreamId, num int64) error {
+	return tx.set(embeddedStreamKey(embeddedStreamPrefix, streamId, 0), encodeEmbeddedInt64(num))
+}
+
+// getMaxMiniblockNumber returns the last miniblock number of the stream and false if there are no miniblocks.
+func (tx *embeddedTx) getMaxMiniblockNumber(streamId StreamId) (int64, bool, error) {
+	start := embeddedStreamKey(embeddedMiniblockPrefix, streamId, 0)
+	iter, err := tx.reader.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: embeddedPrefixEnd(start)})
+	if err != nil {
+		return -1, false, err
+	}
+	defer iter.Close()
+	if !iter.Last() {
+		return -1, false, iter.Error()
+	}
+	return embeddedDecodeNum(iter.Key()), true, nil
+}
+
+// getMinMiniblockNumber returns the first miniblock number of the stream and false if there are no miniblocks.
+func (tx *embeddedTx) getMinMiniblockNumber(streamId StreamId) (int64, bool, error) {
+	start := embeddedStreamKey(embeddedMiniblockPrefix, streamId, 0)
+	iter, err := tx.reader.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: embeddedPrefixEnd(start)})
+	if err != nil {
+		return -1, false, err
+	}
+	defer iter.Close()
+	if !iter.First() {
+		return -1, false, iter.Error()
+	}
+	return embeddedDecodeNum(iter.Key()), true, nil
+}
+
+// getMinipoolGeneration returns generation of the minipool service record and false if it doesn't exist.
+func (tx *embeddedTx) getMinipoolGeneration(streamId StreamId) (int64, bool, error) {
+	value, ok, err := tx.get(embeddedMinipoolKey(streamId, -1))
+	if err != nil || !ok {
+		return -1, ok, err
+	}
+	generation, _ := decodeEmbeddedMinipoolRecord(value)
+	return generation, true, nil
+}
+
+// replaceMinipool deletes all events in the minipool and stores envelopes at the given generation.
+func (tx *embeddedTx) replaceMinipool(streamId StreamId, generation int64, envelopes [][]byte) error {
+	if err := tx.deleteStreamPrefix(embeddedMinipoolPrefix, streamId); err != nil {
+		return err
+	}
+	if err := tx.set(embeddedMinipoolKey(streamId, -1), encodeEmbeddedMinipoolRecord(generation, nil)); err != nil {
+		return err
+	}
+	for i, envelope := range envelopes {
+		err := tx.set(embeddedMinipoolKey(streamId, int64(i)), encodeEmbeddedMinipoolRecord(generation, envelope))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *EmbeddedEventStore) CreateStreamStorage(
+	ctx context.Context,
+	streamId StreamId,
+	genesisMiniblock []byte,
+) error {
+	return s.txRunner(
+		ctx,
+		"CreateStreamStorage",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			return s.createStreamStorageTx(ctx, tx, streamId, 0, genesisMiniblock)
+		},
+		nil,
+		"streamId", streamId,
+	)
+}
+
+func (s *EmbeddedEventStore) CreateStreamStorageFromSnapshot(
+	ctx context.Context,
+	streamId StreamId,
+	snapshotMiniblockNum int64,
+	snapshotMiniblock []byte,
+) error {
+	return s.txRunner(
+		ctx,
+		"CreateStreamStorageFromSnapshot",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			return s.createStreamStorageTx(ctx, tx, streamId, snapshotMiniblockNum, snapshotMiniblock)
+		},
+		nil,
+		"streamId", streamId,
+		"snapshotMiniblockNum", snapshotMiniblockNum,
+	)
+}
+
+// createStreamStorageTx creates a stream that starts with the given miniblock,
+// which is either the genesis miniblock or a snapshot miniblock.
+func (s *EmbeddedEventStore) createStreamStorageTx(
+	ctx context.Context,
+	tx *embeddedTx,
+	streamId StreamId,
+	miniblockNum int64,
+	miniblock []byte,
+) error {
+	if _, exists, err := tx.getLatestSnapshot(streamId); err != nil {
+		return err
+	} else if exists {
+		return RiverError(Err_ALREADY_EXISTS, "stream already exists")
+	}
+
+	if err := tx.setLatestSnapshot(streamId, miniblockNum); err != nil {
+		return err
+	}
+	if err := tx.setMiniblock(streamId, miniblockNum, miniblock); err != nil {
+		return err
+	}
+	return tx.replaceMinipool(streamId, miniblockNum+1, nil)
+}
+
+func (s *EmbeddedEventStore) CreateStreamArchiveStorage(
+	ctx context.Context,
+	streamId StreamId,
+) error {
+	return s.txRunner(
+		ctx,
+		"CreateStreamArchiveStorage",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			if _, exists, err := tx.getLatestSnapshot(streamId); err != nil {
+				return err
+			} else if exists {
+				return RiverError(Err_ALREADY_EXISTS, "stream already exists")
+			}
+			return tx.setLatestSnapshot(streamId, -1)
+		},
+		nil,
+		"streamId", streamId,
+	)
+}
+
+func (s *EmbeddedEventStore) GetMaxArchivedMiniblockNumber(ctx context.Context, streamId StreamId) (int64, error) {
+	var maxArchivedMiniblockNumber int64
+	err := s.txRunner(
+		ctx,
+		"GetMaxArchivedMiniblockNumber",
+		false,
+		func(ctx context.Context, tx *embeddedTx) error {
+			var err error
+			maxArchivedMiniblockNumber, err = s.getMaxArchivedMiniblockNumberTx(ctx, tx, streamId)
+			return err
+		},
+		&txRunnerOpts{skipLoggingNotFound: true},
+		"streamId", streamId,
+	)
+	if err != nil {
+		return -1, err
+	}
+	return maxArchivedMiniblockNumber, nil
+}
+
+func (s *EmbeddedEventStore) getMaxArchivedMiniblockNumberTx(
+	ctx context.Context,
+	tx *embeddedTx,
+	streamId StreamId,
+) (int64, error) {
+	if _, exists, err := tx.getLatestSnapshot(streamId); err != nil {
+		return -1, err
+	} else if !exists {
+		return -1, RiverError(Err_NOT_FOUND, "stream not found in local storage", "streamId", streamId)
+	}
+	num, _, err := tx.getMaxMiniblockNumber(streamId)
+	return num, err
+}
+
+func (s *EmbeddedEventStore) WriteArchiveMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	startMiniblockNum int64,
+	miniblocks [][]byte,
+) error {
+	return s.txRunner(
+		ctx,
+		"WriteArchiveMiniblocks",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			lastKnownMiniblockNum, err := s.getMaxArchivedMiniblockNumberTx(ctx, tx, streamId)
+			if err != nil {
+				return err
+			}
+			if lastKnownMiniblockNum+1 != startMiniblockNum {
+				return RiverError(
+					Err_DB_OPERATION_FAILURE,
+					"miniblock sequence number mismatch",
+					"lastKnownMiniblockNum", lastKnownMiniblockNum,
+					"startMiniblockNum", startMiniblockNum,
+					"streamId", streamId,
+				)
+			}
+			for i, miniblock := range miniblocks {
+				if err := tx.setMiniblock(streamId, startMiniblockNum+int64(i), miniblock); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		nil,
+		"streamId", streamId,
+		"startMiniblockNum", startMiniblockNum,
+		"numMiniblocks", len(miniblocks),
+	)
+}
+
+func (s *EmbeddedEventStore) ReadStreamFromLastSnapshot(
+	ctx context.Context,
+	streamId StreamId,
+	precedingBlockCount int,
+) (*ReadStreamFromLastSnapshotResult, error) {
+	var ret *ReadStreamFromLastSnapshotResult
+	err := s.txRunner(
+		ctx,
+		"ReadStreamFromLastSnapshot",
+		false,
+		func(ctx context.Context, tx *embeddedTx) error {
+			var err error
+			ret, err = s.readStreamFromLastSnapshotTx(ctx, tx, streamId, precedingBlockCount)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Performs the same consistency checks as PostgresEventStore.readStreamFromLastSnapshotTx.
+func (s *EmbeddedEventStore) readStreamFromLastSnapshotTx(
+	ctx context.Context,
+	tx *embeddedTx,
+	streamId StreamId,
+	precedingBlockCount int,
+) (*ReadStreamFromLastSnapshotResult, error) {
+	latestSnapshot, exists, err := tx.getLatestSnapshot(streamId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, RiverError(Err_NOT_FOUND, "stream not found in local storage")
+	}
+
+	var result ReadStreamFromLastSnapshotResult
+	result.StartMiniblockNumber = max(0, latestSnapshot-int64(max(0, precedingBlockCount)))
+
+	var seqNum int64
+	err = tx.iterate(
+		embeddedNumKey(embeddedMiniblockPrefix, streamId, max(0, latestSnapshot)),
+		embeddedPrefixEnd(embeddedStreamKey(embeddedMiniblockPrefix, streamId, 0)),
+		func(key []byte, value []byte) error {
+			seqNum = embeddedDecodeNum(key)
+			expected := latestSnapshot + int64(len(result.Miniblocks))
+			if seqNum != expected {
+				return RiverError(
+					Err_MINIBLOCKS_STORAGE_FAILURE,
+					"Miniblocks consistency violation - wrong block sequence number",
+					"ActualSeqNum", seqNum,
+					"ExpectedSeqNum", expected)
+			}
+			result.Miniblocks = append(result.Miniblocks, bytes.Clone(value))
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.iterate(
+		embeddedMinipoolKey(streamId, 0),
+		embeddedPrefixEnd(embeddedStreamKey(embeddedMinipoolPrefix, streamId, 0)),
+		func(key []byte, value []byte) error {
+			slotNum := embeddedDecodeNum(key) - 1
+			generation, envelope := decodeEmbeddedMinipoolRecord(value)
+			expectedSlot := int64(len(result.MinipoolEnvelopes))
+			if slotNum != expectedSlot {
+				return RiverError(
+					Err_MINIBLOCKS_STORAGE_FAILURE,
+					"Minipool consistency violation - slotNums are not sequential",
+				).
+					Tag("ActualSlotNumber", slotNum).
+					Tag("ExpectedSlotNumber", expectedSlot)
+			}
+			if generation != seqNum+1 {
+				return RiverError(
+					Err_MINIBLOCKS_STORAGE_FAILURE,
+					"Minipool consistency violation - wrong event generation",
+				).
+					Tag("ActualGeneration", generation).
+					Tag("ExpectedGeneration", seqNum+1)
+			}
+			result.MinipoolEnvelopes = append(result.MinipoolEnvelopes, envelope)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *EmbeddedEventStore) WriteEvent(
+	ctx context.Context,
+	streamId StreamId,
+	minipoolGeneration int64,
+	minipoolSlot int,
+	envelope []byte,
+) error {
+	return s.txRunner(
+		ctx,
+		"WriteEvent",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			return s.writeEventTx(ctx, tx, streamId, minipoolGeneration, minipoolSlot, envelope)
+		},
+		nil,
+		"streamId", streamId,
+		"minipoolGeneration", minipoolGeneration,
+		"minipoolSlot", minipoolSlot,
+	)
+}
+
+// Performs the same consistency checks as PostgresEventStore.writeEventTx.
+func (s *EmbeddedEventStore) writeEventTx(
+	ctx context.Context,
+	tx *embeddedTx,
+	streamId StreamId,
+	minipoolGeneration int64,
+	minipoolSlot int,
+	envelope []byte,
+) error {
+	counter := -1 // service record with slot -1 comes first
+	err := tx.iterateStream(embeddedMinipoolPrefix, streamId, func(key []byte, value []byte) error {
+		slotNum := int(embeddedDecodeNum(key) - 1)
+		generation, _ := decodeEmbeddedMinipoolRecord(value)
+		if generation != minipoolGeneration {
+			return RiverError(Err_DB_OPERATION_FAILURE, "Wrong event generation in minipool").
+				Tag("ExpectedGeneration", minipoolGeneration).Tag("ActualGeneration", generation).
+				Tag("SlotNumber", slotNum)
+		}
+		if slotNum != counter {
+			return RiverError(Err_DB_OPERATION_FAILURE, "Wrong slot number in minipool").
+				Tag("ExpectedSlotNumber", counter).Tag("ActualSlotNumber", slotNum)
+		}
+		counter++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if counter != minipoolSlot {
+		return RiverError(Err_DB_OPERATION_FAILURE, "Wrong number of records in minipool").
+			Tag("ActualRecordsNumber", counter).Tag("ExpectedRecordsNumber", minipoolSlot)
+	}
+
+	return tx.set(
+		embeddedMinipoolKey(streamId, int64(minipoolSlot)),
+		encodeEmbeddedMinipoolRecord(minipoolGeneration, envelope),
+	)
+}
+
+func (s *EmbeddedEventStore) ReadMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([][]byte, error) {
+	var miniblocks [][]byte
+	err := s.txRunner(
+		ctx,
+		"ReadMiniblocks",
+		false,
+		func(ctx context.Context, tx *embeddedTx) error {
+			var err error
+			miniblocks, err = s.readMiniblocksTx(ctx, tx, streamId, fromInclusive, toExclusive)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return miniblocks, nil
+}
+
+func (s *EmbeddedEventStore) readMiniblocksTx(
+	ctx context.Context,
+	tx *embeddedTx,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([][]byte, error) {
+	if toExclusive <= fromInclusive {
+		return nil, nil
+	}
+
+	var miniblocks [][]byte
+	prevSeqNum := -1
+	err := tx.iterate(
+		embeddedNumKey(embeddedMiniblockPrefix, streamId, max(0, fromInclusive)),
+		embeddedNumKey(embeddedMiniblockPrefix, streamId, max(0, toExclusive)),
+		func(key []byte, value []byte) error {
+			seqNum := int(embeddedDecodeNum(key))
+			if prevSeqNum != -1 && seqNum != prevSeqNum+1 {
+				return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+					Tag("ActualBlockNumber", seqNum).Tag("ExpectedBlockNumber", prevSeqNum+1).Tag("streamId", streamId)
+			}
+			prevSeqNum = seqNum
+			miniblocks = append(miniblocks, bytes.Clone(value))
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If beginning of the requested range is missing, check if it was pruned.
+	if len(miniblocks) == 0 || int64(prevSeqNum-len(miniblocks)+1) > fromInclusive {
+		firstSeqNum, ok, err := tx.getMinMiniblockNumber(streamId)
+		if err != nil {
+			return nil, err
+		}
+		if ok && firstSeqNum > max(0, fromInclusive) {
+			return nil, miniblocksPrunedError(streamId, fromInclusive, firstSeqNum)
+		}
+	}
+	return miniblocks, nil
+}
+
+// PruneMiniblocks deletes miniblocks with numbers lower than pruneBelow.
+func (s *EmbeddedEventStore) PruneMiniblocks(ctx context.Context, streamId StreamId, pruneBelow int64) (int64, error) {
+	var pruned int64
+	err := s.txRunner(
+		ctx,
+		"PruneMiniblocks",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			latestSnapshot, exists, err := tx.getLatestSnapshot(streamId)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return RiverError(Err_NOT_FOUND, "stream not found in local storage")
+			}
+			if pruneBelow > latestSnapshot {
+				return RiverError(Err_INVALID_ARGUMENT, "Can't prune miniblocks after the latest snapshot").
+					Tag("latestSnapshotMiniblock", latestSnapshot)
+			}
+			start := embeddedStreamKey(embeddedMiniblockPrefix, streamId, 0)
+			end := embeddedNumKey(embeddedMiniblockPrefix, streamId, max(0, pruneBelow))
+			err = tx.iterate(start, end, func(key []byte, value []byte) error {
+				pruned++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if err := tx.unindexMiniblocks(start, end); err != nil {
+				return err
+			}
+			return tx.deleteRange(start, end)
+		},
+		nil,
+		"streamId", streamId,
+		"pruneBelow", pruneBelow,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
+
+func (s *EmbeddedEventStore) WriteBlockProposal(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+	miniblock []byte,
+) error {
+	return s.txRunner(
+		ctx,
+		"WriteBlockProposal",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			seqNum, ok, err := tx.getMaxMiniblockNumber(streamId)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return RiverError(Err_NOT_FOUND, "No blocks for the stream found in block storage")
+			}
+			// Proposal should be for or after the next block number.
+			if blockNumber < seqNum+1 {
+				return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblock proposal blockNumber mismatch").
+					Tag("ExpectedBlockNumber", seqNum+1).Tag("ActualBlockNumber", blockNumber)
+			}
+			key := embeddedCandidateKey(streamId, blockNumber, blockHash)
+			if _, exists, err := tx.get(key); err != nil || exists {
+				return err
+			}
+			return tx.set(key, miniblock)
+		},
+		nil,
+		"streamId", streamId,
+		"blockHash", blockHash,
+		"blockNumber", blockNumber,
+	)
+}
+
+func (s *EmbeddedEventStore) ReadMiniblockCandidate(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+) ([]byte, error) {
+	var miniblock []byte
+	err := s.txRunner(
+		ctx,
+		"ReadMiniblockCandidate",
+		false,
+		func(ctx context.Context, tx *embeddedTx) error {
+			var (
+				exists bool
+				err    error
+			)
+			miniblock, exists, err = tx.get(embeddedCandidateKey(streamId, blockNumber, blockHash))
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return RiverError(Err_NOT_FOUND, "Miniblock candidate not found")
+			}
+			return nil
+		},
+		nil,
+		"streamId", streamId,
+		"blockHash", blockHash,
+		"blockNumber", blockNumber,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return miniblock, nil
+}
+
+func (s *EmbeddedEventStore) PromoteBlock(
+	ctx context.Context,
+	streamId StreamId,
+	minipoolGeneration int64,
+	candidateBlockHash common.Hash,
+	snapshotMiniblock bool,
+	envelopes [][]byte,
+) error {
+	return s.txRunner(
+		ctx,
+		"PromoteBlock",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			return s.promoteBlockTx(
+				ctx,
+				tx,
+				streamId,
+				minipoolGeneration,
+				candidateBlockHash,
+				snapshotMiniblock,
+				envelopes,
+			)
+		},
+		nil,
+		"streamId", streamId,
+		"minipoolGeneration", minipoolGeneration,
+		"candidateBlockHash", candidateBlockHash,
+		"snapshotMiniblock", snapshotMiniblock,
+	)
+}
+
+func (s *EmbeddedEventStore) promoteBlockTx(
+	ctx context.Context,
+	tx *embeddedTx,
+	streamId StreamId,
+	minipoolGeneration int64,
+	candidateBlockHash common.Hash,
+	snapshotMiniblock bool,
+	envelopes [][]byte,
+) error {
+	seqNum, ok, err := tx.getMaxMiniblockNumber(streamId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return RiverError(Err_NOT_FOUND, "No blocks for the stream found in block storage")
+	}
+	if minipoolGeneration != seqNum+1 {
+		return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Minipool generation mismatch").
+			Tag("ExpectedNewMinipoolGeneration", minipoolGeneration).Tag("ActualNewMinipoolGeneration", seqNum+1)
+	}
+
+	miniblock, exists, err := tx.get(embeddedCandidateKey(streamId, minipoolGeneration, candidateBlockHash))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return RiverError(Err_NOT_FOUND, "No candidate block found")
+	}
+
+	if err := tx.replaceMinipool(streamId, minipoolGeneration+1, envelopes); err != nil {
+		return err
+	}
+
+	if snapshotMiniblock {
+		if err := tx.setLatestSnapshot(streamId, minipoolGeneration); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.setMiniblock(streamId, minipoolGeneration, miniblock); err != nil {
+		return err
+	}
+
+	// clean up miniblock proposals for stream id up to and including the promoted one
+	return tx.deleteRange(
+		embeddedStreamKey(embeddedCandidatePrefix, streamId, 0),
+		embeddedNumKey(embeddedCandidatePrefix, streamId, minipoolGeneration+1),
+	)
+}
+
+func (s *EmbeddedEventStore) GetLastMiniblockNumber(ctx context.Context, streamId StreamId) (int64, error) {
+	var lastMiniblockNumber int64
+	err := s.txRunner(
+		ctx,
+		"GetLastMiniblockNumber",
+		false,
+		func(ctx context.Context, tx *embeddedTx) error {
+			var (
+				ok  bool
+				err error
+			)
+			lastMiniblockNumber, ok, err = tx.getMaxMiniblockNumber(streamId)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return RiverError(Err_NOT_FOUND, "stream not found in local storage", "streamId", streamId)
+			}
+			return nil
+		},
+		&txRunnerOpts{skipLoggingNotFound: true},
+		"streamId", streamId,
+	)
+	if err != nil {
+		return -1, err
+	}
+	return lastMiniblockNumber, nil
+}
+
+func (s *EmbeddedEventStore) WriteMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolEnvelopes [][]byte,
+) error {
+	if len(miniblocks) == 0 {
+		return RiverError(Err_INVALID_ARGUMENT, "no miniblocks to write", "streamId", streamId)
+	}
+	return s.txRunner(
+		ctx,
+		"WriteMiniblocks",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			return s.writeMiniblocksTx(ctx, tx, streamId, miniblocks, newMinipoolEnvelopes)
+		},
+		nil,
+		"streamId", streamId,
+		"firstMiniblockNum", miniblocks[0].Number,
+		"numMiniblocks", len(miniblocks),
+	)
+}
+
+func (s *EmbeddedEventStore) writeMiniblocksTx(
+	ctx context.Context,
+	tx *embeddedTx,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolEnvelopes [][]byte,
+) error {
+	generation, ok, err := tx.getMinipoolGeneration(streamId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return RiverError(Err_NOT_FOUND, "stream not found in local storage")
+	}
+
+	lastMiniblockNum, ok, err := tx.getMaxMiniblockNumber(streamId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return RiverError(Err_NOT_FOUND, "stream not found in local storage", "streamId", streamId)
+	}
+	if lastMiniblockNum+1 != generation {
+		return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Minipool generation mismatch").
+			Tag("ExpectedNewMinipoolGeneration", lastMiniblockNum+1).Tag("ActualNewMinipoolGeneration", generation)
+	}
+
+	for i, mb := range miniblocks {
+		if mb.Number != generation+int64(i) {
+			return RiverError(
+				Err_DB_OPERATION_FAILURE,
+				"miniblock sequence number mismatch",
+				"expectedMiniblockNum", generation+int64(i),
+				"actualMiniblockNum", mb.Number,
+				"streamId", streamId,
+			)
+		}
+		if err := tx.setMiniblock(streamId, mb.Number, mb.Data); err != nil {
+			return err
+		}
+		if mb.Snapshot {
+			if err := tx.setLatestSnapshot(streamId, mb.Number); err != nil {
+				return err
+			}
+		}
+	}
+
+	newGeneration := miniblocks[len(miniblocks)-1].Number + 1
+	if err := tx.replaceMinipool(streamId, newGeneration, newMinipoolEnvelopes); err != nil {
+		return err
+	}
+
+	// candidates for the written miniblocks are obsolete
+	return tx.deleteRange(
+		embeddedStreamKey(embeddedCandidatePrefix, streamId, 0),
+		embeddedNumKey(embeddedCandidatePrefix, streamId, newGeneration),
+	)
+}
+
+func (s *EmbeddedEventStore) ReplaceStreamStorage(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolEnvelopes [][]byte,
+) error {
+	if err := validateReplaceMiniblocks(streamId, miniblocks); err != nil {
+		return err
+	}
+	return s.txRunner(
+		ctx,
+		"ReplaceStreamStorage",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			if _, exists, err := tx.getLatestSnapshot(streamId); err != nil {
+				return err
+			} else if exists {
+				if err := s.deleteStreamTx(ctx, tx, streamId); err != nil {
+					return err
+				}
+			}
+			if err := s.createStreamStorageTx(ctx, tx, streamId, miniblocks[0].Number, miniblocks[0].Data); err != nil {
+				return err
+			}
+			if len(miniblocks) > 1 {
+				return s.writeMiniblocksTx(ctx, tx, streamId, miniblocks[1:], newMinipoolEnvelopes)
+			}
+			return tx.replaceMinipool(streamId, miniblocks[0].Number+1, newMinipoolEnvelopes)
+		},
+		nil,
+		"streamId", streamId,
+		"firstMiniblockNum", miniblocks[0].Number,
+		"numMiniblocks", len(miniblocks),
+	)
+}
+
+// GetStreamsNumber returns number of streams in the store.
+func (s *EmbeddedEventStore) GetStreamsNumber(ctx context.Context) (int, error) {
+	streams, err := s.GetStreams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(streams), nil
+}
+
+// GetStreams returns a list of all event streams
+func (s *EmbeddedEventStore) GetStreams(ctx context.Context) ([]StreamId, error) {
+	var streams []StreamId
+	err := s.txRunner(
+		ctx,
+		"GetStreams",
+		false,
+		func(ctx context.Context, tx *embeddedTx) error {
+			return tx.iterate(
+				[]byte{embeddedStreamPrefix},
+				[]byte{embeddedStreamPrefix + 1},
+				func(key []byte, _ []byte) error {
+					streamId, err := StreamIdFromBytes(key[1:])
+					if err != nil {
+						return err
+					}
+					streams = append(streams, streamId)
+					return nil
+				},
+			)
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+func (s *EmbeddedEventStore) DeleteStream(ctx context.Context, streamId StreamId) error {
+	return s.txRunner(
+		ctx,
+		"DeleteStream",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			if _, exists, err := tx.getLatestSnapshot(streamId); err != nil {
+				return err
+			} else if !exists {
+				return RiverError(Err_NOT_FOUND, "stream not found in local storage")
+			}
+			return s.deleteStreamTx(ctx, tx, streamId)
+		},
+		nil,
+		"streamId", streamId,
+	)
+}
+
+func (s *EmbeddedEventStore) deleteStreamTx(ctx context.Context, tx *embeddedTx, streamId StreamId) error {
+	start := embeddedStreamKey(embeddedMiniblockPrefix, streamId, 0)
+	if err := tx.unindexMiniblocks(start, embeddedPrefixEnd(start)); err != nil {
+		return err
+	}
+	for _, prefix := range []byte{embeddedMiniblockPrefix, embeddedMinipoolPrefix, embeddedCandidatePrefix} {
+		if err := tx.deleteStreamPrefix(prefix, streamId); err != nil {
+			return err
+		}
+	}
+	return tx.delete(embeddedStreamKey(embeddedStreamPrefix, streamId, 0))
+}
+
+func (s *EmbeddedEventStore) DebugReadStreamData(
+	ctx context.Context,
+	streamId StreamId,
+) (*DebugReadStreamDataResult, error) {
+	var ret *DebugReadStreamDataResult
+	err := s.txRunner(
+		ctx,
+		"DebugReadStreamData",
+		false,
+		func(ctx context.Context, tx *embeddedTx) error {
+			var err error
+			ret, err = s.debugReadStreamDataTx(ctx, tx, streamId)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func (s *EmbeddedEventStore) debugReadStreamDataTx(
+	ctx context.Context,
+	tx *embeddedTx,
+	streamId StreamId,
+) (*DebugReadStreamDataResult, error) {
+	result := &DebugReadStreamDataResult{
+		StreamId: streamId,
+	}
+
+	var (
+		exists bool
+		err    error
+	)
+	result.LatestSnapshotMiniblockNum, exists, err = tx.getLatestSnapshot(streamId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, RiverError(Err_NOT_FOUND, "stream not found in local storage")
+	}
+
+	err = tx.iterateStream(embeddedMiniblockPrefix, streamId, func(key []byte, value []byte) error {
+		result.Miniblocks = append(result.Miniblocks, MiniblockDescriptor{
+			MiniblockNumber: embeddedDecodeNum(key),
+			Data:            bytes.Clone(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.iterateStream(embeddedMinipoolPrefix, streamId, func(key []byte, value []byte) error {
+		generation, envelope := decodeEmbeddedMinipoolRecord(value)
+		result.Events = append(result.Events, EventDescriptor{
+			Generation: generation,
+			Slot:       embeddedDecodeNum(key) - 1,
+			Data:       envelope,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.iterateStream(embeddedCandidatePrefix, streamId, func(key []byte, value []byte) error {
+		result.MbCandidates = append(result.MbCandidates, MiniblockDescriptor{
+			MiniblockNumber: embeddedDecodeNum(key[:len(key)-common.HashLength]),
+			Data:            bytes.Clone(value),
+			Hash:            common.BytesToHash(key[len(key)-common.HashLength:]),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *EmbeddedEventStore) WriteSyncSession(
+	ctx context.Context,
+	syncId string,
+	session []byte,
+	ttl time.Duration,
+) error {
+	return s.txRunner(
+		ctx,
+		"WriteSyncSession",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			value := binary.BigEndian.AppendUint64(nil, uint64(time.Now().Add(ttl).UnixMilli()))
+			return tx.set(embeddedSyncSessionKey(syncId), append(value, session...))
+		},
+		nil,
+		"syncId", syncId,
+	)
+}
+
+func (s *EmbeddedEventStore) ReadSyncSession(ctx context.Context, syncId string) ([]byte, error) {
+	var session []byte
+	err := s.txRunner(
+		ctx,
+		"ReadSyncSession",
+		false,
+		func(ctx context.Context, tx *embeddedTx) error {
+			value, ok, err := tx.get(embeddedSyncSessionKey(syncId))
+			if err != nil {
+				return err
+			}
+			if !ok || int64(binary.BigEndian.Uint64(value)) <= time.Now().UnixMilli() {
+				return syncSessionNotFoundError(syncId)
+			}
+			session = value[8:]
+			return nil
+		},
+		&txRunnerOpts{skipLoggingNotFound: true},
+		"syncId", syncId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s *EmbeddedEventStore) DeleteSyncSession(ctx context.Context, syncId string) error {
+	return s.txRunner(
+		ctx,
+		"DeleteSyncSession",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			return tx.delete(embeddedSyncSessionKey(syncId))
+		},
+		nil,
+		"syncId", syncId,
+	)
+}
+
+func (s *EmbeddedEventStore) DeleteExpiredSyncSessions(ctx context.Context) (int, error) {
+	var deleted int
+	err := s.txRunner(
+		ctx,
+		"DeleteExpiredSyncSessions",
+		true,
+		func(ctx context.Context, tx *embeddedTx) error {
+			deleted = 0
+			now := time.Now().UnixMilli()
+			prefix := []byte{embeddedSyncSessionPrefix}
+			var expired [][]byte
+			err := tx.iterate(prefix, embeddedPrefixEnd(prefix), func(key []byte, value []byte) error {
+				if int64(binary.BigEndian.Uint64(value)) <= now {
+					expired = append(expired, bytes.Clone(key))
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			for _, key := range expired {
+				if err := tx.delete(key); err != nil {
+					return err
+				}
+			}
+			deleted = len(expired)
+			return nil
+		},
+		nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+func (s *EmbeddedEventStore) GetEventLocation(ctx context.Context, eventHash common.Hash) (*EventLocation, error) {
+	var location *EventLocation
+	err := s.txRunner(
+		ctx,
+		"GetEventLocation",
+		false,
+		func(ctx context.Context, tx *embeddedTx) error {
+			value, ok, err := tx.get(embeddedEventIndexKey(eventHash))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return eventNotFoundError(eventHash)
+			}
+			streamId, err := StreamIdFromBytes(value[:STREAM_ID_BYTES_LENGTH])
+			if err != nil {
+				return err
+			}
+			location = &EventLocation{
+				StreamId:     streamId,
+				MiniblockNum: int64(binary.BigEndian.Uint64(value[STREAM_ID_BYTES_LENGTH:])),
+				EventIndex:   int(binary.BigEndian.Uint32(value[STREAM_ID_BYTES_LENGTH+8:])),
+			}
+			return nil
+		},
+		&txRunnerOpts{skipLoggingNotFound: true},
+		"eventHash", eventHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return location, nil
+}
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/river-build/river/core/config"
+	"github.com/river-build/river/core/node/base/test"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func setupEmbeddedTest(t *testing.T) (context.Context, *EmbeddedEventStore, func()) {
+	ctx, ctxCloser := test.NewTestContext()
+
+	store, err := NewEmbeddedEventStore(
+		ctx,
+		&config.EmbeddedStorageConfig{Path: t.TempDir()},
+		infra.NewMetricsFactory(nil, "", ""),
+	)
+	require.NoError(t, err)
+
+	return ctx, store, func() {
+		store.Close(ctx)
+		ctxCloser()
+	}
+}
+
+// embeddedTestHooks corrupts the embedded store by writing keys directly.
+func embeddedTestHooks(t *testing.T, store *EmbeddedEventStore) *storageTestHooks {
+	update := func(f func(tx *embeddedTx) error) {
+		require.NoError(t, store.runWriteTx(context.Background(), func(_ context.Context, tx *embeddedTx) error {
+			return f(tx)
+		}))
+	}
+	return &storageTestHooks{
+		setMinipoolGeneration: func(streamId StreamId, slot int, generation int64) {
+			update(func(tx *embeddedTx) error {
+				key := embeddedMinipoolKey(streamId, int64(slot))
+				value, ok, err := tx.get(key)
+				if err != nil || !ok {
+					return err
+				}
+				_, envelope := decodeEmbeddedMinipoolRecord(value)
+				return tx.set(key, encodeEmbeddedMinipoolRecord(generation, envelope))
+			})
+		},
+		deleteMinipoolSlot: func(streamId StreamId, slot int) {
+			update(func(tx *embeddedTx) error {
+				return tx.delete(embeddedMinipoolKey(streamId, int64(slot)))
+			})
+		},
+		deleteMiniblock: func(streamId StreamId, num int64) {
+			update(func(tx *embeddedTx) error {
+				return tx.delete(embeddedNumKey(embeddedMiniblockPrefix, streamId, num))
+			})
+		},
+		deleteMiniblocks: func(streamId StreamId) {
+			update(func(tx *embeddedTx) error {
+				return tx.deleteStreamPrefix(embeddedMiniblockPrefix, streamId)
+			})
+		},
+	}
+}
+
+func TestEmbeddedStoreReopen(t *testing.T) {
+	require := require.New(t)
+	ctx, ctxCloser := test.NewTestContext()
+	defer ctxCloser()
+
+	cfg := &config.EmbeddedStorageConfig{Path: t.TempDir()}
+	store, err := NewEmbeddedEventStore(ctx, cfg, infra.NewMetricsFactory(nil, "", ""))
+	require.NoError(err)
+
+	// Directory is locked while the store is open.
+	_, err = NewEmbeddedEventStore(ctx, cfg, infra.NewMetricsFactory(nil, "", ""))
+	require.Error(err)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, []byte("genesis")))
+	require.NoError(store.WriteEvent(ctx, streamId, 1, 0, []byte("event1")))
+	blockHash := common.BytesToHash([]byte("block_hash"))
+	require.NoError(store.WriteBlockProposal(ctx, streamId, blockHash, 1, []byte("block1")))
+	require.NoError(store.PromoteBlock(ctx, streamId, 1, blockHash, true, [][]byte{[]byte("event2")}))
+	store.Close(ctx)
+
+	store, err = NewEmbeddedEventStore(ctx, cfg, infra.NewMetricsFactory(nil, "", ""))
+	require.NoError(err)
+	defer store.Close(ctx)
+
+	streams, err := store.GetStreams(ctx)
+	require.NoError(err)
+	require.Equal([]StreamId{streamId}, streams)
+
+	result, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 1)
+	require.NoError(err)
+	require.Equal(int64(0), result.StartMiniblockNumber)
+	require.Equal([][]byte{[]byte("block1")}, result.Miniblocks)
+	require.Equal([][]byte{[]byte("event2")}, result.MinipoolEnvelopes)
+
+	data, err := store.DebugReadStreamData(ctx, streamId)
+	require.NoError(err)
+	require.Equal(int64(1), data.LatestSnapshotMiniblockNum)
+	require.Len(data.Miniblocks, 2)
+	require.Empty(data.MbCandidates)
+}
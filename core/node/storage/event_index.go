@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// EventLocation is the position of an event in the locally stored miniblocks of a stream.
+type EventLocation struct {
+	StreamId     StreamId
+	MiniblockNum int64
+	// EventIndex is the index of the event in the miniblock events.
+	EventIndex int
+}
+
+// miniblockEventHashes returns hashes of the events of the serialized miniblock in the miniblock order.
+// Data that can't be decoded as a miniblock has no events to index.
+func miniblockEventHashes(data []byte) []common.Hash {
+	var mb Miniblock
+	if err := proto.Unmarshal(data, &mb); err != nil {
+		return nil
+	}
+	hashes := make([]common.Hash, 0, len(mb.Events))
+	for _, e := range mb.Events {
+		hashes = append(hashes, common.BytesToHash(e.Hash))
+	}
+	return hashes
+}
+
+func eventNotFoundError(eventHash common.Hash) error {
+	return RiverError(Err_NOT_FOUND, "Event not found in local storage", "eventHash", eventHash)
+}
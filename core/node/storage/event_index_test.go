@@ -0,0 +1,150 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func makeEventIndexTestMiniblock(t *testing.T, num int64, eventHashes ...common.Hash) []byte {
+	event, err := proto.Marshal(&StreamEvent{
+		Payload: &StreamEvent_MiniblockHeader{MiniblockHeader: &MiniblockHeader{MiniblockNum: num}},
+	})
+	require.NoError(t, err)
+	mb := &Miniblock{Header: &Envelope{Event: event}}
+	for _, hash := range eventHashes {
+		mb.Events = append(mb.Events, &Envelope{Hash: hash.Bytes()})
+	}
+	data, err := proto.Marshal(mb)
+	require.NoError(t, err)
+	return data
+}
+
+func requireEventLocation(
+	t *testing.T,
+	ctx context.Context,
+	store StreamStorage,
+	eventHash common.Hash,
+	expected *EventLocation,
+) {
+	location, err := store.GetEventLocation(ctx, eventHash)
+	if expected == nil {
+		require.Equal(t, Err_NOT_FOUND, AsRiverError(err).Code, "eventHash %s", eventHash)
+		return
+	}
+	require.NoError(t, err)
+	require.Equal(t, expected, location)
+}
+
+func TestEventIndex(t *testing.T) {
+	runStorageTests(t, func(t *testing.T, ctx context.Context, store testStreamStore, _ *storageTestHooks) {
+		require := require.New(t)
+
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		hashes := make([]common.Hash, 5)
+		for i := range hashes {
+			hashes[i] = common.BytesToHash([]byte{byte(i + 1)})
+		}
+
+		require.NoError(store.CreateStreamStorage(ctx, streamId, makeEventIndexTestMiniblock(t, 0, hashes[0])))
+
+		mb1 := makeEventIndexTestMiniblock(t, 1, hashes[1], hashes[2])
+		mbHash := common.BytesToHash([]byte("mb1"))
+		require.NoError(store.WriteBlockProposal(ctx, streamId, mbHash, 1, mb1))
+		requireEventLocation(t, ctx, store, hashes[1], nil)
+		require.NoError(store.PromoteBlock(ctx, streamId, 1, mbHash, true, nil))
+
+		require.NoError(store.WriteMiniblocks(
+			ctx,
+			streamId,
+			[]*WriteMiniblockData{{Number: 2, Data: makeEventIndexTestMiniblock(t, 2, hashes[3])}},
+			nil,
+		))
+
+		requireEventLocation(t, ctx, store, hashes[0], &EventLocation{StreamId: streamId, MiniblockNum: 0})
+		requireEventLocation(t, ctx, store, hashes[2], &EventLocation{StreamId: streamId, MiniblockNum: 1, EventIndex: 1})
+		requireEventLocation(t, ctx, store, hashes[3], &EventLocation{StreamId: streamId, MiniblockNum: 2})
+		requireEventLocation(t, ctx, store, hashes[4], nil)
+
+		pruned, err := store.PruneMiniblocks(ctx, streamId, 1)
+		require.NoError(err)
+		require.EqualValues(1, pruned)
+		requireEventLocation(t, ctx, store, hashes[0], nil)
+		requireEventLocation(t, ctx, store, hashes[1], &EventLocation{StreamId: streamId, MiniblockNum: 1})
+
+		require.NoError(store.DeleteStream(ctx, streamId))
+		requireEventLocation(t, ctx, store, hashes[1], nil)
+		requireEventLocation(t, ctx, store, hashes[3], nil)
+
+		archiveId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		require.NoError(store.CreateStreamArchiveStorage(ctx, archiveId))
+		require.NoError(store.WriteArchiveMiniblocks(
+			ctx,
+			archiveId,
+			0,
+			[][]byte{makeEventIndexTestMiniblock(t, 0, hashes[0]), makeEventIndexTestMiniblock(t, 1, hashes[4])},
+		))
+		requireEventLocation(t, ctx, store, hashes[4], &EventLocation{StreamId: archiveId, MiniblockNum: 1})
+	})
+}
+
+func TestPostgresEventIndexBackfill(t *testing.T) {
+	require := require.New(t)
+
+	ctx, store, testParams := setupTest()
+	defer testParams.closer()
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	hashes := make([]common.Hash, 5)
+	for i := range hashes {
+		hashes[i] = common.BytesToHash([]byte{byte(i + 1)})
+	}
+
+	require.NoError(store.CreateStreamStorage(ctx, streamId, makeEventIndexTestMiniblock(t, 0, hashes[0])))
+	mbs := make([]*WriteMiniblockData, 0, len(hashes)-1)
+	for i := 1; i < len(hashes); i++ {
+		mbs = append(mbs, &WriteMiniblockData{
+			Number:   int64(i),
+			Snapshot: i == 1,
+			Data:     makeEventIndexTestMiniblock(t, int64(i), hashes[i]),
+		})
+	}
+	require.NoError(store.WriteMiniblocks(ctx, streamId, mbs, nil))
+
+	// Streams created after the event index are not backfilled.
+	indexed, done, err := store.BackfillEventIndex(ctx, streamId, 2)
+	require.NoError(err)
+	require.Zero(indexed)
+	require.True(done)
+
+	// Simulate a stream written before the event index was created.
+	_, err = store.pool.Exec(ctx, "DELETE FROM event_index WHERE stream_id = $1", streamId)
+	require.NoError(err)
+	_, err = store.pool.Exec(ctx, "UPDATE es SET event_index_backfill_from = 0 WHERE stream_id = $1", streamId)
+	require.NoError(err)
+	pruned, err := store.PruneMiniblocks(ctx, streamId, 1)
+	require.NoError(err)
+	require.EqualValues(1, pruned)
+	requireEventLocation(t, ctx, store, hashes[1], nil)
+
+	total := 0
+	for !done {
+		indexed, done, err = store.BackfillEventIndex(ctx, streamId, 2)
+		require.NoError(err)
+		total += indexed
+	}
+	require.Equal(4, total)
+
+	requireEventLocation(t, ctx, store, hashes[0], nil)
+	for i := 1; i < len(hashes); i++ {
+		requireEventLocation(t, ctx, store, hashes[i], &EventLocation{StreamId: streamId, MiniblockNum: int64(i)})
+	}
+}
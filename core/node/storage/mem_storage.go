@@ -0,0 +1,742 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/exp/maps"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// MemEventStore is an in-memory StreamStorage implementation for tests.
+// It performs the same consistency checks and returns the same error codes as PostgresEventStore,
+// so components that depend on StreamStorage can be tested without a database.
+type MemEventStore struct {
+	mu         sync.Mutex
+	streams    map[StreamId]*memStream
+	eventIndex map[common.Hash]EventLocation
+	sessions   map[string]*memSyncSession
+}
+
+var _ StreamStorage = (*MemEventStore)(nil)
+
+type memStream struct {
+	latestSnapshotMiniblock int64
+	miniblocks              map[int64][]byte
+	// minipool is indexed by slot number, slot -1 is a service record that keeps current generation.
+	minipool   map[int64]*memMinipoolRecord
+	candidates map[memCandidateKey][]byte
+}
+
+type memSyncSession struct {
+	session   []byte
+	expiresAt time.Time
+}
+
+type memMinipoolRecord struct {
+	generation int64
+	envelope   []byte
+}
+
+type memCandidateKey struct {
+	num  int64
+	hash common.Hash
+}
+
+func NewMemEventStore() *MemEventStore {
+	return &MemEventStore{
+		streams:    make(map[StreamId]*memStream),
+		eventIndex: make(map[common.Hash]EventLocation),
+		sessions:   make(map[string]*memSyncSession),
+	}
+}
+
+func (s *MemEventStore) Close(ctx context.Context) {}
+
+// txRunner runs f under the store lock and wraps errors the same way as PostgresEventStore.
+// f must validate all preconditions before modifying the store.
+func (s *MemEventStore) txRunner(name string, f func() error, tags ...any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := f(); err != nil {
+		return WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Func("mem.txRunner").
+			Message("transaction failed").
+			Tag("name", name).
+			Tags(tags...)
+	}
+	return nil
+}
+
+func (s *MemEventStore) getStream(streamId StreamId) (*memStream, error) {
+	stream, ok := s.streams[streamId]
+	if !ok {
+		return nil, RiverError(Err_NOT_FOUND, "stream not found in local storage", "streamId", streamId)
+	}
+	return stream, nil
+}
+
+// miniblockNums returns sorted numbers of stored miniblocks.
+func (ms *memStream) miniblockNums() []int64 {
+	nums := maps.Keys(ms.miniblocks)
+	slices.Sort(nums)
+	return nums
+}
+
+// lastMiniblockNum returns the last stored miniblock number and false if there are no miniblocks.
+func (ms *memStream) lastMiniblockNum() (int64, bool) {
+	if len(ms.miniblocks) == 0 {
+		return -1, false
+	}
+	return slices.Max(maps.Keys(ms.miniblocks)), true
+}
+
+// minipoolSlots returns sorted slot numbers of the minipool including the service record.
+func (ms *memStream) minipoolSlots() []int64 {
+	slots := maps.Keys(ms.minipool)
+	slices.Sort(slots)
+	return slots
+}
+
+func (ms *memStream) replaceMinipool(generation int64, envelopes [][]byte) {
+	ms.minipool = map[int64]*memMinipoolRecord{-1: {generation: generation}}
+	for i, envelope := range envelopes {
+		ms.minipool[int64(i)] = &memMinipoolRecord{generation: generation, envelope: bytes.Clone(envelope)}
+	}
+}
+
+func (ms *memStream) deleteCandidatesBefore(toExclusive int64) {
+	maps.DeleteFunc(ms.candidates, func(k memCandidateKey, _ []byte) bool { return k.num < toExclusive })
+}
+
+// setMiniblock stores the miniblock and adds its events to the event index.
+func (s *MemEventStore) setMiniblock(streamId StreamId, stream *memStream, num int64, miniblock []byte) {
+	stream.miniblocks[num] = bytes.Clone(miniblock)
+	for i, hash := range miniblockEventHashes(miniblock) {
+		s.eventIndex[hash] = EventLocation{StreamId: streamId, MiniblockNum: num, EventIndex: i}
+	}
+}
+
+// deleteMiniblock deletes the miniblock and removes its events from the event index.
+func (s *MemEventStore) deleteMiniblock(stream *memStream, num int64) {
+	for _, hash := range miniblockEventHashes(stream.miniblocks[num]) {
+		delete(s.eventIndex, hash)
+	}
+	delete(stream.miniblocks, num)
+}
+
+func newMemStream(latestSnapshotMiniblock int64) *memStream {
+	return &memStream{
+		latestSnapshotMiniblock: latestSnapshotMiniblock,
+		miniblocks:              make(map[int64][]byte),
+		minipool:                make(map[int64]*memMinipoolRecord),
+		candidates:              make(map[memCandidateKey][]byte),
+	}
+}
+
+func (s *MemEventStore) CreateStreamStorage(ctx context.Context, streamId StreamId, genesisMiniblock []byte) error {
+	return s.txRunner("CreateStreamStorage", func() error {
+		return s.createStream(streamId, 0, genesisMiniblock)
+	}, "streamId", streamId)
+}
+
+func (s *MemEventStore) CreateStreamStorageFromSnapshot(
+	ctx context.Context,
+	streamId StreamId,
+	snapshotMiniblockNum int64,
+	snapshotMiniblock []byte,
+) error {
+	return s.txRunner("CreateStreamStorageFromSnapshot", func() error {
+		return s.createStream(streamId, snapshotMiniblockNum, snapshotMiniblock)
+	}, "streamId", streamId, "snapshotMiniblockNum", snapshotMiniblockNum)
+}
+
+// createStream creates a stream that starts with the given miniblock,
+// which is either the genesis miniblock or a snapshot miniblock.
+func (s *MemEventStore) createStream(streamId StreamId, miniblockNum int64, miniblock []byte) error {
+	if _, ok := s.streams[streamId]; ok {
+		return RiverError(Err_ALREADY_EXISTS, "stream already exists")
+	}
+	stream := newMemStream(miniblockNum)
+	s.setMiniblock(streamId, stream, miniblockNum, miniblock)
+	stream.replaceMinipool(miniblockNum+1, nil)
+	s.streams[streamId] = stream
+	return nil
+}
+
+func (s *MemEventStore) CreateStreamArchiveStorage(ctx context.Context, streamId StreamId) error {
+	return s.txRunner("CreateStreamArchiveStorage", func() error {
+		if _, ok := s.streams[streamId]; ok {
+			return RiverError(Err_ALREADY_EXISTS, "stream already exists")
+		}
+		s.streams[streamId] = newMemStream(-1)
+		return nil
+	}, "streamId", streamId)
+}
+
+func (s *MemEventStore) GetMaxArchivedMiniblockNumber(ctx context.Context, streamId StreamId) (int64, error) {
+	var num int64
+	err := s.txRunner("GetMaxArchivedMiniblockNumber", func() error {
+		stream, err := s.getStream(streamId)
+		if err != nil {
+			return err
+		}
+		num, _ = stream.lastMiniblockNum()
+		return nil
+	}, "streamId", streamId)
+	if err != nil {
+		return -1, err
+	}
+	return num, nil
+}
+
+func (s *MemEventStore) WriteArchiveMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	startMiniblockNum int64,
+	miniblocks [][]byte,
+) error {
+	return s.txRunner("WriteArchiveMiniblocks", func() error {
+		stream, err := s.getStream(streamId)
+		if err != nil {
+			return err
+		}
+		lastKnownMiniblockNum, _ := stream.lastMiniblockNum()
+		if lastKnownMiniblockNum+1 != startMiniblockNum {
+			return RiverError(
+				Err_DB_OPERATION_FAILURE,
+				"miniblock sequence number mismatch",
+				"lastKnownMiniblockNum", lastKnownMiniblockNum,
+				"startMiniblockNum", startMiniblockNum,
+				"streamId", streamId,
+			)
+		}
+		for i, mb := range miniblocks {
+			s.setMiniblock(streamId, stream, startMiniblockNum+int64(i), mb)
+		}
+		return nil
+	}, "streamId", streamId, "startMiniblockNum", startMiniblockNum, "numMiniblocks", len(miniblocks))
+}
+
+func (s *MemEventStore) ReadStreamFromLastSnapshot(
+	ctx context.Context,
+	streamId StreamId,
+	precedingBlockCount int,
+) (*ReadStreamFromLastSnapshotResult, error) {
+	var result *ReadStreamFromLastSnapshotResult
+	err := s.txRunner("ReadStreamFromLastSnapshot", func() error {
+		stream, err := s.getStream(streamId)
+		if err != nil {
+			return err
+		}
+
+		latestSnapshot := stream.latestSnapshotMiniblock
+		res := &ReadStreamFromLastSnapshotResult{
+			StartMiniblockNumber: max(0, latestSnapshot-int64(max(0, precedingBlockCount))),
+		}
+
+		var seqNum int64
+		for _, num := range stream.miniblockNums() {
+			if num < latestSnapshot {
+				continue
+			}
+			seqNum = num
+			expected := latestSnapshot + int64(len(res.Miniblocks))
+			if seqNum != expected {
+				return RiverError(
+					Err_MINIBLOCKS_STORAGE_FAILURE,
+					"Miniblocks consistency violation - wrong block sequence number",
+					"ActualSeqNum", seqNum,
+					"ExpectedSeqNum", expected)
+			}
+			res.Miniblocks = append(res.Miniblocks, bytes.Clone(stream.miniblocks[num]))
+		}
+
+		for _, slot := range stream.minipoolSlots() {
+			if slot < 0 {
+				continue
+			}
+			record := stream.minipool[slot]
+			expectedSlot := int64(len(res.MinipoolEnvelopes))
+			if slot != expectedSlot {
+				return RiverError(
+					Err_MINIBLOCKS_STORAGE_FAILURE,
+					"Minipool consistency violation - slotNums are not sequential",
+				).
+					Tag("ActualSlotNumber", slot).
+					Tag("ExpectedSlotNumber", expectedSlot)
+			}
+			if record.generation != seqNum+1 {
+				return RiverError(
+					Err_MINIBLOCKS_STORAGE_FAILURE,
+					"Minipool consistency violation - wrong event generation",
+				).
+					Tag("ActualGeneration", record.generation).
+					Tag("ExpectedGeneration", seqNum+1)
+			}
+			res.MinipoolEnvelopes = append(res.MinipoolEnvelopes, bytes.Clone(record.envelope))
+		}
+
+		result = res
+		return nil
+	}, "streamId", streamId)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *MemEventStore) WriteEvent(
+	ctx context.Context,
+	streamId StreamId,
+	minipoolGeneration int64,
+	minipoolSlot int,
+	envelope []byte,
+) error {
+	return s.txRunner("WriteEvent", func() error {
+		stream, err := s.getStream(streamId)
+		if err != nil {
+			return err
+		}
+
+		counter := -1 // service record with slot -1 comes first
+		for _, slot := range stream.minipoolSlots() {
+			slotNum := int(slot)
+			generation := stream.minipool[slot].generation
+			if generation != minipoolGeneration {
+				return RiverError(Err_DB_OPERATION_FAILURE, "Wrong event generation in minipool").
+					Tag("ExpectedGeneration", minipoolGeneration).Tag("ActualGeneration", generation).
+					Tag("SlotNumber", slotNum)
+			}
+			if slotNum != counter {
+				return RiverError(Err_DB_OPERATION_FAILURE, "Wrong slot number in minipool").
+					Tag("ExpectedSlotNumber", counter).Tag("ActualSlotNumber", slotNum)
+			}
+			counter++
+		}
+
+		if counter != minipoolSlot {
+			return RiverError(Err_DB_OPERATION_FAILURE, "Wrong number of records in minipool").
+				Tag("ActualRecordsNumber", counter).Tag("ExpectedRecordsNumber", minipoolSlot)
+		}
+
+		stream.minipool[int64(minipoolSlot)] = &memMinipoolRecord{
+			generation: minipoolGeneration,
+			envelope:   bytes.Clone(envelope),
+		}
+		return nil
+	}, "streamId", streamId, "minipoolGeneration", minipoolGeneration, "minipoolSlot", minipoolSlot)
+}
+
+func (s *MemEventStore) ReadMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+) ([][]byte, error) {
+	var miniblocks [][]byte
+	err := s.txRunner("ReadMiniblocks", func() error {
+		stream, ok := s.streams[streamId]
+		if !ok {
+			return nil
+		}
+		nums := stream.miniblockNums()
+		if fromInclusive < toExclusive && len(nums) > 0 && nums[0] > max(0, fromInclusive) {
+			return miniblocksPrunedError(streamId, fromInclusive, nums[0])
+		}
+		prevSeqNum := -1
+		for _, num := range nums {
+			if num < fromInclusive || num >= toExclusive {
+				continue
+			}
+			seqNum := int(num)
+			if prevSeqNum != -1 && seqNum != prevSeqNum+1 {
+				return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+					Tag("ActualBlockNumber", seqNum).Tag("ExpectedBlockNumber", prevSeqNum+1).Tag("streamId", streamId)
+			}
+			prevSeqNum = seqNum
+			miniblocks = append(miniblocks, bytes.Clone(stream.miniblocks[num]))
+		}
+		return nil
+	}, "streamId", streamId)
+	if err != nil {
+		return nil, err
+	}
+	return miniblocks, nil
+}
+
+// PruneMiniblocks deletes miniblocks with numbers lower than pruneBelow.
+func (s *MemEventStore) PruneMiniblocks(ctx context.Context, streamId StreamId, pruneBelow int64) (int64, error) {
+	var pruned int64
+	err := s.txRunner("PruneMiniblocks", func() error {
+		stream, err := s.getStream(streamId)
+		if err != nil {
+			return err
+		}
+		if pruneBelow > stream.latestSnapshotMiniblock {
+			return RiverError(Err_INVALID_ARGUMENT, "Can't prune miniblocks after the latest snapshot").
+				Tag("latestSnapshotMiniblock", stream.latestSnapshotMiniblock)
+		}
+		for _, num := range stream.miniblockNums() {
+			if num < pruneBelow {
+				s.deleteMiniblock(stream, num)
+				pruned++
+			}
+		}
+		return nil
+	}, "streamId", streamId, "pruneBelow", pruneBelow)
+	if err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
+
+func (s *MemEventStore) WriteBlockProposal(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+	miniblock []byte,
+) error {
+	return s.txRunner("WriteBlockProposal", func() error {
+		stream, ok := s.streams[streamId]
+		var seqNum int64
+		if ok {
+			seqNum, ok = stream.lastMiniblockNum()
+		}
+		if !ok {
+			return RiverError(Err_NOT_FOUND, "No blocks for the stream found in block storage")
+		}
+		if blockNumber < seqNum+1 {
+			return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblock proposal blockNumber mismatch").
+				Tag("ExpectedBlockNumber", seqNum+1).Tag("ActualBlockNumber", blockNumber)
+		}
+		key := memCandidateKey{num: blockNumber, hash: blockHash}
+		if _, exists := stream.candidates[key]; !exists {
+			stream.candidates[key] = bytes.Clone(miniblock)
+		}
+		return nil
+	}, "streamId", streamId, "blockHash", blockHash, "blockNumber", blockNumber)
+}
+
+func (s *MemEventStore) ReadMiniblockCandidate(
+	ctx context.Context,
+	streamId StreamId,
+	blockHash common.Hash,
+	blockNumber int64,
+) ([]byte, error) {
+	var miniblock []byte
+	err := s.txRunner("ReadMiniblockCandidate", func() error {
+		stream, ok := s.streams[streamId]
+		if ok {
+			miniblock, ok = stream.candidates[memCandidateKey{num: blockNumber, hash: blockHash}]
+		}
+		if !ok {
+			return RiverError(Err_NOT_FOUND, "Miniblock candidate not found")
+		}
+		miniblock = bytes.Clone(miniblock)
+		return nil
+	}, "streamId", streamId, "blockHash", blockHash, "blockNumber", blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return miniblock, nil
+}
+
+func (s *MemEventStore) PromoteBlock(
+	ctx context.Context,
+	streamId StreamId,
+	minipoolGeneration int64,
+	candidateBlockHash common.Hash,
+	snapshotMiniblock bool,
+	envelopes [][]byte,
+) error {
+	return s.txRunner("PromoteBlock", func() error {
+		stream, ok := s.streams[streamId]
+		var seqNum int64
+		if ok {
+			seqNum, ok = stream.lastMiniblockNum()
+		}
+		if !ok {
+			return RiverError(Err_NOT_FOUND, "No blocks for the stream found in block storage")
+		}
+		if minipoolGeneration != seqNum+1 {
+			return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Minipool generation mismatch").
+				Tag("ExpectedNewMinipoolGeneration", minipoolGeneration).Tag("ActualNewMinipoolGeneration", seqNum+1)
+		}
+
+		miniblock, ok := stream.candidates[memCandidateKey{num: minipoolGeneration, hash: candidateBlockHash}]
+		if !ok {
+			return RiverError(Err_NOT_FOUND, "No candidate block found")
+		}
+
+		stream.replaceMinipool(minipoolGeneration+1, envelopes)
+		if snapshotMiniblock {
+			stream.latestSnapshotMiniblock = minipoolGeneration
+		}
+		s.setMiniblock(streamId, stream, minipoolGeneration, miniblock)
+		stream.deleteCandidatesBefore(minipoolGeneration + 1)
+		return nil
+	},
+		"streamId", streamId,
+		"minipoolGeneration", minipoolGeneration,
+		"candidateBlockHash", candidateBlockHash,
+		"snapshotMiniblock", snapshotMiniblock,
+	)
+}
+
+func (s *MemEventStore) GetLastMiniblockNumber(ctx context.Context, streamId StreamId) (int64, error) {
+	var num int64
+	err := s.txRunner("GetLastMiniblockNumber", func() error {
+		stream, ok := s.streams[streamId]
+		if ok {
+			num, ok = stream.lastMiniblockNum()
+		}
+		if !ok {
+			return RiverError(Err_NOT_FOUND, "stream not found in local storage", "streamId", streamId)
+		}
+		return nil
+	}, "streamId", streamId)
+	if err != nil {
+		return -1, err
+	}
+	return num, nil
+}
+
+func (s *MemEventStore) WriteMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolEnvelopes [][]byte,
+) error {
+	if len(miniblocks) == 0 {
+		return RiverError(Err_INVALID_ARGUMENT, "no miniblocks to write", "streamId", streamId)
+	}
+	return s.txRunner("WriteMiniblocks", func() error {
+		stream, err := s.getStream(streamId)
+		if err != nil {
+			return err
+		}
+		serviceRecord, ok := stream.minipool[-1]
+		if !ok {
+			return RiverError(Err_NOT_FOUND, "stream not found in local storage")
+		}
+		generation := serviceRecord.generation
+
+		lastMiniblockNum, ok := stream.lastMiniblockNum()
+		if !ok {
+			return RiverError(Err_NOT_FOUND, "stream not found in local storage", "streamId", streamId)
+		}
+		if lastMiniblockNum+1 != generation {
+			return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Minipool generation mismatch").
+				Tag("ExpectedNewMinipoolGeneration", lastMiniblockNum+1).Tag("ActualNewMinipoolGeneration", generation)
+		}
+
+		for i, mb := range miniblocks {
+			if mb.Number != generation+int64(i) {
+				return RiverError(
+					Err_DB_OPERATION_FAILURE,
+					"miniblock sequence number mismatch",
+					"expectedMiniblockNum", generation+int64(i),
+					"actualMiniblockNum", mb.Number,
+					"streamId", streamId,
+				)
+			}
+		}
+
+		for _, mb := range miniblocks {
+			s.setMiniblock(streamId, stream, mb.Number, mb.Data)
+			if mb.Snapshot {
+				stream.latestSnapshotMiniblock = mb.Number
+			}
+		}
+
+		newGeneration := miniblocks[len(miniblocks)-1].Number + 1
+		stream.replaceMinipool(newGeneration, newMinipoolEnvelopes)
+		stream.deleteCandidatesBefore(newGeneration)
+		return nil
+	}, "streamId", streamId, "firstMiniblockNum", miniblocks[0].Number, "numMiniblocks", len(miniblocks))
+}
+
+func (s *MemEventStore) ReplaceStreamStorage(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolEnvelopes [][]byte,
+) error {
+	if err := validateReplaceMiniblocks(streamId, miniblocks); err != nil {
+		return err
+	}
+	return s.txRunner("ReplaceStreamStorage", func() error {
+		if stream, ok := s.streams[streamId]; ok {
+			s.deleteStream(streamId, stream)
+		}
+		stream := newMemStream(miniblocks[0].Number)
+		for _, mb := range miniblocks {
+			s.setMiniblock(streamId, stream, mb.Number, mb.Data)
+			if mb.Snapshot {
+				stream.latestSnapshotMiniblock = mb.Number
+			}
+		}
+		stream.replaceMinipool(miniblocks[len(miniblocks)-1].Number+1, newMinipoolEnvelopes)
+		s.streams[streamId] = stream
+		return nil
+	}, "streamId", streamId, "firstMiniblockNum", miniblocks[0].Number, "numMiniblocks", len(miniblocks))
+}
+
+// GetStreamsNumber returns number of streams in the store.
+func (s *MemEventStore) GetStreamsNumber(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.streams), nil
+}
+
+// GetStreams returns a list of all event streams
+func (s *MemEventStore) GetStreams(ctx context.Context) ([]StreamId, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return maps.Keys(s.streams), nil
+}
+
+func (s *MemEventStore) DeleteStream(ctx context.Context, streamId StreamId) error {
+	return s.txRunner("DeleteStream", func() error {
+		stream, err := s.getStream(streamId)
+		if err != nil {
+			return err
+		}
+		s.deleteStream(streamId, stream)
+		return nil
+	}, "streamId", streamId)
+}
+
+// deleteStream deletes the stream and removes events of its miniblocks from the event index.
+func (s *MemEventStore) deleteStream(streamId StreamId, stream *memStream) {
+	for _, num := range stream.miniblockNums() {
+		s.deleteMiniblock(stream, num)
+	}
+	delete(s.streams, streamId)
+}
+
+func (s *MemEventStore) GetEventLocation(ctx context.Context, eventHash common.Hash) (*EventLocation, error) {
+	var location *EventLocation
+	err := s.txRunner("GetEventLocation", func() error {
+		l, ok := s.eventIndex[eventHash]
+		if !ok {
+			return eventNotFoundError(eventHash)
+		}
+		location = &l
+		return nil
+	}, "eventHash", eventHash)
+	if err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+func (s *MemEventStore) WriteSyncSession(
+	ctx context.Context,
+	syncId string,
+	session []byte,
+	ttl time.Duration,
+) error {
+	return s.txRunner("WriteSyncSession", func() error {
+		s.sessions[syncId] = &memSyncSession{session: bytes.Clone(session), expiresAt: time.Now().Add(ttl)}
+		return nil
+	}, "syncId", syncId)
+}
+
+func (s *MemEventStore) ReadSyncSession(ctx context.Context, syncId string) ([]byte, error) {
+	var result []byte
+	err := s.txRunner("ReadSyncSession", func() error {
+		session, ok := s.sessions[syncId]
+		if !ok || !session.expiresAt.After(time.Now()) {
+			return syncSessionNotFoundError(syncId)
+		}
+		result = bytes.Clone(session.session)
+		return nil
+	}, "syncId", syncId)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *MemEventStore) DeleteSyncSession(ctx context.Context, syncId string) error {
+	return s.txRunner("DeleteSyncSession", func() error {
+		delete(s.sessions, syncId)
+		return nil
+	}, "syncId", syncId)
+}
+
+func (s *MemEventStore) DeleteExpiredSyncSessions(ctx context.Context) (int, error) {
+	deleted := 0
+	err := s.txRunner("DeleteExpiredSyncSessions", func() error {
+		now := time.Now()
+		for id, session := range s.sessions {
+			if !session.expiresAt.After(now) {
+				delete(s.sessions, id)
+				deleted++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
+func (s *MemEventStore) DebugReadStreamData(
+	ctx context.Context,
+	streamId StreamId,
+) (*DebugReadStreamDataResult, error) {
+	var result *DebugReadStreamDataResult
+	err := s.txRunner("DebugReadStreamData", func() error {
+		stream, err := s.getStream(streamId)
+		if err != nil {
+			return err
+		}
+
+		result = &DebugReadStreamDataResult{
+			StreamId:                   streamId,
+			LatestSnapshotMiniblockNum: stream.latestSnapshotMiniblock,
+		}
+		for _, num := range stream.miniblockNums() {
+			result.Miniblocks = append(result.Miniblocks, MiniblockDescriptor{
+				MiniblockNumber: num,
+				Data:            bytes.Clone(stream.miniblocks[num]),
+			})
+		}
+		for _, slot := range stream.minipoolSlots() {
+			record := stream.minipool[slot]
+			result.Events = append(result.Events, EventDescriptor{
+				Generation: record.generation,
+				Slot:       slot,
+				Data:       bytes.Clone(record.envelope),
+			})
+		}
+		for key, data := range stream.candidates {
+			result.MbCandidates = append(result.MbCandidates, MiniblockDescriptor{
+				MiniblockNumber: key.num,
+				Data:            bytes.Clone(data),
+				Hash:            key.hash,
+			})
+		}
+		slices.SortFunc(result.MbCandidates, func(a, b MiniblockDescriptor) int {
+			return int(a.MiniblockNumber - b.MiniblockNumber)
+		})
+		return nil
+	}, "streamId", streamId)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package storage
+
+import (
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// memTestHooks corrupts the in-memory store by modifying its maps directly.
+func memTestHooks(store *MemEventStore) *storageTestHooks {
+	update := func(streamId StreamId, f func(stream *memStream)) {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		if stream, ok := store.streams[streamId]; ok {
+			f(stream)
+		}
+	}
+	return &storageTestHooks{
+		setMinipoolGeneration: func(streamId StreamId, slot int, generation int64) {
+			update(streamId, func(stream *memStream) {
+				if record, ok := stream.minipool[int64(slot)]; ok {
+					record.generation = generation
+				}
+			})
+		},
+		deleteMinipoolSlot: func(streamId StreamId, slot int) {
+			update(streamId, func(stream *memStream) { delete(stream.minipool, int64(slot)) })
+		},
+		deleteMiniblock: func(streamId StreamId, num int64) {
+			update(streamId, func(stream *memStream) { delete(stream.miniblocks, num) })
+		},
+		deleteMiniblocks: func(streamId StreamId) {
+			update(streamId, func(stream *memStream) { clear(stream.miniblocks) })
+		},
+	}
+}
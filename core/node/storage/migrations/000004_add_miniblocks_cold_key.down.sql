@@ -0,0 +1,2 @@
+ALTER TABLE miniblocks ALTER COLUMN blockdata SET NOT NULL;
+ALTER TABLE miniblocks DROP COLUMN IF EXISTS cold_key;
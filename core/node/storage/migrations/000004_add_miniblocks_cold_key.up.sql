@@ -0,0 +1,4 @@
+-- Miniblocks moved to the cold storage keep their row with blockdata set to NULL
+-- and cold_key pointing to the cold storage object.
+ALTER TABLE miniblocks ADD COLUMN IF NOT EXISTS cold_key CHAR(64);
+ALTER TABLE miniblocks ALTER COLUMN blockdata DROP NOT NULL;
@@ -0,0 +1,10 @@
+-- event_index maps hashes of events in stored miniblocks to their position in the stream.
+CREATE TABLE IF NOT EXISTS event_index (
+  event_hash CHAR(64) NOT NULL,
+  stream_id CHAR(64) NOT NULL,
+  seq_num BIGINT NOT NULL,
+  event_index INT NOT NULL,
+  PRIMARY KEY (event_hash)
+  );
+
+CREATE INDEX IF NOT EXISTS event_index_stream_id_seq_num_idx ON event_index (stream_id, seq_num);
@@ -0,0 +1,9 @@
+-- sync_sessions keeps the state of streams sync sessions, so clients can resume them after reconnecting.
+CREATE TABLE IF NOT EXISTS sync_sessions (
+  sync_id VARCHAR(64) NOT NULL,
+  session BYTEA NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (sync_id)
+  );
+
+CREATE INDEX IF NOT EXISTS sync_sessions_expires_at_idx ON sync_sessions (expires_at);
@@ -0,0 +1 @@
+ALTER TABLE es DROP COLUMN IF EXISTS event_index_backfill_from;
@@ -0,0 +1,5 @@
+-- event_index_backfill_from is the number of the next miniblock of the stream to add to the event index.
+-- Streams created before the event index are indexed in the background,
+-- the column is NULL for streams that are indexed when miniblocks are written.
+ALTER TABLE es ADD COLUMN IF NOT EXISTS event_index_backfill_from BIGINT DEFAULT 0;
+ALTER TABLE es ALTER COLUMN event_index_backfill_from SET DEFAULT NULL;
@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/crypto"
+	"github.com/river-build/river/core/node/dlog"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// PrunableStreamStorage is a StreamStorage that can list all locally stored streams.
+type PrunableStreamStorage interface {
+	StreamStorage
+
+	GetStreams(ctx context.Context) ([]StreamId, error)
+}
+
+// MiniblockPruner periodically deletes miniblocks that are outside of the retention window
+// configured on-chain for the stream type. Pruned miniblocks are only available from archive nodes,
+// so the pruner must not run in archive mode.
+//
+// Stream is always pruned up to a miniblock with a snapshot, so it can still be loaded from local storage.
+type MiniblockPruner struct {
+	store         PrunableStreamStorage
+	onChainConfig crypto.OnChainConfiguration
+	interval      time.Duration
+
+	prunedStreams    prometheus.Counter
+	prunedMiniblocks prometheus.Counter
+	failedStreams    prometheus.Counter
+	runDuration      prometheus.Histogram
+}
+
+func NewMiniblockPruner(
+	store PrunableStreamStorage,
+	onChainConfig crypto.OnChainConfiguration,
+	interval time.Duration,
+	metrics infra.MetricsFactory,
+) *MiniblockPruner {
+	return &MiniblockPruner{
+		store:         store,
+		onChainConfig: onChainConfig,
+		interval:      interval,
+		prunedStreams: metrics.NewCounterEx(
+			"miniblock_pruner_pruned_streams",
+			"Number of times historical miniblocks were pruned from a stream",
+		),
+		prunedMiniblocks: metrics.NewCounterEx(
+			"miniblock_pruner_pruned_miniblocks",
+			"Number of miniblocks deleted by the pruner",
+		),
+		failedStreams: metrics.NewCounterEx(
+			"miniblock_pruner_failed_streams",
+			"Number of streams that failed to be pruned",
+		),
+		runDuration: metrics.NewHistogramEx(
+			"miniblock_pruner_run_duration_seconds",
+			"Duration of pruning all local streams",
+			prometheus.DefBuckets,
+		),
+	}
+}
+
+// Start runs the pruner in the background until ctx is cancelled.
+func (p *MiniblockPruner) Start(ctx context.Context) {
+	go p.run(ctx)
+}
+
+func (p *MiniblockPruner) run(ctx context.Context) {
+	log := dlog.FromCtx(ctx)
+
+	for {
+		select {
+		case <-time.After(p.interval):
+			if err := p.PruneStreams(ctx); err != nil && ctx.Err() == nil {
+				log.Error("MiniblockPruner: failed to prune streams", "error", err)
+			}
+		case <-ctx.Done():
+			log.Debug("MiniblockPruner: shutdown")
+			return
+		}
+	}
+}
+
+// PruneStreams prunes all local streams once according to the current retention settings.
+func (p *MiniblockPruner) PruneStreams(ctx context.Context) error {
+	log := dlog.FromCtx(ctx)
+	start := time.Now()
+
+	retention := p.onChainConfig.Get().Retention
+	if retention == (crypto.StreamRetentionSettings{}) {
+		return nil
+	}
+
+	streamIds, err := p.store.GetStreams(ctx)
+	if err != nil {
+		return err
+	}
+
+	pruned := 0
+	for _, streamId := range streamIds {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		snapshots, age := retention.ForType(streamId.Type())
+		ok, err := p.PruneStream(ctx, streamId, snapshots, age, start)
+		if err != nil {
+			p.failedStreams.Inc()
+			log.Warn("MiniblockPruner: failed to prune stream", "streamId", streamId, "error", err)
+			continue
+		}
+		if ok {
+			p.prunedStreams.Inc()
+			pruned++
+		}
+	}
+
+	p.runDuration.Observe(time.Since(start).Seconds())
+	log.Info(
+		"MiniblockPruner: pruned streams",
+		"totalStreams", len(streamIds),
+		"prunedStreams", pruned,
+		"duration", time.Since(start),
+	)
+	return nil
+}
+
+// PruneStream deletes miniblocks of the given stream that are older than the retention window.
+// At least the given number of snapshots is kept and only miniblocks older than now - age are deleted.
+// If both snapshots and age are 0, nothing is pruned.
+// Returns true if miniblocks were pruned.
+func (p *MiniblockPruner) PruneStream(
+	ctx context.Context,
+	streamId StreamId,
+	snapshots uint64,
+	age time.Duration,
+	now time.Time,
+) (bool, error) {
+	if snapshots == 0 && age == 0 {
+		return false, nil
+	}
+
+	res, err := p.store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	if err != nil {
+		return false, err
+	}
+	if len(res.Miniblocks) == 0 {
+		return false, nil
+	}
+
+	// Walk snapshots backwards until the oldest snapshot that needs to be kept is found.
+	num := res.StartMiniblockNumber
+	header, err := parseMiniblockHeader(res.Miniblocks[0])
+	if err != nil {
+		return false, err
+	}
+	for kept := uint64(1); ; kept++ {
+		if kept >= snapshots && (age == 0 || !header.GetTimestamp().AsTime().After(now.Add(-age))) {
+			break
+		}
+		prev := header.GetPrevSnapshotMiniblockNum()
+		if num == 0 || prev >= num {
+			return false, nil
+		}
+		miniblocks, err := p.store.ReadMiniblocks(ctx, streamId, prev, prev+1)
+		if err != nil {
+			if AsRiverError(err).Code == Err_MINIBLOCKS_PRUNED {
+				// Everything that can be pruned is already pruned.
+				return false, nil
+			}
+			return false, err
+		}
+		if len(miniblocks) != 1 {
+			return false, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Snapshot miniblock not found").
+				Tag("miniblockNum", prev)
+		}
+		header, err = parseMiniblockHeader(miniblocks[0])
+		if err != nil {
+			return false, err
+		}
+		num = prev
+	}
+
+	if num <= 0 {
+		return false, nil
+	}
+
+	prunedCount, err := p.store.PruneMiniblocks(ctx, streamId, num)
+	if err != nil {
+		return false, err
+	}
+	p.prunedMiniblocks.Add(float64(prunedCount))
+	return prunedCount > 0, nil
+}
+
+func parseMiniblockHeader(data []byte) (*MiniblockHeader, error) {
+	var mb Miniblock
+	if err := proto.Unmarshal(data, &mb); err != nil {
+		return nil, AsRiverError(err, Err_BAD_BLOCK).Message("Failed to decode miniblock")
+	}
+	var event StreamEvent
+	if err := proto.Unmarshal(mb.GetHeader().GetEvent(), &event); err != nil {
+		return nil, AsRiverError(err, Err_BAD_BLOCK).Message("Failed to decode miniblock header event")
+	}
+	header := event.GetMiniblockHeader()
+	if header == nil {
+		return nil, RiverError(Err_BAD_BLOCK, "Miniblock header is missing")
+	}
+	return header, nil
+}
+
+func miniblocksPrunedError(streamId StreamId, fromInclusive int64, firstAvailable int64) error {
+	return RiverError(
+		Err_MINIBLOCKS_PRUNED,
+		"Miniblocks are pruned on this node, read them from an archive node",
+		"streamId", streamId,
+		"fromInclusive", fromInclusive,
+		"firstAvailableMiniblock", firstAvailable,
+	)
+}
@@ -0,0 +1,204 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/infra"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func makePrunerTestMiniblock(t *testing.T, num int64, prevSnapshot int64, snapshot bool, ts time.Time) []byte {
+	header := &MiniblockHeader{
+		MiniblockNum:             num,
+		Timestamp:                timestamppb.New(ts),
+		PrevSnapshotMiniblockNum: prevSnapshot,
+	}
+	if snapshot {
+		header.Snapshot = &Snapshot{}
+	}
+	event, err := proto.Marshal(&StreamEvent{Payload: &StreamEvent_MiniblockHeader{MiniblockHeader: header}})
+	require.NoError(t, err)
+	mb, err := proto.Marshal(&Miniblock{Header: &Envelope{Event: event}})
+	require.NoError(t, err)
+	return mb
+}
+
+// writePrunerTestStream creates stream with 10 miniblocks, one hour apart, with snapshots at 0, 3, 6 and 9.
+func writePrunerTestStream(t *testing.T, ctx context.Context, store StreamStorage, start time.Time) StreamId {
+	require := require.New(t)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, streamId, makePrunerTestMiniblock(t, 0, 0, true, start)))
+
+	prevSnapshot := int64(0)
+	for i := int64(1); i < 10; i++ {
+		snapshot := i%3 == 0
+		hash := common.BytesToHash([]byte{byte(i)})
+		mb := makePrunerTestMiniblock(t, i, prevSnapshot, snapshot, start.Add(time.Duration(i)*time.Hour))
+		require.NoError(store.WriteBlockProposal(ctx, streamId, hash, i, mb))
+		require.NoError(store.PromoteBlock(ctx, streamId, i, hash, snapshot, nil))
+		if snapshot {
+			prevSnapshot = i
+		}
+	}
+	return streamId
+}
+
+func TestMiniblockPruner(t *testing.T) {
+	runStorageTests(t, func(t *testing.T, ctx context.Context, store testStreamStore, hooks *storageTestHooks) {
+		require := require.New(t)
+
+		start := time.Now().Add(-24 * time.Hour)
+		streamId := writePrunerTestStream(t, ctx, store, start)
+		pruner := NewMiniblockPruner(store, nil, time.Hour, infra.NewMetricsFactory(nil, "", ""))
+
+		// Retention is disabled.
+		pruned, err := pruner.PruneStream(ctx, streamId, 0, 0, time.Now())
+		require.NoError(err)
+		require.False(pruned)
+
+		// Keep two last snapshots.
+		pruned, err = pruner.PruneStream(ctx, streamId, 2, 0, time.Now())
+		require.NoError(err)
+		require.True(pruned)
+
+		_, err = store.ReadMiniblocks(ctx, streamId, 0, 5)
+		require.Equal(Err_MINIBLOCKS_PRUNED, AsRiverError(err).Code)
+		require.EqualValues(6, AsRiverError(err).GetTag("firstAvailableMiniblock"))
+
+		mbs, err := store.ReadMiniblocks(ctx, streamId, 6, 10)
+		require.NoError(err)
+		require.Len(mbs, 4)
+
+		pruned, err = pruner.PruneStream(ctx, streamId, 2, 0, time.Now())
+		require.NoError(err)
+		require.False(pruned)
+
+		// Latest snapshot is not old enough.
+		pruned, err = pruner.PruneStream(ctx, streamId, 1, 2*time.Hour, start.Add(10*time.Hour))
+		require.NoError(err)
+		require.False(pruned)
+
+		pruned, err = pruner.PruneStream(ctx, streamId, 1, time.Hour, start.Add(10*time.Hour))
+		require.NoError(err)
+		require.True(pruned)
+
+		_, err = store.ReadMiniblocks(ctx, streamId, 6, 10)
+		require.Equal(Err_MINIBLOCKS_PRUNED, AsRiverError(err).Code)
+
+		// Stream can still be loaded.
+		res, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+		require.NoError(err)
+		require.EqualValues(9, res.StartMiniblockNumber)
+		require.Len(res.Miniblocks, 1)
+
+		// Can't prune after the latest snapshot.
+		_, err = store.PruneMiniblocks(ctx, streamId, 10)
+		require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+	})
+}
+
+func TestCreateStreamStorageFromSnapshot(t *testing.T) {
+	runStorageTests(t, func(t *testing.T, ctx context.Context, store testStreamStore, hooks *storageTestHooks) {
+		require := require.New(t)
+
+		start := time.Now().Add(-24 * time.Hour)
+		streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		require.NoError(store.CreateStreamStorageFromSnapshot(
+			ctx,
+			streamId,
+			6,
+			makePrunerTestMiniblock(t, 6, 3, true, start),
+		))
+
+		err := store.CreateStreamStorageFromSnapshot(ctx, streamId, 6, makePrunerTestMiniblock(t, 6, 3, true, start))
+		require.Equal(Err_ALREADY_EXISTS, AsRiverError(err).Code)
+
+		// Stream is read as if the preceding miniblocks were pruned.
+		res, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+		require.NoError(err)
+		require.EqualValues(6, res.StartMiniblockNumber)
+		require.Len(res.Miniblocks, 1)
+
+		_, err = store.ReadMiniblocks(ctx, streamId, 0, 7)
+		require.Equal(Err_MINIBLOCKS_PRUNED, AsRiverError(err).Code)
+
+		// Minipool starts at the next generation.
+		hash := common.BytesToHash([]byte{7})
+		mb := makePrunerTestMiniblock(t, 7, 6, false, start.Add(time.Hour))
+		require.NoError(store.WriteEvent(ctx, streamId, 7, 0, []byte("event")))
+		require.NoError(store.WriteBlockProposal(ctx, streamId, hash, 7, mb))
+		require.NoError(store.PromoteBlock(ctx, streamId, 7, hash, false, nil))
+
+		mbs, err := store.ReadMiniblocks(ctx, streamId, 6, 10)
+		require.NoError(err)
+		require.Len(mbs, 2)
+	})
+}
+
+func TestReplaceStreamStorage(t *testing.T) {
+	runStorageTests(t, func(t *testing.T, ctx context.Context, store testStreamStore, hooks *storageTestHooks) {
+		require := require.New(t)
+
+		start := time.Now().Add(-24 * time.Hour)
+		streamId := writePrunerTestStream(t, ctx, store, start)
+		require.NoError(store.WriteEvent(ctx, streamId, 10, 0, []byte("event")))
+
+		// Miniblocks must start with a snapshot and be sequential, invalid input keeps the stream as is.
+		err := store.ReplaceStreamStorage(ctx, streamId, []*WriteMiniblockData{
+			{Number: 7, Data: makePrunerTestMiniblock(t, 7, 6, false, start)},
+		}, nil)
+		require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+		err = store.ReplaceStreamStorage(ctx, streamId, []*WriteMiniblockData{
+			{Number: 6, Snapshot: true, Data: makePrunerTestMiniblock(t, 6, 3, true, start)},
+			{Number: 8, Data: makePrunerTestMiniblock(t, 8, 6, false, start)},
+		}, nil)
+		require.Equal(Err_INVALID_ARGUMENT, AsRiverError(err).Code)
+		mbs, err := store.ReadMiniblocks(ctx, streamId, 0, 10)
+		require.NoError(err)
+		require.Len(mbs, 10)
+
+		// Existing stream is replaced, minipool is replaced with the given envelopes.
+		replacement := []*WriteMiniblockData{
+			{Number: 6, Snapshot: true, Data: makePrunerTestMiniblock(t, 6, 3, true, start)},
+			{Number: 7, Data: makePrunerTestMiniblock(t, 7, 6, false, start)},
+			{Number: 8, Snapshot: true, Data: makePrunerTestMiniblock(t, 8, 6, true, start)},
+			{Number: 9, Data: makePrunerTestMiniblock(t, 9, 8, false, start)},
+		}
+		require.NoError(store.ReplaceStreamStorage(ctx, streamId, replacement, [][]byte{[]byte("event")}))
+
+		res, err := store.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+		require.NoError(err)
+		require.EqualValues(8, res.StartMiniblockNumber)
+		require.Len(res.Miniblocks, 2)
+		require.Equal([][]byte{[]byte("event")}, res.MinipoolEnvelopes)
+
+		_, err = store.ReadMiniblocks(ctx, streamId, 0, 10)
+		require.Equal(Err_MINIBLOCKS_PRUNED, AsRiverError(err).Code)
+		mbs, err = store.ReadMiniblocks(ctx, streamId, 6, 10)
+		require.NoError(err)
+		require.Len(mbs, 4)
+		require.Equal(replacement[1].Data, mbs[1])
+
+		// Missing stream is created.
+		newStreamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+		require.NoError(store.ReplaceStreamStorage(ctx, newStreamId, []*WriteMiniblockData{
+			{Number: 0, Data: makePrunerTestMiniblock(t, 0, 0, true, start)},
+			{Number: 1, Data: makePrunerTestMiniblock(t, 1, 0, false, start)},
+		}, nil))
+		lastMbNum, err := store.GetLastMiniblockNumber(ctx, newStreamId)
+		require.NoError(err)
+		require.EqualValues(1, lastMbNum)
+		require.NoError(store.WriteEvent(ctx, newStreamId, 2, 0, []byte("event")))
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"math"
 	"os"
 	"strings"
 	"time"
@@ -50,6 +51,13 @@ type PostgresEventStore struct {
 
 	txCounter  *infra.StatusCounterVec
 	txDuration *prometheus.HistogramVec
+
+	// coldStorage keeps miniblocks moved out of the miniblocks table, nil if cold tier is disabled.
+	coldStorage         ColdStorage
+	coldMovedMiniblocks prometheus.Counter
+	coldReadMiniblocks  prometheus.Counter
+
+	codec *dataCodec
 }
 
 var _ StreamStorage = (*PostgresEventStore)(nil)
@@ -237,37 +245,59 @@ func (s *PostgresEventStore) CreateStreamStorage(
 		"CreateStreamStorage",
 		pgx.ReadWrite,
 		func(ctx context.Context, tx pgx.Tx) error {
-			return s.createStreamStorageTx(ctx, tx, streamId, genesisMiniblock)
+			return s.createStreamStorageTx(ctx, tx, streamId, 0, genesisMiniblock)
 		},
 		nil,
 		"streamId", streamId,
 	)
 }
 
+func (s *PostgresEventStore) CreateStreamStorageFromSnapshot(
+	ctx context.Context,
+	streamId StreamId,
+	snapshotMiniblockNum int64,
+	snapshotMiniblock []byte,
+) error {
+	return s.txRunner(
+		ctx,
+		"CreateStreamStorageFromSnapshot",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.createStreamStorageTx(ctx, tx, streamId, snapshotMiniblockNum, snapshotMiniblock)
+		},
+		nil,
+		"streamId", streamId,
+		"snapshotMiniblockNum", snapshotMiniblockNum,
+	)
+}
+
+// createStreamStorageTx creates a stream that starts with the given miniblock,
+// which is either the genesis miniblock or a snapshot miniblock.
 func (s *PostgresEventStore) createStreamStorageTx(
 	ctx context.Context,
 	tx pgx.Tx,
 	streamId StreamId,
-	genesisMiniblock []byte,
+	miniblockNum int64,
+	miniblock []byte,
 ) error {
 	tableSuffix := createTableSuffix(streamId)
 	sql := fmt.Sprintf(
-		`INSERT INTO es (stream_id, latest_snapshot_miniblock) VALUES ($1, 0);
+		`INSERT INTO es (stream_id, latest_snapshot_miniblock) VALUES ($1, $3);
 		CREATE TABLE miniblocks_%[1]s PARTITION OF miniblocks FOR VALUES IN ($1);
 		CREATE TABLE minipools_%[1]s PARTITION OF minipools FOR VALUES IN ($1);
 		CREATE TABLE miniblock_candidates_%[1]s PARTITION OF miniblock_candidates for values in ($1);
-		INSERT INTO miniblocks (stream_id, seq_num, blockdata) VALUES ($1, 0, $2);
-		INSERT INTO minipools (stream_id, generation, slot_num) VALUES ($1, 1, -1);`,
+		INSERT INTO miniblocks (stream_id, seq_num, blockdata) VALUES ($1, $3, $2);
+		INSERT INTO minipools (stream_id, generation, slot_num) VALUES ($1, $4, -1);`,
 		tableSuffix,
 	)
-	_, err := tx.Exec(ctx, sql, streamId, genesisMiniblock)
+	_, err := tx.Exec(ctx, sql, streamId, s.codec.encode(miniblock), miniblockNum, miniblockNum+1)
 	if err != nil {
 		if pgerr, ok := err.(*pgconn.PgError); ok && pgerr.Code == pgerrcode.UniqueViolation {
 			return WrapRiverError(Err_ALREADY_EXISTS, err).Message("stream already exists")
 		}
 		return err
 	}
-	return nil
+	return s.indexMiniblockEventsTx(ctx, tx, streamId, miniblockNum, miniblock)
 }
 
 func (s *PostgresEventStore) CreateStreamArchiveStorage(
@@ -404,7 +434,11 @@ func (s *PostgresEventStore) writeArchiveMiniblocksTx(
 			"INSERT INTO miniblocks (stream_id, seq_num, blockdata) VALUES ($1, $2, $3)",
 			streamId,
 			startMiniblockNum+int64(i),
-			miniblock)
+			s.codec.encode(miniblock))
+		if err != nil {
+			return err
+		}
+		err = s.indexMiniblockEventsTx(ctx, tx, streamId, startMiniblockNum+int64(i), miniblock)
 		if err != nil {
 			return err
 		}
@@ -495,6 +529,10 @@ func (s *PostgresEventStore) readStreamFromLastSnapshotTx(
 				"ActualSeqNum", seqNum,
 				"ExpectedSeqNum", latest_snapshot_miniblock_index+counter)
 		}
+		blockdata, err = s.codec.decode(blockdata)
+		if err != nil {
+			return nil, err
+		}
 		miniblocks = append(miniblocks, blockdata)
 		counter++
 	}
@@ -544,6 +582,10 @@ func (s *PostgresEventStore) readStreamFromLastSnapshotTx(
 				Tag("ActualGeneration", generation).
 				Tag("ExpectedGeneration", slotNum)
 		}
+		envelope, err = s.codec.decode(envelope)
+		if err != nil {
+			return nil, err
+		}
 		envelopes = append(envelopes, envelope)
 		slotNumsCounter++
 	}
@@ -632,7 +674,7 @@ func (s *PostgresEventStore) writeEventTx(
 		ctx,
 		"INSERT INTO minipools (stream_id, envelope, generation, slot_num) VALUES ($1, $2, $3, $4)",
 		streamId,
-		envelope,
+		s.codec.encode(envelope),
 		minipoolGeneration,
 		minipoolSlot,
 	)
@@ -654,13 +696,14 @@ func (s *PostgresEventStore) ReadMiniblocks(
 	toExclusive int64,
 ) ([][]byte, error) {
 	var miniblocks [][]byte
+	var coldKeys map[int]string
 	err := s.txRunner(
 		ctx,
 		"ReadMiniblocks",
 		pgx.ReadOnly,
 		func(ctx context.Context, tx pgx.Tx) error {
 			var err error
-			miniblocks, err = s.readMiniblocksTx(ctx, tx, streamId, fromInclusive, toExclusive)
+			miniblocks, coldKeys, err = s.readMiniblocksTx(ctx, tx, streamId, fromInclusive, toExclusive)
 			return err
 		},
 		nil,
@@ -669,6 +712,14 @@ func (s *PostgresEventStore) ReadMiniblocks(
 	if err != nil {
 		return nil, err
 	}
+
+	// Miniblocks in the cold storage are fetched outside of the transaction.
+	if len(coldKeys) > 0 {
+		err = s.readColdMiniblocks(ctx, miniblocks, coldKeys)
+		if err != nil {
+			return nil, AsRiverError(err).Tag("streamId", streamId)
+		}
+	}
 	return miniblocks, nil
 }
 
@@ -678,42 +729,141 @@ func (s *PostgresEventStore) readMiniblocksTx(
 	streamId StreamId,
 	fromInclusive int64,
 	toExclusive int64,
-) ([][]byte, error) {
+) ([][]byte, map[int]string, error) {
 	miniblocksRow, err := tx.Query(
 		ctx,
-		"SELECT blockdata, seq_num FROM miniblocks WHERE seq_num >= $1 AND seq_num < $2 AND stream_id = $3 ORDER BY seq_num",
+		"SELECT blockdata, cold_key, seq_num FROM miniblocks WHERE seq_num >= $1 AND seq_num < $2 AND stream_id = $3 ORDER BY seq_num",
 		fromInclusive,
 		toExclusive,
 		streamId,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer miniblocksRow.Close()
 
 	// Retrieve miniblocks starting from the latest miniblock with snapshot
 	var miniblocks [][]byte
+	// Indexes of miniblocks that are moved to the cold storage
+	var coldKeys map[int]string
 
 	var prevSeqNum int = -1 // There is no negative generation, so we use it as a flag on the first step of the loop during miniblocks sequence check
 	for miniblocksRow.Next() {
 		var blockdata []byte
+		var coldKey *string
 		var seq_num int
 
-		err = miniblocksRow.Scan(&blockdata, &seq_num)
+		err = miniblocksRow.Scan(&blockdata, &coldKey, &seq_num)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if (prevSeqNum != -1) && (seq_num != prevSeqNum+1) {
 			// There is a gap in sequence numbers
-			return nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
+			return nil, nil, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Miniblocks consistency violation").
 				Tag("ActualBlockNumber", seq_num).Tag("ExpectedBlockNumber", prevSeqNum+1).Tag("streamId", streamId)
 		}
 		prevSeqNum = seq_num
 
+		if blockdata == nil && coldKey != nil {
+			if coldKeys == nil {
+				coldKeys = make(map[int]string)
+			}
+			coldKeys[len(miniblocks)] = *coldKey
+		}
+		blockdata, err = s.codec.decode(blockdata)
+		if err != nil {
+			return nil, nil, err
+		}
 		miniblocks = append(miniblocks, blockdata)
 	}
-	return miniblocks, nil
+
+	// If beginning of the requested range is missing, check if it was pruned.
+	if fromInclusive < toExclusive && (len(miniblocks) == 0 || int64(prevSeqNum-len(miniblocks)+1) > fromInclusive) {
+		var firstSeqNum *int64
+		err = tx.QueryRow(ctx, "SELECT MIN(seq_num) FROM miniblocks WHERE stream_id = $1", streamId).
+			Scan(&firstSeqNum)
+		if err != nil {
+			return nil, nil, err
+		}
+		if firstSeqNum != nil && *firstSeqNum > max(0, fromInclusive) {
+			return nil, nil, miniblocksPrunedError(streamId, fromInclusive, *firstSeqNum)
+		}
+	}
+	return miniblocks, coldKeys, nil
+}
+
+// PruneMiniblocks deletes miniblocks with numbers lower than pruneBelow
+// together with their objects in the cold storage.
+func (s *PostgresEventStore) PruneMiniblocks(ctx context.Context, streamId StreamId, pruneBelow int64) (int64, error) {
+	var pruned int64
+	var coldKeys []string
+	err := s.txRunner(
+		ctx,
+		"PruneMiniblocks",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			pruned, coldKeys, err = s.pruneMiniblocksTx(ctx, tx, streamId, pruneBelow)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+		"pruneBelow", pruneBelow,
+	)
+	if err != nil {
+		return 0, err
+	}
+	s.deleteColdMiniblocks(ctx, streamId, coldKeys)
+	return pruned, nil
+}
+
+func (s *PostgresEventStore) pruneMiniblocksTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	pruneBelow int64,
+) (int64, []string, error) {
+	var latestSnapshotMiniblock int64
+	err := tx.
+		QueryRow(ctx, "SELECT latest_snapshot_miniblock FROM es WHERE stream_id = $1 FOR UPDATE", streamId).
+		Scan(&latestSnapshotMiniblock)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, nil, WrapRiverError(Err_NOT_FOUND, err).Message("stream not found in local storage")
+		}
+		return 0, nil, err
+	}
+	if pruneBelow > latestSnapshotMiniblock {
+		return 0, nil, RiverError(Err_INVALID_ARGUMENT, "Can't prune miniblocks after the latest snapshot").
+			Tag("latestSnapshotMiniblock", latestSnapshotMiniblock)
+	}
+
+	coldKeys, err := s.readColdKeysTx(ctx, tx, streamId, pruneBelow)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		"DELETE FROM miniblocks WHERE stream_id = $1 AND seq_num < $2",
+		streamId,
+		pruneBelow,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		"DELETE FROM event_index WHERE stream_id = $1 AND seq_num < $2",
+		streamId,
+		pruneBelow,
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+	return tag.RowsAffected(), coldKeys, nil
 }
 
 // WriteBlockProposal adds a miniblock proposal candidate. When the miniblock is finalized, the node will promote the
@@ -772,7 +922,7 @@ func (s *PostgresEventStore) writeBlockProposalTxn(
 		streamId,
 		blockNumber,
 		hex.EncodeToString(blockHash.Bytes()), // avoid leading '0x'
-		miniblock,
+		s.codec.encode(miniblock),
 	)
 	return err
 }
@@ -822,7 +972,7 @@ func (s *PostgresEventStore) readMiniblockCandidateTx(
 	if err != nil {
 		return nil, err
 	}
-	return miniblock, nil
+	return s.codec.decode(miniblock)
 }
 
 func (s *PostgresEventStore) PromoteBlock(
@@ -918,7 +1068,7 @@ func (s *PostgresEventStore) promoteBlockTxn(
 			streamId,
 			i,
 			minipoolGeneration+1,
-			envelope,
+			s.codec.encode(envelope),
 		)
 		if err != nil {
 			return err
@@ -926,19 +1076,29 @@ func (s *PostgresEventStore) promoteBlockTxn(
 	}
 
 	// promote miniblock candidate into miniblocks table
-	tag, err := tx.Exec(
+	// Exactly one row should be copied. (stream_id, seq_num, blockhash) is a unique key, so we expect 0 or 1 copies.
+	var blockdata []byte
+	err = tx.QueryRow(
 		ctx,
-		"INSERT INTO miniblocks SELECT stream_id, seq_num, blockdata FROM miniblock_candidates WHERE stream_id = $1 AND seq_num = $2 AND miniblock_candidates.block_hash = $3",
+		"INSERT INTO miniblocks SELECT stream_id, seq_num, blockdata FROM miniblock_candidates WHERE stream_id = $1 AND seq_num = $2 AND miniblock_candidates.block_hash = $3 RETURNING blockdata",
 		streamId,
 		minipoolGeneration,
 		hex.EncodeToString(candidateBlockHash.Bytes()), // avoid leading '0x'
-	)
+	).Scan(&blockdata)
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return RiverError(Err_NOT_FOUND, "No candidate block found")
+		}
 		return err
 	}
-	// Exactly one row should be copied. (stream_id, seq_num, blockhash) is a unique key, so we expect 0 or 1 copies.
-	if tag.RowsAffected() < 1 {
-		return RiverError(Err_NOT_FOUND, "No candidate block found")
+
+	miniblock, err := s.codec.decode(blockdata)
+	if err != nil {
+		return err
+	}
+	err = s.indexMiniblockEventsTx(ctx, tx, streamId, minipoolGeneration, miniblock)
+	if err != nil {
+		return err
 	}
 
 	// clean up miniblock proposals for stream id
@@ -951,6 +1111,248 @@ func (s *PostgresEventStore) promoteBlockTxn(
 	return err
 }
 
+func (s *PostgresEventStore) GetLastMiniblockNumber(ctx context.Context, streamId StreamId) (int64, error) {
+	var lastMiniblockNumber int64
+	err := s.txRunner(
+		ctx,
+		"GetLastMiniblockNumber",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.getLastMiniblockNumberTx(ctx, tx, streamId, &lastMiniblockNumber)
+		},
+		&txRunnerOpts{skipLoggingNotFound: true},
+		"streamId", streamId,
+	)
+	if err != nil {
+		return -1, err
+	}
+	return lastMiniblockNumber, nil
+}
+
+func (s *PostgresEventStore) getLastMiniblockNumberTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	lastMiniblockNumber *int64,
+) error {
+	var seqNum *int64
+	err := tx.QueryRow(ctx, "SELECT MAX(seq_num) FROM miniblocks WHERE stream_id = $1", streamId).Scan(&seqNum)
+	if err != nil {
+		return err
+	}
+	if seqNum == nil {
+		return RiverError(Err_NOT_FOUND, "stream not found in local storage", "streamId", streamId)
+	}
+	*lastMiniblockNumber = *seqNum
+	return nil
+}
+
+func (s *PostgresEventStore) WriteMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolEnvelopes [][]byte,
+) error {
+	if len(miniblocks) == 0 {
+		return RiverError(Err_INVALID_ARGUMENT, "no miniblocks to write", "streamId", streamId)
+	}
+	return s.txRunner(
+		ctx,
+		"WriteMiniblocks",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.writeMiniblocksTx(ctx, tx, streamId, miniblocks, newMinipoolEnvelopes)
+		},
+		nil,
+		"streamId", streamId,
+		"firstMiniblockNum", miniblocks[0].Number,
+		"numMiniblocks", len(miniblocks),
+	)
+}
+
+func (s *PostgresEventStore) writeMiniblocksTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolEnvelopes [][]byte,
+) error {
+	var generation int64
+	err := tx.QueryRow(
+		ctx,
+		"SELECT generation FROM minipools WHERE slot_num = -1 AND stream_id = $1 FOR UPDATE",
+		streamId,
+	).Scan(&generation)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return WrapRiverError(Err_NOT_FOUND, err).Message("stream not found in local storage")
+		}
+		return err
+	}
+
+	var lastMiniblockNum int64
+	if err := s.getLastMiniblockNumberTx(ctx, tx, streamId, &lastMiniblockNum); err != nil {
+		return err
+	}
+	if lastMiniblockNum+1 != generation {
+		return RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Minipool generation mismatch").
+			Tag("ExpectedNewMinipoolGeneration", lastMiniblockNum+1).Tag("ActualNewMinipoolGeneration", generation)
+	}
+
+	latestSnapshot := int64(-1)
+	for i, mb := range miniblocks {
+		if mb.Number != generation+int64(i) {
+			return RiverError(
+				Err_DB_OPERATION_FAILURE,
+				"miniblock sequence number mismatch",
+				"expectedMiniblockNum", generation+int64(i),
+				"actualMiniblockNum", mb.Number,
+				"streamId", streamId,
+			)
+		}
+		_, err := tx.Exec(
+			ctx,
+			"INSERT INTO miniblocks (stream_id, seq_num, blockdata) VALUES ($1, $2, $3)",
+			streamId,
+			mb.Number,
+			s.codec.encode(mb.Data),
+		)
+		if err != nil {
+			return err
+		}
+		err = s.indexMiniblockEventsTx(ctx, tx, streamId, mb.Number, mb.Data)
+		if err != nil {
+			return err
+		}
+		if mb.Snapshot {
+			latestSnapshot = mb.Number
+		}
+	}
+
+	if latestSnapshot >= 0 {
+		_, err := tx.Exec(
+			ctx,
+			`UPDATE es SET latest_snapshot_miniblock = $1 WHERE stream_id = $2`,
+			latestSnapshot,
+			streamId,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	newGeneration := miniblocks[len(miniblocks)-1].Number + 1
+
+	// replace minipool with the given envelopes at the new generation
+	_, err = tx.Exec(ctx, "DELETE FROM minipools WHERE slot_num > -1 AND stream_id = $1", streamId)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(
+		ctx,
+		"UPDATE minipools SET generation = $1 WHERE slot_num = -1 AND stream_id = $2",
+		newGeneration,
+		streamId,
+	)
+	if err != nil {
+		return err
+	}
+	for i, envelope := range newMinipoolEnvelopes {
+		_, err = tx.Exec(
+			ctx,
+			"INSERT INTO minipools (stream_id, slot_num, generation, envelope) VALUES ($1, $2, $3, $4)",
+			streamId,
+			i,
+			newGeneration,
+			s.codec.encode(envelope),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// candidates for the written miniblocks are obsolete
+	_, err = tx.Exec(
+		ctx,
+		"DELETE FROM miniblock_candidates WHERE stream_id = $1 and seq_num < $2",
+		streamId,
+		newGeneration,
+	)
+	return err
+}
+
+func (s *PostgresEventStore) ReplaceStreamStorage(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolEnvelopes [][]byte,
+) error {
+	if err := validateReplaceMiniblocks(streamId, miniblocks); err != nil {
+		return err
+	}
+	var coldKeys []string
+	err := s.txRunner(
+		ctx,
+		"ReplaceStreamStorage",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			coldKeys, err = s.replaceStreamStorageTx(ctx, tx, streamId, miniblocks, newMinipoolEnvelopes)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+		"firstMiniblockNum", miniblocks[0].Number,
+		"numMiniblocks", len(miniblocks),
+	)
+	if err != nil {
+		return err
+	}
+	s.deleteColdMiniblocks(ctx, streamId, coldKeys)
+	return nil
+}
+
+func (s *PostgresEventStore) replaceStreamStorageTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	miniblocks []*WriteMiniblockData,
+	newMinipoolEnvelopes [][]byte,
+) ([]string, error) {
+	var exists bool
+	err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM es WHERE stream_id = $1)", streamId).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	var coldKeys []string
+	if exists {
+		if coldKeys, err = s.deleteStreamTx(ctx, tx, streamId); err != nil {
+			return nil, err
+		}
+		coldKeys = s.excludeColdKeysOfMiniblocks(coldKeys, miniblocks)
+	}
+	if err := s.createStreamStorageTx(ctx, tx, streamId, miniblocks[0].Number, miniblocks[0].Data); err != nil {
+		return nil, err
+	}
+	if len(miniblocks) > 1 {
+		return coldKeys, s.writeMiniblocksTx(ctx, tx, streamId, miniblocks[1:], newMinipoolEnvelopes)
+	}
+	for i, envelope := range newMinipoolEnvelopes {
+		_, err = tx.Exec(
+			ctx,
+			"INSERT INTO minipools (stream_id, slot_num, generation, envelope) VALUES ($1, $2, $3, $4)",
+			streamId,
+			i,
+			miniblocks[0].Number+1,
+			s.codec.encode(envelope),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return coldKeys, nil
+}
+
 func (s *PostgresEventStore) GetStreamsNumber(ctx context.Context) (int, error) {
 	var count int
 	err := s.txRunner(
@@ -1076,29 +1478,48 @@ func (s *PostgresEventStore) getStreamsTx(ctx context.Context, tx pgx.Tx) ([]Str
 }
 
 func (s *PostgresEventStore) DeleteStream(ctx context.Context, streamId StreamId) error {
-	return s.txRunner(
+	var coldKeys []string
+	err := s.txRunner(
 		ctx,
 		"DeleteStream",
 		pgx.ReadWrite,
 		func(ctx context.Context, tx pgx.Tx) error {
-			return s.deleteStreamTx(ctx, tx, streamId)
+			var err error
+			coldKeys, err = s.deleteStreamTx(ctx, tx, streamId)
+			return err
 		},
 		nil,
 		"streamId", streamId,
 	)
+	if err != nil {
+		return err
+	}
+	s.deleteColdMiniblocks(ctx, streamId, coldKeys)
+	return nil
 }
 
-func (s *PostgresEventStore) deleteStreamTx(ctx context.Context, tx pgx.Tx, streamId StreamId) error {
-	_, err := tx.Exec(
+// deleteStreamTx deletes the stream and returns cold storage keys of its miniblocks
+// that should be deleted after the transaction is committed.
+func (s *PostgresEventStore) deleteStreamTx(ctx context.Context, tx pgx.Tx, streamId StreamId) ([]string, error) {
+	coldKeys, err := s.readColdKeysTx(ctx, tx, streamId, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(
 		ctx,
 		fmt.Sprintf(
 			`DROP TABLE miniblocks_%[1]s;
 			DROP TABLE minipools_%[1]s;
+			DROP TABLE IF EXISTS miniblock_candidates_%[1]s;
+			DELETE FROM event_index WHERE stream_id = $1;
 			DELETE FROM es WHERE stream_id = $1`,
 			createTableSuffix(streamId),
 		),
 		streamId)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return coldKeys, nil
 }
 
 func DbSchemaNameFromAddress(address string) string {
@@ -1259,6 +1680,15 @@ func newPostgresEventStore(
 			infra.DefaultDurationBucketsSeconds,
 			"name",
 		),
+		coldMovedMiniblocks: metrics.NewCounterEx(
+			"cold_storage_moved_miniblocks",
+			"Number of miniblocks moved from the database to the cold storage",
+		),
+		coldReadMiniblocks: metrics.NewCounterEx(
+			"cold_storage_read_miniblocks",
+			"Number of miniblocks read from the cold storage",
+		),
+		codec: newDataCodec(metrics),
 	}
 
 	err := store.InitStorage(ctx)
@@ -1582,6 +2012,15 @@ func (s *PostgresEventStore) DebugReadStreamData(
 	if err != nil {
 		return nil, err
 	}
+
+	for i := range ret.Miniblocks {
+		if mb := &ret.Miniblocks[i]; mb.Data == nil && mb.ColdKey != "" {
+			mb.Data, err = s.readColdMiniblock(ctx, mb.ColdKey)
+			if err != nil {
+				return nil, AsRiverError(err).Tag("streamId", streamId).Tag("miniblockNum", mb.MiniblockNumber)
+			}
+		}
+	}
 	return ret, nil
 }
 
@@ -1607,7 +2046,7 @@ func (s *PostgresEventStore) debugReadStreamData(
 
 	miniblocksRow, err := tx.Query(
 		ctx,
-		"SELECT seq_num, blockdata FROM miniblocks WHERE stream_id = $1 ORDER BY seq_num",
+		"SELECT seq_num, blockdata, cold_key FROM miniblocks WHERE stream_id = $1 ORDER BY seq_num",
 		streamId,
 	)
 	if err != nil {
@@ -1617,8 +2056,16 @@ func (s *PostgresEventStore) debugReadStreamData(
 
 	for miniblocksRow.Next() {
 		var mb MiniblockDescriptor
+		var coldKey *string
 
-		err = miniblocksRow.Scan(&mb.MiniblockNumber, &mb.Data)
+		err = miniblocksRow.Scan(&mb.MiniblockNumber, &mb.Data, &coldKey)
+		if err != nil {
+			return nil, err
+		}
+		if coldKey != nil {
+			mb.ColdKey = *coldKey
+		}
+		mb.Data, err = s.codec.decode(mb.Data)
 		if err != nil {
 			return nil, err
 		}
@@ -1641,6 +2088,10 @@ func (s *PostgresEventStore) debugReadStreamData(
 		if err != nil {
 			return nil, err
 		}
+		e.Data, err = s.codec.decode(e.Data)
+		if err != nil {
+			return nil, err
+		}
 		result.Events = append(result.Events, e)
 	}
 
@@ -1662,6 +2113,10 @@ func (s *PostgresEventStore) debugReadStreamData(
 		if err != nil {
 			return nil, err
 		}
+		data, err = s.codec.decode(data)
+		if err != nil {
+			return nil, err
+		}
 		result.MbCandidates = append(result.MbCandidates, MiniblockDescriptor{
 			MiniblockNumber: num,
 			Data:            data,
@@ -0,0 +1,251 @@
+package storage
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// SetColdStorage enables reads of miniblocks moved to the cold storage.
+// Should be called right after the store is created, before it's used.
+func (s *PostgresEventStore) SetColdStorage(cold ColdStorage) {
+	s.coldStorage = cold
+}
+
+// readColdMiniblocks fills miniblocks at the given indexes with data from the cold storage.
+func (s *PostgresEventStore) readColdMiniblocks(
+	ctx context.Context,
+	miniblocks [][]byte,
+	coldKeys map[int]string,
+) error {
+	for i, key := range coldKeys {
+		data, err := s.readColdMiniblock(ctx, key)
+		if err != nil {
+			return err
+		}
+		miniblocks[i] = data
+	}
+	return nil
+}
+
+// readColdMiniblock returns uncompressed miniblock from the cold storage.
+// Miniblocks are moved to the cold storage as stored in the database, so they may be compressed.
+func (s *PostgresEventStore) readColdMiniblock(ctx context.Context, key string) ([]byte, error) {
+	data, err := readColdMiniblock(ctx, s.coldStorage, key)
+	if err != nil {
+		return nil, err
+	}
+	s.coldReadMiniblocks.Inc()
+	return s.codec.decode(data)
+}
+
+// readColdKeysTx returns cold storage keys of the stream miniblocks with numbers lower than toExclusive.
+func (s *PostgresEventStore) readColdKeysTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	toExclusive int64,
+) ([]string, error) {
+	rows, err := tx.Query(
+		ctx,
+		"SELECT cold_key FROM miniblocks WHERE stream_id = $1 AND seq_num < $2 AND cold_key IS NOT NULL",
+		streamId,
+		toExclusive,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// excludeColdKeysOfMiniblocks removes keys of objects with the same content as the given miniblocks
+// that are written again, so the cold storage mover can't reference an object that is being deleted.
+func (s *PostgresEventStore) excludeColdKeysOfMiniblocks(coldKeys []string, miniblocks []*WriteMiniblockData) []string {
+	if len(coldKeys) == 0 {
+		return coldKeys
+	}
+	written := make(map[string]bool, len(miniblocks))
+	for _, mb := range miniblocks {
+		written[ColdStorageKey(s.codec.encode(mb.Data))] = true
+	}
+	return slices.DeleteFunc(coldKeys, func(key string) bool { return written[key] })
+}
+
+// deleteColdMiniblocks deletes cold storage objects of deleted miniblock rows.
+// Called after the rows are deleted, so rows never reference missing objects.
+// Objects that failed to be deleted are unreferenced and only logged.
+func (s *PostgresEventStore) deleteColdMiniblocks(ctx context.Context, streamId StreamId, keys []string) {
+	if s.coldStorage == nil || len(keys) == 0 {
+		return
+	}
+	for _, key := range keys {
+		if err := s.coldStorage.Delete(ctx, key); err != nil {
+			dlog.FromCtx(ctx).Warn("Failed to delete miniblock from cold storage", "streamId", streamId, "key", key, "error", err)
+		}
+	}
+}
+
+// MoveMiniblocksToColdStorage moves up to maxCount miniblocks of the stream that are older than
+// the latest snapshot to the cold storage and returns number of moved miniblocks.
+// Miniblock rows are kept in the miniblocks table with blockdata set to NULL and
+// cold_key pointing to the cold storage object.
+func (s *PostgresEventStore) MoveMiniblocksToColdStorage(
+	ctx context.Context,
+	streamId StreamId,
+	maxCount int,
+) (int, error) {
+	if s.coldStorage == nil {
+		return 0, RiverError(Err_BAD_CONFIG, "Cold storage is not configured").Func("MoveMiniblocksToColdStorage")
+	}
+
+	var miniblocks []*MiniblockDescriptor
+	err := s.txRunner(
+		ctx,
+		"ReadMiniblocksForColdStorage",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			miniblocks, err = s.readMiniblocksForColdStorageTx(ctx, tx, streamId, maxCount)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil || len(miniblocks) == 0 {
+		return 0, err
+	}
+
+	// Objects are written before rows are updated, so pointer rows always reference existing objects.
+	keys := make([]string, len(miniblocks))
+	for i, mb := range miniblocks {
+		keys[i] = ColdStorageKey(mb.Data)
+		if err := s.coldStorage.Put(ctx, keys[i], mb.Data); err != nil {
+			return 0, AsRiverError(err).Tag("streamId", streamId).Tag("miniblockNum", mb.MiniblockNumber)
+		}
+	}
+
+	var moved int
+	err = s.txRunner(
+		ctx,
+		"MoveMiniblocksToColdStorage",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			moved, err = s.moveMiniblocksToColdStorageTx(ctx, tx, streamId, miniblocks, keys)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil {
+		return 0, err
+	}
+	s.coldMovedMiniblocks.Add(float64(moved))
+	return moved, nil
+}
+
+func (s *PostgresEventStore) readMiniblocksForColdStorageTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	maxCount int,
+) ([]*MiniblockDescriptor, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT seq_num, blockdata FROM miniblocks
+		WHERE stream_id = $1 AND blockdata IS NOT NULL
+			AND seq_num < (SELECT latest_snapshot_miniblock FROM es WHERE stream_id = $1)
+		ORDER BY seq_num LIMIT $2`,
+		streamId,
+		maxCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var miniblocks []*MiniblockDescriptor
+	for rows.Next() {
+		var mb MiniblockDescriptor
+		if err := rows.Scan(&mb.MiniblockNumber, &mb.Data); err != nil {
+			return nil, err
+		}
+		miniblocks = append(miniblocks, &mb)
+	}
+	return miniblocks, rows.Err()
+}
+
+func (s *PostgresEventStore) moveMiniblocksToColdStorageTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	miniblocks []*MiniblockDescriptor,
+	keys []string,
+) (int, error) {
+	moved := 0
+	for i, mb := range miniblocks {
+		tag, err := tx.Exec(
+			ctx,
+			"UPDATE miniblocks SET blockdata = NULL, cold_key = $3 WHERE stream_id = $1 AND seq_num = $2 AND blockdata IS NOT NULL",
+			streamId,
+			mb.MiniblockNumber,
+			keys[i],
+		)
+		if err != nil {
+			return 0, err
+		}
+		moved += int(tag.RowsAffected())
+	}
+	return moved, nil
+}
+
+// StartColdStorageMover periodically moves miniblocks older than the latest snapshot of each stream
+// to the cold storage until ctx is cancelled.
+func (s *PostgresEventStore) StartColdStorageMover(ctx context.Context, interval time.Duration, batchSize int) {
+	go s.runColdStorageMover(ctx, interval, batchSize)
+}
+
+func (s *PostgresEventStore) runColdStorageMover(ctx context.Context, interval time.Duration, batchSize int) {
+	log := dlog.FromCtx(ctx)
+
+	for {
+		select {
+		case <-time.After(interval):
+			streamIds, err := s.GetStreams(ctx)
+			if err != nil {
+				log.Error("ColdStorageMover: failed to list streams", "error", err)
+				continue
+			}
+
+			start := time.Now()
+			total := 0
+			for _, streamId := range streamIds {
+				if ctx.Err() != nil {
+					return
+				}
+				moved, err := s.MoveMiniblocksToColdStorage(ctx, streamId, batchSize)
+				if err != nil {
+					log.Warn("ColdStorageMover: failed to move miniblocks", "streamId", streamId, "error", err)
+					continue
+				}
+				total += moved
+			}
+			log.Info(
+				"ColdStorageMover: moved miniblocks to cold storage",
+				"streams", len(streamIds),
+				"miniblocks", total,
+				"duration", time.Since(start),
+			)
+		case <-ctx.Done():
+			log.Debug("ColdStorageMover: shutdown")
+			return
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestPostgresColdStorage(t *testing.T) {
+	require := require.New(t)
+	ctx, pgEventStore, testParams := setupTest()
+	defer testParams.closer()
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	expected := [][]byte{[]byte("block0")}
+	require.NoError(pgEventStore.CreateStreamStorage(ctx, streamId, expected[0]))
+	for i := int64(1); i < 5; i++ {
+		block := []byte(fmt.Sprintf("block%d", i))
+		hash := common.BytesToHash(block)
+		require.NoError(pgEventStore.WriteBlockProposal(ctx, streamId, hash, i, block))
+		require.NoError(pgEventStore.PromoteBlock(ctx, streamId, i, hash, i == 3, nil))
+		expected = append(expected, block)
+	}
+
+	_, err := pgEventStore.MoveMiniblocksToColdStorage(ctx, streamId, 10)
+	require.Error(err)
+
+	cold, err := NewFilesystemColdStorage(t.TempDir())
+	require.NoError(err)
+	pgEventStore.SetColdStorage(cold)
+
+	// Only miniblocks before the latest snapshot are moved.
+	moved, err := pgEventStore.MoveMiniblocksToColdStorage(ctx, streamId, 2)
+	require.NoError(err)
+	require.Equal(2, moved)
+	moved, err = pgEventStore.MoveMiniblocksToColdStorage(ctx, streamId, 10)
+	require.NoError(err)
+	require.Equal(1, moved)
+	moved, err = pgEventStore.MoveMiniblocksToColdStorage(ctx, streamId, 10)
+	require.NoError(err)
+	require.Zero(moved)
+
+	miniblocks, err := pgEventStore.ReadMiniblocks(ctx, streamId, 0, 5)
+	require.NoError(err)
+	require.Equal(expected, miniblocks)
+
+	miniblocks, err = pgEventStore.ReadMiniblocks(ctx, streamId, 2, 4)
+	require.NoError(err)
+	require.Equal(expected[2:4], miniblocks)
+
+	data, err := pgEventStore.DebugReadStreamData(ctx, streamId)
+	require.NoError(err)
+	require.Len(data.Miniblocks, 5)
+	for i, mb := range data.Miniblocks {
+		require.Equal(expected[i], mb.Data)
+		if i < 3 {
+			require.Equal(ColdStorageKey(expected[i]), mb.ColdKey)
+		} else {
+			require.Empty(mb.ColdKey)
+		}
+	}
+
+	result, err := pgEventStore.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	require.Equal(expected[3:], result.Miniblocks)
+
+	// Pruned and deleted miniblocks are deleted from the cold storage.
+	pruned, err := pgEventStore.PruneMiniblocks(ctx, streamId, 2)
+	require.NoError(err)
+	require.EqualValues(2, pruned)
+	for i := range 2 {
+		_, err = cold.Get(ctx, ColdStorageKey(expected[i]))
+		require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+	}
+	_, err = cold.Get(ctx, ColdStorageKey(expected[2]))
+	require.NoError(err)
+
+	require.NoError(pgEventStore.DeleteStream(ctx, streamId))
+	_, err = cold.Get(ctx, ColdStorageKey(expected[2]))
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+}
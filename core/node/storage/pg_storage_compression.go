@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// EnableCompression turns on zstd compression of miniblocks and envelopes written to the database.
+// Should be called right after the store is created, before it's used.
+func (s *PostgresEventStore) EnableCompression(level int) error {
+	return s.codec.enable(level)
+}
+
+// CompressMiniblocks compresses up to maxCount miniblocks of the stream that were written
+// before compression was enabled and returns number of compressed miniblocks.
+// Minipools and miniblock candidates are short-lived, so they are not rewritten.
+func (s *PostgresEventStore) CompressMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	maxCount int,
+) (int, error) {
+	if !s.codec.enabled() {
+		return 0, nil
+	}
+
+	var compressed int
+	err := s.txRunner(
+		ctx,
+		"CompressMiniblocks",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			compressed, err = s.compressMiniblocksTx(ctx, tx, streamId, maxCount)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return compressed, nil
+}
+
+func (s *PostgresEventStore) compressMiniblocksTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	maxCount int,
+) (int, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT seq_num, blockdata FROM miniblocks
+		WHERE stream_id = $1 AND blockdata IS NOT NULL AND substring(blockdata from 1 for 1) <> '\x00'::bytea
+		ORDER BY seq_num LIMIT $2`,
+		streamId,
+		maxCount,
+	)
+	if err != nil {
+		return 0, err
+	}
+	var miniblocks []*MiniblockDescriptor
+	for rows.Next() {
+		var mb MiniblockDescriptor
+		if err := rows.Scan(&mb.MiniblockNumber, &mb.Data); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		miniblocks = append(miniblocks, &mb)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	compressed := 0
+	for _, mb := range miniblocks {
+		tag, err := tx.Exec(
+			ctx,
+			"UPDATE miniblocks SET blockdata = $3 WHERE stream_id = $1 AND seq_num = $2 AND blockdata IS NOT NULL",
+			streamId,
+			mb.MiniblockNumber,
+			s.codec.encode(mb.Data),
+		)
+		if err != nil {
+			return 0, err
+		}
+		compressed += int(tag.RowsAffected())
+	}
+	return compressed, nil
+}
+
+// StartCompressionRewriter periodically compresses miniblocks written before compression was enabled
+// until ctx is cancelled.
+func (s *PostgresEventStore) StartCompressionRewriter(ctx context.Context, interval time.Duration, batchSize int) {
+	go s.runCompressionRewriter(ctx, interval, batchSize)
+}
+
+func (s *PostgresEventStore) runCompressionRewriter(ctx context.Context, interval time.Duration, batchSize int) {
+	log := dlog.FromCtx(ctx)
+
+	for {
+		select {
+		case <-time.After(interval):
+			streamIds, err := s.GetStreams(ctx)
+			if err != nil {
+				log.Error("CompressionRewriter: failed to list streams", "error", err)
+				continue
+			}
+
+			start := time.Now()
+			total := 0
+			for _, streamId := range streamIds {
+				if ctx.Err() != nil {
+					return
+				}
+				compressed, err := s.CompressMiniblocks(ctx, streamId, batchSize)
+				if err != nil {
+					log.Warn("CompressionRewriter: failed to compress miniblocks", "streamId", streamId, "error", err)
+					continue
+				}
+				total += compressed
+			}
+			if total > 0 {
+				log.Info(
+					"CompressionRewriter: compressed miniblocks",
+					"streams", len(streamIds),
+					"miniblocks", total,
+					"duration", time.Since(start),
+				)
+			}
+		case <-ctx.Done():
+			log.Debug("CompressionRewriter: shutdown")
+			return
+		}
+	}
+}
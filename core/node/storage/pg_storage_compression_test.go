@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestPostgresCompression(t *testing.T) {
+	require := require.New(t)
+	ctx, pgEventStore, testParams := setupTest()
+	defer testParams.closer()
+
+	block := func(i int64) []byte {
+		return bytes.Repeat([]byte(fmt.Sprintf("block%d", i)), 50)
+	}
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	expected := [][]byte{block(0)}
+	require.NoError(pgEventStore.CreateStreamStorage(ctx, streamId, expected[0]))
+
+	// Nothing to rewrite while compression is disabled.
+	compressed, err := pgEventStore.CompressMiniblocks(ctx, streamId, 10)
+	require.NoError(err)
+	require.Zero(compressed)
+
+	require.NoError(pgEventStore.EnableCompression(3))
+
+	for i := int64(1); i < 4; i++ {
+		hash := common.BytesToHash(block(i))
+		require.NoError(pgEventStore.WriteBlockProposal(ctx, streamId, hash, i, block(i)))
+		require.NoError(pgEventStore.PromoteBlock(ctx, streamId, i, hash, false, nil))
+		expected = append(expected, block(i))
+	}
+	require.NoError(pgEventStore.WriteEvent(ctx, streamId, 3, 0, []byte("event")))
+	require.NoError(pgEventStore.WriteArchiveMiniblocks(ctx, streamId, 4, [][]byte{block(4)}))
+	expected = append(expected, block(4))
+
+	miniblocks, err := pgEventStore.ReadMiniblocks(ctx, streamId, 0, 5)
+	require.NoError(err)
+	require.Equal(expected, miniblocks)
+
+	// Only the genesis miniblock was written uncompressed.
+	compressed, err = pgEventStore.CompressMiniblocks(ctx, streamId, 10)
+	require.NoError(err)
+	require.Equal(1, compressed)
+	compressed, err = pgEventStore.CompressMiniblocks(ctx, streamId, 10)
+	require.NoError(err)
+	require.Zero(compressed)
+
+	miniblocks, err = pgEventStore.ReadMiniblocks(ctx, streamId, 0, 5)
+	require.NoError(err)
+	require.Equal(expected, miniblocks)
+
+	result, err := pgEventStore.ReadStreamFromLastSnapshot(ctx, streamId, 0)
+	require.NoError(err)
+	require.Equal(expected, result.Miniblocks)
+	require.Equal([][]byte{[]byte("event")}, result.MinipoolEnvelopes)
+
+	data, err := pgEventStore.DebugReadStreamData(ctx, streamId)
+	require.NoError(err)
+	for i, mb := range data.Miniblocks {
+		require.Equal(expected[i], mb.Data)
+	}
+	require.Len(data.Events, 1)
+	require.Equal([]byte("event"), data.Events[0].Data)
+}
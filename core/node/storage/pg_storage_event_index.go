@@ -0,0 +1,354 @@
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/river-build/river/core/node/base"
+	"github.com/river-build/river/core/node/dlog"
+	. "github.com/river-build/river/core/node/protocol"
+	. "github.com/river-build/river/core/node/shared"
+)
+
+// eventIndexBackfillBatchSize is the max number of miniblocks added to the event index in a single transaction
+// by the event index backfill.
+const eventIndexBackfillBatchSize = 100
+
+func (s *PostgresEventStore) GetEventLocation(ctx context.Context, eventHash common.Hash) (*EventLocation, error) {
+	var location *EventLocation
+	err := s.txRunner(
+		ctx,
+		"GetEventLocation",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			location, err = s.getEventLocationTx(ctx, tx, eventHash)
+			return err
+		},
+		&txRunnerOpts{skipLoggingNotFound: true},
+		"eventHash", eventHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+func (s *PostgresEventStore) getEventLocationTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	eventHash common.Hash,
+) (*EventLocation, error) {
+	var (
+		streamId string
+		location EventLocation
+	)
+	err := tx.QueryRow(
+		ctx,
+		"SELECT stream_id, seq_num, event_index FROM event_index WHERE event_hash = $1",
+		hex.EncodeToString(eventHash.Bytes()), // avoid leading '0x'
+	).Scan(&streamId, &location.MiniblockNum, &location.EventIndex)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, eventNotFoundError(eventHash)
+		}
+		return nil, err
+	}
+	location.StreamId, err = StreamIdFromString(streamId)
+	if err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+// indexMiniblockEventsTx adds events of the given miniblock to the event index.
+func (s *PostgresEventStore) indexMiniblockEventsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	miniblockNum int64,
+	miniblock []byte,
+) error {
+	hashes := miniblockEventHashes(miniblock)
+	if len(hashes) == 0 {
+		return nil
+	}
+	hexHashes := make([]string, len(hashes))
+	for i, hash := range hashes {
+		hexHashes[i] = hex.EncodeToString(hash.Bytes())
+	}
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO event_index (event_hash, stream_id, seq_num, event_index)
+			SELECT h, $2, $3, i - 1 FROM unnest($1::text[]) WITH ORDINALITY AS t(h, i)
+		ON CONFLICT (event_hash) DO UPDATE
+			SET stream_id = EXCLUDED.stream_id, seq_num = EXCLUDED.seq_num, event_index = EXCLUDED.event_index`,
+		hexHashes,
+		streamId,
+		miniblockNum,
+	)
+	return err
+}
+
+// BackfillEventIndex adds events of up to maxCount miniblocks of the stream that were written
+// before the event index was created to the index and returns number of indexed miniblocks.
+// done is true when all miniblocks of the stream are indexed.
+func (s *PostgresEventStore) BackfillEventIndex(
+	ctx context.Context,
+	streamId StreamId,
+	maxCount int,
+) (indexed int, done bool, err error) {
+	var (
+		from       *int64
+		miniblocks []*MiniblockDescriptor
+		coldKeys   map[int]string
+	)
+	err = s.txRunner(
+		ctx,
+		"BackfillEventIndex.Read",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			from, miniblocks, coldKeys, err = s.readEventIndexBackfillTx(ctx, tx, streamId, maxCount)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil || from == nil {
+		return 0, err == nil, err
+	}
+
+	// Miniblocks in the cold storage are fetched outside of the transaction.
+	for i, key := range coldKeys {
+		miniblocks[i].Data, err = s.readColdMiniblock(ctx, key)
+		if err != nil {
+			return 0, false, AsRiverError(err).Tag("streamId", streamId)
+		}
+	}
+
+	err = s.txRunner(
+		ctx,
+		"BackfillEventIndex.Write",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			indexed, done, err = s.writeEventIndexBackfillTx(ctx, tx, streamId, *from, miniblocks, maxCount)
+			return err
+		},
+		nil,
+		"streamId", streamId,
+	)
+	if err != nil {
+		return 0, false, err
+	}
+	return indexed, done, nil
+}
+
+// readEventIndexBackfillTx returns the backfill position of the stream and up to maxCount miniblocks starting
+// from it. Position is nil if the stream is indexed. Miniblocks in the cold storage are returned in coldKeys.
+func (s *PostgresEventStore) readEventIndexBackfillTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	maxCount int,
+) (*int64, []*MiniblockDescriptor, map[int]string, error) {
+	var from *int64
+	err := tx.
+		QueryRow(ctx, "SELECT event_index_backfill_from FROM es WHERE stream_id = $1", streamId).
+		Scan(&from)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil, nil, WrapRiverError(Err_NOT_FOUND, err).Message("stream not found in local storage")
+		}
+		return nil, nil, nil, err
+	}
+	if from == nil {
+		return nil, nil, nil, nil
+	}
+
+	rows, err := tx.Query(
+		ctx,
+		"SELECT seq_num, blockdata, cold_key FROM miniblocks WHERE stream_id = $1 AND seq_num >= $2 ORDER BY seq_num LIMIT $3",
+		streamId,
+		*from,
+		maxCount,
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer rows.Close()
+
+	var (
+		miniblocks []*MiniblockDescriptor
+		coldKeys   map[int]string
+	)
+	for rows.Next() {
+		var (
+			mb      MiniblockDescriptor
+			coldKey *string
+		)
+		if err := rows.Scan(&mb.MiniblockNumber, &mb.Data, &coldKey); err != nil {
+			return nil, nil, nil, err
+		}
+		if mb.Data == nil && coldKey != nil {
+			if coldKeys == nil {
+				coldKeys = make(map[int]string)
+			}
+			coldKeys[len(miniblocks)] = *coldKey
+		} else if mb.Data, err = s.codec.decode(mb.Data); err != nil {
+			return nil, nil, nil, err
+		}
+		miniblocks = append(miniblocks, &mb)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, nil, err
+	}
+	return from, miniblocks, coldKeys, nil
+}
+
+// writeEventIndexBackfillTx indexes events of the miniblocks read from the backfill position from
+// and advances the position. Miniblocks pruned since they were read are skipped.
+func (s *PostgresEventStore) writeEventIndexBackfillTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	from int64,
+	miniblocks []*MiniblockDescriptor,
+	maxCount int,
+) (int, bool, error) {
+	var current *int64
+	err := tx.
+		QueryRow(ctx, "SELECT event_index_backfill_from FROM es WHERE stream_id = $1 FOR UPDATE", streamId).
+		Scan(&current)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, false, WrapRiverError(Err_NOT_FOUND, err).Message("stream not found in local storage")
+		}
+		return 0, false, err
+	}
+	if current == nil || *current != from {
+		// Stream was indexed concurrently.
+		return 0, current == nil, nil
+	}
+
+	var firstSeqNum *int64
+	err = tx.QueryRow(ctx, "SELECT MIN(seq_num) FROM miniblocks WHERE stream_id = $1", streamId).Scan(&firstSeqNum)
+	if err != nil {
+		return 0, false, err
+	}
+
+	indexed := 0
+	for _, mb := range miniblocks {
+		if firstSeqNum == nil || mb.MiniblockNumber < *firstSeqNum {
+			continue
+		}
+		if err := s.indexMiniblockEventsTx(ctx, tx, streamId, mb.MiniblockNumber, mb.Data); err != nil {
+			return 0, false, err
+		}
+		indexed++
+	}
+
+	var next *int64
+	done := len(miniblocks) < maxCount
+	if !done {
+		n := miniblocks[len(miniblocks)-1].MiniblockNumber + 1
+		next = &n
+	}
+	_, err = tx.Exec(ctx, "UPDATE es SET event_index_backfill_from = $2 WHERE stream_id = $1", streamId, next)
+	if err != nil {
+		return 0, false, err
+	}
+	return indexed, done, nil
+}
+
+func (s *PostgresEventStore) getEventIndexBackfillStreamsTx(ctx context.Context, tx pgx.Tx) ([]StreamId, error) {
+	rows, err := tx.Query(ctx, "SELECT stream_id FROM es WHERE event_index_backfill_from IS NOT NULL")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var streams []StreamId
+	for rows.Next() {
+		var streamName string
+		if err := rows.Scan(&streamName); err != nil {
+			return nil, err
+		}
+		streamId, err := StreamIdFromString(streamName)
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, streamId)
+	}
+	return streams, rows.Err()
+}
+
+// StartEventIndexBackfill adds events of miniblocks that were written before the event index was created
+// to the index in the background. Until a stream is indexed, lookups of its older events return NOT_FOUND.
+// Backfill stops when all streams are indexed or ctx is cancelled, streams that failed to be indexed
+// are retried on the next start.
+func (s *PostgresEventStore) StartEventIndexBackfill(ctx context.Context) {
+	go s.runEventIndexBackfill(ctx)
+}
+
+func (s *PostgresEventStore) runEventIndexBackfill(ctx context.Context) {
+	log := dlog.FromCtx(ctx)
+
+	var streamIds []StreamId
+	err := s.txRunner(
+		ctx,
+		"GetEventIndexBackfillStreams",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			streamIds, err = s.getEventIndexBackfillStreamsTx(ctx, tx)
+			return err
+		},
+		nil,
+	)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Error("EventIndexBackfill: failed to list streams", "error", err)
+		}
+		return
+	}
+	if len(streamIds) == 0 {
+		return
+	}
+
+	start := time.Now()
+	total := 0
+	failed := 0
+	for _, streamId := range streamIds {
+		for {
+			if ctx.Err() != nil {
+				log.Debug("EventIndexBackfill: shutdown")
+				return
+			}
+			indexed, done, err := s.BackfillEventIndex(ctx, streamId, eventIndexBackfillBatchSize)
+			if err != nil {
+				if AsRiverError(err).Code != Err_NOT_FOUND {
+					failed++
+					log.Warn("EventIndexBackfill: failed to index stream", "streamId", streamId, "error", err)
+				}
+				break
+			}
+			total += indexed
+			if done {
+				break
+			}
+		}
+	}
+	log.Info(
+		"EventIndexBackfill: indexed miniblocks",
+		"streams", len(streamIds),
+		"failedStreams", failed,
+		"miniblocks", total,
+		"duration", time.Since(start),
+	)
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// streamTablePrefixes are prefixes of per-stream partition tables.
+var streamTablePrefixes = []string{"miniblocks_", "minipools_", "miniblock_candidates_"}
+
+// GetOrphanedStreamTables returns names of per-stream partition tables that don't belong to any stream in the es table.
+// Such tables are left behind if stream creation or deletion was interrupted.
+func (s *PostgresEventStore) GetOrphanedStreamTables(ctx context.Context) ([]string, error) {
+	var tables []string
+	err := s.txRunner(
+		ctx,
+		"GetOrphanedStreamTables",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			tables, err = s.getOrphanedStreamTablesTx(ctx, tx)
+			return err
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+func (s *PostgresEventStore) getOrphanedStreamTablesTx(ctx context.Context, tx pgx.Tx) ([]string, error) {
+	streamIds, err := s.getStreamsTx(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	suffixes := make(map[string]struct{}, len(streamIds))
+	for _, streamId := range streamIds {
+		suffixes[createTableSuffix(streamId)] = struct{}{}
+	}
+
+	rows, err := tx.Query(
+		ctx,
+		`SELECT tablename FROM pg_tables WHERE schemaname = $1
+			AND (tablename LIKE 'miniblocks\_%' OR tablename LIKE 'minipools\_%' OR tablename LIKE 'miniblock\_candidates\_%')
+		ORDER BY tablename`,
+		s.schemaName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		for _, prefix := range streamTablePrefixes {
+			if suffix, ok := strings.CutPrefix(table, prefix); ok && !strings.Contains(suffix, "_") {
+				if _, exists := suffixes[suffix]; !exists {
+					tables = append(tables, table)
+				}
+				break
+			}
+		}
+	}
+	return tables, rows.Err()
+}
+
+// DropOrphanedStreamTables drops per-stream partition tables that don't belong to any stream
+// and returns names of the dropped tables.
+func (s *PostgresEventStore) DropOrphanedStreamTables(ctx context.Context) ([]string, error) {
+	var tables []string
+	err := s.txRunner(
+		ctx,
+		"DropOrphanedStreamTables",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			var err error
+			tables, err = s.getOrphanedStreamTablesTx(ctx, tx)
+			if err != nil {
+				return err
+			}
+			for _, table := range tables {
+				if _, err := tx.Exec(ctx, fmt.Sprintf("DROP TABLE %s", pgx.Identifier{table}.Sanitize())); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
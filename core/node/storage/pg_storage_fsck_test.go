@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/shared"
+	"github.com/river-build/river/core/node/testutils"
+)
+
+func TestPostgresOrphanedStreamTables(t *testing.T) {
+	require := require.New(t)
+	ctx, pgEventStore, testParams := setupTest()
+	defer testParams.closer()
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	orphanId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(pgEventStore.CreateStreamStorage(ctx, streamId, []byte("genesis")))
+	require.NoError(pgEventStore.CreateStreamStorage(ctx, orphanId, []byte("genesis")))
+
+	tables, err := pgEventStore.GetOrphanedStreamTables(ctx)
+	require.NoError(err)
+	require.Empty(tables)
+
+	// Simulate interrupted stream deletion.
+	_, err = pgEventStore.pool.Exec(ctx, "DELETE FROM es WHERE stream_id = $1", orphanId)
+	require.NoError(err)
+
+	suffix := createTableSuffix(orphanId)
+	expected := []string{"miniblock_candidates_" + suffix, "miniblocks_" + suffix, "minipools_" + suffix}
+	tables, err = pgEventStore.GetOrphanedStreamTables(ctx)
+	require.NoError(err)
+	require.Equal(expected, tables)
+
+	dropped, err := pgEventStore.DropOrphanedStreamTables(ctx)
+	require.NoError(err)
+	require.Equal(expected, dropped)
+
+	tables, err = pgEventStore.GetOrphanedStreamTables(ctx)
+	require.NoError(err)
+	require.Empty(tables)
+
+	miniblocks, err := pgEventStore.ReadMiniblocks(ctx, streamId, 0, 1)
+	require.NoError(err)
+	require.Equal([][]byte{[]byte("genesis")}, miniblocks)
+}
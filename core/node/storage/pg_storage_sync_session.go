@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *PostgresEventStore) WriteSyncSession(
+	ctx context.Context,
+	syncId string,
+	session []byte,
+	ttl time.Duration,
+) error {
+	return s.txRunner(
+		ctx,
+		"WriteSyncSession",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				`INSERT INTO sync_sessions (sync_id, session, expires_at) VALUES ($1, $2, $3)
+				ON CONFLICT (sync_id) DO UPDATE SET session = EXCLUDED.session, expires_at = EXCLUDED.expires_at`,
+				syncId,
+				session,
+				time.Now().Add(ttl),
+			)
+			return err
+		},
+		nil,
+		"syncId", syncId,
+	)
+}
+
+func (s *PostgresEventStore) ReadSyncSession(ctx context.Context, syncId string) ([]byte, error) {
+	var session []byte
+	err := s.txRunner(
+		ctx,
+		"ReadSyncSession",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			err := tx.QueryRow(
+				ctx,
+				"SELECT session FROM sync_sessions WHERE sync_id = $1 AND expires_at > NOW()",
+				syncId,
+			).Scan(&session)
+			if err == pgx.ErrNoRows {
+				return syncSessionNotFoundError(syncId)
+			}
+			return err
+		},
+		&txRunnerOpts{skipLoggingNotFound: true},
+		"syncId", syncId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s *PostgresEventStore) DeleteSyncSession(ctx context.Context, syncId string) error {
+	return s.txRunner(
+		ctx,
+		"DeleteSyncSession",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, "DELETE FROM sync_sessions WHERE sync_id = $1", syncId)
+			return err
+		},
+		nil,
+		"syncId", syncId,
+	)
+}
+
+func (s *PostgresEventStore) DeleteExpiredSyncSessions(ctx context.Context) (int, error) {
+	var deleted int
+	err := s.txRunner(
+		ctx,
+		"DeleteExpiredSyncSessions",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			tag, err := tx.Exec(ctx, "DELETE FROM sync_sessions WHERE expires_at <= NOW()")
+			if err != nil {
+				return err
+			}
+			deleted = int(tag.RowsAffected())
+			return nil
+		},
+		nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
@@ -2,14 +2,20 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
 	. "github.com/river-build/river/core/node/shared"
 )
 
 const (
 	StreamStorageTypePostgres = "postgres"
+	StreamStorageTypeEmbedded = "embedded"
+	// StreamStorageTypeMemory keeps streams in memory only, they are lost when the node is stopped.
+	StreamStorageTypeMemory = "memory"
 )
 
 type ReadStreamFromLastSnapshotResult struct {
@@ -24,6 +30,18 @@ type StreamStorage interface {
 	// Minipool is set to generation number 1 (i.e. number of miniblock that is going to be produced next) and is empty.
 	CreateStreamStorage(ctx context.Context, streamId StreamId, genesisMiniblock []byte) error
 
+	// CreateStreamStorageFromSnapshot creates a new stream that starts with the given snapshot miniblock,
+	// as if all preceding miniblocks were pruned. It's used to create replicas of streams that are pruned
+	// on the other replicas.
+	// Last snapshot miniblock index is set to snapshotMiniblockNum.
+	// Minipool is set to generation number snapshotMiniblockNum+1 and is empty.
+	CreateStreamStorageFromSnapshot(
+		ctx context.Context,
+		streamId StreamId,
+		snapshotMiniblockNum int64,
+		snapshotMiniblock []byte,
+	) error
+
 	// Returns all stream blocks starting from last snapshot miniblock index and all envelopes in the given minipool.
 	// TODO: tests with precedingBlockCount > 0
 	ReadStreamFromLastSnapshot(
@@ -33,6 +51,7 @@ type StreamStorage interface {
 	) (*ReadStreamFromLastSnapshotResult, error)
 
 	// Returns miniblocks with miniblockNum or "generation" from fromInclusive, to toExlusive.
+	// Returns MINIBLOCKS_PRUNED if the beginning of the range was pruned from local storage.
 	ReadMiniblocks(ctx context.Context, streamId StreamId, fromInclusive int64, toExclusive int64) ([][]byte, error)
 
 	// Adds event to the given minipool.
@@ -100,6 +119,64 @@ type StreamStorage interface {
 		miniblocks [][]byte,
 	) error
 
+	// GetLastMiniblockNumber returns the number of the last miniblock stored for the given stream.
+	// Returns NOT_FOUND if the stream is not present in local storage.
+	GetLastMiniblockNumber(ctx context.Context, streamId StreamId) (int64, error)
+
+	// WriteMiniblocks appends miniblocks fetched from other replicas during reconciliation.
+	// Number of the first miniblock must be equal to the current minipool generation
+	// and miniblocks must be sequential.
+	// Current minipool is replaced with newMinipoolEnvelopes at generation of the last miniblock + 1,
+	// latest snapshot miniblock index is updated if any of the miniblocks has a snapshot
+	// and candidates up to the last miniblock are deleted.
+	WriteMiniblocks(
+		ctx context.Context,
+		streamId StreamId,
+		miniblocks []*WriteMiniblockData,
+		newMinipoolEnvelopes [][]byte,
+	) error
+
+	// ReplaceStreamStorage deletes all data of the stream if it exists and stores the given miniblocks instead
+	// in a single transaction, so local copy of the stream is either fully replaced or kept as it is.
+	// It's used to rebuild streams from miniblocks fetched from other replicas.
+	// The first miniblock must be either the genesis miniblock or a snapshot miniblock, miniblocks must be sequential.
+	// Latest snapshot miniblock index is set to the last snapshot in the miniblocks.
+	// Minipool is set to generation of the last miniblock + 1 with newMinipoolEnvelopes in slots starting with 0.
+	ReplaceStreamStorage(
+		ctx context.Context,
+		streamId StreamId,
+		miniblocks []*WriteMiniblockData,
+		newMinipoolEnvelopes [][]byte,
+	) error
+
+	// PruneMiniblocks deletes miniblocks with numbers lower than pruneBelow and returns number of deleted miniblocks.
+	// pruneBelow can't be greater than the latest snapshot miniblock number, so the stream can still be loaded.
+	PruneMiniblocks(ctx context.Context, streamId StreamId, pruneBelow int64) (int64, error)
+
+	// DeleteStream deletes all data of the given stream from local storage.
+	DeleteStream(ctx context.Context, streamId StreamId) error
+
+	// GetEventLocation returns the position of the event with the given hash in locally stored miniblocks.
+	// Events are indexed when miniblocks are created, promoted or written, minipool events are not indexed.
+	// Postgres storage indexes miniblocks written before the event index was created in the background,
+	// see PostgresEventStore.StartEventIndexBackfill.
+	// Returns NOT_FOUND if the event is not in the index.
+	GetEventLocation(ctx context.Context, eventHash common.Hash) (*EventLocation, error)
+
+	// WriteSyncSession stores the state of the sync session with the given id replacing the previously stored state.
+	// The session expires after ttl unless it's written again.
+	WriteSyncSession(ctx context.Context, syncId string, session []byte, ttl time.Duration) error
+
+	// ReadSyncSession returns the state of the sync session with the given id.
+	// Returns NOT_FOUND if the session doesn't exist or is expired.
+	ReadSyncSession(ctx context.Context, syncId string) ([]byte, error)
+
+	// DeleteSyncSession deletes the sync session with the given id if it exists.
+	DeleteSyncSession(ctx context.Context, syncId string) error
+
+	// DeleteExpiredSyncSessions deletes the expired sync sessions and returns the number of deleted sessions.
+	DeleteExpiredSyncSessions(ctx context.Context) (int, error)
+
 	DebugReadStreamData(
 		ctx context.Context,
 		streamId StreamId,
@@ -112,6 +189,42 @@ type MiniblockDescriptor struct {
 	MiniblockNumber int64
 	Data            []byte
 	Hash            common.Hash // Only set for miniblock candidates
+	ColdKey         string      // Only set for miniblocks moved to the cold storage
+}
+
+// WriteMiniblockData is a miniblock written to storage by WriteMiniblocks.
+type WriteMiniblockData struct {
+	Number   int64
+	Snapshot bool
+	Data     []byte
+}
+
+// validateReplaceMiniblocks checks that miniblocks passed to ReplaceStreamStorage
+// start with the genesis or a snapshot miniblock and are sequential.
+func validateReplaceMiniblocks(streamId StreamId, miniblocks []*WriteMiniblockData) error {
+	if len(miniblocks) == 0 {
+		return RiverError(Err_INVALID_ARGUMENT, "no miniblocks to write", "streamId", streamId)
+	}
+	if miniblocks[0].Number != 0 && !miniblocks[0].Snapshot {
+		return RiverError(
+			Err_INVALID_ARGUMENT,
+			"first miniblock is neither genesis nor snapshot",
+			"streamId", streamId,
+			"miniblockNum", miniblocks[0].Number,
+		)
+	}
+	for i, mb := range miniblocks {
+		if mb.Number != miniblocks[0].Number+int64(i) {
+			return RiverError(
+				Err_INVALID_ARGUMENT,
+				"miniblock sequence number mismatch",
+				"expectedMiniblockNum", miniblocks[0].Number+int64(i),
+				"actualMiniblockNum", mb.Number,
+				"streamId", streamId,
+			)
+		}
+	}
+	return nil
 }
 
 type EventDescriptor struct {
@@ -0,0 +1,10 @@
+package storage
+
+import (
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+func syncSessionNotFoundError(syncId string) error {
+	return RiverError(Err_NOT_FOUND, "Sync session not found", "syncId", syncId)
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/river-build/river/core/node/base"
+	. "github.com/river-build/river/core/node/protocol"
+)
+
+func TestSyncSessions(t *testing.T) {
+	runStorageTests(t, func(t *testing.T, ctx context.Context, store testStreamStore, _ *storageTestHooks) {
+		require := require.New(t)
+
+		_, err := store.ReadSyncSession(ctx, "sync1")
+		require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+		require.NoError(store.WriteSyncSession(ctx, "sync1", []byte("session1"), time.Minute))
+		require.NoError(store.WriteSyncSession(ctx, "sync2", []byte("session2"), time.Minute))
+		session, err := store.ReadSyncSession(ctx, "sync1")
+		require.NoError(err)
+		require.Equal([]byte("session1"), session)
+
+		// Write replaces the session.
+		require.NoError(store.WriteSyncSession(ctx, "sync1", []byte("session1.1"), time.Minute))
+		session, err = store.ReadSyncSession(ctx, "sync1")
+		require.NoError(err)
+		require.Equal([]byte("session1.1"), session)
+
+		require.NoError(store.DeleteSyncSession(ctx, "sync1"))
+		_, err = store.ReadSyncSession(ctx, "sync1")
+		require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+		require.NoError(store.DeleteSyncSession(ctx, "sync1"))
+
+		// Expired sessions are not returned.
+		require.NoError(store.WriteSyncSession(ctx, "sync3", []byte("session3"), -time.Second))
+		_, err = store.ReadSyncSession(ctx, "sync3")
+		require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+		session, err = store.ReadSyncSession(ctx, "sync2")
+		require.NoError(err)
+		require.Equal([]byte("session2"), session)
+
+		// Only expired sessions are deleted.
+		require.NoError(store.WriteSyncSession(ctx, "sync4", []byte("session4"), -time.Second))
+		deleted, err := store.DeleteExpiredSyncSessions(ctx)
+		require.NoError(err)
+		require.Equal(2, deleted)
+		deleted, err = store.DeleteExpiredSyncSessions(ctx)
+		require.NoError(err)
+		require.Zero(deleted)
+
+		session, err = store.ReadSyncSession(ctx, "sync2")
+		require.NoError(err)
+		require.Equal([]byte("session2"), session)
+	})
+}
@@ -47,6 +47,36 @@ func (_m *MockStreamServiceClient) AddEvent(_a0 context.Context, _a1 *connect.Re
 	return r0, r1
 }
 
+// AddEvents provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) AddEvents(_a0 context.Context, _a1 *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEvents")
+	}
+
+	var r0 *connect.Response[protocol.AddEventsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.AddEventsRequest]) (*connect.Response[protocol.AddEventsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.AddEventsRequest]) *connect.Response[protocol.AddEventsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[protocol.AddEventsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.AddEventsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddStreamToSync provides a mock function with given fields: _a0, _a1
 func (_m *MockStreamServiceClient) AddStreamToSync(_a0 context.Context, _a1 *connect.Request[protocol.AddStreamToSyncRequest]) (*connect.Response[protocol.AddStreamToSyncResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -137,6 +167,36 @@ func (_m *MockStreamServiceClient) CreateStream(_a0 context.Context, _a1 *connec
 	return r0, r1
 }
 
+// GetEvent provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) GetEvent(_a0 context.Context, _a1 *connect.Request[protocol.GetEventRequest]) (*connect.Response[protocol.GetEventResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvent")
+	}
+
+	var r0 *connect.Response[protocol.GetEventResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetEventRequest]) (*connect.Response[protocol.GetEventResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetEventRequest]) *connect.Response[protocol.GetEventResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[protocol.GetEventResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.GetEventRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastMiniblockHash provides a mock function with given fields: _a0, _a1
 func (_m *MockStreamServiceClient) GetLastMiniblockHash(_a0 context.Context, _a1 *connect.Request[protocol.GetLastMiniblockHashRequest]) (*connect.Response[protocol.GetLastMiniblockHashResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -257,6 +317,96 @@ func (_m *MockStreamServiceClient) GetStreamEx(_a0 context.Context, _a1 *connect
 	return r0, r1
 }
 
+// GetStreamHistory provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) GetStreamHistory(_a0 context.Context, _a1 *connect.Request[protocol.GetStreamHistoryRequest]) (*connect.Response[protocol.GetStreamHistoryResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreamHistory")
+	}
+
+	var r0 *connect.Response[protocol.GetStreamHistoryResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetStreamHistoryRequest]) (*connect.Response[protocol.GetStreamHistoryResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetStreamHistoryRequest]) *connect.Response[protocol.GetStreamHistoryResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[protocol.GetStreamHistoryResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.GetStreamHistoryRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStreams provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) GetStreams(_a0 context.Context, _a1 *connect.Request[protocol.GetStreamsRequest]) (*connect.Response[protocol.GetStreamsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreams")
+	}
+
+	var r0 *connect.Response[protocol.GetStreamsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetStreamsRequest]) (*connect.Response[protocol.GetStreamsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetStreamsRequest]) *connect.Response[protocol.GetStreamsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[protocol.GetStreamsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.GetStreamsRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetThread provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) GetThread(_a0 context.Context, _a1 *connect.Request[protocol.GetThreadRequest]) (*connect.Response[protocol.GetThreadResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetThread")
+	}
+
+	var r0 *connect.Response[protocol.GetThreadResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetThreadRequest]) (*connect.Response[protocol.GetThreadResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.GetThreadRequest]) *connect.Response[protocol.GetThreadResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[protocol.GetThreadResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.GetThreadRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Info provides a mock function with given fields: _a0, _a1
 func (_m *MockStreamServiceClient) Info(_a0 context.Context, _a1 *connect.Request[protocol.InfoRequest]) (*connect.Response[protocol.InfoResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -347,6 +497,36 @@ func (_m *MockStreamServiceClient) RemoveStreamFromSync(_a0 context.Context, _a1
 	return r0, r1
 }
 
+// ResumeSync provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) ResumeSync(_a0 context.Context, _a1 *connect.Request[protocol.ResumeSyncRequest]) (*connect.ServerStreamForClient[protocol.SyncStreamsResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeSync")
+	}
+
+	var r0 *connect.ServerStreamForClient[protocol.SyncStreamsResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.ResumeSyncRequest]) (*connect.ServerStreamForClient[protocol.SyncStreamsResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.ResumeSyncRequest]) *connect.ServerStreamForClient[protocol.SyncStreamsResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.ServerStreamForClient[protocol.SyncStreamsResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.ResumeSyncRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SyncStreams provides a mock function with given fields: _a0, _a1
 func (_m *MockStreamServiceClient) SyncStreams(_a0 context.Context, _a1 *connect.Request[protocol.SyncStreamsRequest]) (*connect.ServerStreamForClient[protocol.SyncStreamsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -377,6 +557,36 @@ func (_m *MockStreamServiceClient) SyncStreams(_a0 context.Context, _a1 *connect
 	return r0, r1
 }
 
+// ValidateEvent provides a mock function with given fields: _a0, _a1
+func (_m *MockStreamServiceClient) ValidateEvent(_a0 context.Context, _a1 *connect.Request[protocol.ValidateEventRequest]) (*connect.Response[protocol.ValidateEventResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateEvent")
+	}
+
+	var r0 *connect.Response[protocol.ValidateEventResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.ValidateEventRequest]) (*connect.Response[protocol.ValidateEventResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[protocol.ValidateEventRequest]) *connect.Response[protocol.ValidateEventResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[protocol.ValidateEventResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[protocol.ValidateEventRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewMockStreamServiceClient creates a new instance of MockStreamServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStreamServiceClient(t interface {
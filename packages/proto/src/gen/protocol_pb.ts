@@ -493,6 +493,11 @@ export enum Err {
    * @generated from enum value: DOWNSTREAM_NETWORK_ERROR = 62;
    */
   DOWNSTREAM_NETWORK_ERROR = 62,
+
+  /**
+   * @generated from enum value: MINIBLOCKS_PRUNED = 63;
+   */
+  MINIBLOCKS_PRUNED = 63,
 }
 // Retrieve enum metadata with: proto3.getEnumType(Err)
 proto3.util.setEnumType(Err, "river.Err", [
@@ -559,6 +564,7 @@ proto3.util.setEnumType(Err, "river.Err", [
   { no: 60, name: "MINIPOOL_MISSING_EVENTS" },
   { no: 61, name: "STREAM_LAST_BLOCK_MISMATCH" },
   { no: 62, name: "DOWNSTREAM_NETWORK_ERROR" },
+  { no: 63, name: "MINIBLOCKS_PRUNED" },
 ]);
 
 /**
@@ -744,6 +750,15 @@ export class StreamEvent extends Message<StreamEvent> {
    */
   delegateExpiryEpochMs = protoInt64.zero;
 
+  /**
+   * * Ephemeral events are validated and delivered to the current stream subscribers,
+   * but are not stored and are never included into miniblocks.
+   * Only MemberPayload.Typing and MemberPayload.Presence can be ephemeral, and they can only be ephemeral. 
+   *
+   * @generated from field: bool ephemeral = 7;
+   */
+  ephemeral = false;
+
   /**
    * * Variable-type payload. 
    * Payloads should obey the following rules:
@@ -838,6 +853,7 @@ export class StreamEvent extends Message<StreamEvent> {
     { no: 4, name: "prev_miniblock_hash", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
     { no: 5, name: "created_at_epoch_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 6, name: "delegate_expiry_epoch_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "ephemeral", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 100, name: "miniblock_header", kind: "message", T: MiniblockHeader, oneof: "payload" },
     { no: 101, name: "member_payload", kind: "message", T: MemberPayload, oneof: "payload" },
     { no: 102, name: "space_payload", kind: "message", T: SpacePayload, oneof: "payload" },
@@ -1041,6 +1057,22 @@ export class MemberPayload extends Message<MemberPayload> {
      */
     value: MemberPayload_Unpin;
     case: "unpin";
+  } | {
+    /**
+     * ephemeral only
+     *
+     * @generated from field: river.MemberPayload.Typing typing = 10;
+     */
+    value: MemberPayload_Typing;
+    case: "typing";
+  } | {
+    /**
+     * ephemeral only
+     *
+     * @generated from field: river.MemberPayload.Presence presence = 11;
+     */
+    value: MemberPayload_Presence;
+    case: "presence";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<MemberPayload>) {
@@ -1060,6 +1092,8 @@ export class MemberPayload extends Message<MemberPayload> {
     { no: 7, name: "nft", kind: "message", T: MemberPayload_Nft, oneof: "content" },
     { no: 8, name: "pin", kind: "message", T: MemberPayload_Pin, oneof: "content" },
     { no: 9, name: "unpin", kind: "message", T: MemberPayload_Unpin, oneof: "content" },
+    { no: 10, name: "typing", kind: "message", T: MemberPayload_Typing, oneof: "content" },
+    { no: 11, name: "presence", kind: "message", T: MemberPayload_Presence, oneof: "content" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberPayload {
@@ -1538,6 +1572,92 @@ export class MemberPayload_Unpin extends Message<MemberPayload_Unpin> {
   }
 }
 
+/**
+ * Typing is sent as an ephemeral event while the member is typing a message.
+ *
+ * @generated from message river.MemberPayload.Typing
+ */
+export class MemberPayload_Typing extends Message<MemberPayload_Typing> {
+  /**
+   * @generated from field: bool is_typing = 1;
+   */
+  isTyping = false;
+
+  /**
+   * set if the member is typing a reply in a thread
+   *
+   * @generated from field: optional bytes thread_id = 2;
+   */
+  threadId?: Uint8Array;
+
+  constructor(data?: PartialMessage<MemberPayload_Typing>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.MemberPayload.Typing";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "is_typing", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "thread_id", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberPayload_Typing {
+    return new MemberPayload_Typing().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberPayload_Typing {
+    return new MemberPayload_Typing().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberPayload_Typing {
+    return new MemberPayload_Typing().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MemberPayload_Typing | PlainMessage<MemberPayload_Typing> | undefined, b: MemberPayload_Typing | PlainMessage<MemberPayload_Typing> | undefined): boolean {
+    return proto3.util.equals(MemberPayload_Typing, a, b);
+  }
+}
+
+/**
+ * Presence is sent as an ephemeral event when the presence of the member changes.
+ *
+ * @generated from message river.MemberPayload.Presence
+ */
+export class MemberPayload_Presence extends Message<MemberPayload_Presence> {
+  /**
+   * @generated from field: bool is_online = 1;
+   */
+  isOnline = false;
+
+  constructor(data?: PartialMessage<MemberPayload_Presence>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.MemberPayload.Presence";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "is_online", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberPayload_Presence {
+    return new MemberPayload_Presence().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberPayload_Presence {
+    return new MemberPayload_Presence().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberPayload_Presence {
+    return new MemberPayload_Presence().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MemberPayload_Presence | PlainMessage<MemberPayload_Presence> | undefined, b: MemberPayload_Presence | PlainMessage<MemberPayload_Presence> | undefined): boolean {
+    return proto3.util.equals(MemberPayload_Presence, a, b);
+  }
+}
+
 /**
  * *
  * SpacePayload
@@ -1813,6 +1933,30 @@ export class ChannelPayload extends Message<ChannelPayload> {
      */
     value: ChannelPayload_Redaction;
     case: "redaction";
+  } | {
+    /**
+     * @generated from field: river.MessageEdit edit = 4;
+     */
+    value: MessageEdit;
+    case: "edit";
+  } | {
+    /**
+     * @generated from field: river.Reaction reaction = 5;
+     */
+    value: Reaction;
+    case: "reaction";
+  } | {
+    /**
+     * @generated from field: river.Reaction remove_reaction = 6;
+     */
+    value: Reaction;
+    case: "removeReaction";
+  } | {
+    /**
+     * @generated from field: river.ChannelPayload.ThreadReply thread_reply = 7;
+     */
+    value: ChannelPayload_ThreadReply;
+    case: "threadReply";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<ChannelPayload>) {
@@ -1826,6 +1970,10 @@ export class ChannelPayload extends Message<ChannelPayload> {
     { no: 1, name: "inception", kind: "message", T: ChannelPayload_Inception, oneof: "content" },
     { no: 2, name: "message", kind: "message", T: EncryptedData, oneof: "content" },
     { no: 3, name: "redaction", kind: "message", T: ChannelPayload_Redaction, oneof: "content" },
+    { no: 4, name: "edit", kind: "message", T: MessageEdit, oneof: "content" },
+    { no: 5, name: "reaction", kind: "message", T: Reaction, oneof: "content" },
+    { no: 6, name: "remove_reaction", kind: "message", T: Reaction, oneof: "content" },
+    { no: 7, name: "thread_reply", kind: "message", T: ChannelPayload_ThreadReply, oneof: "content" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChannelPayload {
@@ -1856,6 +2004,27 @@ export class ChannelPayload_Snapshot extends Message<ChannelPayload_Snapshot> {
    */
   inception?: ChannelPayload_Inception;
 
+  /**
+   * latest edits of at most 100 most recently edited messages: sorted by event_id
+   *
+   * @generated from field: repeated river.SnappedMessageEdit edits = 2;
+   */
+  edits: SnappedMessageEdit[] = [];
+
+  /**
+   * message reactions: sorted by event_id
+   *
+   * @generated from field: repeated river.SnappedReactions reactions = 3;
+   */
+  reactions: SnappedReactions[] = [];
+
+  /**
+   * thread summaries: sorted by thread_id
+   *
+   * @generated from field: repeated river.ChannelPayload.ThreadSummary threads = 4;
+   */
+  threads: ChannelPayload_ThreadSummary[] = [];
+
   constructor(data?: PartialMessage<ChannelPayload_Snapshot>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1865,6 +2034,9 @@ export class ChannelPayload_Snapshot extends Message<ChannelPayload_Snapshot> {
   static readonly typeName = "river.ChannelPayload.Snapshot";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "inception", kind: "message", T: ChannelPayload_Inception },
+    { no: 2, name: "edits", kind: "message", T: SnappedMessageEdit, repeated: true },
+    { no: 3, name: "reactions", kind: "message", T: SnappedReactions, repeated: true },
+    { no: 4, name: "threads", kind: "message", T: ChannelPayload_ThreadSummary, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChannelPayload_Snapshot {
@@ -1942,6 +2114,13 @@ export class ChannelPayload_Redaction extends Message<ChannelPayload_Redaction>
    */
   eventId = new Uint8Array(0);
 
+  /**
+   * hash of the thread root message event, must be set if the redacted event is a thread reply
+   *
+   * @generated from field: optional bytes thread_id = 2;
+   */
+  threadId?: Uint8Array;
+
   constructor(data?: PartialMessage<ChannelPayload_Redaction>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1951,6 +2130,7 @@ export class ChannelPayload_Redaction extends Message<ChannelPayload_Redaction>
   static readonly typeName = "river.ChannelPayload.Redaction";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "event_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "thread_id", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChannelPayload_Redaction {
@@ -1970,6 +2150,178 @@ export class ChannelPayload_Redaction extends Message<ChannelPayload_Redaction>
   }
 }
 
+/**
+ * @generated from message river.ChannelPayload.ThreadReply
+ */
+export class ChannelPayload_ThreadReply extends Message<ChannelPayload_ThreadReply> {
+  /**
+   * hash of the thread root message event
+   *
+   * @generated from field: bytes thread_id = 1;
+   */
+  threadId = new Uint8Array(0);
+
+  /**
+   * @generated from field: river.EncryptedData message = 2;
+   */
+  message?: EncryptedData;
+
+  constructor(data?: PartialMessage<ChannelPayload_ThreadReply>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.ChannelPayload.ThreadReply";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "thread_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "message", kind: "message", T: EncryptedData },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChannelPayload_ThreadReply {
+    return new ChannelPayload_ThreadReply().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChannelPayload_ThreadReply {
+    return new ChannelPayload_ThreadReply().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChannelPayload_ThreadReply {
+    return new ChannelPayload_ThreadReply().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChannelPayload_ThreadReply | PlainMessage<ChannelPayload_ThreadReply> | undefined, b: ChannelPayload_ThreadReply | PlainMessage<ChannelPayload_ThreadReply> | undefined): boolean {
+    return proto3.util.equals(ChannelPayload_ThreadReply, a, b);
+  }
+}
+
+/**
+ * @generated from message river.ChannelPayload.ThreadSummary
+ */
+export class ChannelPayload_ThreadSummary extends Message<ChannelPayload_ThreadSummary> {
+  /**
+   * hash of the thread root message event
+   *
+   * @generated from field: bytes thread_id = 1;
+   */
+  threadId = new Uint8Array(0);
+
+  /**
+   * @generated from field: int64 reply_count = 2;
+   */
+  replyCount = protoInt64.zero;
+
+  /**
+   * not set if the last reply is redacted and there are no previous replies in the summary
+   *
+   * @generated from field: bytes last_reply_event_hash = 3;
+   */
+  lastReplyEventHash = new Uint8Array(0);
+
+  /**
+   * @generated from field: int64 last_reply_event_num = 4;
+   */
+  lastReplyEventNum = protoInt64.zero;
+
+  /**
+   * users who replied to the thread: sorted
+   *
+   * @generated from field: repeated bytes participants = 5;
+   */
+  participants: Uint8Array[] = [];
+
+  /**
+   * up to 10 replies before the last reply in the stream order,
+   * the latest of them becomes the last reply when the last reply is redacted
+   *
+   * @generated from field: repeated river.ChannelPayload.ThreadSummary.Reply previous_replies = 6;
+   */
+  previousReplies: ChannelPayload_ThreadSummary_Reply[] = [];
+
+  /**
+   * hashes of redacted replies: sorted, repeated redactions of the same reply don't change the summary
+   *
+   * @generated from field: repeated bytes redacted_replies = 7;
+   */
+  redactedReplies: Uint8Array[] = [];
+
+  constructor(data?: PartialMessage<ChannelPayload_ThreadSummary>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.ChannelPayload.ThreadSummary";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "thread_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "reply_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "last_reply_event_hash", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "last_reply_event_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "participants", kind: "scalar", T: 12 /* ScalarType.BYTES */, repeated: true },
+    { no: 6, name: "previous_replies", kind: "message", T: ChannelPayload_ThreadSummary_Reply, repeated: true },
+    { no: 7, name: "redacted_replies", kind: "scalar", T: 12 /* ScalarType.BYTES */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChannelPayload_ThreadSummary {
+    return new ChannelPayload_ThreadSummary().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChannelPayload_ThreadSummary {
+    return new ChannelPayload_ThreadSummary().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChannelPayload_ThreadSummary {
+    return new ChannelPayload_ThreadSummary().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChannelPayload_ThreadSummary | PlainMessage<ChannelPayload_ThreadSummary> | undefined, b: ChannelPayload_ThreadSummary | PlainMessage<ChannelPayload_ThreadSummary> | undefined): boolean {
+    return proto3.util.equals(ChannelPayload_ThreadSummary, a, b);
+  }
+}
+
+/**
+ * @generated from message river.ChannelPayload.ThreadSummary.Reply
+ */
+export class ChannelPayload_ThreadSummary_Reply extends Message<ChannelPayload_ThreadSummary_Reply> {
+  /**
+   * @generated from field: bytes event_hash = 1;
+   */
+  eventHash = new Uint8Array(0);
+
+  /**
+   * @generated from field: int64 event_num = 2;
+   */
+  eventNum = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ChannelPayload_ThreadSummary_Reply>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.ChannelPayload.ThreadSummary.Reply";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_hash", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "event_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChannelPayload_ThreadSummary_Reply {
+    return new ChannelPayload_ThreadSummary_Reply().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChannelPayload_ThreadSummary_Reply {
+    return new ChannelPayload_ThreadSummary_Reply().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChannelPayload_ThreadSummary_Reply {
+    return new ChannelPayload_ThreadSummary_Reply().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChannelPayload_ThreadSummary_Reply | PlainMessage<ChannelPayload_ThreadSummary_Reply> | undefined, b: ChannelPayload_ThreadSummary_Reply | PlainMessage<ChannelPayload_ThreadSummary_Reply> | undefined): boolean {
+    return proto3.util.equals(ChannelPayload_ThreadSummary_Reply, a, b);
+  }
+}
+
 /**
  * *
  * DmChannelPayload
@@ -1992,6 +2344,24 @@ export class DmChannelPayload extends Message<DmChannelPayload> {
      */
     value: EncryptedData;
     case: "message";
+  } | {
+    /**
+     * @generated from field: river.MessageEdit edit = 4;
+     */
+    value: MessageEdit;
+    case: "edit";
+  } | {
+    /**
+     * @generated from field: river.Reaction reaction = 5;
+     */
+    value: Reaction;
+    case: "reaction";
+  } | {
+    /**
+     * @generated from field: river.Reaction remove_reaction = 6;
+     */
+    value: Reaction;
+    case: "removeReaction";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<DmChannelPayload>) {
@@ -2004,6 +2374,9 @@ export class DmChannelPayload extends Message<DmChannelPayload> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "inception", kind: "message", T: DmChannelPayload_Inception, oneof: "content" },
     { no: 3, name: "message", kind: "message", T: EncryptedData, oneof: "content" },
+    { no: 4, name: "edit", kind: "message", T: MessageEdit, oneof: "content" },
+    { no: 5, name: "reaction", kind: "message", T: Reaction, oneof: "content" },
+    { no: 6, name: "remove_reaction", kind: "message", T: Reaction, oneof: "content" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DmChannelPayload {
@@ -2032,6 +2405,20 @@ export class DmChannelPayload_Snapshot extends Message<DmChannelPayload_Snapshot
    */
   inception?: DmChannelPayload_Inception;
 
+  /**
+   * latest edits of at most 100 most recently edited messages: sorted by event_id
+   *
+   * @generated from field: repeated river.SnappedMessageEdit edits = 2;
+   */
+  edits: SnappedMessageEdit[] = [];
+
+  /**
+   * message reactions: sorted by event_id
+   *
+   * @generated from field: repeated river.SnappedReactions reactions = 3;
+   */
+  reactions: SnappedReactions[] = [];
+
   constructor(data?: PartialMessage<DmChannelPayload_Snapshot>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2041,6 +2428,8 @@ export class DmChannelPayload_Snapshot extends Message<DmChannelPayload_Snapshot
   static readonly typeName = "river.DmChannelPayload.Snapshot";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "inception", kind: "message", T: DmChannelPayload_Inception },
+    { no: 2, name: "edits", kind: "message", T: SnappedMessageEdit, repeated: true },
+    { no: 3, name: "reactions", kind: "message", T: SnappedReactions, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DmChannelPayload_Snapshot {
@@ -2143,6 +2532,24 @@ export class GdmChannelPayload extends Message<GdmChannelPayload> {
      */
     value: EncryptedData;
     case: "channelProperties";
+  } | {
+    /**
+     * @generated from field: river.MessageEdit edit = 4;
+     */
+    value: MessageEdit;
+    case: "edit";
+  } | {
+    /**
+     * @generated from field: river.Reaction reaction = 5;
+     */
+    value: Reaction;
+    case: "reaction";
+  } | {
+    /**
+     * @generated from field: river.Reaction remove_reaction = 6;
+     */
+    value: Reaction;
+    case: "removeReaction";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<GdmChannelPayload>) {
@@ -2156,6 +2563,9 @@ export class GdmChannelPayload extends Message<GdmChannelPayload> {
     { no: 1, name: "inception", kind: "message", T: GdmChannelPayload_Inception, oneof: "content" },
     { no: 2, name: "message", kind: "message", T: EncryptedData, oneof: "content" },
     { no: 3, name: "channel_properties", kind: "message", T: EncryptedData, oneof: "content" },
+    { no: 4, name: "edit", kind: "message", T: MessageEdit, oneof: "content" },
+    { no: 5, name: "reaction", kind: "message", T: Reaction, oneof: "content" },
+    { no: 6, name: "remove_reaction", kind: "message", T: Reaction, oneof: "content" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GdmChannelPayload {
@@ -2189,6 +2599,20 @@ export class GdmChannelPayload_Snapshot extends Message<GdmChannelPayload_Snapsh
    */
   channelProperties?: WrappedEncryptedData;
 
+  /**
+   * latest edits of at most 100 most recently edited messages: sorted by event_id
+   *
+   * @generated from field: repeated river.SnappedMessageEdit edits = 3;
+   */
+  edits: SnappedMessageEdit[] = [];
+
+  /**
+   * message reactions: sorted by event_id
+   *
+   * @generated from field: repeated river.SnappedReactions reactions = 4;
+   */
+  reactions: SnappedReactions[] = [];
+
   constructor(data?: PartialMessage<GdmChannelPayload_Snapshot>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2199,6 +2623,8 @@ export class GdmChannelPayload_Snapshot extends Message<GdmChannelPayload_Snapsh
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "inception", kind: "message", T: GdmChannelPayload_Inception },
     { no: 2, name: "channel_properties", kind: "message", T: WrappedEncryptedData },
+    { no: 3, name: "edits", kind: "message", T: SnappedMessageEdit, repeated: true },
+    { no: 4, name: "reactions", kind: "message", T: SnappedReactions, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GdmChannelPayload_Snapshot {
@@ -3940,44 +4366,306 @@ export class WrappedEncryptedData extends Message<WrappedEncryptedData> {
 }
 
 /**
- * @generated from message river.SyncCookie
+ * *
+ * MessageEdit replaces the content of a message previously added to the same stream.
+ * Only the creator of the message can edit it within the message edit window.
+ *
+ * @generated from message river.MessageEdit
  */
-export class SyncCookie extends Message<SyncCookie> {
-  /**
-   * @generated from field: bytes node_address = 1;
-   */
-  nodeAddress = new Uint8Array(0);
-
-  /**
-   * @generated from field: bytes stream_id = 2;
-   */
-  streamId = new Uint8Array(0);
-
+export class MessageEdit extends Message<MessageEdit> {
   /**
-   * @generated from field: int64 minipool_gen = 3;
+   * hash of the edited message event
+   *
+   * @generated from field: bytes event_id = 1;
    */
-  minipoolGen = protoInt64.zero;
+  eventId = new Uint8Array(0);
 
   /**
-   * @generated from field: int64 minipool_slot = 4;
+   * edited message event, hash of the event must match event_id
+   *
+   * @generated from field: river.StreamEvent event = 2;
    */
-  minipoolSlot = protoInt64.zero;
+  event?: StreamEvent;
 
   /**
-   * @generated from field: bytes prev_miniblock_hash = 5;
+   * new content of the message
+   *
+   * @generated from field: river.EncryptedData message = 3;
    */
-  prevMiniblockHash = new Uint8Array(0);
+  message?: EncryptedData;
 
-  constructor(data?: PartialMessage<SyncCookie>) {
+  constructor(data?: PartialMessage<MessageEdit>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "river.SyncCookie";
+  static readonly typeName = "river.MessageEdit";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "node_address", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 2, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 1, name: "event_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "event", kind: "message", T: StreamEvent },
+    { no: 3, name: "message", kind: "message", T: EncryptedData },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MessageEdit {
+    return new MessageEdit().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MessageEdit {
+    return new MessageEdit().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MessageEdit {
+    return new MessageEdit().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MessageEdit | PlainMessage<MessageEdit> | undefined, b: MessageEdit | PlainMessage<MessageEdit> | undefined): boolean {
+    return proto3.util.equals(MessageEdit, a, b);
+  }
+}
+
+/**
+ * *
+ * SnappedMessageEdit is the latest edit of a message.
+ *
+ * @generated from message river.SnappedMessageEdit
+ */
+export class SnappedMessageEdit extends Message<SnappedMessageEdit> {
+  /**
+   * hash of the edited message event
+   *
+   * @generated from field: bytes event_id = 1;
+   */
+  eventId = new Uint8Array(0);
+
+  /**
+   * latest content of the message
+   *
+   * @generated from field: river.WrappedEncryptedData message = 2;
+   */
+  message?: WrappedEncryptedData;
+
+  constructor(data?: PartialMessage<SnappedMessageEdit>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.SnappedMessageEdit";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "message", kind: "message", T: WrappedEncryptedData },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SnappedMessageEdit {
+    return new SnappedMessageEdit().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SnappedMessageEdit {
+    return new SnappedMessageEdit().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SnappedMessageEdit {
+    return new SnappedMessageEdit().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SnappedMessageEdit | PlainMessage<SnappedMessageEdit> | undefined, b: SnappedMessageEdit | PlainMessage<SnappedMessageEdit> | undefined): boolean {
+    return proto3.util.equals(SnappedMessageEdit, a, b);
+  }
+}
+
+/**
+ * *
+ * Reaction of the event creator to a message, e.g. an emoji.
+ * Adding the same reaction twice or removing a reaction that wasn't added has no effect.
+ *
+ * @generated from message river.Reaction
+ */
+export class Reaction extends Message<Reaction> {
+  /**
+   * hash of the message event
+   *
+   * @generated from field: bytes event_id = 1;
+   */
+  eventId = new Uint8Array(0);
+
+  /**
+   * @generated from field: string reaction = 2;
+   */
+  reaction = "";
+
+  constructor(data?: PartialMessage<Reaction>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.Reaction";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "reaction", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Reaction {
+    return new Reaction().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Reaction {
+    return new Reaction().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Reaction {
+    return new Reaction().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Reaction | PlainMessage<Reaction> | undefined, b: Reaction | PlainMessage<Reaction> | undefined): boolean {
+    return proto3.util.equals(Reaction, a, b);
+  }
+}
+
+/**
+ * *
+ * SnappedReactions aggregates reactions to a message.
+ * Snapshots keep reactions of at most 100 most recently reacted messages and at most 20 reactions per message.
+ *
+ * @generated from message river.SnappedReactions
+ */
+export class SnappedReactions extends Message<SnappedReactions> {
+  /**
+   * hash of the message event
+   *
+   * @generated from field: bytes event_id = 1;
+   */
+  eventId = new Uint8Array(0);
+
+  /**
+   * reactions: sorted by reaction
+   *
+   * @generated from field: repeated river.SnappedReactions.Reaction reactions = 2;
+   */
+  reactions: SnappedReactions_Reaction[] = [];
+
+  /**
+   * event number of the last reaction to the message
+   *
+   * @generated from field: int64 updated_at_event_num = 3;
+   */
+  updatedAtEventNum = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SnappedReactions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.SnappedReactions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "reactions", kind: "message", T: SnappedReactions_Reaction, repeated: true },
+    { no: 3, name: "updated_at_event_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SnappedReactions {
+    return new SnappedReactions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SnappedReactions {
+    return new SnappedReactions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SnappedReactions {
+    return new SnappedReactions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SnappedReactions | PlainMessage<SnappedReactions> | undefined, b: SnappedReactions | PlainMessage<SnappedReactions> | undefined): boolean {
+    return proto3.util.equals(SnappedReactions, a, b);
+  }
+}
+
+/**
+ * @generated from message river.SnappedReactions.Reaction
+ */
+export class SnappedReactions_Reaction extends Message<SnappedReactions_Reaction> {
+  /**
+   * @generated from field: string reaction = 1;
+   */
+  reaction = "";
+
+  /**
+   * users with this reaction: sorted
+   *
+   * @generated from field: repeated bytes user_addresses = 2;
+   */
+  userAddresses: Uint8Array[] = [];
+
+  constructor(data?: PartialMessage<SnappedReactions_Reaction>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.SnappedReactions.Reaction";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reaction", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_addresses", kind: "scalar", T: 12 /* ScalarType.BYTES */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SnappedReactions_Reaction {
+    return new SnappedReactions_Reaction().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SnappedReactions_Reaction {
+    return new SnappedReactions_Reaction().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SnappedReactions_Reaction {
+    return new SnappedReactions_Reaction().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SnappedReactions_Reaction | PlainMessage<SnappedReactions_Reaction> | undefined, b: SnappedReactions_Reaction | PlainMessage<SnappedReactions_Reaction> | undefined): boolean {
+    return proto3.util.equals(SnappedReactions_Reaction, a, b);
+  }
+}
+
+/**
+ * @generated from message river.SyncCookie
+ */
+export class SyncCookie extends Message<SyncCookie> {
+  /**
+   * @generated from field: bytes node_address = 1;
+   */
+  nodeAddress = new Uint8Array(0);
+
+  /**
+   * @generated from field: bytes stream_id = 2;
+   */
+  streamId = new Uint8Array(0);
+
+  /**
+   * @generated from field: int64 minipool_gen = 3;
+   */
+  minipoolGen = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 minipool_slot = 4;
+   */
+  minipoolSlot = protoInt64.zero;
+
+  /**
+   * @generated from field: bytes prev_miniblock_hash = 5;
+   */
+  prevMiniblockHash = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<SyncCookie>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.SyncCookie";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "node_address", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
     { no: 3, name: "minipool_gen", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 4, name: "minipool_slot", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 5, name: "prev_miniblock_hash", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
@@ -4066,6 +4754,30 @@ export class GetStreamExRequest extends Message<GetStreamExRequest> {
    */
   streamId = new Uint8Array(0);
 
+  /**
+   * Number of the first miniblock to return.
+   * Interrupted download can be resumed by setting it to the number of the last received miniblock + 1.
+   *
+   * @generated from field: int64 from_inclusive = 2;
+   */
+  fromInclusive = protoInt64.zero;
+
+  /**
+   * Miniblocks up to, but not including, to_exclusive are returned.
+   * If not set, all miniblocks up to the last one are returned.
+   *
+   * @generated from field: int64 to_exclusive = 3;
+   */
+  toExclusive = protoInt64.zero;
+
+  /**
+   * If set, minipool is returned after the last miniblock of the stream.
+   * Minipool is not returned if to_exclusive is set.
+   *
+   * @generated from field: bool include_minipool = 4;
+   */
+  includeMinipool = false;
+
   constructor(data?: PartialMessage<GetStreamExRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4075,6 +4787,9 @@ export class GetStreamExRequest extends Message<GetStreamExRequest> {
   static readonly typeName = "river.GetStreamExRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "from_inclusive", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "to_exclusive", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "include_minipool", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStreamExRequest {
@@ -4361,183 +5076,1291 @@ export class GetStreamResponse extends Message<GetStreamResponse> {
 }
 
 /**
- * @generated from message river.GetMiniblocksRequest
+ * GetStreamsRequest returns multiple streams at once, the number of stream ids is limited by the node configuration.
+ *
+ * @generated from message river.GetStreamsRequest
  */
-export class GetMiniblocksRequest extends Message<GetMiniblocksRequest> {
+export class GetStreamsRequest extends Message<GetStreamsRequest> {
   /**
-   * @generated from field: bytes stream_id = 1;
+   * @generated from field: repeated bytes stream_ids = 1;
    */
-  streamId = new Uint8Array(0);
+  streamIds: Uint8Array[] = [];
 
   /**
-   * @generated from field: int64 fromInclusive = 2;
+   * if optional is true, streams that don't exist are returned without stream and error
+   *
+   * @generated from field: bool optional = 2;
    */
-  fromInclusive = protoInt64.zero;
+  optional = false;
+
+  constructor(data?: PartialMessage<GetStreamsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetStreamsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_ids", kind: "scalar", T: 12 /* ScalarType.BYTES */, repeated: true },
+    { no: 2, name: "optional", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStreamsRequest {
+    return new GetStreamsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStreamsRequest {
+    return new GetStreamsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStreamsRequest {
+    return new GetStreamsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetStreamsRequest | PlainMessage<GetStreamsRequest> | undefined, b: GetStreamsRequest | PlainMessage<GetStreamsRequest> | undefined): boolean {
+    return proto3.util.equals(GetStreamsRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message river.GetStreamsResponse
+ */
+export class GetStreamsResponse extends Message<GetStreamsResponse> {
   /**
-   * @generated from field: int64 toExclusive = 3;
+   * results contains a result for each stream id in the request order
+   *
+   * @generated from field: repeated river.GetStreamsResponse.Result results = 1;
    */
-  toExclusive = protoInt64.zero;
+  results: GetStreamsResponse_Result[] = [];
 
-  constructor(data?: PartialMessage<GetMiniblocksRequest>) {
+  constructor(data?: PartialMessage<GetStreamsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "river.GetMiniblocksRequest";
+  static readonly typeName = "river.GetStreamsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 2, name: "fromInclusive", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
-    { no: 3, name: "toExclusive", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 1, name: "results", kind: "message", T: GetStreamsResponse_Result, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMiniblocksRequest {
-    return new GetMiniblocksRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStreamsResponse {
+    return new GetStreamsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMiniblocksRequest {
-    return new GetMiniblocksRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStreamsResponse {
+    return new GetStreamsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMiniblocksRequest {
-    return new GetMiniblocksRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStreamsResponse {
+    return new GetStreamsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetMiniblocksRequest | PlainMessage<GetMiniblocksRequest> | undefined, b: GetMiniblocksRequest | PlainMessage<GetMiniblocksRequest> | undefined): boolean {
-    return proto3.util.equals(GetMiniblocksRequest, a, b);
+  static equals(a: GetStreamsResponse | PlainMessage<GetStreamsResponse> | undefined, b: GetStreamsResponse | PlainMessage<GetStreamsResponse> | undefined): boolean {
+    return proto3.util.equals(GetStreamsResponse, a, b);
   }
 }
 
 /**
- * @generated from message river.GetMiniblocksResponse
+ * @generated from message river.GetStreamsResponse.Error
  */
-export class GetMiniblocksResponse extends Message<GetMiniblocksResponse> {
+export class GetStreamsResponse_Error extends Message<GetStreamsResponse_Error> {
   /**
-   * @generated from field: repeated river.Miniblock miniblocks = 1;
+   * @generated from field: river.Err code = 1;
    */
-  miniblocks: Miniblock[] = [];
+  code = Err.ERR_UNSPECIFIED;
+
+  /**
+   * @generated from field: string msg = 2;
+   */
+  msg = "";
+
+  /**
+   * @generated from field: repeated string funcs = 3;
+   */
+  funcs: string[] = [];
+
+  constructor(data?: PartialMessage<GetStreamsResponse_Error>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetStreamsResponse.Error";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "enum", T: proto3.getEnumType(Err) },
+    { no: 2, name: "msg", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "funcs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStreamsResponse_Error {
+    return new GetStreamsResponse_Error().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStreamsResponse_Error {
+    return new GetStreamsResponse_Error().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStreamsResponse_Error {
+    return new GetStreamsResponse_Error().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetStreamsResponse_Error | PlainMessage<GetStreamsResponse_Error> | undefined, b: GetStreamsResponse_Error | PlainMessage<GetStreamsResponse_Error> | undefined): boolean {
+    return proto3.util.equals(GetStreamsResponse_Error, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetStreamsResponse.Result
+ */
+export class GetStreamsResponse_Result extends Message<GetStreamsResponse_Result> {
+  /**
+   * @generated from field: bytes stream_id = 1;
+   */
+  streamId = new Uint8Array(0);
+
+  /**
+   * all events in current minipool and cookie allowing to sync from the end of the stream
+   *
+   * @generated from field: river.StreamAndCookie stream = 2;
+   */
+  stream?: StreamAndCookie;
+
+  /**
+   * set if the stream could not be loaded
+   *
+   * @generated from field: river.GetStreamsResponse.Error error = 3;
+   */
+  error?: GetStreamsResponse_Error;
+
+  constructor(data?: PartialMessage<GetStreamsResponse_Result>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetStreamsResponse.Result";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "stream", kind: "message", T: StreamAndCookie },
+    { no: 3, name: "error", kind: "message", T: GetStreamsResponse_Error },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStreamsResponse_Result {
+    return new GetStreamsResponse_Result().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStreamsResponse_Result {
+    return new GetStreamsResponse_Result().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStreamsResponse_Result {
+    return new GetStreamsResponse_Result().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetStreamsResponse_Result | PlainMessage<GetStreamsResponse_Result> | undefined, b: GetStreamsResponse_Result | PlainMessage<GetStreamsResponse_Result> | undefined): boolean {
+    return proto3.util.equals(GetStreamsResponse_Result, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetMiniblocksRequest
+ */
+export class GetMiniblocksRequest extends Message<GetMiniblocksRequest> {
+  /**
+   * @generated from field: bytes stream_id = 1;
+   */
+  streamId = new Uint8Array(0);
+
+  /**
+   * @generated from field: int64 fromInclusive = 2;
+   */
+  fromInclusive = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 toExclusive = 3;
+   */
+  toExclusive = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetMiniblocksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetMiniblocksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "fromInclusive", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "toExclusive", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMiniblocksRequest {
+    return new GetMiniblocksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMiniblocksRequest {
+    return new GetMiniblocksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMiniblocksRequest {
+    return new GetMiniblocksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMiniblocksRequest | PlainMessage<GetMiniblocksRequest> | undefined, b: GetMiniblocksRequest | PlainMessage<GetMiniblocksRequest> | undefined): boolean {
+    return proto3.util.equals(GetMiniblocksRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetMiniblocksResponse
+ */
+export class GetMiniblocksResponse extends Message<GetMiniblocksResponse> {
+  /**
+   * @generated from field: repeated river.Miniblock miniblocks = 1;
+   */
+  miniblocks: Miniblock[] = [];
+
+  /**
+   * terminus: true if there are no more blocks to fetch because they've been garbage collected, or you've reached block 0
+   *
+   * @generated from field: bool terminus = 2;
+   */
+  terminus = false;
+
+  constructor(data?: PartialMessage<GetMiniblocksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetMiniblocksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "miniblocks", kind: "message", T: Miniblock, repeated: true },
+    { no: 2, name: "terminus", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMiniblocksResponse {
+    return new GetMiniblocksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMiniblocksResponse {
+    return new GetMiniblocksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMiniblocksResponse {
+    return new GetMiniblocksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMiniblocksResponse | PlainMessage<GetMiniblocksResponse> | undefined, b: GetMiniblocksResponse | PlainMessage<GetMiniblocksResponse> | undefined): boolean {
+    return proto3.util.equals(GetMiniblocksResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetEventRequest
+ */
+export class GetEventRequest extends Message<GetEventRequest> {
+  /**
+   * @generated from field: bytes stream_id = 1;
+   */
+  streamId = new Uint8Array(0);
+
+  /**
+   * @generated from field: bytes event_hash = 2;
+   */
+  eventHash = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<GetEventRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetEventRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "event_hash", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEventRequest {
+    return new GetEventRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEventRequest {
+    return new GetEventRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEventRequest {
+    return new GetEventRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetEventRequest | PlainMessage<GetEventRequest> | undefined, b: GetEventRequest | PlainMessage<GetEventRequest> | undefined): boolean {
+    return proto3.util.equals(GetEventRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetEventResponse
+ */
+export class GetEventResponse extends Message<GetEventResponse> {
+  /**
+   * @generated from field: river.Envelope event = 1;
+   */
+  event?: Envelope;
+
+  /**
+   * number of the miniblock the event is included in
+   *
+   * @generated from field: int64 miniblock_num = 2;
+   */
+  miniblockNum = protoInt64.zero;
+
+  /**
+   * number of the event in the stream, see MiniblockHeader.event_num_offset
+   *
+   * @generated from field: int64 event_num = 3;
+   */
+  eventNum = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetEventResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetEventResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event", kind: "message", T: Envelope },
+    { no: 2, name: "miniblock_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "event_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEventResponse {
+    return new GetEventResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEventResponse {
+    return new GetEventResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEventResponse {
+    return new GetEventResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetEventResponse | PlainMessage<GetEventResponse> | undefined, b: GetEventResponse | PlainMessage<GetEventResponse> | undefined): boolean {
+    return proto3.util.equals(GetEventResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetStreamHistoryRequest
+ */
+export class GetStreamHistoryRequest extends Message<GetStreamHistoryRequest> {
+  /**
+   * @generated from field: bytes stream_id = 1;
+   */
+  streamId = new Uint8Array(0);
+
+  /**
+   * Events older than the cursor are returned.
+   * If cursor is not set, paging starts from the last miniblock of the stream.
+   *
+   * @generated from oneof river.GetStreamHistoryRequest.cursor
+   */
+  cursor: {
+    /**
+     * return events with numbers lower than event_num
+     *
+     * @generated from field: int64 event_num = 2;
+     */
+    value: bigint;
+    case: "eventNum";
+  } | {
+    /**
+     * return events from miniblocks with numbers lower than miniblock_num
+     *
+     * @generated from field: int64 miniblock_num = 3;
+     */
+    value: bigint;
+    case: "miniblockNum";
+  } | {
+    /**
+     * return events created before the given time
+     *
+     * @generated from field: int64 created_before_epoch_ms = 4;
+     */
+    value: bigint;
+    case: "createdBeforeEpochMs";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * maximum number of events to return, server default is used if not set
+   *
+   * @generated from field: int32 page_size = 5;
+   */
+  pageSize = 0;
+
+  /**
+   * If not empty, only events of the given payload types are returned.
+   * Type is a payload field name of StreamEvent, e.g. "channel_payload",
+   * optionally followed by a dot and a content field name, e.g. "channel_payload.message".
+   *
+   * @generated from field: repeated string payload_types = 6;
+   */
+  payloadTypes: string[] = [];
+
+  constructor(data?: PartialMessage<GetStreamHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetStreamHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "event_num", kind: "scalar", T: 3 /* ScalarType.INT64 */, oneof: "cursor" },
+    { no: 3, name: "miniblock_num", kind: "scalar", T: 3 /* ScalarType.INT64 */, oneof: "cursor" },
+    { no: 4, name: "created_before_epoch_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */, oneof: "cursor" },
+    { no: 5, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "payload_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStreamHistoryRequest {
+    return new GetStreamHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStreamHistoryRequest {
+    return new GetStreamHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStreamHistoryRequest {
+    return new GetStreamHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetStreamHistoryRequest | PlainMessage<GetStreamHistoryRequest> | undefined, b: GetStreamHistoryRequest | PlainMessage<GetStreamHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetStreamHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message river.StreamHistoryEvent
+ */
+export class StreamHistoryEvent extends Message<StreamHistoryEvent> {
+  /**
+   * @generated from field: river.Envelope event = 1;
+   */
+  event?: Envelope;
+
+  /**
+   * @generated from field: int64 miniblock_num = 2;
+   */
+  miniblockNum = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 event_num = 3;
+   */
+  eventNum = protoInt64.zero;
+
+  constructor(data?: PartialMessage<StreamHistoryEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.StreamHistoryEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "event", kind: "message", T: Envelope },
+    { no: 2, name: "miniblock_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "event_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamHistoryEvent {
+    return new StreamHistoryEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamHistoryEvent {
+    return new StreamHistoryEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamHistoryEvent {
+    return new StreamHistoryEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamHistoryEvent | PlainMessage<StreamHistoryEvent> | undefined, b: StreamHistoryEvent | PlainMessage<StreamHistoryEvent> | undefined): boolean {
+    return proto3.util.equals(StreamHistoryEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetStreamHistoryResponse
+ */
+export class GetStreamHistoryResponse extends Message<GetStreamHistoryResponse> {
+  /**
+   * events in the stream order, i.e. the oldest first
+   *
+   * @generated from field: repeated river.StreamHistoryEvent events = 1;
+   */
+  events: StreamHistoryEvent[] = [];
+
+  /**
+   * pass as event_num cursor to get the next page
+   *
+   * @generated from field: int64 next_event_num = 2;
+   */
+  nextEventNum = protoInt64.zero;
+
+  /**
+   * true if there are no older events on this node: the beginning of the stream is reached
+   * or older miniblocks are pruned and should be read from an archive node
+   *
+   * @generated from field: bool terminus = 3;
+   */
+  terminus = false;
+
+  constructor(data?: PartialMessage<GetStreamHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetStreamHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: StreamHistoryEvent, repeated: true },
+    { no: 2, name: "next_event_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "terminus", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStreamHistoryResponse {
+    return new GetStreamHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStreamHistoryResponse {
+    return new GetStreamHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStreamHistoryResponse {
+    return new GetStreamHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetStreamHistoryResponse | PlainMessage<GetStreamHistoryResponse> | undefined, b: GetStreamHistoryResponse | PlainMessage<GetStreamHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(GetStreamHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetThreadRequest
+ */
+export class GetThreadRequest extends Message<GetThreadRequest> {
+  /**
+   * @generated from field: bytes stream_id = 1;
+   */
+  streamId = new Uint8Array(0);
+
+  /**
+   * hash of the thread root event
+   *
+   * @generated from field: bytes thread_id = 2;
+   */
+  threadId = new Uint8Array(0);
+
+  /**
+   * return replies from miniblocks with numbers greater or equal to from_miniblock_num,
+   * pass next_miniblock_num of the previous response to get the next page
+   *
+   * @generated from field: int64 from_miniblock_num = 3;
+   */
+  fromMiniblockNum = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetThreadRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetThreadRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "thread_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "from_miniblock_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetThreadRequest {
+    return new GetThreadRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetThreadRequest {
+    return new GetThreadRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetThreadRequest {
+    return new GetThreadRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetThreadRequest | PlainMessage<GetThreadRequest> | undefined, b: GetThreadRequest | PlainMessage<GetThreadRequest> | undefined): boolean {
+    return proto3.util.equals(GetThreadRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetThreadResponse
+ */
+export class GetThreadResponse extends Message<GetThreadResponse> {
+  /**
+   * replies in the stream order, replies from the minipool have miniblock_num set to -1
+   *
+   * @generated from field: repeated river.StreamHistoryEvent replies = 1;
+   */
+  replies: StreamHistoryEvent[] = [];
+
+  /**
+   * pass as from_miniblock_num to get the next page
+   *
+   * @generated from field: int64 next_miniblock_num = 2;
+   */
+  nextMiniblockNum = protoInt64.zero;
+
+  /**
+   * true if all replies including the minipool are returned
+   *
+   * @generated from field: bool terminus = 3;
+   */
+  terminus = false;
+
+  constructor(data?: PartialMessage<GetThreadResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetThreadResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "replies", kind: "message", T: StreamHistoryEvent, repeated: true },
+    { no: 2, name: "next_miniblock_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "terminus", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetThreadResponse {
+    return new GetThreadResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetThreadResponse {
+    return new GetThreadResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetThreadResponse {
+    return new GetThreadResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetThreadResponse | PlainMessage<GetThreadResponse> | undefined, b: GetThreadResponse | PlainMessage<GetThreadResponse> | undefined): boolean {
+    return proto3.util.equals(GetThreadResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetLastMiniblockHashRequest
+ */
+export class GetLastMiniblockHashRequest extends Message<GetLastMiniblockHashRequest> {
+  /**
+   * @generated from field: bytes stream_id = 1;
+   */
+  streamId = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<GetLastMiniblockHashRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetLastMiniblockHashRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLastMiniblockHashRequest {
+    return new GetLastMiniblockHashRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLastMiniblockHashRequest {
+    return new GetLastMiniblockHashRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLastMiniblockHashRequest {
+    return new GetLastMiniblockHashRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetLastMiniblockHashRequest | PlainMessage<GetLastMiniblockHashRequest> | undefined, b: GetLastMiniblockHashRequest | PlainMessage<GetLastMiniblockHashRequest> | undefined): boolean {
+    return proto3.util.equals(GetLastMiniblockHashRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message river.GetLastMiniblockHashResponse
+ */
+export class GetLastMiniblockHashResponse extends Message<GetLastMiniblockHashResponse> {
+  /**
+   * @generated from field: bytes hash = 1;
+   */
+  hash = new Uint8Array(0);
+
+  /**
+   * @generated from field: int64 miniblock_num = 2;
+   */
+  miniblockNum = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetLastMiniblockHashResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.GetLastMiniblockHashResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "hash", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "miniblock_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLastMiniblockHashResponse {
+    return new GetLastMiniblockHashResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLastMiniblockHashResponse {
+    return new GetLastMiniblockHashResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLastMiniblockHashResponse {
+    return new GetLastMiniblockHashResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetLastMiniblockHashResponse | PlainMessage<GetLastMiniblockHashResponse> | undefined, b: GetLastMiniblockHashResponse | PlainMessage<GetLastMiniblockHashResponse> | undefined): boolean {
+    return proto3.util.equals(GetLastMiniblockHashResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message river.AddEventRequest
+ */
+export class AddEventRequest extends Message<AddEventRequest> {
+  /**
+   * @generated from field: bytes stream_id = 1;
+   */
+  streamId = new Uint8Array(0);
+
+  /**
+   * @generated from field: river.Envelope event = 2;
+   */
+  event?: Envelope;
+
+  /**
+   * if true, response will contain non nil error if event didn't pass validation
+   *
+   * @generated from field: bool optional = 3;
+   */
+  optional = false;
+
+  constructor(data?: PartialMessage<AddEventRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.AddEventRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "event", kind: "message", T: Envelope },
+    { no: 3, name: "optional", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddEventRequest {
+    return new AddEventRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddEventRequest {
+    return new AddEventRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddEventRequest {
+    return new AddEventRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddEventRequest | PlainMessage<AddEventRequest> | undefined, b: AddEventRequest | PlainMessage<AddEventRequest> | undefined): boolean {
+    return proto3.util.equals(AddEventRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message river.AddEventResponse
+ */
+export class AddEventResponse extends Message<AddEventResponse> {
+  /**
+   * only set if AddEventRequest.optional is true
+   *
+   * @generated from field: river.AddEventResponse.Error error = 1;
+   */
+  error?: AddEventResponse_Error;
+
+  constructor(data?: PartialMessage<AddEventResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.AddEventResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "error", kind: "message", T: AddEventResponse_Error },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddEventResponse {
+    return new AddEventResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddEventResponse {
+    return new AddEventResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddEventResponse {
+    return new AddEventResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddEventResponse | PlainMessage<AddEventResponse> | undefined, b: AddEventResponse | PlainMessage<AddEventResponse> | undefined): boolean {
+    return proto3.util.equals(AddEventResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message river.AddEventResponse.Error
+ */
+export class AddEventResponse_Error extends Message<AddEventResponse_Error> {
+  /**
+   * @generated from field: river.Err code = 1;
+   */
+  code = Err.ERR_UNSPECIFIED;
+
+  /**
+   * @generated from field: string msg = 2;
+   */
+  msg = "";
+
+  /**
+   * @generated from field: repeated string funcs = 3;
+   */
+  funcs: string[] = [];
+
+  constructor(data?: PartialMessage<AddEventResponse_Error>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.AddEventResponse.Error";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "enum", T: proto3.getEnumType(Err) },
+    { no: 2, name: "msg", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "funcs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddEventResponse_Error {
+    return new AddEventResponse_Error().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddEventResponse_Error {
+    return new AddEventResponse_Error().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddEventResponse_Error {
+    return new AddEventResponse_Error().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddEventResponse_Error | PlainMessage<AddEventResponse_Error> | undefined, b: AddEventResponse_Error | PlainMessage<AddEventResponse_Error> | undefined): boolean {
+    return proto3.util.equals(AddEventResponse_Error, a, b);
+  }
+}
+
+/**
+ * ValidateEventRequest evaluates stream rules for the event as AddEvent does, but the event is not added.
+ * If create_stream_events are set, stream creation is evaluated as CreateStream does instead, but the stream is not created.
+ *
+ * @generated from message river.ValidateEventRequest
+ */
+export class ValidateEventRequest extends Message<ValidateEventRequest> {
+  /**
+   * @generated from field: bytes stream_id = 1;
+   */
+  streamId = new Uint8Array(0);
+
+  /**
+   * @generated from field: river.Envelope event = 2;
+   */
+  event?: Envelope;
+
+  /**
+   * @generated from field: repeated river.Envelope create_stream_events = 3;
+   */
+  createStreamEvents: Envelope[] = [];
+
+  /**
+   * @generated from field: map<string, bytes> create_stream_metadata = 4;
+   */
+  createStreamMetadata: { [key: string]: Uint8Array } = {};
+
+  constructor(data?: PartialMessage<ValidateEventRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.ValidateEventRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "event", kind: "message", T: Envelope },
+    { no: 3, name: "create_stream_events", kind: "message", T: Envelope, repeated: true },
+    { no: 4, name: "create_stream_metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateEventRequest {
+    return new ValidateEventRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateEventRequest {
+    return new ValidateEventRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateEventRequest {
+    return new ValidateEventRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidateEventRequest | PlainMessage<ValidateEventRequest> | undefined, b: ValidateEventRequest | PlainMessage<ValidateEventRequest> | undefined): boolean {
+    return proto3.util.equals(ValidateEventRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message river.ValidateEventResponse
+ */
+export class ValidateEventResponse extends Message<ValidateEventResponse> {
+  /**
+   * true if the event would be added by AddEvent, false if it is rejected or would be a no-op.
+   *
+   * @generated from field: bool valid = 1;
+   */
+  valid = false;
+
+  /**
+   * result of the stream rules, false without error if adding the event would be a no-op.
+   *
+   * @generated from field: bool can_add_event = 2;
+   */
+  canAddEvent = false;
+
+  /**
+   * true if no chain auth is required or at least one of chain_auths is entitled.
+   *
+   * @generated from field: bool entitled = 3;
+   */
+  entitled = false;
+
+  /**
+   * set if the event is rejected.
+   *
+   * @generated from field: river.AddEventResponse.Error error = 4;
+   */
+  error?: AddEventResponse_Error;
+
+  /**
+   * checks in the evaluation order.
+   *
+   * @generated from field: repeated river.ValidateEventResponse.Check checks = 5;
+   */
+  checks: ValidateEventResponse_Check[] = [];
+
+  /**
+   * on-chain requirements, at least one of them must be satisfied.
+   *
+   * @generated from field: repeated river.ValidateEventResponse.ChainAuth chain_auths = 6;
+   */
+  chainAuths: ValidateEventResponse_ChainAuth[] = [];
+
+  /**
+   * event that AddEvent would add to another stream before the event.
+   *
+   * @generated from field: river.ValidateEventResponse.DerivedEvent required_parent_event = 7;
+   */
+  requiredParentEvent?: ValidateEventResponse_DerivedEvent;
+
+  /**
+   * event that AddEvent would add to another stream if entitlement checks fail.
+   *
+   * @generated from field: river.ValidateEventResponse.DerivedEvent on_chain_auth_failure_event = 8;
+   */
+  onChainAuthFailureEvent?: ValidateEventResponse_DerivedEvent;
+
+  /**
+   * result of the stream creation rules if create_stream_events are set.
+   *
+   * @generated from field: bool can_create_stream = 9;
+   */
+  canCreateStream = false;
+
+  /**
+   * events that CreateStream would add to other streams after the stream is created.
+   *
+   * @generated from field: repeated river.ValidateEventResponse.DerivedEvent derived_events = 10;
+   */
+  derivedEvents: ValidateEventResponse_DerivedEvent[] = [];
+
+  constructor(data?: PartialMessage<ValidateEventResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.ValidateEventResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "valid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "can_add_event", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "entitled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "error", kind: "message", T: AddEventResponse_Error },
+    { no: 5, name: "checks", kind: "message", T: ValidateEventResponse_Check, repeated: true },
+    { no: 6, name: "chain_auths", kind: "message", T: ValidateEventResponse_ChainAuth, repeated: true },
+    { no: 7, name: "required_parent_event", kind: "message", T: ValidateEventResponse_DerivedEvent },
+    { no: 8, name: "on_chain_auth_failure_event", kind: "message", T: ValidateEventResponse_DerivedEvent },
+    { no: 9, name: "can_create_stream", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "derived_events", kind: "message", T: ValidateEventResponse_DerivedEvent, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateEventResponse {
+    return new ValidateEventResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateEventResponse {
+    return new ValidateEventResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateEventResponse {
+    return new ValidateEventResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidateEventResponse | PlainMessage<ValidateEventResponse> | undefined, b: ValidateEventResponse | PlainMessage<ValidateEventResponse> | undefined): boolean {
+    return proto3.util.equals(ValidateEventResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message river.ValidateEventResponse.Check
+ */
+export class ValidateEventResponse_Check extends Message<ValidateEventResponse_Check> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: bool passed = 2;
+   */
+  passed = false;
+
+  /**
+   * set if the check returned an error
+   *
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ValidateEventResponse_Check>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.ValidateEventResponse.Check";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateEventResponse_Check {
+    return new ValidateEventResponse_Check().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateEventResponse_Check {
+    return new ValidateEventResponse_Check().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateEventResponse_Check {
+    return new ValidateEventResponse_Check().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidateEventResponse_Check | PlainMessage<ValidateEventResponse_Check> | undefined, b: ValidateEventResponse_Check | PlainMessage<ValidateEventResponse_Check> | undefined): boolean {
+    return proto3.util.equals(ValidateEventResponse_Check, a, b);
+  }
+}
+
+/**
+ * @generated from message river.ValidateEventResponse.ChainAuth
+ */
+export class ValidateEventResponse_ChainAuth extends Message<ValidateEventResponse_ChainAuth> {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: bytes space_id = 2;
+   */
+  spaceId = new Uint8Array(0);
+
+  /**
+   * @generated from field: bytes channel_id = 3;
+   */
+  channelId = new Uint8Array(0);
+
+  /**
+   * @generated from field: bytes principal = 4;
+   */
+  principal = new Uint8Array(0);
+
+  /**
+   * @generated from field: string permission = 5;
+   */
+  permission = "";
+
+  /**
+   * @generated from field: bool entitled = 6;
+   */
+  entitled = false;
 
   /**
-   * terminus: true if there are no more blocks to fetch because they've been garbage collected, or you've reached block 0
+   * set if the entitlement check failed
    *
-   * @generated from field: bool terminus = 2;
+   * @generated from field: string error = 7;
    */
-  terminus = false;
+  error = "";
 
-  constructor(data?: PartialMessage<GetMiniblocksResponse>) {
+  constructor(data?: PartialMessage<ValidateEventResponse_ChainAuth>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "river.GetMiniblocksResponse";
+  static readonly typeName = "river.ValidateEventResponse.ChainAuth";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "miniblocks", kind: "message", T: Miniblock, repeated: true },
-    { no: 2, name: "terminus", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "space_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "channel_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "principal", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 5, name: "permission", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "entitled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMiniblocksResponse {
-    return new GetMiniblocksResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateEventResponse_ChainAuth {
+    return new ValidateEventResponse_ChainAuth().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMiniblocksResponse {
-    return new GetMiniblocksResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateEventResponse_ChainAuth {
+    return new ValidateEventResponse_ChainAuth().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMiniblocksResponse {
-    return new GetMiniblocksResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateEventResponse_ChainAuth {
+    return new ValidateEventResponse_ChainAuth().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetMiniblocksResponse | PlainMessage<GetMiniblocksResponse> | undefined, b: GetMiniblocksResponse | PlainMessage<GetMiniblocksResponse> | undefined): boolean {
-    return proto3.util.equals(GetMiniblocksResponse, a, b);
+  static equals(a: ValidateEventResponse_ChainAuth | PlainMessage<ValidateEventResponse_ChainAuth> | undefined, b: ValidateEventResponse_ChainAuth | PlainMessage<ValidateEventResponse_ChainAuth> | undefined): boolean {
+    return proto3.util.equals(ValidateEventResponse_ChainAuth, a, b);
   }
 }
 
 /**
- * @generated from message river.GetLastMiniblockHashRequest
+ * @generated from message river.ValidateEventResponse.DerivedEvent
  */
-export class GetLastMiniblockHashRequest extends Message<GetLastMiniblockHashRequest> {
+export class ValidateEventResponse_DerivedEvent extends Message<ValidateEventResponse_DerivedEvent> {
   /**
    * @generated from field: bytes stream_id = 1;
    */
   streamId = new Uint8Array(0);
 
-  constructor(data?: PartialMessage<GetLastMiniblockHashRequest>) {
+  /**
+   * only payload is set
+   *
+   * @generated from field: river.StreamEvent event = 2;
+   */
+  event?: StreamEvent;
+
+  constructor(data?: PartialMessage<ValidateEventResponse_DerivedEvent>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "river.GetLastMiniblockHashRequest";
+  static readonly typeName = "river.ValidateEventResponse.DerivedEvent";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "event", kind: "message", T: StreamEvent },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLastMiniblockHashRequest {
-    return new GetLastMiniblockHashRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateEventResponse_DerivedEvent {
+    return new ValidateEventResponse_DerivedEvent().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLastMiniblockHashRequest {
-    return new GetLastMiniblockHashRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateEventResponse_DerivedEvent {
+    return new ValidateEventResponse_DerivedEvent().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLastMiniblockHashRequest {
-    return new GetLastMiniblockHashRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateEventResponse_DerivedEvent {
+    return new ValidateEventResponse_DerivedEvent().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetLastMiniblockHashRequest | PlainMessage<GetLastMiniblockHashRequest> | undefined, b: GetLastMiniblockHashRequest | PlainMessage<GetLastMiniblockHashRequest> | undefined): boolean {
-    return proto3.util.equals(GetLastMiniblockHashRequest, a, b);
+  static equals(a: ValidateEventResponse_DerivedEvent | PlainMessage<ValidateEventResponse_DerivedEvent> | undefined, b: ValidateEventResponse_DerivedEvent | PlainMessage<ValidateEventResponse_DerivedEvent> | undefined): boolean {
+    return proto3.util.equals(ValidateEventResponse_DerivedEvent, a, b);
   }
 }
 
 /**
- * @generated from message river.GetLastMiniblockHashResponse
+ * AddEventsRequest adds multiple events to one or more streams.
+ * Events are validated and added in the request order, so events of the same stream
+ * should be ordered in the same way as they would be added by separate AddEvent calls.
+ *
+ * @generated from message river.AddEventsRequest
  */
-export class GetLastMiniblockHashResponse extends Message<GetLastMiniblockHashResponse> {
-  /**
-   * @generated from field: bytes hash = 1;
-   */
-  hash = new Uint8Array(0);
-
+export class AddEventsRequest extends Message<AddEventsRequest> {
   /**
-   * @generated from field: int64 miniblock_num = 2;
+   * @generated from field: repeated river.AddEventsRequest.Event events = 1;
    */
-  miniblockNum = protoInt64.zero;
+  events: AddEventsRequest_Event[] = [];
 
-  constructor(data?: PartialMessage<GetLastMiniblockHashResponse>) {
+  constructor(data?: PartialMessage<AddEventsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "river.GetLastMiniblockHashResponse";
+  static readonly typeName = "river.AddEventsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "hash", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 2, name: "miniblock_num", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 1, name: "events", kind: "message", T: AddEventsRequest_Event, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLastMiniblockHashResponse {
-    return new GetLastMiniblockHashResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddEventsRequest {
+    return new AddEventsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLastMiniblockHashResponse {
-    return new GetLastMiniblockHashResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddEventsRequest {
+    return new AddEventsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLastMiniblockHashResponse {
-    return new GetLastMiniblockHashResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddEventsRequest {
+    return new AddEventsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetLastMiniblockHashResponse | PlainMessage<GetLastMiniblockHashResponse> | undefined, b: GetLastMiniblockHashResponse | PlainMessage<GetLastMiniblockHashResponse> | undefined): boolean {
-    return proto3.util.equals(GetLastMiniblockHashResponse, a, b);
+  static equals(a: AddEventsRequest | PlainMessage<AddEventsRequest> | undefined, b: AddEventsRequest | PlainMessage<AddEventsRequest> | undefined): boolean {
+    return proto3.util.equals(AddEventsRequest, a, b);
   }
 }
 
 /**
- * @generated from message river.AddEventRequest
+ * @generated from message river.AddEventsRequest.Event
  */
-export class AddEventRequest extends Message<AddEventRequest> {
+export class AddEventsRequest_Event extends Message<AddEventsRequest_Event> {
   /**
    * @generated from field: bytes stream_id = 1;
    */
@@ -4548,128 +6371,179 @@ export class AddEventRequest extends Message<AddEventRequest> {
    */
   event?: Envelope;
 
-  /**
-   * if true, response will contain non nil error if event didn't pass validation
-   *
-   * @generated from field: bool optional = 3;
-   */
-  optional = false;
-
-  constructor(data?: PartialMessage<AddEventRequest>) {
+  constructor(data?: PartialMessage<AddEventsRequest_Event>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "river.AddEventRequest";
+  static readonly typeName = "river.AddEventsRequest.Event";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
     { no: 2, name: "event", kind: "message", T: Envelope },
-    { no: 3, name: "optional", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddEventRequest {
-    return new AddEventRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddEventsRequest_Event {
+    return new AddEventsRequest_Event().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddEventRequest {
-    return new AddEventRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddEventsRequest_Event {
+    return new AddEventsRequest_Event().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddEventRequest {
-    return new AddEventRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddEventsRequest_Event {
+    return new AddEventsRequest_Event().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AddEventRequest | PlainMessage<AddEventRequest> | undefined, b: AddEventRequest | PlainMessage<AddEventRequest> | undefined): boolean {
-    return proto3.util.equals(AddEventRequest, a, b);
+  static equals(a: AddEventsRequest_Event | PlainMessage<AddEventsRequest_Event> | undefined, b: AddEventsRequest_Event | PlainMessage<AddEventsRequest_Event> | undefined): boolean {
+    return proto3.util.equals(AddEventsRequest_Event, a, b);
   }
 }
 
 /**
- * @generated from message river.AddEventResponse
+ * @generated from message river.AddEventsResponse
  */
-export class AddEventResponse extends Message<AddEventResponse> {
+export class AddEventsResponse extends Message<AddEventsResponse> {
   /**
-   * only set if AddEventRequest.optional is true
+   * results contains a result for each event in the request order.
+   * Error is set if event was not added.
    *
-   * @generated from field: river.AddEventResponse.Error error = 1;
+   * @generated from field: repeated river.AddEventResponse results = 1;
    */
-  error?: AddEventResponse_Error;
+  results: AddEventResponse[] = [];
 
-  constructor(data?: PartialMessage<AddEventResponse>) {
+  constructor(data?: PartialMessage<AddEventsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "river.AddEventResponse";
+  static readonly typeName = "river.AddEventsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "error", kind: "message", T: AddEventResponse_Error },
+    { no: 1, name: "results", kind: "message", T: AddEventResponse, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddEventResponse {
-    return new AddEventResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddEventsResponse {
+    return new AddEventsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddEventResponse {
-    return new AddEventResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddEventsResponse {
+    return new AddEventsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddEventResponse {
-    return new AddEventResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddEventsResponse {
+    return new AddEventsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AddEventResponse | PlainMessage<AddEventResponse> | undefined, b: AddEventResponse | PlainMessage<AddEventResponse> | undefined): boolean {
-    return proto3.util.equals(AddEventResponse, a, b);
+  static equals(a: AddEventsResponse | PlainMessage<AddEventsResponse> | undefined, b: AddEventsResponse | PlainMessage<AddEventsResponse> | undefined): boolean {
+    return proto3.util.equals(AddEventsResponse, a, b);
   }
 }
 
 /**
- * @generated from message river.AddEventResponse.Error
+ * SyncFilter limits the events that are sent to the client in a streams sync session.
+ * Each payload type is a payload field name of StreamEvent, e.g. "channel_payload",
+ * optionally followed by a dot and a content field name of the payload, e.g. "channel_payload.message".
+ * Miniblocks sent on sync reset and miniblock header events are not filtered,
+ * the client needs the headers to follow the miniblocks of the stream.
+ *
+ * @generated from message river.SyncFilter
  */
-export class AddEventResponse_Error extends Message<AddEventResponse_Error> {
+export class SyncFilter extends Message<SyncFilter> {
   /**
-   * @generated from field: river.Err code = 1;
+   * include_payload_types is the list of payload types to send, all payload types are sent if empty.
+   *
+   * @generated from field: repeated string include_payload_types = 1;
    */
-  code = Err.ERR_UNSPECIFIED;
+  includePayloadTypes: string[] = [];
 
   /**
-   * @generated from field: string msg = 2;
+   * exclude_payload_types is the list of payload types that are not sent.
+   *
+   * @generated from field: repeated string exclude_payload_types = 2;
    */
-  msg = "";
+  excludePayloadTypes: string[] = [];
 
   /**
-   * @generated from field: repeated string funcs = 3;
+   * exclude_creator_addresses is the list of addresses which events are not sent,
+   * e.g. the client's own address.
+   *
+   * @generated from field: repeated bytes exclude_creator_addresses = 3;
    */
-  funcs: string[] = [];
+  excludeCreatorAddresses: Uint8Array[] = [];
 
-  constructor(data?: PartialMessage<AddEventResponse_Error>) {
+  constructor(data?: PartialMessage<SyncFilter>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "river.AddEventResponse.Error";
+  static readonly typeName = "river.SyncFilter";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "code", kind: "enum", T: proto3.getEnumType(Err) },
-    { no: 2, name: "msg", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "funcs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 1, name: "include_payload_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "exclude_payload_types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "exclude_creator_addresses", kind: "scalar", T: 12 /* ScalarType.BYTES */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddEventResponse_Error {
-    return new AddEventResponse_Error().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncFilter {
+    return new SyncFilter().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddEventResponse_Error {
-    return new AddEventResponse_Error().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncFilter {
+    return new SyncFilter().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddEventResponse_Error {
-    return new AddEventResponse_Error().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncFilter {
+    return new SyncFilter().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AddEventResponse_Error | PlainMessage<AddEventResponse_Error> | undefined, b: AddEventResponse_Error | PlainMessage<AddEventResponse_Error> | undefined): boolean {
-    return proto3.util.equals(AddEventResponse_Error, a, b);
+  static equals(a: SyncFilter | PlainMessage<SyncFilter> | undefined, b: SyncFilter | PlainMessage<SyncFilter> | undefined): boolean {
+    return proto3.util.equals(SyncFilter, a, b);
+  }
+}
+
+/**
+ * StreamSyncFilter is a filter for a single stream in the sync session.
+ *
+ * @generated from message river.StreamSyncFilter
+ */
+export class StreamSyncFilter extends Message<StreamSyncFilter> {
+  /**
+   * @generated from field: bytes stream_id = 1;
+   */
+  streamId = new Uint8Array(0);
+
+  /**
+   * @generated from field: river.SyncFilter filter = 2;
+   */
+  filter?: SyncFilter;
+
+  constructor(data?: PartialMessage<StreamSyncFilter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.StreamSyncFilter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stream_id", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "filter", kind: "message", T: SyncFilter },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamSyncFilter {
+    return new StreamSyncFilter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamSyncFilter {
+    return new StreamSyncFilter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamSyncFilter {
+    return new StreamSyncFilter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamSyncFilter | PlainMessage<StreamSyncFilter> | undefined, b: StreamSyncFilter | PlainMessage<StreamSyncFilter> | undefined): boolean {
+    return proto3.util.equals(StreamSyncFilter, a, b);
   }
 }
 
@@ -4686,6 +6560,20 @@ export class SyncStreamsRequest extends Message<SyncStreamsRequest> {
    */
   syncPos: SyncCookie[] = [];
 
+  /**
+   * filter is applied to events of all streams in the sync session.
+   *
+   * @generated from field: optional river.SyncFilter filter = 2;
+   */
+  filter?: SyncFilter;
+
+  /**
+   * stream_filters replace filter for the given streams.
+   *
+   * @generated from field: repeated river.StreamSyncFilter stream_filters = 3;
+   */
+  streamFilters: StreamSyncFilter[] = [];
+
   constructor(data?: PartialMessage<SyncStreamsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4695,6 +6583,8 @@ export class SyncStreamsRequest extends Message<SyncStreamsRequest> {
   static readonly typeName = "river.SyncStreamsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "sync_pos", kind: "message", T: SyncCookie, repeated: true },
+    { no: 2, name: "filter", kind: "message", T: SyncFilter, opt: true },
+    { no: 3, name: "stream_filters", kind: "message", T: StreamSyncFilter, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncStreamsRequest {
@@ -4811,6 +6701,13 @@ export class AddStreamToSyncRequest extends Message<AddStreamToSyncRequest> {
    */
   syncPos?: SyncCookie;
 
+  /**
+   * filter replaces the sync session filter for the stream.
+   *
+   * @generated from field: optional river.SyncFilter filter = 3;
+   */
+  filter?: SyncFilter;
+
   constructor(data?: PartialMessage<AddStreamToSyncRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -4821,6 +6718,7 @@ export class AddStreamToSyncRequest extends Message<AddStreamToSyncRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "sync_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "sync_pos", kind: "message", T: SyncCookie },
+    { no: 3, name: "filter", kind: "message", T: SyncFilter, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddStreamToSyncRequest {
@@ -5101,6 +6999,174 @@ export class PingSyncResponse extends Message<PingSyncResponse> {
   }
 }
 
+/**
+ * ResumeSyncRequest is a request to continue a streams sync session after the client reconnected.
+ * Streams are synced from the positions that were last delivered to the client in the session,
+ * so the client doesn't need to send the sync cookies again.
+ *
+ * @generated from message river.ResumeSyncRequest
+ */
+export class ResumeSyncRequest extends Message<ResumeSyncRequest> {
+  /**
+   * sync_id is the unique id of the sync session.
+   *
+   * @generated from field: string sync_id = 1;
+   */
+  syncId = "";
+
+  constructor(data?: PartialMessage<ResumeSyncRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.ResumeSyncRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sync_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeSyncRequest {
+    return new ResumeSyncRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeSyncRequest {
+    return new ResumeSyncRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeSyncRequest {
+    return new ResumeSyncRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeSyncRequest | PlainMessage<ResumeSyncRequest> | undefined, b: ResumeSyncRequest | PlainMessage<ResumeSyncRequest> | undefined): boolean {
+    return proto3.util.equals(ResumeSyncRequest, a, b);
+  }
+}
+
+/**
+ * SyncCommand is sent in-band by clients of the WebSocket sync transport to change their sync session.
+ * The sync_id fields of the requests are set by the node to the id of the WebSocket sync session.
+ *
+ * @generated from message river.SyncCommand
+ */
+export class SyncCommand extends Message<SyncCommand> {
+  /**
+   * @generated from oneof river.SyncCommand.command
+   */
+  command: {
+    /**
+     * @generated from field: river.AddStreamToSyncRequest add_stream = 1;
+     */
+    value: AddStreamToSyncRequest;
+    case: "addStream";
+  } | {
+    /**
+     * @generated from field: river.RemoveStreamFromSyncRequest remove_stream = 2;
+     */
+    value: RemoveStreamFromSyncRequest;
+    case: "removeStream";
+  } | {
+    /**
+     * @generated from field: river.PingSyncRequest ping = 3;
+     */
+    value: PingSyncRequest;
+    case: "ping";
+  } | {
+    /**
+     * @generated from field: river.CancelSyncRequest cancel = 4;
+     */
+    value: CancelSyncRequest;
+    case: "cancel";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<SyncCommand>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.SyncCommand";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "add_stream", kind: "message", T: AddStreamToSyncRequest, oneof: "command" },
+    { no: 2, name: "remove_stream", kind: "message", T: RemoveStreamFromSyncRequest, oneof: "command" },
+    { no: 3, name: "ping", kind: "message", T: PingSyncRequest, oneof: "command" },
+    { no: 4, name: "cancel", kind: "message", T: CancelSyncRequest, oneof: "command" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncCommand {
+    return new SyncCommand().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncCommand {
+    return new SyncCommand().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncCommand {
+    return new SyncCommand().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SyncCommand | PlainMessage<SyncCommand> | undefined, b: SyncCommand | PlainMessage<SyncCommand> | undefined): boolean {
+    return proto3.util.equals(SyncCommand, a, b);
+  }
+}
+
+/**
+ * SyncSession is the state of a streams sync session that is stored by the node, so the session can be resumed
+ * with ResumeSync after the client reconnected or the node restarted.
+ *
+ * @generated from message river.SyncSession
+ */
+export class SyncSession extends Message<SyncSession> {
+  /**
+   * sync_pos is the list of streams in the session and positions last delivered to the client.
+   *
+   * @generated from field: repeated river.SyncCookie sync_pos = 1;
+   */
+  syncPos: SyncCookie[] = [];
+
+  /**
+   * filter is the filter of the sync session.
+   *
+   * @generated from field: optional river.SyncFilter filter = 2;
+   */
+  filter?: SyncFilter;
+
+  /**
+   * stream_filters are the filters of individual streams in the sync session.
+   *
+   * @generated from field: repeated river.StreamSyncFilter stream_filters = 3;
+   */
+  streamFilters: StreamSyncFilter[] = [];
+
+  constructor(data?: PartialMessage<SyncSession>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "river.SyncSession";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sync_pos", kind: "message", T: SyncCookie, repeated: true },
+    { no: 2, name: "filter", kind: "message", T: SyncFilter, opt: true },
+    { no: 3, name: "stream_filters", kind: "message", T: StreamSyncFilter, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncSession {
+    return new SyncSession().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncSession {
+    return new SyncSession().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncSession {
+    return new SyncSession().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SyncSession | PlainMessage<SyncSession> | undefined, b: SyncSession | PlainMessage<SyncSession> | undefined): boolean {
+    return proto3.util.equals(SyncSession, a, b);
+  }
+}
+
 /**
  * @generated from message river.InfoRequest
  */
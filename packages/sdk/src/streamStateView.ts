@@ -103,6 +103,9 @@ export class StreamStateView implements IStreamStateView {
     prevSnapshotMiniblockNum: bigint
     miniblockInfo?: { max: bigint; min: bigint; terminusReached: boolean }
     syncCookie?: SyncCookie
+    // set if the stream is synced with a sync filter: events filtered out by the node
+    // are not received, but miniblock headers still reference them.
+    syncFiltered = false
 
     // membership content
     membershipContent: StreamStateView_Members
@@ -414,7 +417,11 @@ export class StreamStateView implements IStreamStateView {
             const eventId = bin_toHexString(eventHashes[i])
             const event = this.events.get(eventId)
             if (!event) {
-                logError(`Mininblock event not found ${eventId}`) // aellis this is pretty serious
+                if (this.syncFiltered) {
+                    log(`Miniblock event was filtered out ${eventId}`)
+                } else {
+                    logError(`Mininblock event not found ${eventId}`) // aellis this is pretty serious
+                }
                 continue
             }
             event.miniblockNum = header.miniblockNum
@@ -60,6 +60,12 @@ export class StreamStateView_Channel extends StreamStateView_AbstractContent {
                 break
             case 'redaction':
                 break
+            case 'edit':
+            case 'reaction':
+            case 'removeReaction':
+            case 'threadReply':
+                // nothing to do, edits, reactions and threads are summarized in the snapshot
+                break
             case undefined:
                 break
             default:
@@ -92,6 +98,12 @@ export class StreamStateView_Channel extends StreamStateView_AbstractContent {
                 break
             case 'redaction':
                 break
+            case 'edit':
+            case 'reaction':
+            case 'removeReaction':
+            case 'threadReply':
+                // nothing to do, edits, reactions and threads are summarized in the snapshot
+                break
             case undefined:
                 break
             default:
@@ -60,6 +60,11 @@ export class StreamStateView_DMChannel extends StreamStateView_AbstractContent {
                 this.updateLastEvent(event.remoteEvent, stateEmitter)
                 break
 
+            case 'edit':
+            case 'reaction':
+            case 'removeReaction':
+                // nothing to do, edits and reactions are summarized in the snapshot
+                break
             case undefined:
                 break
             default:
@@ -89,6 +94,11 @@ export class StreamStateView_DMChannel extends StreamStateView_AbstractContent {
                     encryptionEmitter,
                 )
                 break
+            case 'edit':
+            case 'reaction':
+            case 'removeReaction':
+                // nothing to do, edits and reactions are summarized in the snapshot
+                break
             case undefined:
                 break
             default:
@@ -65,6 +65,11 @@ export class StreamStateView_GDMChannel extends StreamStateView_AbstractContent
             case 'channelProperties':
                 // nothing to do, conveyed in the snapshot
                 break
+            case 'edit':
+            case 'reaction':
+            case 'removeReaction':
+                // nothing to do, edits and reactions are summarized in the snapshot
+                break
             case undefined:
                 break
             default:
@@ -97,6 +102,11 @@ export class StreamStateView_GDMChannel extends StreamStateView_AbstractContent
             case 'channelProperties':
                 this.channelMetadata.appendEvent(event, cleartext, encryptionEmitter)
                 break
+            case 'edit':
+            case 'reaction':
+            case 'removeReaction':
+                // nothing to do, edits and reactions are summarized in the snapshot
+                break
             case undefined:
                 break
             default: